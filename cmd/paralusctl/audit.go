@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	auditLogPath    = "/event/v1/auditlog"
+	auditVerifyPath = "/event/v1/auditlog/verify"
+	relayAuditPath  = "/event/v1/audit/relay"
+	sessionsPath    = "/event/v1/audit/relay/sessions"
+)
+
+// stringList is a flag which can be repeated or comma separated
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*l = append(*l, s)
+		}
+	}
+	return nil
+}
+
+func cmdAudit(a *app, args []string) error {
+	if len(args) > 0 && args[0] == "verify" {
+		return cmdAuditVerify(a, args[1:])
+	}
+	if len(args) > 0 && args[0] == "sessions" {
+		return cmdAuditSessions(a, args[1:])
+	}
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+	relay := fs.Bool("relay", false, "Query kubectl (relay) audit logs instead of system audit logs")
+	since := fs.String("since", "1h", "Return events newer than this, e.g. 30m, 24h, 7d")
+	typ := fs.String("type", "", "Event type")
+	user := fs.String("user", "", "Username")
+	client := fs.String("client", "", "Client type")
+	portal := fs.String("portal", "", "Portal")
+	cluster := fs.String("cluster", "", "Cluster")
+	namespace := fs.String("namespace", "", "Namespace")
+	kind := fs.String("kind", "", "Kubernetes kind")
+	method := fs.String("method", "", "HTTP method")
+	search := fs.String("q", "", "Query, e.g. 'user:bob AND NOT cluster:prod*'")
+	from := fs.String("from", "", "Return events at or after this RFC3339 time instead of -since")
+	to := fs.String("to", "", "Return events before this RFC3339 time")
+	limit := fs.Int("limit", 0, "Number of events per page (default 500)")
+	pageToken := fs.String("page-token", "", "Page token printed by the previous query")
+	var projects, clusters stringList
+	fs.Var(&projects, "project", "Project, can be repeated (default project in config)")
+	fs.Var(&clusters, "clusters", "Clusters any of which events belong to, can be repeated")
+	fs.Parse(args)
+	for _, t := range []string{*from, *to} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return fmt.Errorf("invalid time %q, expected RFC3339: %w", t, err)
+		}
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if len(projects) == 0 && c.p.Project != "" {
+		projects = stringList{c.p.Project}
+	}
+
+	q := url.Values{}
+	set := func(k, v string) {
+		if v != "" {
+			q.Set("filter."+k, v)
+		}
+	}
+	if *from == "" && *to == "" {
+		set("timefrom", "now-"+*since)
+	}
+	set("from", *from)
+	set("to", *to)
+	if *limit > 0 {
+		set("pageSize", strconv.Itoa(*limit))
+	}
+	set("pageToken", *pageToken)
+	set("type", *typ)
+	set("user", *user)
+	set("client", *client)
+	set("portal", *portal)
+	set("cluster", *cluster)
+	set("namespace", *namespace)
+	set("kind", *kind)
+	set("method", *method)
+	set("queryString", *search)
+	for _, p := range projects {
+		q.Add("filter.projects", p)
+	}
+	for _, cl := range clusters {
+		q.Add("filter.clusters", cl)
+	}
+
+	path := auditLogPath
+	if *relay {
+		path = relayAuditPath
+	}
+	b, err := c.do(context.Background(), http.MethodGet, path, q, nil)
+	if err != nil {
+		return err
+	}
+	// only the search result is of interest
+	var res struct {
+		Result        json.RawMessage `json:"result"`
+		NextPageToken string          `json:"nextPageToken"`
+	}
+	if err := json.Unmarshal(b, &res); err == nil && len(res.Result) > 0 {
+		b = res.Result
+	}
+	if res.NextPageToken != "" {
+		fmt.Fprintf(os.Stderr, "More events, continue with -page-token %s\n", res.NextPageToken)
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// cmdAuditSessions prints the kubectl sessions of users reconstructed from
+// the relay audits and kubectl commands
+func cmdAuditSessions(a *app, args []string) error {
+	fs := flag.NewFlagSet("audit sessions", flag.ExitOnError)
+	since := fs.String("since", "24h", "Return sessions newer than this, e.g. 30m, 24h, 7d")
+	from := fs.String("from", "", "Return sessions at or after this RFC3339 time instead of -since")
+	to := fs.String("to", "", "Return sessions before this RFC3339 time")
+	user := fs.String("user", "", "Username")
+	gap := fs.String("gap", "", "Idle time which splits sessions, e.g. 30m (default 15m)")
+	var projects, clusters stringList
+	fs.Var(&projects, "project", "Project, can be repeated (default project in config)")
+	fs.Var(&clusters, "clusters", "Clusters any of which sessions belong to, can be repeated")
+	fs.Parse(args)
+	for _, t := range []string{*from, *to} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return fmt.Errorf("invalid time %q, expected RFC3339: %w", t, err)
+		}
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if len(projects) == 0 && c.p.Project != "" {
+		projects = stringList{c.p.Project}
+	}
+
+	q := url.Values{}
+	set := func(k, v string) {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	if *from == "" && *to == "" {
+		set("filter.timefrom", "now-"+*since)
+	}
+	set("filter.from", *from)
+	set("filter.to", *to)
+	set("filter.user", *user)
+	set("idleGap", *gap)
+	for _, p := range projects {
+		q.Add("filter.projects", p)
+	}
+	for _, cl := range clusters {
+		q.Add("filter.clusters", cl)
+	}
+
+	b, err := c.do(context.Background(), http.MethodGet, sessionsPath, q, nil)
+	if err != nil {
+		return err
+	}
+	var res struct {
+		Truncated bool `json:"truncated"`
+	}
+	if err := json.Unmarshal(b, &res); err == nil && res.Truncated {
+		fmt.Fprintln(os.Stderr, "Too many events, the oldest sessions are missing, narrow the time range")
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// cmdAuditVerify walks the audit hash chain over a time range, it fails
+// when gaps or tampered events are reported
+func cmdAuditVerify(a *app, args []string) error {
+	fs := flag.NewFlagSet("audit verify", flag.ExitOnError)
+	from := fs.String("from", "", "Verify events at or after this RFC3339 time (default first event)")
+	to := fs.String("to", "", "Verify events before this RFC3339 time (default now)")
+	fs.Parse(args)
+
+	q := url.Values{}
+	for k, t := range map[string]string{"from": *from, "to": *to} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return fmt.Errorf("invalid time %q, expected RFC3339: %w", t, err)
+		}
+		q.Set(k, t)
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	b, err := c.do(context.Background(), http.MethodGet, auditVerifyPath, q, nil)
+	if err != nil {
+		return err
+	}
+	if err := printJSON(a.out, a.output, b); err != nil {
+		return err
+	}
+	var res struct {
+		Verified bool `json:"verified"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if !res.Verified {
+		return fmt.Errorf("audit log verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/paralus/paralus/pkg/gateway"
+)
+
+// client talks to the paralus REST gateway on behalf of the user in
+// profile
+type client struct {
+	endpoint *url.URL
+	p        *profile
+	hc       *http.Client
+	// sessionToken is the kratos session token, only used during login
+	// to retrieve an api key
+	sessionToken string
+}
+
+// apiError is the error returned by the gateway for a failed request
+type apiError struct {
+	Status  int
+	Message string
+}
+
+func (e *apiError) Error() string {
+	if e.Message == "" {
+		return http.StatusText(e.Status)
+	}
+	return fmt.Sprintf("%s: %s", http.StatusText(e.Status), e.Message)
+}
+
+// parseEndpoint accepts endpoints with or without scheme, the dashboard
+// hands out host:port
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: no host", endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u, nil
+}
+
+func newClient(p *profile) (*client, error) {
+	u, err := parseEndpoint(p.RestEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		endpoint: u,
+		p:        p,
+		hc:       &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+// apiToken returns the token expected along with an api key, checksum
+// of the api secret
+func apiToken(secret string) string {
+	sum := md5.Sum([]byte(secret))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// headers returns the authentication headers for the profile, session
+// token takes precedence over api key
+func (c *client) headers() map[string]string {
+	h := map[string]string{}
+	switch {
+	case c.sessionToken != "":
+		h[gateway.GatewayAPIKey] = c.sessionToken
+	case c.p.ApiKey != "":
+		h[gateway.APIKey] = c.p.ApiKey
+		h[gateway.APIKeyToken] = apiToken(c.p.ApiSecret)
+	}
+	return h
+}
+
+// do sends request to path on the gateway and returns the response
+// body; body, when not nil, is sent as JSON
+func (c *client) do(ctx context.Context, method, path string, query url.Values, body interface{}) ([]byte, error) {
+	u := *c.endpoint
+	u.Path = c.endpoint.Path + path
+	u.RawQuery = query.Encode()
+
+	var rb []byte
+	if body != nil {
+		var err error
+		if rb, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", "paralusctl")
+	for k, v := range c.headers() {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		e := &apiError{Status: resp.StatusCode}
+		var eb struct {
+			Err     string `json:"error"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(b, &eb) == nil {
+			e.Message = eb.Err
+			if e.Message == "" {
+				e.Message = eb.Message
+			}
+		}
+		return nil, e
+	}
+	return b, nil
+}
+
+// transport returns a transport for the generated clients in
+// api/def/clients
+func (c *client) transport() runtime.ClientTransport {
+	basePath := c.endpoint.Path
+	if basePath == "" {
+		basePath = "/"
+	}
+	t := httptransport.New(c.endpoint.Host, basePath, []string{c.endpoint.Scheme})
+	t.Transport = c.hc.Transport
+	if t.Transport == nil {
+		t.Transport = http.DefaultTransport
+	}
+	return t
+}
+
+// authInfo authenticates requests made using the generated clients
+func (c *client) authInfo() runtime.ClientAuthInfoWriter {
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		for k, v := range c.headers() {
+			if err := r.SetHeaderParam(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
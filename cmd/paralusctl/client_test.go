@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	tt := []struct {
+		endpoint string
+		expected string
+		err      bool
+	}{
+		{"console.paralus.dev:443", "https://console.paralus.dev:443", false},
+		{"http://localhost:11000/", "http://localhost:11000", false},
+		{"https://example.com/paralus", "https://example.com/paralus", false},
+		{"http://", "", true},
+	}
+	for _, tc := range tt {
+		u, err := parseEndpoint(tc.endpoint)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected error", tc.endpoint)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", tc.endpoint, err)
+			continue
+		}
+		if u.String() != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.endpoint, tc.expected, u)
+		}
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(gateway.APIKey) != "key" || r.Header.Get(gateway.APIKeyToken) != apiToken("secret") {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"invalid api key"}`))
+			return
+		}
+		if r.URL.Path != "/auth/v3/users" || r.URL.Query().Get("partner") != "p" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"kind":"UserList"}`))
+	}))
+	defer srv.Close()
+
+	p := &profile{RestEndpoint: srv.URL, ApiKey: "key", ApiSecret: "secret"}
+	c, err := newClient(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.do(context.Background(), http.MethodGet, "/auth/v3/users", url.Values{"partner": {"p"}}, nil)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if string(b) != `{"kind":"UserList"}` {
+		t.Errorf("unexpected response %s", b)
+	}
+
+	c.p = &common.CliConfigDownloadData{RestEndpoint: srv.URL, ApiKey: "key", ApiSecret: "wrong"}
+	_, err = c.do(context.Background(), http.MethodGet, "/auth/v3/users", nil, nil)
+	var ae *apiError
+	if !errors.As(err, &ae) || ae.Status != http.StatusUnauthorized || ae.Message != "invalid api key" {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestClientSessionToken(t *testing.T) {
+	c, err := newClient(&profile{RestEndpoint: "localhost", ApiKey: "key", ApiSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.sessionToken = "session"
+	h := c.headers()
+	if h[gateway.GatewayAPIKey] != "session" || h[gateway.APIKey] != "" {
+		t.Errorf("expected only session token, got %v", h)
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/paralus/paralus/api/def/clients/sentry/client/kubectl_cluster_settings"
+	"github.com/paralus/paralus/api/def/clients/sentry/models"
+)
+
+// cmdCluster handles cluster specific actions and falls back to the
+// generic resource actions
+func cmdCluster(a *app, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "bootstrap":
+			return clusterBootstrap(a, args[1:])
+		case "settings":
+			return clusterSettings(a, args[1:])
+		case "reset-registration":
+			return clusterAction(a, "cluster", "reset-registration", "/registration/reset", args[1:])
+		case "rotate-token":
+			return clusterAction(a, "cluster", "rotate-token", "/token/rotate", args[1:])
+		case "revoke-sessions":
+			return clusterAction(a, "cluster", "revoke-sessions", "/sessions/revoke", args[1:])
+		case "approve", "reject":
+			return clusterApproval(a, args[0], args[1:])
+		case "namespaces":
+			return clusterNamespaces(a, args[1:])
+		case "share", "unshare":
+			return clusterShare(a, args[0], args[1:])
+		}
+	}
+	return cmdResource(a, "cluster", args)
+}
+
+// cmdClusterGroup handles bulk actions on the clusters of a group and
+// falls back to the generic resource actions
+func cmdClusterGroup(a *app, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "rotate-token":
+			return clusterAction(a, "clustergroup", "rotate-token", "/token/rotate", args[1:])
+		case "revoke-sessions":
+			return clusterAction(a, "clustergroup", "revoke-sessions", "/sessions/revoke", args[1:])
+		}
+	}
+	return cmdResource(a, "clustergroup", args)
+}
+
+// clusterBootstrap prints the manifest that imports a cluster, helm
+// and kustomize packages are archives and better written to a file
+func clusterBootstrap(a *app, args []string) error {
+	fs := flag.NewFlagSet("cluster bootstrap", flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	format := fs.String("format", "yaml", "Package format, yaml, helm or kustomize")
+	out := fs.String("out", "", "Write package to file instead of stdout")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: paralusctl cluster bootstrap <name> [-format yaml|helm|kustomize] [-out <file>]")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	b, err := c.do(context.Background(), http.MethodGet,
+		resources["cluster"].item(c.p, *project, fs.Arg(0))+"/download/"+*format, nil, nil)
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		return ioutil.WriteFile(*out, b, 0600)
+	}
+	_, err = a.out.Write(b)
+	return err
+}
+
+// clusterAction posts an action of a cluster or cluster group and
+// prints the response
+func clusterAction(a *app, resource, name, suffix string, args []string) error {
+	fs := flag.NewFlagSet(resource+" "+name, flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: paralusctl %s %s <name>", resource, name)
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	b, err := c.do(context.Background(), http.MethodPost,
+		resources[resource].item(c.p, *project, fs.Arg(0))+suffix, nil, nil)
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// clusterApproval approves or rejects a cluster pending approval
+func clusterApproval(a *app, action string, args []string) error {
+	fs := flag.NewFlagSet("cluster "+action, flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	reason := fs.String("reason", "", "Reason recorded with the approval")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: paralusctl cluster %s <name> [-reason reason]", action)
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	b, err := c.do(context.Background(), http.MethodPost,
+		resources["cluster"].item(c.p, *project, fs.Arg(0))+"/"+action, nil, map[string]string{"reason": *reason})
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// clusterNamespaces lists the namespaces reported by a cluster or, without
+// a name, by all clusters of the project
+func clusterNamespaces(a *app, args []string) error {
+	fs := flag.NewFlagSet("cluster namespaces", flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	selector := fs.String("selector", "", "Filter namespaces by label selector")
+	fs.Parse(args)
+	if fs.NArg() > 1 {
+		return fmt.Errorf("usage: paralusctl cluster namespaces [<name>] [-selector selector]")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	path := "/infra/v3/project/" + url.PathEscape(*project) + "/namespaces"
+	if fs.NArg() == 1 {
+		path = resources["cluster"].item(c.p, *project, fs.Arg(0)) + "/namespaces"
+	}
+	var q url.Values
+	if *selector != "" {
+		q = url.Values{"selector": []string{*selector}}
+	}
+	b, err := c.do(context.Background(), http.MethodGet, path, q, nil)
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// clusterShare shares a cluster with another project or stops sharing it
+func clusterShare(a *app, action string, args []string) error {
+	fs := flag.NewFlagSet("cluster "+action, flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	access := fs.String("access", "FULL_ACCESS", "Access of the project, FULL_ACCESS or READ_ONLY")
+	namespaces := fs.String("namespaces", "", "Comma separated namespaces the project is restricted to")
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		return fmt.Errorf("usage: paralusctl cluster share <name> <project> [-access FULL_ACCESS|READ_ONLY] [-namespaces ns1,ns2] | unshare <name> <project>")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	body := map[string]interface{}{"project": fs.Arg(1)}
+	if action == "share" {
+		body["access"] = *access
+		if *namespaces != "" {
+			body["namespaces"] = strings.Split(*namespaces, ",")
+		}
+	}
+	b, err := c.do(context.Background(), http.MethodPost,
+		resources["cluster"].item(c.p, *project, fs.Arg(0))+"/"+action, nil, body)
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
+
+// clusterSettings gets or updates the kubectl settings of a cluster
+func clusterSettings(a *app, args []string) error {
+	fs := flag.NewFlagSet("cluster settings", flag.ExitOnError)
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	disableWeb := fs.Bool("disable-web-kubectl", false, "Disable kubectl from the dashboard")
+	disableCLI := fs.Bool("disable-cli-kubectl", false, "Disable kubectl using downloaded kubeconfig")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: paralusctl cluster settings <name> [-disable-web-kubectl] [-disable-cli-kubectl]")
+	}
+	update := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "disable-web-kubectl" || f.Name == "disable-cli-kubectl" {
+			update = true
+		}
+	})
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	ctx := context.Background()
+
+	// settings are scoped by cluster id
+	b, err := c.do(ctx, http.MethodGet, resources["cluster"].item(c.p, *project, fs.Arg(0)), nil, nil)
+	if err != nil {
+		return err
+	}
+	var cluster struct {
+		Metadata struct {
+			ID string `json:"id"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(b, &cluster); err != nil {
+		return err
+	}
+	scope := "cluster/" + cluster.Metadata.ID
+
+	kcs := kubectl_cluster_settings.New(c.transport(), strfmt.Default)
+	if update {
+		current, err := kcs.KubectlClusterSettingsGetKubectlClusterSettings(
+			kubectl_cluster_settings.NewKubectlClusterSettingsGetKubectlClusterSettingsParamsWithContext(ctx).
+				WithOptsURLScope(scope), c.authInfo())
+		if err != nil {
+			return err
+		}
+		req := &models.RPCUpdateKubectlClusterSettingsRequest{
+			DisableWebKubectl: current.Payload.DisableWebKubectl,
+			DisableCLIKubectl: current.Payload.DisableCLIKubectl,
+		}
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "disable-web-kubectl":
+				req.DisableWebKubectl = *disableWeb
+			case "disable-cli-kubectl":
+				req.DisableCLIKubectl = *disableCLI
+			}
+		})
+		_, err = kcs.KubectlClusterSettingsUpdateKubectlClusterSettings(
+			kubectl_cluster_settings.NewKubectlClusterSettingsUpdateKubectlClusterSettingsParamsWithContext(ctx).
+				WithOptsURLScope(scope).WithBody(req), c.authInfo())
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := kcs.KubectlClusterSettingsGetKubectlClusterSettings(
+		kubectl_cluster_settings.NewKubectlClusterSettingsGetKubectlClusterSettingsParamsWithContext(ctx).
+			WithOptsURLScope(scope), c.authInfo())
+	if err != nil {
+		return err
+	}
+	return printValue(a.out, a.output, res.Payload)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/paralus/paralus/pkg/common"
+)
+
+const configEnv = "PARALUS_CONFIG"
+
+var errNotLoggedIn = errors.New("not logged in, run `paralusctl login` first")
+
+// profile is the on disk configuration of paralusctl. It is the CLI
+// config downloaded from the dashboard, so a downloaded file can be used
+// as is.
+type profile = common.CliConfigDownloadData
+
+func defaultConfigPath() string {
+	if p := os.Getenv(configEnv); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".paralus", "cli", "config.json")
+	}
+	return filepath.Join(home, ".paralus", "cli", "config.json")
+}
+
+func loadProfile(path string) (*profile, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errNotLoggedIn
+	}
+	if err != nil {
+		return nil, err
+	}
+	var p profile
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("unable to parse config %s: %w", path, err)
+	}
+	if p.RestEndpoint == "" {
+		return nil, fmt.Errorf("config %s has no rest_endpoint", path)
+	}
+	return &p, nil
+}
+
+func saveProfile(path string, p *profile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	// config holds credentials, keep it private to the user
+	return ioutil.WriteFile(path, b, 0600)
+}
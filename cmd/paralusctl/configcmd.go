@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+)
+
+func cmdConfig(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: paralusctl config apply|export")
+	}
+	switch args[0] {
+	case "apply":
+		return configApply(a, args[1:])
+	case "export":
+		return configExport(a, args[1:])
+	}
+	return fmt.Errorf("unknown action %q for config", args[0])
+}
+
+func configApply(a *app, args []string) error {
+	fs := flag.NewFlagSet("config apply", flag.ExitOnError)
+	file := fs.String("f", "", "Bundle of YAML documents to apply")
+	dryRun := fs.Bool("dry-run", false, "Only show the changes")
+	fs.Parse(args)
+	if *file == "" {
+		return errors.New("usage: paralusctl config apply -f <file> [-dry-run]")
+	}
+	bundle, err := ioutil.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	b, err := c.do(context.Background(), http.MethodPost, orgPath(c.p)+"/config/apply", nil, &systemv3.Config{
+		Spec: &systemv3.ConfigSpec{Bundle: string(bundle), DryRun: *dryRun},
+	})
+	if err != nil {
+		return err
+	}
+	var cfg systemv3.Config
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return err
+	}
+	return printValue(a.out, a.output, cfg.GetStatus())
+}
+
+func configExport(a *app, args []string) error {
+	fs := flag.NewFlagSet("config export", flag.ExitOnError)
+	out := fs.String("out", "", "Write bundle to file instead of stdout")
+	var kinds stringList
+	fs.Var(&kinds, "kind", "Kind to export, can be repeated (default all)")
+	fs.Parse(args)
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	q := url.Values{}
+	for _, k := range kinds {
+		q.Add("spec.kinds", k)
+	}
+	b, err := c.do(context.Background(), http.MethodGet, orgPath(c.p)+"/config/export", q, nil)
+	if err != nil {
+		return err
+	}
+	var cfg systemv3.Config
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return err
+	}
+	bundle := []byte(cfg.GetSpec().GetBundle())
+	if *out != "" {
+		return ioutil.WriteFile(*out, bundle, 0600)
+	}
+	_, err = a.out.Write(bundle)
+	return err
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	kubeconfigPath       = "/v2/sentry/kubeconfig/user"
+	kubeconfigRevokePath = "/v2/sentry/kubeconfig/revoke"
+)
+
+// defaultKubeconfig returns the kubeconfig kubectl would write to
+func defaultKubeconfig() string {
+	if env := os.Getenv(clientcmd.RecommendedConfigPathEnvVar); env != "" {
+		if files := filepath.SplitList(env); len(files) > 0 {
+			return files[0]
+		}
+	}
+	return clientcmd.RecommendedHomeFile
+}
+
+// mergeKubeconfig merges clusters, users and contexts of src into dst;
+// entries with the same name are replaced. Current context of dst is
+// only set when it has none or setCurrent is set.
+func mergeKubeconfig(dst, src *clientcmdapi.Config, setCurrent bool) {
+	for n, c := range src.Clusters {
+		dst.Clusters[n] = c
+	}
+	for n, a := range src.AuthInfos {
+		dst.AuthInfos[n] = a
+	}
+	for n, c := range src.Contexts {
+		dst.Contexts[n] = c
+	}
+	if src.CurrentContext != "" && (dst.CurrentContext == "" || setCurrent) {
+		dst.CurrentContext = src.CurrentContext
+	}
+}
+
+func cmdKubeconfig(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: paralusctl kubeconfig download|revoke")
+	}
+	switch args[0] {
+	case "download":
+		return kubeconfigDownload(a, args[1:])
+	case "revoke":
+		return kubeconfigRevoke(a, args[1:])
+	}
+	return fmt.Errorf("unknown action %q for kubeconfig", args[0])
+}
+
+func kubeconfigDownload(a *app, args []string) error {
+	fs := flag.NewFlagSet("kubeconfig download", flag.ExitOnError)
+	namespace := fs.String("namespace", "", "Default namespace of the contexts")
+	out := fs.String("out", "", "Write kubeconfig to file instead of stdout")
+	merge := fs.Bool("merge", false, "Merge into the kubeconfig at -kubeconfig")
+	kubeconfig := fs.String("kubeconfig", defaultKubeconfig(), "Kubeconfig to merge into")
+	setCurrent := fs.Bool("set-current-context", false, "Switch current context when merging")
+	fs.Parse(args)
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	q := url.Values{}
+	if *namespace != "" {
+		q.Set("namespace", *namespace)
+	}
+	b, err := c.do(context.Background(), http.MethodGet, kubeconfigPath, q, nil)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case *merge:
+		src, err := clientcmd.Load(b)
+		if err != nil {
+			return fmt.Errorf("unable to parse kubeconfig: %w", err)
+		}
+		dst, err := clientcmd.LoadFromFile(*kubeconfig)
+		if os.IsNotExist(err) {
+			dst, err = clientcmdapi.NewConfig(), nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to load %s: %w", *kubeconfig, err)
+		}
+		mergeKubeconfig(dst, src, *setCurrent)
+		if err := clientcmd.WriteToFile(*dst, *kubeconfig); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Merged %d contexts into %s\n", len(src.Contexts), *kubeconfig)
+		return nil
+	case *out != "":
+		return ioutil.WriteFile(*out, b, 0600)
+	}
+	_, err = a.out.Write(b)
+	return err
+}
+
+func kubeconfigRevoke(a *app, args []string) error {
+	fs := flag.NewFlagSet("kubeconfig revoke", flag.ExitOnError)
+	user := fs.String("user", "", "Revoke kubeconfig of another user instead of self")
+	fs.Parse(args)
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	path := kubeconfigRevokePath
+	if *user != "" {
+		b, err := c.do(ctx, http.MethodGet, resources["user"].item(c.p, "", *user), nil, nil)
+		if err != nil {
+			return err
+		}
+		var u struct {
+			Metadata struct {
+				ID string `json:"id"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(b, &u); err != nil {
+			return err
+		}
+		if u.Metadata.ID == "" {
+			return fmt.Errorf("unable to find id of user %s", *user)
+		}
+		path = "/v2/sentry/kubeconfig/user/" + url.PathEscape(u.Metadata.ID) + "/revoke"
+	}
+	if _, err := c.do(ctx, http.MethodPost, path, nil, map[string]interface{}{}); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "Kubeconfig revoked")
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func testKubeconfig(name, server string) *clientcmdapi.Config {
+	c := clientcmdapi.NewConfig()
+	c.Clusters[name] = &clientcmdapi.Cluster{Server: server}
+	c.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: name}
+	c.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	c.CurrentContext = name
+	return c
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	dst := testKubeconfig("local", "https://127.0.0.1:6443")
+	src := testKubeconfig("paralus", "https://relay.paralus.dev")
+	src.Clusters["local"] = &clientcmdapi.Cluster{Server: "https://replaced"}
+
+	mergeKubeconfig(dst, src, false)
+	if dst.CurrentContext != "local" {
+		t.Errorf("current context should not change, got %s", dst.CurrentContext)
+	}
+	if len(dst.Contexts) != 2 || dst.Clusters["paralus"] == nil || dst.AuthInfos["paralus"] == nil {
+		t.Errorf("contexts not merged: %v", dst.Contexts)
+	}
+	if dst.Clusters["local"].Server != "https://replaced" {
+		t.Errorf("cluster with same name should be replaced")
+	}
+
+	mergeKubeconfig(dst, src, true)
+	if dst.CurrentContext != "paralus" {
+		t.Errorf("expected current context paralus, got %s", dst.CurrentContext)
+	}
+
+	empty := clientcmdapi.NewConfig()
+	mergeKubeconfig(empty, src, false)
+	if empty.CurrentContext != "paralus" {
+		t.Errorf("expected current context to be set on empty kubeconfig, got %s", empty.CurrentContext)
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	kclient "github.com/ory/kratos-client-go"
+	"golang.org/x/term"
+)
+
+const (
+	cliConfigPath      = "/auth/v3/cli/config"
+	cliLoginPath       = "/auth/v3/cli/login"
+	cliLoginConfigPath = "/auth/v3/cli/login/config"
+	userInfoPath       = "/auth/v3/userinfo"
+)
+
+// cliLogin is a login started on the server, approved by the user in the
+// browser
+type cliLogin struct {
+	DeviceCode              string `json:"deviceCode"`
+	UserCode                string `json:"userCode"`
+	VerificationUri         string `json:"verificationUri"`
+	VerificationUriComplete string `json:"verificationUriComplete"`
+	ExpiresIn               int64  `json:"expiresIn"`
+	Interval                int64  `json:"interval"`
+}
+
+// openBrowser opens u in the default browser of the user
+func openBrowser(u string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", u).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", u).Start()
+	default:
+		return exec.Command("xdg-open", u).Start()
+	}
+}
+
+// deviceLogin starts a login on the server and waits for the user to
+// approve it in a browser logged in to the dashboard, it returns the cli
+// config of the approving user. The verification page is opened when
+// browser is set, otherwise only printed for use on another device.
+func deviceLogin(ctx context.Context, c *client, browser bool) ([]byte, error) {
+	b, err := c.do(ctx, http.MethodPost, cliLoginPath, nil, struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start login: %w", err)
+	}
+	var l cliLogin
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, fmt.Errorf("unable to parse login: %w", err)
+	}
+	verify, err := url.Parse(l.VerificationUriComplete)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse verification uri: %w", err)
+	}
+	// verification uri is relative to the rest endpoint
+	if !verify.IsAbs() {
+		u := *c.endpoint
+		u.Path = c.endpoint.Path + verify.Path
+		u.RawQuery = verify.RawQuery
+		verify = &u
+	}
+
+	if browser && openBrowser(verify.String()) == nil {
+		fmt.Fprintf(os.Stderr, "Opened %s in your browser, confirm the code %s\n", verify, l.UserCode)
+	} else {
+		fmt.Fprintf(os.Stderr, "Open %s in a browser logged in to the dashboard and confirm the code %s\n", verify, l.UserCode)
+	}
+
+	interval := time.Duration(l.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(l.ExpiresIn)*time.Second)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("login expired, the code was not approved in time")
+		case <-time.After(interval):
+		}
+		b, err := c.do(ctx, http.MethodPost, cliLoginConfigPath, nil, map[string]string{"deviceCode": l.DeviceCode})
+		var ae *apiError
+		if errors.As(err, &ae) && ae.Message == "authorization_pending" {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to login: %w", err)
+		}
+		return b, nil
+	}
+}
+
+// kratosLogin logs in to kratos using the native (API) login flow and
+// returns the session token
+func kratosLogin(ctx context.Context, kratosAddr, username, password string) (string, error) {
+	cfg := kclient.NewConfiguration()
+	cfg.Servers[0].URL = kratosAddr
+	kc := kclient.NewAPIClient(cfg)
+
+	flow, _, err := kc.FrontendApi.CreateNativeLoginFlow(ctx).Execute()
+	if err != nil {
+		return "", fmt.Errorf("unable to start login flow: %w", err)
+	}
+	body := kclient.UpdateLoginFlowWithPasswordMethodAsUpdateLoginFlowBody(
+		kclient.NewUpdateLoginFlowWithPasswordMethod(username, "password", password),
+	)
+	res, _, err := kc.FrontendApi.UpdateLoginFlow(ctx).Flow(flow.Id).UpdateLoginFlowBody(body).Execute()
+	if err != nil {
+		return "", fmt.Errorf("unable to login: %w", err)
+	}
+	if res.GetSessionToken() == "" {
+		return "", errors.New("unable to login: no session token")
+	}
+	return res.GetSessionToken(), nil
+}
+
+func readPassword() (string, error) {
+	fmt.Fprint(os.Stderr, "Password: ")
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		b, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		return string(b), err
+	}
+	s, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && s == "" {
+		return "", err
+	}
+	return strings.TrimRight(s, "\r\n"), nil
+}
+
+func cmdLogin(a *app, args []string) error {
+	fs := flag.NewFlagSet("login", flag.ExitOnError)
+	file := fs.String("f", "", "CLI config downloaded from the dashboard")
+	endpoint := fs.String("endpoint", "", "Paralus REST endpoint")
+	apiKey := fs.String("api-key", "", "API key")
+	apiSecret := fs.String("api-secret", "", "API secret")
+	username := fs.String("username", "", "Login using username and password")
+	kratosAddr := fs.String("kratos", "", "Kratos public endpoint used for username login")
+	browser := fs.Bool("browser", false, "Login by approving in the browser, opened automatically")
+	device := fs.Bool("device", false, "Login by approving in a browser on any device")
+	project := fs.String("project", "", "Default project")
+	fs.Parse(args)
+
+	ctx := context.Background()
+	p := &profile{}
+	switch {
+	case *file != "":
+		b, err := ioutil.ReadFile(*file)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, p); err != nil {
+			return fmt.Errorf("unable to parse %s: %w", *file, err)
+		}
+	case *apiKey != "":
+		if *apiSecret == "" {
+			return errors.New("-api-secret is required with -api-key")
+		}
+		p.ApiKey, p.ApiSecret = *apiKey, *apiSecret
+	case *username != "":
+		if *kratosAddr == "" {
+			return errors.New("-kratos is required with -username")
+		}
+	case *browser || *device:
+	default:
+		return errors.New("one of -f, -api-key, -username, -browser or -device is required")
+	}
+	if *endpoint != "" {
+		p.RestEndpoint = *endpoint
+	}
+	if p.RestEndpoint == "" {
+		return errors.New("-endpoint is required")
+	}
+
+	c, err := newClient(p)
+	if err != nil {
+		return err
+	}
+	if *username != "" {
+		password, err := readPassword()
+		if err != nil {
+			return err
+		}
+		if c.sessionToken, err = kratosLogin(ctx, *kratosAddr, *username, password); err != nil {
+			return err
+		}
+	}
+
+	// CLI config of the user fills in partner, organization and default
+	// project, and issues an api key when logging in using a session
+	var b []byte
+	if *browser || *device {
+		b, err = deviceLogin(ctx, c, *browser)
+	} else {
+		b, err = c.do(ctx, http.MethodGet, cliConfigPath, nil, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to retrieve cli config: %w", err)
+	}
+	var cc profile
+	if err := json.Unmarshal(b, &cc); err != nil {
+		return fmt.Errorf("unable to parse cli config: %w", err)
+	}
+	cc.RestEndpoint = p.RestEndpoint
+	if *project != "" {
+		cc.Project = *project
+	}
+	if err := saveProfile(a.configPath, &cc); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Logged in to organization %s, default project %s\n", cc.Organization, cc.Project)
+	return nil
+}
+
+func cmdLogout(a *app, args []string) error {
+	err := os.Remove(a.configPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func cmdWhoami(a *app, args []string) error {
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	b, err := c.do(context.Background(), http.MethodGet, userInfoPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
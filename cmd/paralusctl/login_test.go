@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeviceLogin(t *testing.T) {
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case cliLoginPath:
+			w.Write([]byte(`{"deviceCode":"device","userCode":"BCDF-GHJK","verificationUri":"/auth/v3/cli/login/verify",` +
+				`"verificationUriComplete":"/auth/v3/cli/login/verify?userCode=BCDF-GHJK","expiresIn":30,"interval":1}`))
+		case cliLoginConfigPath:
+			var req map[string]string
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["deviceCode"] != "device" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":"expired_token"}`))
+				return
+			}
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"authorization_pending"}`))
+				return
+			}
+			w.Write([]byte(`{"organization":"org","project":"default","api_key":"key"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := newClient(&profile{RestEndpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := deviceLogin(context.Background(), c, false)
+	if err != nil {
+		t.Fatal("unable to login:", err)
+	}
+	var cc profile
+	if err := json.Unmarshal(b, &cc); err != nil {
+		t.Fatal(err)
+	}
+	if cc.ApiKey != "key" || polls != 2 {
+		t.Errorf("expected api key after second poll, got %v after %d polls", cc, polls)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// paralusctl manages paralus without the dashboard:
+//
+//	paralusctl login -f cli-config.json
+//	paralusctl project list
+//	paralusctl kubeconfig download -merge
+//	paralusctl audit -since 24h -o yaml
+
+// app holds the global options of a paralusctl invocation
+type app struct {
+	configPath string
+	output     string
+	out        io.Writer
+}
+
+func (a *app) client() (*client, error) {
+	p, err := loadProfile(a.configPath)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(p)
+}
+
+var commands = map[string]func(a *app, args []string) error{
+	"login":        cmdLogin,
+	"logout":       cmdLogout,
+	"whoami":       cmdWhoami,
+	"kubeconfig":   cmdKubeconfig,
+	"audit":        cmdAudit,
+	"config":       cmdConfig,
+	"cluster":      cmdCluster,
+	"clustergroup": cmdClusterGroup,
+	"relaynetwork": cmdRelayNetwork,
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintln(out, "Usage: paralusctl [-config <file>] [-o json|yaml] <command> [args]")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Commands:")
+	fmt.Fprintln(out, "  login -f <cli-config> | -api-key <key> -api-secret <secret> | -username <user> -kratos <addr> | -browser | -device")
+	fmt.Fprintln(out, "  logout")
+	fmt.Fprintln(out, "  whoami")
+	fmt.Fprintf(out, "  <%s> list|get|create|update|delete\n", resourceNames())
+	fmt.Fprintln(out, "  cluster bootstrap <name> [-format yaml|helm|kustomize] | settings <name>")
+	fmt.Fprintln(out, "  cluster reset-registration|rotate-token|revoke-sessions <name>")
+	fmt.Fprintln(out, "  cluster approve|reject <name> [-reason reason]")
+	fmt.Fprintln(out, "  cluster namespaces [<name>] [-selector selector]")
+	fmt.Fprintln(out, "  cluster share <name> <project> [-access FULL_ACCESS|READ_ONLY] [-namespaces ns1,ns2] | unshare <name> <project>")
+	fmt.Fprintln(out, "  clustergroup rotate-token|revoke-sessions <name>")
+	fmt.Fprintln(out, "  relaynetwork download <name> [-out <file>]")
+	fmt.Fprintln(out, "  kubeconfig download [-merge] | revoke [-user <user>]")
+	fmt.Fprintln(out, "  audit [-since 1h | -from t -to t] [-limit n] [-page-token t] [-relay] [filters]")
+	fmt.Fprintln(out, "  audit verify [-from t] [-to t]")
+	fmt.Fprintln(out, "  audit sessions [-since 24h | -from t -to t] [-user u] [-gap 15m] [-project p] [-clusters c]")
+	fmt.Fprintln(out, "  config apply -f <bundle> [-dry-run] | export [-kind <kind>]")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Options:")
+	flag.PrintDefaults()
+}
+
+func main() {
+	a := &app{out: os.Stdout}
+	flag.StringVar(&a.configPath, "config", defaultConfigPath(), "paralusctl config file, also set using "+configEnv)
+	flag.StringVar(&a.output, "o", outputJSON, "Output format, json or yaml")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	if err := validOutput(a.output); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
+	}
+
+	name, args := flag.Arg(0), flag.Args()[1:]
+	var err error
+	if cmd, ok := commands[name]; ok {
+		err = cmd(a, args)
+	} else if _, ok := resources[name]; ok {
+		err = cmdResource(a, name, args)
+	} else {
+		fmt.Fprintf(os.Stderr, "Error: unknown command %q\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
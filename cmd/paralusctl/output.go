@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+func validOutput(format string) error {
+	switch format {
+	case outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q, use %s or %s", format, outputJSON, outputYAML)
+}
+
+// printJSON writes the JSON document b to w in format
+func printJSON(w io.Writer, format string, b []byte) error {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+	switch format {
+	case outputYAML:
+		y, err := yaml.JSONToYAML(b)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(y)
+		return err
+	default:
+		var out bytes.Buffer
+		if err := json.Indent(&out, b, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		_, err := out.WriteTo(w)
+		return err
+	}
+}
+
+// printValue marshals v to JSON and writes it to w in format
+func printValue(w io.Writer, format string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return printJSON(w, format, b)
+}
+
+// readObject reads a JSON or YAML document into a generic object
+func readObject(b []byte) (map[string]interface{}, error) {
+	j, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("empty document")
+	}
+	return obj, nil
+}
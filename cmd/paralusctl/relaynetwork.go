@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// cmdRelayNetwork handles relay network specific actions and falls back
+// to the generic resource actions
+func cmdRelayNetwork(a *app, args []string) error {
+	if len(args) > 0 && args[0] == "download" {
+		return relayNetworkDownload(a, args[1:])
+	}
+	return cmdResource(a, "relaynetwork", args)
+}
+
+// relayNetworkDownload prints the deployment of the relay serving the
+// relay network
+func relayNetworkDownload(a *app, args []string) error {
+	fs := flag.NewFlagSet("relaynetwork download", flag.ExitOnError)
+	out := fs.String("out", "", "Write manifest to file instead of stdout")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: paralusctl relaynetwork download <name> [-out <file>]")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	b, err := c.do(context.Background(), http.MethodGet,
+		resources["relaynetwork"].item(c.p, "", fs.Arg(0))+"/download", nil, nil)
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		return ioutil.WriteFile(*out, b, 0600)
+	}
+	_, err = a.out.Write(b)
+	return err
+}
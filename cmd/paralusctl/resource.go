@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// resource describes how a kind is addressed on the gateway
+type resource struct {
+	kind string
+	// list and create paths of the collection
+	list   func(p *profile, project string) string
+	create func(p *profile, project string) string
+	// item is the path of a single object
+	item func(p *profile, project, name string) string
+	// scope sets the scope of the object before create and update
+	scope func(meta map[string]interface{}, p *profile, project string)
+}
+
+func orgPath(p *profile) string {
+	return fmt.Sprintf("/auth/v3/partner/%s/organization/%s",
+		url.PathEscape(p.Partner), url.PathEscape(p.Organization))
+}
+
+func sentryOrgPath(p *profile) string {
+	return fmt.Sprintf("/v2/sentry/bootstrap/partner/%s/organization/%s",
+		url.PathEscape(p.Partner), url.PathEscape(p.Organization))
+}
+
+func eventOrgPath(p *profile) string {
+	return fmt.Sprintf("/event/v1/partner/%s/organization/%s",
+		url.PathEscape(p.Partner), url.PathEscape(p.Organization))
+}
+
+func orgScope(meta map[string]interface{}, p *profile, _ string) {
+	meta["partner"] = p.Partner
+	meta["organization"] = p.Organization
+}
+
+func projectScope(meta map[string]interface{}, p *profile, project string) {
+	orgScope(meta, p, project)
+	meta["project"] = project
+}
+
+var resources = map[string]*resource{
+	"project": {
+		kind:   "Project",
+		list:   func(p *profile, _ string) string { return orgPath(p) + "/projects" },
+		create: func(p *profile, _ string) string { return orgPath(p) + "/project" },
+		item: func(p *profile, _, name string) string {
+			return orgPath(p) + "/project/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+	"group": {
+		kind:   "Group",
+		list:   func(p *profile, _ string) string { return orgPath(p) + "/groups" },
+		create: func(p *profile, _ string) string { return orgPath(p) + "/groups" },
+		item: func(p *profile, _, name string) string {
+			return orgPath(p) + "/group/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+	"role": {
+		kind:   "Role",
+		list:   func(p *profile, _ string) string { return orgPath(p) + "/roles" },
+		create: func(p *profile, _ string) string { return orgPath(p) + "/roles" },
+		item: func(p *profile, _, name string) string {
+			return orgPath(p) + "/role/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+	"user": {
+		kind:   "User",
+		list:   func(*profile, string) string { return "/auth/v3/users" },
+		create: func(*profile, string) string { return "/auth/v3/users" },
+		item: func(_ *profile, _, name string) string {
+			return "/auth/v3/user/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+	"cluster": {
+		kind: "Cluster",
+		list: func(_ *profile, project string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/cluster"
+		},
+		create: func(_ *profile, project string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/cluster"
+		},
+		item: func(_ *profile, project, name string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/cluster/" + url.PathEscape(name)
+		},
+		scope: projectScope,
+	},
+	"clustergroup": {
+		kind: "ClusterGroup",
+		list: func(_ *profile, project string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/clustergroup"
+		},
+		create: func(_ *profile, project string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/clustergroup"
+		},
+		item: func(_ *profile, project, name string) string {
+			return "/infra/v3/project/" + url.PathEscape(project) + "/clustergroup/" + url.PathEscape(name)
+		},
+		scope: projectScope,
+	},
+	"relaynetwork": {
+		kind:   "RelayNetwork",
+		list:   func(p *profile, _ string) string { return sentryOrgPath(p) + "/relaynetwork" },
+		create: func(p *profile, _ string) string { return sentryOrgPath(p) + "/relaynetwork" },
+		item: func(p *profile, _, name string) string {
+			return sentryOrgPath(p) + "/relaynetwork/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+	"alertrule": {
+		kind:   "AlertRule",
+		list:   func(p *profile, _ string) string { return eventOrgPath(p) + "/alertrule" },
+		create: func(p *profile, _ string) string { return eventOrgPath(p) + "/alertrule" },
+		item: func(p *profile, _, name string) string {
+			return eventOrgPath(p) + "/alertrule/" + url.PathEscape(name)
+		},
+		scope: orgScope,
+	},
+}
+
+func resourceNames() string {
+	var names []string
+	for n := range resources {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// readManifest reads the object in file and sets its kind, name and
+// scope
+func (r *resource) readManifest(file, name string, p *profile, project string) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	if file != "" {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if obj, err = readObject(b); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", file, err)
+		}
+	}
+	if k, ok := obj["kind"].(string); ok && k != r.kind {
+		return nil, fmt.Errorf("expected kind %s, got %s", r.kind, k)
+	}
+	obj["kind"] = r.kind
+
+	meta, _ := obj["metadata"].(map[string]interface{})
+	if meta == nil {
+		meta = map[string]interface{}{}
+		obj["metadata"] = meta
+	}
+	if name != "" {
+		meta["name"] = name
+	}
+	if n, _ := meta["name"].(string); n == "" {
+		return nil, errors.New("name is required")
+	}
+	r.scope(meta, p, project)
+	return obj, nil
+}
+
+func cmdResource(a *app, name string, args []string) error {
+	r := resources[name]
+	if len(args) == 0 {
+		return fmt.Errorf("usage: paralusctl %s list|get|create|update|delete", name)
+	}
+	action := args[0]
+	fs := flag.NewFlagSet(name+" "+action, flag.ExitOnError)
+	file := fs.String("f", "", "File with the "+name+" in JSON or YAML")
+	project := fs.String("project", "", "Project, defaults to the project in config")
+	fs.Parse(args[1:])
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	if *project == "" {
+		*project = c.p.Project
+	}
+	ctx := context.Background()
+
+	var b []byte
+	switch action {
+	case "list":
+		q := url.Values{}
+		// users and clusters are listed using query options
+		if name == "user" || name == "cluster" {
+			q.Set("partner", c.p.Partner)
+			q.Set("organization", c.p.Organization)
+		}
+		b, err = c.do(ctx, http.MethodGet, r.list(c.p, *project), q, nil)
+	case "get", "delete":
+		if fs.NArg() != 1 {
+			return fmt.Errorf("usage: paralusctl %s %s <name>", name, action)
+		}
+		method := http.MethodGet
+		if action == "delete" {
+			method = http.MethodDelete
+		}
+		b, err = c.do(ctx, method, r.item(c.p, *project, fs.Arg(0)), nil, nil)
+	case "create", "update":
+		if *file == "" && fs.NArg() != 1 {
+			return fmt.Errorf("usage: paralusctl %s %s -f <file> | <name>", name, action)
+		}
+		obj, err := r.readManifest(*file, fs.Arg(0), c.p, *project)
+		if err != nil {
+			return err
+		}
+		if action == "create" {
+			b, err = c.do(ctx, http.MethodPost, r.create(c.p, *project), nil, obj)
+		} else {
+			n := obj["metadata"].(map[string]interface{})["name"].(string)
+			b, err = c.do(ctx, http.MethodPut, r.item(c.p, *project, n), nil, obj)
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown action %q for %s", action, name)
+	}
+	if err != nil {
+		return err
+	}
+	return printJSON(a.out, a.output, b)
+}
@@ -0,0 +1,361 @@
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/cluster/constants"
+	"github.com/paralus/paralus/internal/cluster/fixtures"
+	"github.com/paralus/paralus/pkg/common"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// Bootstrap package formats supported by GetClusterPackage
+const (
+	PackageFormatYAML      = "yaml"
+	PackageFormatHelm      = "helm"
+	PackageFormatKustomize = "kustomize"
+)
+
+const (
+	// packageName is the name of the helm chart and the top level
+	// directory of the helm and kustomize archives
+	packageName = "paralus-agent"
+	initImage   = "busybox:1.33"
+
+	yamlContentType    = "application/x-paralus-yaml"
+	archiveContentType = "application/gzip"
+)
+
+// defaultResources of the relay agent
+var defaultResources = &infrav3.BootstrapResources{
+	Requests: map[string]string{"cpu": "100m", "memory": "128Mi"},
+	Limits:   map[string]string{"cpu": "500m", "memory": "512Mi"},
+}
+
+// MirrorImage replaces the registry of image with registry. Images
+// without a registry, i.e. docker hub images, are moved under it.
+func MirrorImage(image, registry string) string {
+	registry = strings.TrimSuffix(registry, "/")
+	if registry == "" || image == "" {
+		return image
+	}
+	if i := strings.Index(image, "/"); i > 0 {
+		host := image[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			image = image[i+1:]
+		}
+	}
+	return registry + "/" + image
+}
+
+// ValidateBootstrapOverrides validates the overrides so that the
+// bootstrap manifests render into valid kubernetes objects
+func ValidateBootstrapOverrides(o *infrav3.BootstrapOverrides) error {
+	if o == nil {
+		return nil
+	}
+	for _, s := range o.ImagePullSecrets {
+		if errs := validation.IsDNS1123Subdomain(s); len(errs) > 0 {
+			return fmt.Errorf("invalid image pull secret %q: %s", s, strings.Join(errs, ", "))
+		}
+	}
+	if err := validateLabels("node selector", o.NodeSelector); err != nil {
+		return err
+	}
+	if err := validateLabels("pod security label", o.PodSecurityLabels); err != nil {
+		return err
+	}
+	for _, t := range o.Tolerations {
+		switch t.Operator {
+		case "", corev1.TolerationOpEqual, corev1.TolerationOpExists:
+		default:
+			return fmt.Errorf("invalid toleration operator %q", t.Operator)
+		}
+		switch t.Effect {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			return fmt.Errorf("invalid toleration effect %q", t.Effect)
+		}
+	}
+	if o.Resources != nil {
+		for _, rl := range []map[string]string{o.Resources.Requests, o.Resources.Limits} {
+			for name, q := range rl {
+				if _, err := resource.ParseQuantity(q); err != nil {
+					return fmt.Errorf("invalid quantity %q for resource %s: %s", q, name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateLabels(kind string, labels map[string]string) error {
+	for k, v := range labels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return fmt.Errorf("invalid %s key %q: %s", kind, k, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("invalid %s value %q: %s", kind, v, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// GetClusterPackage returns the bootstrap manifests of the cluster in
+// the requested format along with their content type. The yaml format
+// is the plain manifest, helm and kustomize formats are gzipped tar
+// archives. All formats are rendered from the agent helm chart.
+func GetClusterPackage(ctx context.Context, data *common.DownloadData, cluster *infrav3.Cluster, format string) (string, []byte, error) {
+	switch format {
+	case "", PackageFormatYAML:
+		b, err := renderBootstrapYaml(bootstrapValues(data, cluster))
+		return yamlContentType, b, err
+	case PackageFormatHelm:
+		b, err := helmPackage(bootstrapValues(data, cluster), data, cluster)
+		return archiveContentType, b, err
+	case PackageFormatKustomize:
+		b, err := kustomizePackage(bootstrapValues(data, cluster))
+		return archiveContentType, b, err
+	}
+	return "", nil, fmt.Errorf("invalid package format %q, must be one of %s, %s or %s", format, PackageFormatYAML, PackageFormatHelm, PackageFormatKustomize)
+}
+
+type helmProxyValues struct {
+	HTTPProxy              string `json:"httpProxy"`
+	HTTPSProxy             string `json:"httpsProxy"`
+	NoProxy                string `json:"noProxy"`
+	ProxyAuth              string `json:"proxyAuth"`
+	BootstrapCA            string `json:"bootstrapCA"`
+	AllowInsecureBootstrap bool   `json:"allowInsecureBootstrap"`
+}
+
+type helmValues struct {
+	Namespace struct {
+		Create bool              `json:"create"`
+		Labels map[string]string `json:"labels"`
+	} `json:"namespace"`
+	ClusterID string          `json:"clusterID"`
+	Relays    string          `json:"relays"`
+	OpenShift bool            `json:"openshift"`
+	Proxy     helmProxyValues `json:"proxy"`
+	Image     struct {
+		RelayAgent string `json:"relayAgent"`
+		Init       string `json:"init"`
+	} `json:"image"`
+	ImagePullSecrets []string                    `json:"imagePullSecrets"`
+	NodeSelector     map[string]string           `json:"nodeSelector"`
+	Tolerations      []*corev1.Toleration        `json:"tolerations"`
+	Resources        *infrav3.BootstrapResources `json:"resources"`
+}
+
+// bootstrapValues returns the values of the agent helm chart for the
+// cluster with its bootstrap overrides applied
+func bootstrapValues(data *common.DownloadData, cluster *infrav3.Cluster) *helmValues {
+	o := cluster.Spec.BootstrapOverrides
+	if o == nil {
+		o = &infrav3.BootstrapOverrides{}
+	}
+
+	v := &helmValues{}
+	v.Namespace.Create = true
+	v.Namespace.Labels = o.PodSecurityLabels
+	if v.Namespace.Labels == nil {
+		v.Namespace.Labels = map[string]string{}
+	}
+	v.ClusterID = cluster.Metadata.Labels[constants.ClusterID]
+	v.Relays = cluster.Metadata.Annotations[constants.ClusterRelays]
+	v.OpenShift = cluster.Metadata.Labels[constants.KubernetesProvider] == "OPENSHIFT"
+	if pc := cluster.Spec.ProxyConfig; pc != nil && pc.Enabled {
+		v.Proxy = helmProxyValues{
+			HTTPProxy:              pc.HttpProxy,
+			HTTPSProxy:             pc.HttpsProxy,
+			NoProxy:                pc.NoProxy,
+			ProxyAuth:              pc.ProxyAuth,
+			BootstrapCA:            pc.BootstrapCA,
+			AllowInsecureBootstrap: pc.AllowInsecureBootstrap,
+		}
+	}
+	v.Image.RelayAgent = MirrorImage(data.RelayAgentImage, o.ImageRegistry)
+	v.Image.Init = MirrorImage(initImage, o.ImageRegistry)
+	v.ImagePullSecrets = append([]string{}, o.ImagePullSecrets...)
+	v.NodeSelector = o.NodeSelector
+	if v.NodeSelector == nil {
+		v.NodeSelector = map[string]string{}
+	}
+	v.Tolerations = append([]*corev1.Toleration{}, o.Tolerations...)
+	v.Resources = o.Resources
+	if r := v.Resources; r == nil || (len(r.Requests) == 0 && len(r.Limits) == 0) {
+		v.Resources = defaultResources
+	}
+	return v
+}
+
+// renderBootstrapYaml renders the templates of the agent helm chart with
+// values the way helm does, as a single manifest
+func renderBootstrapYaml(v *helmValues) ([]byte, error) {
+	// templates see the values as helm does, decoded from values.yaml
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+
+	bb := new(bytes.Buffer)
+	for _, name := range fixtures.ManifestOrder {
+		doc := new(bytes.Buffer)
+		err := fixtures.ChartTemplates.ExecuteTemplate(doc, name, map[string]interface{}{"Values": values})
+		if err != nil {
+			return nil, err
+		}
+		if s := strings.TrimSpace(doc.String()); s != "" {
+			bb.WriteString("---\n")
+			bb.WriteString(s)
+			bb.WriteString("\n")
+		}
+	}
+	return bb.Bytes(), nil
+}
+
+// helmPackage returns the helm chart of the agent with the values of the
+// cluster pre-filled
+func helmPackage(v *helmValues, data *common.DownloadData, cluster *infrav3.Cluster) ([]byte, error) {
+	values, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	chart, err := yaml.Marshal(map[string]string{
+		"apiVersion":  "v2",
+		"name":        packageName,
+		"description": "Paralus agent for cluster " + cluster.Metadata.Name,
+		"type":        "application",
+		"version":     "0.1.0",
+		"appVersion":  imageTag(data.RelayAgentImage),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{
+		"Chart.yaml":  chart,
+		"values.yaml": values,
+	}
+	templates, err := readFixtureDir(fixtures.ChartDir)
+	if err != nil {
+		return nil, err
+	}
+	for name, b := range templates {
+		files[path.Join("templates", name)] = b
+	}
+	return tarGz(files)
+}
+
+// kustomizePackage returns a kustomize base with the rendered manifest
+// as its only resource
+func kustomizePackage(v *helmValues) ([]byte, error) {
+	manifest, err := renderBootstrapYaml(v)
+	if err != nil {
+		return nil, err
+	}
+	kustomization, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{"bootstrap.yaml"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tarGz(map[string][]byte{
+		"kustomization.yaml": kustomization,
+		"bootstrap.yaml":     manifest,
+	})
+}
+
+func imageTag(image string) string {
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return "latest"
+}
+
+func readFixtureDir(dir string) (map[string][]byte, error) {
+	d, err := fixtures.Fixtures.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+	fis, err := d.Readdir(0)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte, len(fis))
+	for _, fi := range fis {
+		b, err := readFixture(fixtures.Fixtures, path.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[fi.Name()] = b
+	}
+	return files, nil
+}
+
+func readFixture(fs http.FileSystem, name string) ([]byte, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// tarGz archives files under packageName directory
+func tarGz(files map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bb := new(bytes.Buffer)
+	gw := gzip.NewWriter(bb)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+	for _, name := range names {
+		b := files[name]
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(packageName, name),
+			Mode:    0644,
+			Size:    int64(len(b)),
+			ModTime: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(b); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
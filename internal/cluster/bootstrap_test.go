@@ -0,0 +1,213 @@
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/common"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestMirrorImage(t *testing.T) {
+	tt := []struct {
+		image, registry, expected string
+	}{
+		{"busybox:1.33", "", "busybox:1.33"},
+		{"busybox:1.33", "mirror.local", "mirror.local/busybox:1.33"},
+		{"paralusio/relay:v0.1.0", "mirror.local/", "mirror.local/paralusio/relay:v0.1.0"},
+		{"docker.io/paralusio/relay:v0.1.0", "mirror.local:5000", "mirror.local:5000/paralusio/relay:v0.1.0"},
+		{"localhost/relay", "mirror.local", "mirror.local/relay"},
+	}
+	for _, tc := range tt {
+		if got := MirrorImage(tc.image, tc.registry); got != tc.expected {
+			t.Errorf("MirrorImage(%q, %q) = %q, expected %q", tc.image, tc.registry, got, tc.expected)
+		}
+	}
+}
+
+func TestValidateBootstrapOverrides(t *testing.T) {
+	tt := []struct {
+		name      string
+		overrides *infrav3.BootstrapOverrides
+		err       bool
+	}{
+		{"nil", nil, false},
+		{"valid", &infrav3.BootstrapOverrides{
+			ImagePullSecrets:  []string{"regcred"},
+			NodeSelector:      map[string]string{"kubernetes.io/os": "linux"},
+			PodSecurityLabels: map[string]string{"pod-security.kubernetes.io/enforce": "privileged"},
+			Tolerations:       []*corev1.Toleration{{Key: "infra", Operator: corev1.TolerationOpExists}},
+			Resources:         &infrav3.BootstrapResources{Limits: map[string]string{"cpu": "1"}},
+		}, false},
+		{"secret", &infrav3.BootstrapOverrides{ImagePullSecrets: []string{"Reg Cred"}}, true},
+		{"selector", &infrav3.BootstrapOverrides{NodeSelector: map[string]string{"a b": "c"}}, true},
+		{"toleration", &infrav3.BootstrapOverrides{Tolerations: []*corev1.Toleration{{Operator: "In"}}}, true},
+		{"quantity", &infrav3.BootstrapOverrides{Resources: &infrav3.BootstrapResources{Requests: map[string]string{"cpu": "lots"}}}, true},
+	}
+	for _, tc := range tt {
+		err := ValidateBootstrapOverrides(tc.overrides)
+		if (err != nil) != tc.err {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func testBootstrapCluster() *infrav3.Cluster {
+	return &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{
+			Name:        "c-1",
+			Labels:      map[string]string{"paralus.dev/clusterID": "abc"},
+			Annotations: map[string]string{"paralus.dev/relays": `[{"token":"t"}]`},
+		},
+		Spec: &infrav3.ClusterSpec{
+			ProxyConfig: &infrav3.ProxyConfig{Enabled: true, HttpsProxy: "http://proxy:3128", BootstrapCA: "ca"},
+			BootstrapOverrides: &infrav3.BootstrapOverrides{
+				ImageRegistry:     "mirror.local",
+				ImagePullSecrets:  []string{"regcred"},
+				NodeSelector:      map[string]string{"role": "infra"},
+				Tolerations:       []*corev1.Toleration{{Key: "infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+				Resources:         &infrav3.BootstrapResources{Limits: map[string]string{"memory": "1Gi"}},
+				PodSecurityLabels: map[string]string{"pod-security.kubernetes.io/enforce": "privileged"},
+			},
+		},
+	}
+}
+
+func findDoc(t *testing.T, manifest []byte, kind string) []byte {
+	for _, doc := range strings.Split(string(manifest), "\n---\n") {
+		if strings.Contains(doc, "\nkind: "+kind+"\n") {
+			return []byte(doc)
+		}
+	}
+	t.Fatalf("%s not found in manifest", kind)
+	return nil
+}
+
+func TestGetClusterPackageYaml(t *testing.T) {
+	data := &common.DownloadData{RelayAgentImage: "paralusio/relay:v0.1.0"}
+	cluster := testBootstrapCluster()
+	contentType, b, err := GetClusterPackage(context.Background(), data, cluster, PackageFormatYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != yamlContentType {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	if cluster.Spec.ProxyConfig.BootstrapCA != "ca" {
+		t.Errorf("cluster should not be modified")
+	}
+
+	var ns corev1.Namespace
+	if err := yaml.Unmarshal(findDoc(t, b, "Namespace"), &ns); err != nil {
+		t.Fatal(err)
+	}
+	if ns.Labels["pod-security.kubernetes.io/enforce"] != "privileged" {
+		t.Errorf("pod security labels not set: %v", ns.Labels)
+	}
+
+	var d appsv1.Deployment
+	if err := yaml.Unmarshal(findDoc(t, b, "Deployment"), &d); err != nil {
+		t.Fatal(err)
+	}
+	spec := d.Spec.Template.Spec
+	if spec.Containers[0].Image != "mirror.local/paralusio/relay:v0.1.0" || spec.InitContainers[0].Image != "mirror.local/busybox:1.33" {
+		t.Errorf("images not mirrored: %s %s", spec.Containers[0].Image, spec.InitContainers[0].Image)
+	}
+	if spec.NodeSelector["role"] != "infra" || len(spec.Tolerations) != 1 || spec.Tolerations[0].Key != "infra" {
+		t.Errorf("scheduling overrides not set: %v %v", spec.NodeSelector, spec.Tolerations)
+	}
+	if len(spec.ImagePullSecrets) != 1 || spec.ImagePullSecrets[0].Name != "regcred" {
+		t.Errorf("image pull secrets not set: %v", spec.ImagePullSecrets)
+	}
+	res := spec.Containers[0].Resources
+	if res.Limits.Memory().String() != "1Gi" || len(res.Requests) != 0 {
+		t.Errorf("resources not overridden: %v", res)
+	}
+
+	// without overrides the defaults are rendered
+	cluster.Spec.BootstrapOverrides = nil
+	_, b, err = GetClusterPackage(context.Background(), data, cluster, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = appsv1.Deployment{}
+	if err := yaml.Unmarshal(findDoc(t, b, "Deployment"), &d); err != nil {
+		t.Fatal(err)
+	}
+	spec = d.Spec.Template.Spec
+	if spec.Containers[0].Image != data.RelayAgentImage || spec.Containers[0].Resources.Requests.Cpu().String() != "100m" {
+		t.Errorf("unexpected defaults: %s %v", spec.Containers[0].Image, spec.Containers[0].Resources)
+	}
+}
+
+func untar(t *testing.T, b []byte) map[string][]byte {
+	gr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[h.Name], _ = ioutil.ReadAll(tr)
+	}
+	return files
+}
+
+func TestGetClusterPackageArchives(t *testing.T) {
+	data := &common.DownloadData{RelayAgentImage: "paralusio/relay:v0.1.0"}
+
+	contentType, b, err := GetClusterPackage(context.Background(), data, testBootstrapCluster(), PackageFormatHelm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != archiveContentType {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	files := untar(t, b)
+	for _, name := range []string{"Chart.yaml", "values.yaml", "templates/deployment.yaml", "templates/rbac.yaml"} {
+		if _, ok := files["paralus-agent/"+name]; !ok {
+			t.Errorf("%s missing from helm chart", name)
+		}
+	}
+	var values helmValues
+	if err := yaml.Unmarshal(files["paralus-agent/values.yaml"], &values); err != nil {
+		t.Fatal(err)
+	}
+	if values.ClusterID != "abc" || values.Relays == "" || values.Proxy.BootstrapCA != "ca" ||
+		values.Image.RelayAgent != "mirror.local/paralusio/relay:v0.1.0" || values.Resources.Limits["memory"] != "1Gi" {
+		t.Errorf("unexpected values: %+v", values)
+	}
+
+	_, b, err = GetClusterPackage(context.Background(), data, testBootstrapCluster(), PackageFormatKustomize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = untar(t, b)
+	if !strings.Contains(string(files["paralus-agent/kustomization.yaml"]), "- bootstrap.yaml") {
+		t.Errorf("unexpected kustomization: %s", files["paralus-agent/kustomization.yaml"])
+	}
+	if !strings.Contains(string(files["paralus-agent/bootstrap.yaml"]), "mirror.local/paralusio/relay:v0.1.0") {
+		t.Errorf("bootstrap manifest not rendered")
+	}
+
+	if _, _, err := GetClusterPackage(context.Background(), data, testBootstrapCluster(), "zip"); err == nil {
+		t.Errorf("expected error for invalid format")
+	}
+}
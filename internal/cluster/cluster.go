@@ -1,15 +1,12 @@
 package cluster
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/paralus/paralus/internal/cluster/constants"
-	"github.com/paralus/paralus/internal/cluster/fixtures"
 	"github.com/paralus/paralus/internal/cluster/util"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/log"
@@ -84,25 +81,11 @@ func GetClusterOperatorYaml(ctx context.Context, data *common.DownloadData, clus
 
 	_log.Infow("printing cluster in GetClusterOperatorYaml", "cluster", cluster)
 
-	bb := new(bytes.Buffer)
-
-	if cluster.Spec.ProxyConfig != nil {
-		if cluster.Spec.ProxyConfig.BootstrapCA != "" {
-			cluster.Spec.ProxyConfig.BootstrapCA = base64.StdEncoding.EncodeToString([]byte(cluster.Spec.ProxyConfig.BootstrapCA))
-		}
-	}
-
-	err := fixtures.DownloadTemplate.Execute(bb, struct {
-		DownloadData *common.DownloadData
-		Cluster      *infrav3.Cluster
-	}{
-		data, cluster,
-	})
+	b, err := renderBootstrapYaml(bootstrapValues(data, cluster))
 	if err != nil {
 		_log.Errorw("error while downloading template GetClusterOperatorYaml", "cluster", cluster)
 		return "", err
 	}
-	operatorSpec := string(bb.String())
 
-	return operatorSpec, nil
+	return string(b), nil
 }
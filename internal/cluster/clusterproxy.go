@@ -2,10 +2,16 @@ package cluster
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"strings"
 
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -39,3 +45,128 @@ func UpdateProxyData(cert string, proxyConfig infrav3.ProxyConfig, clusterCidr m
 func GetNoProxyDataString(noProxyConfig string, clusterCidr map[string]string) string {
 	return NO_PROXY_PARALUS_DATA + "," + clusterCidr["PodNetworkCidr"] + "," + clusterCidr["ServiceCidr"] + "," + noProxyConfig
 }
+
+// ValidateProxyConfig validates the proxy settings of a cluster and
+// normalizes its no proxy list in place, CIDRs are canonicalized and
+// duplicates dropped. Disabled proxy configs are left untouched.
+func ValidateProxyConfig(pc *infrav3.ProxyConfig) error {
+	if pc == nil || !pc.Enabled {
+		return nil
+	}
+	if pc.HttpProxy == "" && pc.HttpsProxy == "" {
+		return fmt.Errorf("proxy is enabled but neither httpProxy nor httpsProxy is set")
+	}
+	if err := validateProxyURL("httpProxy", pc.HttpProxy); err != nil {
+		return err
+	}
+	if err := validateProxyURL("httpsProxy", pc.HttpsProxy); err != nil {
+		return err
+	}
+	if pc.ProxyAuth != "" && !strings.Contains(pc.ProxyAuth, ":") {
+		return fmt.Errorf("invalid proxyAuth: expected user:password")
+	}
+	if pc.BootstrapCA != "" {
+		if err := validateBootstrapCA(pc.BootstrapCA); err != nil {
+			return err
+		}
+	}
+	noProxy, err := normalizeNoProxy(pc.NoProxy)
+	if err != nil {
+		return err
+	}
+	pc.NoProxy = noProxy
+	return nil
+}
+
+func validateProxyURL(field, value string) error {
+	if value == "" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %s", field, value, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid %s %q: scheme must be http or https", field, value)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("invalid %s %q: host is missing", field, value)
+	}
+	if p := u.Port(); p != "" {
+		if n, err := strconv.Atoi(p); err != nil || n <= 0 || n > 65535 {
+			return fmt.Errorf("invalid %s %q: port %s out of range", field, value, p)
+		}
+	}
+	if u.Path != "" && u.Path != "/" {
+		return fmt.Errorf("invalid %s %q: path is not allowed", field, value)
+	}
+	return nil
+}
+
+// validateBootstrapCA checks every block of the bootstrap CA bundle is a
+// parsable certificate
+func validateBootstrapCA(ca string) error {
+	rest := []byte(ca)
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("invalid bootstrapCA: unexpected PEM block %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("invalid bootstrapCA: certificate %d: %s", count+1, err)
+		}
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("invalid bootstrapCA: no PEM encoded certificate found")
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return fmt.Errorf("invalid bootstrapCA: trailing data after certificate %d", count)
+	}
+	return nil
+}
+
+// normalizeNoProxy validates the comma separated no proxy entries and
+// returns them with CIDRs in canonical form
+func normalizeNoProxy(noProxy string) (string, error) {
+	var entries []string
+	seen := make(map[string]bool)
+	for _, e := range strings.Split(noProxy, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if strings.Contains(e, "/") {
+			_, ipnet, err := net.ParseCIDR(e)
+			if err != nil {
+				return "", fmt.Errorf("invalid noProxy entry %q: %s", e, err)
+			}
+			e = ipnet.String()
+		} else if net.ParseIP(e) == nil {
+			host := e
+			if h, p, err := net.SplitHostPort(e); err == nil {
+				if n, err := strconv.Atoi(p); err != nil || n <= 0 || n > 65535 {
+					return "", fmt.Errorf("invalid noProxy entry %q: port %s out of range", e, p)
+				}
+				host = h
+			}
+			host = strings.TrimPrefix(strings.TrimPrefix(host, "*"), ".")
+			if host != "" && net.ParseIP(host) == nil {
+				if errs := validation.IsDNS1123Subdomain(strings.ToLower(host)); len(errs) > 0 {
+					return "", fmt.Errorf("invalid noProxy entry %q: %s", e, strings.Join(errs, ", "))
+				}
+			}
+			e = strings.ToLower(e)
+		}
+		if !seen[e] {
+			seen[e] = true
+			entries = append(entries, e)
+		}
+	}
+	return strings.Join(entries, ","), nil
+}
@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+)
+
+func testCA(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "proxy-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateProxyConfig(t *testing.T) {
+	ca := testCA(t)
+	tt := []struct {
+		name    string
+		pc      *infrav3.ProxyConfig
+		noProxy string
+		wantErr bool
+	}{
+		{"nil", nil, "", false},
+		{"disabled", &infrav3.ProxyConfig{HttpProxy: "::bad"}, "", false},
+		{"no proxy urls", &infrav3.ProxyConfig{Enabled: true}, "", true},
+		{"valid", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", HttpsProxy: "https://proxy.local", BootstrapCA: ca}, "", false},
+		{"ca bundle", &infrav3.ProxyConfig{Enabled: true, HttpsProxy: "http://proxy.local:3128", BootstrapCA: ca + ca}, "", false},
+		{"bad scheme", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "socks5://proxy.local:1080"}, "", true},
+		{"missing scheme", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "proxy.local:3128"}, "", true},
+		{"missing host", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://:3128"}, "", true},
+		{"bad port", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:99999"}, "", true},
+		{"path", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128/path"}, "", true},
+		{"bad auth", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", ProxyAuth: "user"}, "", true},
+		{"ca not pem", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", BootstrapCA: "not a cert"}, "", true},
+		{"ca bad der", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", BootstrapCA: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("junk")}))}, "", true},
+		{"ca key block", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", BootstrapCA: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("junk")}))}, "", true},
+		{"ca trailing data", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", BootstrapCA: ca + "junk"}, "", true},
+		{"no proxy normalized", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", NoProxy: " 10.1.2.3/8, .Example.com,10.0.0.0/8,*.svc,192.168.1.1,registry.local:5000,"}, "10.0.0.0/8,.example.com,*.svc,192.168.1.1,registry.local:5000", false},
+		{"no proxy bad cidr", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", NoProxy: "10.0.0.0/33"}, "", true},
+		{"no proxy bad host", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy.local:3128", NoProxy: "foo_bar.local"}, "", true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProxyConfig(tc.pc)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ValidateProxyConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil && tc.pc != nil && tc.pc.Enabled && tc.pc.NoProxy != tc.noProxy {
+				t.Errorf("NoProxy = %q, expected %q", tc.pc.NoProxy, tc.noProxy)
+			}
+		})
+	}
+}
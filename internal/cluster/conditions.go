@@ -29,15 +29,16 @@ type NamespaceConfitionReasonFunc func(n *scheduler.ClusterNamespace) string
 type ClusterConditionCooledDownFunc func(c *infrav3.Cluster, passed time.Duration) bool
 
 var (
-	NewClusterRegister          ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterRegister)
-	NewClusterApprove           ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterApprove)
-	NewClusterCheckIn           ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterCheckIn)
-	NewClusterNodeSync          ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterNodeSync)
-	NewClusterNamespaceSync     ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterNamespaceSync)
-	NewClusterReady             ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterReady)
-	NewClusterAuxiliaryTaskSync ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterAuxiliaryTaskSync)
-	NewClusterBootstrapAgent    ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterBootstrapAgent)
-	NewClusterDelete            ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterDelete)
+	NewClusterRegister              ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterRegister)
+	NewClusterApprove               ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterApprove)
+	NewClusterCheckIn               ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterCheckIn)
+	NewClusterNodeSync              ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterNodeSync)
+	NewClusterNamespaceSync         ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterNamespaceSync)
+	NewClusterReady                 ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterReady)
+	NewClusterAuxiliaryTaskSync     ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterAuxiliaryTaskSync)
+	NewClusterBootstrapAgent        ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterBootstrapAgent)
+	NewClusterDelete                ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterDelete)
+	NewClusterConnectivityPreflight ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterConnectivityPreflight)
 
 	IsClusterBootstrapAgentPending ClusterConditionReadyFunc = isClusterCondition(constants.Pending, infrav3.ClusterConditionType_ClusterBootstrapAgent)
 	IsClusterBootstrapAgentRetry   ClusterConditionReadyFunc = isClusterCondition(constants.Retry, infrav3.ClusterConditionType_ClusterBootstrapAgent)
@@ -45,12 +46,16 @@ var (
 	IsClusterRegisterd             ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterRegister)
 	IsClusterRegisterPending       ClusterConditionReadyFunc = isClusterCondition(constants.Pending, infrav3.ClusterConditionType_ClusterRegister)
 	IsClusterApproved              ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterApprove)
+	IsClusterApprovePending        ClusterConditionReadyFunc = isClusterCondition(constants.Pending, infrav3.ClusterConditionType_ClusterApprove)
+	IsClusterApproveNotSet         ClusterConditionReadyFunc = isClusterCondition(constants.NotSet, infrav3.ClusterConditionType_ClusterApprove)
+	IsClusterRejected              ClusterConditionReadyFunc = isClusterCondition(constants.Failed, infrav3.ClusterConditionType_ClusterApprove)
 	IsClusterCheckedIn             ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterCheckIn)
 	IsClusterNodeSynced            ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterNodeSync)
 	IsClusterNamespaceSynced       ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterNamespaceSync)
 	IsClusterReady                 ClusterConditionReadyFunc = isClusterConditionSuccess(infrav3.ClusterConditionType_ClusterReady)
 	IsClusterDeletePending         ClusterConditionReadyFunc = isClusterCondition(constants.Pending, infrav3.ClusterConditionType_ClusterDelete)
 	IsClusterDeleteRetry           ClusterConditionReadyFunc = isClusterCondition(constants.Retry, infrav3.ClusterConditionType_ClusterDelete)
+	IsClusterDeleteInProgress      ClusterConditionReadyFunc = isClusterCondition(constants.InProgress, infrav3.ClusterConditionType_ClusterDelete)
 	IsClusterDeleted               ClusterConditionReadyFunc = isClusterCondition(constants.Success, infrav3.ClusterConditionType_ClusterDelete)
 	IsClusterDeleteNotSet          ClusterConditionReadyFunc = isClusterCondition(constants.NotSet, infrav3.ClusterConditionType_ClusterDelete)
 
@@ -70,6 +75,7 @@ var (
 
 	IsClusterBootstrapAgentCooledDown ClusterConditionCooledDownFunc = isClusterCooledDown(infrav3.ClusterConditionType_ClusterBootstrapAgent)
 	IsClusterDeleteCooledDown         ClusterConditionCooledDownFunc = isClusterCooledDown(infrav3.ClusterConditionType_ClusterDelete)
+	IsClusterDeleteTimedOut           ClusterConditionCooledDownFunc = isClusterConditionOlder(constants.InProgress, infrav3.ClusterConditionType_ClusterDelete)
 )
 
 // DefaultClusterConditions is the default cluster conditions list
@@ -177,9 +183,13 @@ func namespaceConditionReason(conditionType scheduler.ClusterNamespaceConditionT
 }
 
 func isClusterCooledDown(conditionType infrav3.ClusterConditionType) func(c *infrav3.Cluster, passed time.Duration) bool {
+	return isClusterConditionOlder(constants.Retry, conditionType)
+}
+
+func isClusterConditionOlder(conditionStatus commonv3.ParalusConditionStatus, conditionType infrav3.ClusterConditionType) func(c *infrav3.Cluster, passed time.Duration) bool {
 	return func(c *infrav3.Cluster, passed time.Duration) bool {
 		for _, condition := range c.Spec.ClusterData.ClusterStatus.Conditions {
-			if condition.Type == conditionType && condition.Status == constants.Retry {
+			if condition.Type == conditionType && condition.Status == conditionStatus {
 				if condition.LastUpdated.AsTime().Before(time.Now().Add(-passed)) {
 					return true
 				}
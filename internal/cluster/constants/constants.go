@@ -32,12 +32,18 @@ const (
 	ClusterID                = "paralus.dev/clusterID"
 	Public                   = "paralus.dev/public"
 	ClusterName              = "paralus.dev/clusterName"
+	ClusterRelays            = "paralus.dev/relays"
+	KubernetesProvider       = "paralus.dev/kubernetesProvider"
 )
 
 const (
-	ApiVersion      = "infra.k8smgmt.io/v3"
-	ClusterKind     = "Cluster"
-	ClusterListKind = "ClusterList"
+	ApiVersion           = "infra.k8smgmt.io/v3"
+	ClusterKind          = "Cluster"
+	ClusterListKind      = "ClusterList"
+	ClusterGroupKind     = "ClusterGroup"
+	ClusterGroupListKind = "ClusterGroupList"
+	NamespaceKind        = "ClusterNamespace"
+	NamespaceListKind    = "ClusterNamespaceList"
 )
 
 const (
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+)
+
+// ListClusterGroupMembers returns the clusters of the project matching the
+// selector of the cluster group
+func ListClusterGroupMembers(ctx context.Context, db bun.IDB, group *models.ClusterGroup) ([]models.Cluster, error) {
+	var clusters []models.Cluster
+	q, err := query.FilterLabels(db.NewSelect().Model(&clusters), &commonv3.QueryOptions{Selector: group.Selector})
+	if err != nil {
+		return nil, err
+	}
+	err = q.Where("project_id = ?", group.ProjectId).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
+
+// ListClusterGroupsForProject returns the cluster groups of the project
+func ListClusterGroupsForProject(ctx context.Context, db bun.IDB, projectID uuid.UUID) ([]models.ClusterGroup, error) {
+	var groups []models.ClusterGroup
+	err := db.NewSelect().Model(&groups).
+		Where("project_id = ?", projectID).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// RevokeClusterSessions marks kubeconfigs issued until at as revoked for
+// the clusters
+func RevokeClusterSessions(ctx context.Context, db bun.IDB, clusterIDs []uuid.UUID, at time.Time) error {
+	if len(clusterIDs) == 0 {
+		return nil
+	}
+	_, err := db.NewUpdate().Model((*models.Cluster)(nil)).
+		Set("sessions_revoked_at = ?", at).
+		Where("id IN (?)", bun.In(clusterIDs)).
+		Exec(ctx)
+	return err
+}
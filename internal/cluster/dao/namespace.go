@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetClusterNamespaces returns the namespaces reported by the cluster,
+// including the ones deleted since
+func GetClusterNamespaces(ctx context.Context, db bun.IDB, clusterID uuid.UUID) ([]models.ClusterNamespace, error) {
+	var namespaces []models.ClusterNamespace
+	err := db.NewSelect().Model(&namespaces).
+		Where("cluster_id = ?", clusterID).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// CreateClusterNamespace adds a namespace to the inventory of the cluster
+func CreateClusterNamespace(ctx context.Context, db bun.IDB, ns *models.ClusterNamespace) error {
+	_, err := db.NewInsert().Model(ns).Exec(ctx)
+	return err
+}
+
+// UpdateClusterNamespace updates a namespace in the inventory of the cluster
+func UpdateClusterNamespace(ctx context.Context, db bun.IDB, ns *models.ClusterNamespace) error {
+	_, err := db.NewUpdate().Model(ns).
+		Column("hash", "type", "namespace", "conditions", "status", "modified_at", "deleted_at").
+		Where("cluster_id = ?", ns.ClusterId).
+		Where("name = ?", ns.Name).
+		Exec(ctx)
+	return err
+}
+
+// ListLiveClusterNamespaces returns the namespaces currently present on
+// the clusters
+func ListLiveClusterNamespaces(ctx context.Context, db bun.IDB, clusterIDs []uuid.UUID) ([]models.ClusterNamespace, error) {
+	var namespaces []models.ClusterNamespace
+	if len(clusterIDs) == 0 {
+		return namespaces, nil
+	}
+	err := db.NewSelect().Model(&namespaces).
+		Where("cluster_id IN (?)", bun.In(clusterIDs)).
+		Where("deleted_at IS NULL").
+		Order("name", "cluster_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// ListProjectClusters returns the clusters owned by the project
+func ListProjectClusters(ctx context.Context, db bun.IDB, projectID uuid.UUID) ([]models.Cluster, error) {
+	var clusters []models.Cluster
+	err := db.NewSelect().Model(&clusters).
+		Where("project_id = ?", projectID).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
@@ -32,6 +32,15 @@ func DeleteProjectsForCluster(ctx context.Context, db bun.IDB, clusterID uuid.UU
 	return dao.DeleteX(ctx, db, "cluster_id", clusterID, &models.ProjectCluster{})
 }
 
+// DeleteProjectCluster removes the association of the project with the cluster
+func DeleteProjectCluster(ctx context.Context, db bun.IDB, clusterID, projectID uuid.UUID) error {
+	_, err := db.NewDelete().Model((*models.ProjectCluster)(nil)).
+		Where("cluster_id = ?", clusterID).
+		Where("project_id = ?", projectID).
+		Exec(ctx)
+	return err
+}
+
 // Check if the project in scope is owner of the cluster
 func ValidateClusterAccess(ctx context.Context, db bun.IDB, opts commonv3.QueryOptions) (bool, error) {
 	var _c models.Cluster
@@ -39,6 +48,9 @@ func ValidateClusterAccess(ctx context.Context, db bun.IDB, opts commonv3.QueryO
 	if err != nil {
 		return false, err
 	}
+	if opts.ID != "" {
+		q = q.Where("?TableAlias.id = ?", opts.ID)
+	}
 
 	count, err := q.ScanAndCount(ctx)
 	if err != nil {
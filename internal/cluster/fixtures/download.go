@@ -1,28 +1,97 @@
 package fixtures
 
 import (
+	"encoding/base64"
+	"errors"
 	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
 	"text/template"
+
+	"sigs.k8s.io/yaml"
 )
 
+// ChartDir is the directory of the templates of the agent helm chart
+const ChartDir = "/helm/templates"
+
 var (
-	// DownloadTemplate is the template for rendering download yaml
-	DownloadTemplate *template.Template
-)
+	// ChartTemplates are the templates of the agent helm chart, the
+	// bootstrap yaml is rendered from them as well
+	ChartTemplates *template.Template
 
-func init() {
-	f, err := Fixtures.Open("download.yaml")
-	if err != nil {
-		panic(err)
+	// ManifestOrder is the order the chart templates are rendered in to
+	// the bootstrap yaml, the namespace comes first
+	ManifestOrder = []string{
+		"namespace.yaml",
+		"rbac.yaml",
+		"openshift-scc.yaml",
+		"configmap.yaml",
+		"deployment.yaml",
 	}
+)
 
-	b, err := ioutil.ReadAll(f)
-	if err != nil {
-		panic(err)
+// funcs are the subset of the helm template functions the chart
+// templates use
+var funcs = template.FuncMap{
+	"toYaml": func(v interface{}) (string, error) {
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(b), "\n"), nil
+	},
+	"indent": indent,
+	"nindent": func(n int, s string) string {
+		return "\n" + indent(n, s)
+	},
+	"quote": func(v interface{}) string {
+		return strconv.Quote(toString(v))
+	},
+	"squote": func(v interface{}) string {
+		return "'" + toString(v) + "'"
+	},
+	"b64enc": func(v interface{}) string {
+		return base64.StdEncoding.EncodeToString([]byte(toString(v)))
+	},
+	"required": func(msg string, v interface{}) (interface{}, error) {
+		if v == nil || toString(v) == "" {
+			return nil, errors.New(msg)
+		}
+		return v, nil
+	},
+}
+
+func indent(n int, s string) string {
+	pad := strings.Repeat(" ", n)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
 	}
+	b, _ := yaml.Marshal(v)
+	return strings.TrimSuffix(string(b), "\n")
+}
 
-	DownloadTemplate, err = template.New("download").Parse(string(b))
-	if err != nil {
-		panic(err)
+func init() {
+	ChartTemplates = template.New("chart").Funcs(funcs)
+	for _, name := range ManifestOrder {
+		f, err := Fixtures.Open(path.Join(ChartDir, name))
+		if err != nil {
+			panic(err)
+		}
+		b, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			panic(err)
+		}
+		if _, err := ChartTemplates.New(name).Parse(string(b)); err != nil {
+			panic(err)
+		}
 	}
 }
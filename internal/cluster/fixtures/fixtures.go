@@ -19,18 +19,64 @@ var Fixtures = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2023, 6, 26, 7, 56, 27, 287059158, time.UTC),
+			modTime: time.Date(2026, 10, 19, 12, 43, 52, 634224540, time.UTC),
 		},
-		"/download.yaml": &vfsgen۰CompressedFileInfo{
-			name:             "download.yaml",
-			modTime:          time.Date(2023, 6, 26, 7, 56, 27, 291059221, time.UTC),
-			uncompressedSize: 10195,
+		"/helm": &vfsgen۰DirInfo{
+			name:    "helm",
+			modTime: time.Date(2026, 10, 19, 8, 57, 54, 348100357, time.UTC),
+		},
+		"/helm/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2026, 10, 19, 8, 58, 6, 105615007, time.UTC),
+		},
+		"/helm/templates/configmap.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "configmap.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 58, 4, 226697911, time.UTC),
+			uncompressedSize: 824,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x92\x31\x4f\xc3\x30\x10\x85\xf7\xfc\x8a\x53\x76\x07\x21\x21\x86\x6c\xa5\x2c\x1d\x90\x98\xba\x5f\x92\x6b\x6b\xd5\xb1\x5d\xdf\x19\x88\xd2\xfc\x77\x94\xb4\x35\xb4\x0a\x48\x0c\xac\xef\xbd\xef\xbd\xc4\x3a\xf4\x7a\x4d\x81\xb5\xb3\x25\xbc\xdd\x67\x7b\x6d\x9b\x12\x96\xce\x6e\xf4\xf6\x05\x7d\xd6\x92\x60\x83\x82\x65\x06\x60\xb1\xa5\x12\x7c\x70\x1f\x9d\xaa\xa7\xc4\x59\x64\x8f\xf5\xe8\x60\x40\x13\x59\x71\xc7\x42\x6d\x06\x60\xb0\x22\xc3\x23\x0a\x80\xde\x17\xfb\x58\x51\xb0\x24\xc4\x85\x76\x77\x2d\x5a\xdc\x52\xa3\xaa\x2e\xa1\xd9\x65\x6a\x27\xe2\x5f\xc7\xa1\x12\xfa\x1e\x8a\x35\x9a\x48\x5c\x4c\xd3\x45\xf2\xe0\x08\x87\xe8\x84\x60\x18\xce\x08\xff\xc6\xf0\x0c\x64\xdd\x4f\x84\x75\x33\xf1\xc9\x5a\x44\xd9\xcd\x00\xc9\xbb\x46\x2a\xe7\x84\x25\xa0\x5f\x2e\x66\xa0\x6f\x2e\x1c\xa1\x7a\x7c\x20\x5b\x5f\xf3\x68\x8c\x7b\x5f\x59\xa6\x3a\x06\x7a\xba\xc4\xa7\x2a\xbd\xb9\x69\x9b\xcf\xc2\x30\xe4\x12\x22\xe5\x7d\x0f\x64\x78\xec\xcd\x37\x68\xf8\x24\xd8\x66\xdc\x51\x4a\x65\x7f\xbe\x84\x40\x06\x3b\x85\x5b\xb2\xf2\xdf\xf7\x50\x9b\xc8\x42\x61\xf5\x3c\xfd\x78\xa0\x43\xd4\x81\x1a\xc8\x93\x0e\x9a\x93\x9c\xa7\x67\xf9\xb2\xaf\xde\x74\xfa\x70\xbe\xa9\x3a\x89\xf3\x3d\x67\xef\x08\x9c\x5a\x3e\x07\x00\xfa\x91\xa4\x48\x38\x03\x00\x00"),
+		},
+		"/helm/templates/deployment.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "deployment.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 58, 6, 105615007, time.UTC),
+			uncompressedSize: 3531,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x56\xdb\x6e\xdb\x46\x10\x7d\xe7\x57\x0c\xfc\xdc\x95\x1d\xa4\x31\x02\x02\x79\x60\x24\xb5\x11\x6a\x4b\x82\x45\xa7\x09\x8a\x42\x18\x2f\xc7\xd4\xc2\x7b\x61\x76\x97\x8a\x04\x27\xff\x5e\x2c\x75\x21\xa9\x8b\xeb\xa4\x89\x80\xbe\x08\xc2\xec\x99\x39\x67\x86\x33\xb3\x8b\x85\x78\x4f\xd6\x09\xa3\x63\xc0\xa2\x70\xe7\xf3\x17\xd1\x83\xd0\x59\x0c\x3d\x2a\xa4\x59\x2a\xd2\x3e\x52\xe4\x31\x43\x8f\x71\x04\xa0\x51\x51\x0c\x96\x24\x2e\x19\xe6\xe1\x74\x65\x73\x05\x72\x8a\xa1\x40\x8b\xb2\x74\xcc\x2d\x9d\x27\x15\x01\x48\xbc\x23\xe9\x82\x27\x04\x82\xce\x43\x79\x47\x56\x93\x27\xd7\x11\xe6\x5c\xa1\xc6\x9c\x32\x76\xb7\xdc\xba\x46\xae\x20\x1e\xf0\x8e\x24\x71\x6f\x6c\xf8\x0f\xa0\xd0\xf3\xd9\x55\x23\x58\x15\x6e\x57\x89\xa5\x42\x0a\x8e\x2e\x86\x17\x11\x40\x61\x4d\x6e\xc9\xb9\x1e\x61\x26\x85\xa6\x09\x71\xa3\xb3\x70\xf8\xfa\xe2\x22\x02\xf0\xa4\x0a\x89\x9e\xd6\x0c\x8d\x2c\x01\xda\xca\x8f\xd0\x01\x6c\xc4\x02\x00\xe0\xfd\xbd\xd0\xc2\x2f\x6b\x17\x6d\x32\x4a\xf6\xac\x00\x96\x3e\x95\xc2\x52\xd6\x2b\xad\xd0\xf9\x84\xcf\x28\x2b\xa5\xd0\xf9\x20\xd7\x66\x6b\xee\x2f\x88\x97\x3e\x7c\x99\x86\xe7\x2a\xe6\x64\x5d\x9a\x94\xac\x72\xed\x63\x00\xb6\xaa\x55\x7f\x51\x84\xdc\x85\xd1\x7b\x08\x00\x00\x06\x0f\xb4\x8c\xa1\xfd\x39\x8c\x3b\x80\x04\x30\x05\x59\x0c\x5f\x02\x06\xfa\x20\x60\x8e\xb2\xa4\x83\x34\x00\x00\x0c\xa4\xd0\xe5\xe2\xb9\x22\xd0\xf2\xd9\xcf\x91\x81\x2a\xbb\xfc\xf5\xf8\xa9\x55\xdb\x53\x4f\x56\x09\x8d\xa1\xfa\xbf\x5b\xe4\x34\x26\x2b\x4c\x56\xf7\xcf\xc5\x1a\x57\x58\x61\xac\xf0\xcb\xae\x44\xe7\x86\xd5\x68\x6c\x26\x80\xcb\xd2\x79\xb2\x8c\x5b\xe1\x05\x47\x19\x01\x00\x00\x38\xb2\x73\xc1\x29\xe1\xdc\x94\xda\xaf\x5c\x56\xc3\xc2\x1c\x46\x00\x00\x00\x8f\x8f\x0c\x3e\x0b\x3f\x83\xce\xfb\x2a\xa3\x4e\xf3\x9b\xc3\xd7\xaf\xd1\x7e\x27\xd4\x49\x07\x67\x6f\x3e\xa2\x92\xd0\x81\x2f\xa0\x85\xce\x48\x7b\x78\x5d\xfb\x05\x04\xe9\xac\x6d\x68\xf1\x79\x23\x43\xa9\x43\xef\xd4\xa8\x86\xf1\xc7\xb2\x09\x85\x39\x8d\x4b\x29\x27\xc4\x2d\xf9\x06\xe5\xee\x49\xdc\x08\x61\x51\xe7\x04\x9d\x1a\xcc\xd6\xbb\xe9\xf1\xb1\x69\x3d\x48\xdf\x32\x84\xe9\xec\x1a\xed\x51\x68\xb2\x2e\xde\x89\xe6\xc8\x33\x29\x94\xf0\xf5\x68\x54\xaa\x56\x3c\xcd\x0c\x3a\x21\x10\x7c\x81\x4f\xa5\xf1\x54\x87\x07\xe0\x46\x29\x0c\x2b\xf5\xaf\x33\x37\x3b\xfb\x05\xce\x18\x0f\xbf\x65\x15\x16\x98\x86\xcb\x57\xaf\x5e\x5e\x9e\xfd\xbd\x75\x70\xc4\xcb\xaa\xab\x8c\xf6\xb4\xf0\x75\xb5\xab\x86\x9b\x0b\x49\x39\x65\x31\x78\x5b\x52\xb4\xa1\x38\xa6\x7f\x77\x61\x3d\x95\x40\x85\x4d\x02\xf4\x50\x1a\x68\x73\x17\x72\x60\x4c\x99\x8c\xde\x70\x29\x48\xfb\x2a\x1d\x26\x4d\xce\x24\xcd\x49\xbe\x79\xd9\x48\x83\xf4\xbc\x96\xbe\xd1\x33\x1e\xf5\xa6\xc3\xe4\xba\x1f\xed\x8c\xed\x6f\xd6\xa8\xb8\x61\x04\xb8\x17\x24\xb3\x1b\xba\x6f\x5b\xd7\xf6\x31\xfa\x59\xbc\x5d\xd9\x9d\x10\xfb\x28\xd5\x64\x9c\x74\x7f\x02\x5f\x75\xe3\xed\x91\x5e\x27\x1f\xa6\xbd\x41\x72\x35\xf9\x77\x42\x6e\xf4\xbd\xc8\xaf\xb1\xf8\x83\x96\x07\x78\xf7\xbe\x1f\x5b\x39\xec\xc0\xaa\x0d\xaa\x70\xd1\x13\x28\x77\xd7\xb7\x29\xc2\xb8\xa2\x6c\xf5\x4a\xad\x35\xe8\x1c\xdd\xa6\xd3\xf1\xcd\xe8\xc3\xc7\x1f\xa4\xb7\xb0\x66\xb1\x7c\x42\xe9\xcc\xfb\xc2\x8d\x03\xe8\x3f\x68\x9d\x26\xb7\xe9\xbb\xfe\x30\x1d\x74\x93\x74\x30\x1a\x9e\x48\x7a\x75\x9e\x94\x7e\xf6\x6d\xca\xdf\xa5\xe9\xf8\xe4\x25\xfe\x8e\x0a\x07\x9d\x93\xff\x45\x2f\x0c\x47\x27\x95\xa9\xcd\x77\x68\x7c\x3b\x1a\xa5\x93\xf4\x26\x19\x4f\xbb\xc9\xb4\xdb\xbf\x49\x4f\x24\xf6\xce\x18\xef\xbc\xc5\xa2\x9b\x7c\x9b\xe0\xe4\xea\x6a\xf4\xe7\x74\x30\x9c\xf4\xbb\xb7\x37\xfd\xe9\x56\xff\x89\x74\xa3\x94\xe6\xf3\x40\x57\x57\x1f\xbd\xdd\x24\xf1\xbc\x14\xe6\x46\x96\x8a\xae\xc3\x83\xaa\xf5\xfa\x63\xcf\xdb\xa1\x2a\x38\xae\x16\xfc\x39\x79\x7e\xbe\x03\xb1\xe4\x4c\x69\x79\xfb\x61\xd9\x7c\xf7\xac\xef\xd0\x2d\xae\xf1\x0e\x7a\x71\x51\xdf\xa0\x2b\x95\x4f\x5d\xd0\xbb\xe2\xb6\x85\x6d\x32\x1f\xf5\xfa\x67\x00\x6e\x44\xc6\xe0\xcb\x0d\x00\x00"),
+		},
+		"/helm/templates/namespace.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "namespace.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 57, 54, 471056226, time.UTC),
+			uncompressedSize: 288,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x5a\x5d\x6f\xdb\x38\xd6\xbe\xf7\xaf\x20\x7c\x93\x99\x17\x23\xb7\x99\xbe\x2d\x0a\x01\x73\xa1\xda\x6e\x6b\x6c\x62\x1b\xb6\x33\x33\x8b\xc5\x22\xa0\xc9\x63\x9b\x1b\x8a\xd4\x90\x94\x63\x6d\xd0\xff\xbe\x20\xf5\x2d\xcb\x4e\x9c\xa6\xde\xac\x2f\x1a\x89\x3c\x3c\xe7\xe1\xf9\xa6\x58\xcf\xf3\x3a\x38\x62\xbf\x83\xd2\x4c\x0a\x1f\x6d\x2f\x3b\x77\x4c\x50\x1f\x8d\x71\x08\x3a\xc2\x04\x3a\x21\x18\x4c\xb1\xc1\x7e\x07\x21\x8e\x97\xc0\xb5\x7d\x42\x88\x48\x61\x94\xe4\x5e\xc4\xb1\x00\x3f\x7f\xe5\xa0\xbc\x10\x0b\xbc\x06\xe5\xa8\x70\x14\xf5\xee\xe2\x25\x28\x01\x06\x74\x8f\xc9\x37\xe9\x2c\xf5\x96\x89\x8f\x22\xac\x30\x8f\x75\x07\x21\x81\x43\x28\xde\x3d\x9d\x68\x03\x61\xe7\x20\xba\x39\xa8\x2d\x23\x10\x10\x22\x63\x61\x6a\x10\x53\x46\x29\x03\x4f\xe3\x6c\xc4\x6d\x65\x8f\x7f\x7d\x43\x4f\x83\x6a\x31\x3d\x3c\x78\x88\xad\x10\x16\x14\xf5\xfa\x3c\xd6\x06\x54\xef\x3a\xc3\xd0\xbb\x72\x2c\xd1\x4f\xf0\x17\xfa\x89\x09\x0a\xbb\xc3\x34\xdd\x8c\x69\x8f\xc2\xf6\x4d\x29\x7a\xaa\xe4\x96\x51\x50\xdd\x9f\x51\x77\x32\x1d\x8e\xe7\x5f\x47\x9f\x17\xdd\x9f\xd1\xb7\x6f\x35\x6d\x68\x20\xb1\x62\x26\xe9\xc9\x08\x84\xde\xb0\x95\xb1\x98\x2b\x3a\x4a\xa7\xfb\x52\x18\xd8\x99\xbe\x14\xda\x28\xcc\x84\xd1\x2d\xfa\xca\x15\x13\x29\xb6\x65\x1c\xec\xa6\x35\x21\x1d\x84\xb0\x10\xd2\x60\xc3\xa4\xc8\xb4\xc4\x04\xe1\x31\x85\x9e\x02\x0e\x58\x43\x5d\x38\x5b\x86\x1e\xe1\x32\xa6\x99\x0f\x50\x1f\x75\x8d\x8a\xa1\xfb\xf8\x52\x0d\x7c\x95\xaf\xf2\x36\x6c\xbd\xf1\xf0\x16\x33\x8e\x97\x8c\x33\x93\x9c\xc0\x87\x89\x35\x07\x4f\x48\x0a\x1e\x85\x2d\x70\x19\x81\xaa\x2d\xaf\xdb\x98\x82\x26\x8a\x45\xc6\xa9\xf4\xa2\xdc\x3f\xc2\x9c\xcb\x7b\x8d\x30\x21\xa0\x35\x32\xd2\x0e\xa0\xea\xbc\xa0\x68\x23\xb5\x71\x3c\x11\x5a\x01\x36\xb1\x02\xed\xc6\xcd\x06\x50\x86\xdc\xae\x54\xb1\x40\xd8\xce\x24\x28\xd6\xa0\x7e\x71\x4f\x6b\x25\xe3\x28\x7d\x5c\xe9\x2f\xf9\x0b\x45\xf7\xcc\x6c\x32\x9e\x76\x6e\x3e\xbc\x62\x22\xde\xb9\xf8\x82\x9d\xe9\x21\xf4\x47\x30\x1b\x8f\xc6\x5f\x7c\x64\x36\x4c\x23\xa6\x9d\xb4\x50\x6a\x83\x14\x70\xbc\x03\x8a\xe6\xfd\xbe\x63\xa5\x37\x32\xe6\x14\x2d\xc1\x8a\xa5\x19\x53\x29\x78\x82\x56\x52\x21\x92\x7a\x25\xc2\x34\x64\x82\x59\xe7\xb0\x4a\xe8\xa1\x2f\x0a\x0b\xe3\x60\x20\x82\x63\x37\x76\xe1\xd6\xb6\xea\x9b\x28\xc0\x06\x3c\xcb\xb5\xa2\xe5\x6a\x5c\x29\x88\xbc\x7b\xa9\xee\xb8\xc4\xd6\x1f\xda\x5d\xad\x7b\x42\x08\x3a\xcb\x7c\x95\xda\x0c\x98\xfa\x5d\xf2\x38\x84\x29\x8f\xd7\x4c\xf8\xc8\x8a\x2f\xa7\x47\xd3\x7e\x73\x68\x0c\xc6\x42\x69\x0e\x4f\x47\x83\xbd\x21\xa9\x8c\xae\x0e\x4e\x73\xbc\x43\x4d\x30\xc7\xa9\xc3\xb4\x4c\x53\x1b\x70\x98\x09\xeb\x75\xe5\x34\xd0\x3e\x8e\x52\x97\x60\xa0\xfd\x8e\x87\x2e\xfe\xef\x22\x9f\xba\x11\x1a\xaf\x60\x9e\x68\x62\x78\x31\x47\x61\x85\x63\x6e\x02\x5a\x5f\x89\x44\xcc\x79\x27\xf3\x18\xab\x60\x93\x44\xe0\xa3\x59\x2c\x02\x1d\x88\xa4\xe3\xdc\xca\x31\x49\x93\x9c\x9f\x99\xd9\x73\x66\xd6\xe5\xb8\x8d\x90\xca\x6b\x88\x2d\x95\xee\x44\x8a\x49\xe5\x22\xce\x09\x52\x80\xe9\x44\xf0\x64\x26\xa5\xf9\xcc\x38\x64\xd4\x68\x85\xb9\x86\x8e\x82\xbf\x62\xa6\x80\x0e\x94\x8c\x5a\x50\x2a\x0b\xea\x46\x83\x6a\xc1\xa9\xc1\xf9\x75\x96\x9d\x5a\x09\x08\x91\x61\x34\x55\x72\x65\xe5\xe6\x6a\xd1\x71\x14\x71\x08\x41\x18\xcc\xbf\x64\x7b\xdd\x5b\x6b\xa3\xac\xaa\x03\x9d\xd6\x0b\x9c\xd6\x0b\xbf\x5e\x06\xfc\x4c\xd3\x4f\x25\x2f\x6b\xcb\x13\x17\x30\xb1\x56\xa0\xb5\x27\xd6\x4c\xec\x9e\xb5\xc8\x19\x4f\xdb\xb4\xff\xd4\xe5\x6b\x6c\xe0\x0e\x20\xca\x0d\xff\x8c\x75\x71\x44\x6d\x64\x17\xe5\xd3\x73\x41\xdd\xd9\xba\x88\x2b\xec\x91\xd7\x43\x10\xb4\x59\xa0\xd4\x12\x93\x1e\x8e\xcd\x46\x2a\xf6\xef\x34\xb9\xdc\x7d\xd4\xb5\x22\x95\x55\xc6\x99\xe4\x70\xb8\x2a\xf9\x65\x43\x71\x7a\xbd\x56\x71\xe6\x3c\x38\x62\xa5\xbf\xa4\xd8\x6d\x6e\xd2\x32\x56\x04\x6a\x83\x5b\x50\xcb\xca\x80\x87\x84\x14\xb3\x8c\xf0\x66\x76\x75\x9c\xb6\x21\x26\x8b\xbe\x5e\xa5\xd2\xef\x8b\x2d\x54\xac\xeb\x1c\xd3\xdc\xea\x1e\x29\x70\xc8\x1e\xd7\x60\xdc\x5f\xce\x74\xfa\x10\x61\x43\x36\xee\x29\x35\x99\x7b\xbc\x77\x83\xdf\x8b\xe7\x8d\x36\xd8\xc4\x0d\x58\x39\x80\x3d\xb9\xcf\x93\x66\xb0\xbe\xe3\x60\x5e\xc9\xde\x73\x34\xe7\xda\xf9\x2b\xda\xf6\xa9\x7b\x6e\x74\xe7\xa7\x85\xfb\x27\x26\x28\x13\xeb\x23\x51\xdf\xc8\x4c\x59\x12\xf0\x94\xe4\xb0\xcc\x16\x3f\x2b\x21\x48\x0e\x33\x58\xd9\x25\xb9\xa2\x8e\x60\xef\x20\xb4\x9f\xa9\x0e\xe5\x27\x1d\x2f\xff\x05\xc4\xb8\x74\xd3\x7a\x50\x39\xed\x78\xf2\x08\x93\xbc\x6c\x1d\x65\xf1\x5d\x36\x3a\x66\x1c\x25\x77\x89\xb3\xc5\x8b\x66\x65\x0b\x0d\x84\x61\xa4\x61\x83\xa6\xb7\xca\x3b\x10\x0a\xb6\x0c\xee\xdb\xe3\xa7\x95\x71\x8b\x6d\x1b\x7c\x33\x03\xa6\x3d\xff\x51\xfe\xe7\xf5\xfd\x52\xdb\xa5\xe7\xbf\xec\xb1\xf6\x07\x44\x45\xc5\x45\x5e\x7f\x60\x68\xb2\x01\x1a\x73\x26\xd6\x15\xc3\xd5\xce\x86\x0b\x7b\xda\xca\xbb\x63\x44\x38\xd6\xba\x71\xc0\x72\xa7\xaa\x4c\x0e\xca\xda\x2c\x14\x49\xaa\xdd\x99\xab\x97\xf9\xc1\x34\x63\xd1\xb7\x1c\x8e\x1c\xc5\xf3\xbe\x9d\x28\x66\xe3\x81\x3f\xcb\xac\x5b\xcc\x63\xf0\xd1\xe5\xdb\xfc\xf7\x1c\xcf\x7d\x24\x15\x70\xc0\x14\x94\x07\x1c\x88\x5d\x9e\x27\x85\x17\xf6\xcf\x03\x09\xa3\xdb\xdd\x8f\x62\x22\xc5\x8a\xad\x43\x1c\x1d\xa8\x64\x45\x15\xbd\x2f\x4a\x5a\xa5\xec\x56\x0a\x6a\x59\xf2\xb2\x5a\x7c\xa2\xf8\xa3\xf5\x74\x4f\xce\x93\x98\xc3\x16\x84\x79\xb1\x9c\xf4\xb4\x64\xd4\x66\xe0\x57\x96\x87\x5a\x13\x50\xab\x63\xbe\xfe\x54\x54\x56\x0c\xe7\x48\xd7\x38\x6a\xb3\x8d\x4b\xae\xa9\xab\xbd\xb8\x11\x72\x51\x1b\x63\xec\xf9\x7b\x97\xf8\xa8\xfb\xf0\xc0\x56\xe5\x97\xcc\x79\x04\xa4\xe7\xa6\x52\x90\xdf\xbe\x3d\x3c\xa0\x63\x04\xbd\xa1\xc0\x4b\x0e\xf6\x8c\x68\x4f\x8b\x87\xe9\xbe\xe6\x32\x91\x67\x49\x41\xd0\xe2\x4f\x37\x83\xa4\xff\x1b\x98\xf4\x11\x50\x42\x9e\x1b\xd1\x58\x1e\x81\xe3\x7c\x23\x88\xcd\xe6\x8c\x80\xa6\xb9\xcc\x76\x48\x4b\x29\x8d\x36\x0a\x47\xfd\xe0\x8c\xa0\x3e\x95\x52\xdb\x61\xb9\xef\x6f\x23\xe1\xbe\xa4\x43\x41\xfd\xa2\x08\xd1\x71\x88\x41\x2b\x82\x36\xb4\xcf\x4a\x13\x0a\x38\x4e\x3c\xbc\x06\x61\x7e\x74\xb2\xc8\xfa\x95\xd1\xc0\x47\x75\xed\x35\x6e\x3d\x52\xe5\x9d\x70\x31\x52\x30\xee\xa2\x42\x1d\xae\x30\x72\x9c\x68\x1f\x5d\x1c\x90\x16\x94\x37\x17\xa9\x9f\x1c\x92\x59\x21\xac\x0b\x4e\x25\x74\x2b\xc6\xb8\xd8\xb3\x02\x8e\x22\x5d\xd6\xd3\x01\x44\x5c\x26\x21\xb4\xde\x49\x55\x6c\xf1\xe2\x46\xd0\x11\x10\x4b\xaf\x5d\xb1\x93\x2a\x5d\x1b\xda\xbe\xe2\xaa\xc2\xcc\xb1\x6b\x22\x51\x10\x71\x46\xb0\xf6\xd1\x65\x9a\x3c\xdc\x87\xc7\x01\x60\xca\x99\x80\x39\x10\x29\xa8\x9d\xfc\xf8\xf6\x6d\x07\x21\x03\x61\xc4\xb1\x81\x4c\x42\x65\x97\xf6\xc7\x6b\xc2\x5a\xc5\x21\x94\x83\x75\x04\xab\x15\x13\xcc\x24\xe5\x12\x21\x29\x04\x7b\xa3\x16\x66\xf6\xad\x39\x56\x4c\xac\xe7\x45\xbf\x3e\x5a\x0b\x59\x0c\x0f\x77\x40\xdc\x95\x45\x75\x65\xca\x73\x9e\xa9\x66\x01\x2a\xd4\xf5\x69\xdb\x44\x39\x5d\x0d\x77\x91\xdd\x7b\x79\xdb\xd5\xa4\xba\x83\xc4\x6f\x5c\x20\x49\xdd\x42\x89\x90\x8c\x40\x61\x6b\x09\x34\x12\xad\x04\xae\x35\x6f\x15\x93\x8a\xe2\x4c\xc4\xbb\xa7\x82\xc0\x8a\x6c\x7e\x0c\x0c\x1c\xd2\x0f\xff\x7f\x78\x56\x85\xc5\xac\x01\x15\x32\xe1\x02\xe9\x8b\xc2\x04\xa6\xa0\x98\xa4\xa5\xff\xbc\xcd\xe8\xa2\xea\x01\x68\xfc\xd8\x99\xc7\x39\x4c\xad\xb9\x1a\xef\xb5\x67\xe9\xa5\x20\x33\xc5\xcd\x4b\xb1\x1f\x2f\x6f\xe6\xc0\x78\x9c\x85\xcc\x94\xc6\x62\x21\x5e\x83\x8f\xba\xcb\x58\x27\x4b\xb9\xf3\x2f\x7b\xef\xde\x75\x8b\x59\x22\xc3\x10\xdb\x90\xfe\x47\x57\x6f\xba\xbf\xa0\xae\x47\xec\xbf\xb1\x63\x82\x3c\x81\x3e\xbc\x7f\xff\xee\x43\xf7\x9f\xc5\x02\x5d\xbf\x6e\xad\x2a\xb4\xbc\xe9\xca\x6e\x84\x72\x11\x87\xd0\x36\x03\xa6\x0a\xf7\xe1\x01\xf5\x06\xf2\x5e\x70\x89\xe9\xc0\xa6\xaf\x99\x25\x0e\x2c\xed\xc8\x92\xa0\xb4\xb2\x65\xd1\xa5\xd6\xda\x6e\xc1\xf3\x42\x49\xe1\x37\xc2\x19\x08\xe3\x76\xe3\x71\xb9\xf6\x38\x6c\x81\xff\xf6\xae\xb2\x0b\x10\xdb\x12\x79\x0e\x67\x3a\x19\xdc\x8e\x83\xeb\x61\xa7\xe1\x35\x9f\x95\x0c\xeb\x8e\xb3\x62\xc0\x69\xd6\xbe\xef\x8d\x4f\xb1\xed\x48\xf2\x8c\xd1\xb3\xbc\x0f\x8a\x9a\x4f\x83\xfe\x0f\x90\x97\xfe\x8f\x86\xa6\xd0\xeb\xe0\xcf\xdb\xc1\x28\xb8\x9a\x3f\x2e\x90\xe4\xb5\xf6\x6f\x90\xb4\xc8\x3d\x52\x74\xab\x3f\x17\xc0\x21\xde\x0d\x18\xe6\xcd\xec\x21\xdd\xe7\x06\xcc\x6b\xae\x52\x62\xb5\x38\x27\x37\x8b\xdb\xe9\x6c\xf2\xe7\xdf\x5f\x08\x6f\xe3\x2c\xb1\x87\xb4\xec\xb9\xbf\x03\xeb\x6d\x70\xb3\xf8\x3a\x1c\x2f\x46\xfd\x60\x31\x9a\x8c\xcf\x04\xbd\x68\x85\x4f\x43\xfe\x75\xb1\x98\x9e\x5d\xc5\xcf\xd0\xb0\xc5\x39\xff\x9f\xf0\x85\xf1\xe4\xac\x30\xb3\x13\xd9\x69\x18\x3f\x4d\x26\x8b\xf9\x62\x16\x4c\x6f\xfb\xc1\x6d\x7f\x38\x5b\x9c\x09\x6c\xe5\x70\x74\x1a\xe0\xe0\xea\x6a\xf2\xc7\xed\x68\x3c\x1f\xf6\x6f\x66\xc3\xdb\x02\xff\x99\x70\xb7\x9f\x9e\x9e\xb6\x85\xf4\x16\xf9\xda\xd6\xf3\x5a\xf3\xe1\x3d\x2d\x87\x86\x76\x61\x9a\xe0\xdf\x80\x21\x6f\x1a\x24\xb5\x8f\x66\xd5\x06\x12\xb4\x69\xf4\x3a\x24\x8a\xdd\xf7\xd1\xb0\xce\x1f\x42\xa9\x12\x1f\x5d\xfe\xfa\xf1\x9a\x55\x66\xd2\x1e\xa2\x85\xc3\xfb\x43\x1c\xde\x5f\xfe\x7a\xcd\xdc\x48\xa7\xba\xf3\x63\x35\xbf\xb9\xe1\xc2\x58\x55\xb9\x07\x57\xfd\x27\x00\x00\xff\xff\xa2\xa1\x26\x2a\xd3\x27\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x8e\x41\x4a\x04\x31\x10\x45\xf7\x39\x45\x5d\x20\x11\xc1\x55\x0e\xe1\x72\xc0\x65\x75\xe7\xab\x61\x92\x4a\x48\x55\x2b\xc3\x38\x77\x97\x4c\xd3\x32\x0b\x77\xf5\xf9\x8f\xfa\xef\x7a\xf5\x94\xdf\x29\x9c\xb8\x6c\xd0\x20\x5c\xa1\x9d\x57\x84\x75\x80\x0d\x74\xbb\x39\xee\xf9\x84\xa1\xb9\x49\xa4\xaf\x67\x77\xce\x92\x22\xbd\x1e\xa0\xab\x30\x4e\x6c\x1c\x1d\x51\xe1\x05\x45\xe7\x45\xb4\x36\xb1\xd1\x8a\xef\x85\x05\xf1\x88\x05\xc3\x57\x16\xfe\xc0\xb8\x53\xdc\x7b\x38\x6f\x0b\x86\xc0\xa0\x21\xb7\xa7\xbd\x4d\x7e\xb9\x44\xea\x3c\xb8\x6c\x7a\x27\xa7\xe9\x77\xb6\xcf\x7f\x5c\xf7\xd9\xe9\x7a\x80\xd6\xde\xb8\x16\x0a\xf4\x43\x92\x25\x41\x8c\x5e\x1e\x7b\x48\xda\xe3\x7c\xf2\xb7\xe3\xf5\xa2\x86\xea\x1e\x88\xdf\x01\x00\xd2\xa0\xdd\x20\x20\x01\x00\x00"),
+		},
+		"/helm/templates/openshift-scc.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "openshift-scc.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 57, 54, 471317786, time.UTC),
+			uncompressedSize: 1721,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x55\x41\x6f\xe4\x36\x0f\xbd\xfb\x57\x10\x7b\x09\xf0\xe1\xf3\x14\xbd\xfa\x16\x4c\xb6\x69\x80\x22\x0d\x12\x6c\x7a\xe6\x48\xf4\x0c\x11\x59\x52\x49\x6a\x12\x63\xb1\xff\xbd\x90\xc6\x93\x4c\x76\x07\x68\xba\x27\xdb\x7c\x7c\x24\x4d\x3e\x4a\x5f\xbf\xf6\xc0\x23\xac\x1e\x31\x14\xd2\x55\xca\x14\x75\xc7\xa3\xc1\xb7\x6f\x1d\x66\x7e\x24\x51\x4e\x71\x00\x25\x57\x84\x6d\x7e\xf3\x58\x71\xfa\x65\xff\x6b\xf7\xc4\xd1\x0f\xf0\xb0\xc0\xeb\x14\x8d\x5e\x6c\x9d\xa2\x9a\x20\x47\xd3\x6e\x22\x43\x8f\x86\x43\x07\x10\x71\xa2\x01\x32\x0a\x86\xa2\x7d\x16\xde\x73\xa0\x2d\xf9\x5e\x9d\xeb\x00\x30\xc6\x64\x68\x9c\xa2\x56\x6f\x00\x8e\x2e\x14\x4f\x2b\xa1\x40\xa8\xf4\x3e\x39\x6f\xa6\xde\x85\x54\x7c\x3f\x61\xc4\x2d\xf9\x01\x3e\x99\x14\xfa\xf4\xef\x54\xa5\x30\x1e\x59\xfd\x8e\xb7\xbb\x1e\xf7\xc8\x01\x37\x1c\xd8\xe6\xff\x10\x87\xe3\x36\x50\x1f\x93\xa7\xde\xd3\x9e\x42\xca\x24\xef\xe8\x4f\x65\x43\x12\xc9\x48\xab\xbf\x27\x75\xc2\xd9\x5a\x4b\x2f\xde\xfe\x1f\x30\x84\xf4\xac\x80\xce\x91\x2a\x58\xaa\x06\x38\xc5\xa3\x87\x5d\x52\x6b\x31\x01\x46\x42\x2b\x42\xda\xec\xb6\x23\x58\x2a\xaf\x4c\x29\x11\xb0\x22\x33\x14\x25\xf9\x7f\x7b\xdb\x4a\x2a\xf9\xf0\x3a\xea\xf5\xf1\xc3\xc3\x33\xdb\x6e\x89\x59\xb1\x87\xcf\x7f\x70\x2c\x2f\xe0\x0e\x63\x5c\x01\xfc\x75\x79\x7f\x7b\x73\x7b\x3d\x80\xed\x58\x81\xb5\x65\x9b\x92\x1a\x08\x05\x7c\x21\x0f\x0f\xeb\x75\x0b\xa5\xbb\x54\x82\x87\x0d\xd5\xb4\x7e\x09\x9a\x62\x98\x61\x4c\x02\x2e\x14\x35\x12\x40\x3f\x71\xe4\x2a\x8e\xda\x84\x15\x5c\x0b\x46\x6b\x65\x80\xc3\xd2\x6c\x17\x8d\x7b\xb6\xdf\x4e\x08\x8d\xfa\x1a\xf5\xa4\xcb\x01\x37\x14\x16\xc5\x08\xe5\xfe\x39\xc9\x53\x48\x58\xf5\x70\x5e\x6a\x87\xd1\x60\xce\xab\xf7\xe3\x39\x2a\x62\x33\xbf\x8a\xb4\x6b\x93\xf9\x3d\xa9\x5d\xb1\x3c\xa6\x50\x26\xba\x0b\x65\xcb\x71\x80\x9a\xfe\x0d\xbe\xb9\x5b\x7f\x6f\xba\x25\xab\xa5\x7c\x6f\xbe\xbb\xb9\xfa\xc1\x94\xc4\xf4\xd4\x78\x77\xac\xf7\xb3\x3a\x0c\x78\x10\xcc\x19\xd8\xd7\x85\x43\x8e\x24\xa7\x30\xf9\x35\xe6\x83\x24\x98\x74\xe8\x7a\xb8\xf8\xdf\xc5\x11\xfa\x12\x15\x47\x7a\x98\xd5\x59\x78\xc5\x3c\x8d\x58\x82\x5d\xfa\xf7\x4c\x88\x25\x84\x6e\x51\x4c\x6d\xb0\xcd\x99\x06\xb8\x2f\xf1\x52\x2f\xe3\xdc\x35\x59\xb5\x20\x3a\xab\xd1\x34\x2c\x63\xee\xdb\x98\xf5\xcd\x5e\x37\xe4\xe4\x73\xc2\xea\xa5\x5d\x16\x4e\xd2\x36\xae\x25\x12\x42\xff\x67\x0c\xf3\x7d\x4a\xf6\x1b\x07\x5a\xbc\x61\xc4\xa0\xd4\x09\xfd\x5d\x58\xc8\x5f\x49\xca\x67\xaa\x94\x5a\xd4\x17\x25\x39\x53\xa7\x52\xd3\xf5\x72\x3a\x9d\x75\x70\x2e\x4d\xf9\x4e\xd2\x58\xf3\x1e\xdb\xa2\x25\xe7\x40\x13\x45\xc3\x70\xbd\xfc\xeb\x0f\xdc\xba\x65\xa7\x3d\x50\x92\x3d\x3b\x42\xe7\x52\x89\x36\x1c\x25\xb8\xa0\x4b\xa7\x3f\xea\x7e\x78\xf4\x8a\x1f\x25\x70\xdc\x0a\xa9\xf6\x71\xcb\xf1\xe5\xa7\x48\x6d\x78\x5a\x8f\xfd\x8f\xd2\xb7\x68\xf4\x44\x94\x8f\x83\xff\x09\x5e\xc9\xbe\x6e\x76\xbd\x20\x34\xa3\xa3\xbe\x2d\x75\xb7\x6f\x1b\xf7\x3a\x8f\x7a\x5b\x51\xf4\xf5\x72\xfa\x67\x00\xae\x1a\xb4\xaf\xb9\x06\x00\x00"),
+		},
+		"/helm/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 58, 4, 226406699, time.UTC),
+			uncompressedSize: 3527,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x95\xcd\x6e\xdb\x30\x0c\xc7\xef\x7e\x0a\xa2\x97\x02\x03\xe4\xae\xb7\xc1\xb7\xad\x87\x5d\x76\x18\xb2\x8f\x3b\x2d\x31\x8e\x16\x45\x12\x44\xc9\x5d\xf6\xf4\x83\x1d\x3b\x75\x12\xc7\x6d\xd2\xb4\x48\x2f\x15\x14\x91\xfc\xd3\x24\x7f\x44\xaf\x7f\x53\x60\xed\x6c\x01\xf5\x7d\xb6\xd4\x56\x15\xf0\x83\x42\xad\x25\x7d\x96\xd2\x25\x1b\xb3\x15\x45\x54\x18\xb1\xc8\x00\x2c\xae\xa8\x00\x5e\x73\xa4\x95\x60\xec\x6e\xd8\xa3\xa4\x02\x3c\x06\x34\x89\xc5\xe6\xe7\x0c\xc0\x60\x49\x86\x1b\x3b\x00\xf4\x3e\x5f\xa6\x92\x82\xa5\x48\x9c\x6b\x77\xb7\x42\x8b\x15\x29\x51\xae\xb7\xa6\x99\x10\x22\x1b\x6a\x0a\x25\xca\x1c\x53\x5c\xb8\xa0\xff\x61\xd4\xce\xe6\xcb\x4f\xad\xf5\x56\xed\x83\x49\x1c\x29\xcc\x9c\xa1\x11\xa9\x9d\xe3\x62\x13\x2c\x9c\x25\x2a\x24\x43\x5c\x64\x02\xd0\xeb\xaf\xc1\x25\xdf\x5a\x0b\xb8\xfd\x70\x9b\x01\x04\x62\x97\x82\xa4\x9d\xcb\x9a\x42\x39\xb8\x10\x60\x9d\x9d\x75\x0f\x7f\xcd\xbe\x4d\xbf\xdd\x0b\x23\x37\x09\xe6\x9d\x9c\x5c\x51\x7d\x18\x76\x5b\x06\xde\xf5\x28\x03\x61\xa4\xf6\xa8\xc8\x50\x77\xac\x28\xb6\xff\x8d\xe6\xcd\xc1\x63\x94\x8b\xf6\x94\xbc\xea\x0d\x1e\xdb\xcb\xd7\xea\xb9\xe3\x88\x31\xed\xc9\xaa\xe8\x48\xdc\xf3\xa2\x45\xe4\xa5\xa1\x78\x25\xb9\xf7\x6a\xde\x2b\xf3\x2b\x4a\xfb\xd4\x9c\x5f\x35\xee\x5f\xb4\x55\xda\x56\x13\x53\xbf\x4b\xa4\x1e\x02\x22\x38\x43\x65\x67\x7c\x16\x10\x9c\xa1\x19\xcd\x1b\x93\xfe\x43\x4d\x68\xcf\x00\x0e\x49\x75\x8c\x4f\x9c\xca\x3f\x24\x63\x8b\x9b\x51\x1a\x9f\xc6\xe0\x67\x9c\x28\x9a\x63\x32\x71\xda\xc5\x5b\x21\xd9\x07\xf7\x77\xdd\xd6\xe2\xa2\x54\x6e\xa4\x91\x8d\x5a\xee\xd5\x60\xbf\x5b\xdd\x92\x6c\xa0\x5a\xd3\xe3\xf8\xfc\x8c\x3a\x1e\xa9\xed\x9e\xdf\xae\x80\x28\x25\x31\x4f\xfa\x7f\xdf\xde\x7f\xfa\xda\x4f\x9d\x7f\xd9\xdd\xfd\x06\x53\x31\x68\x91\xeb\x1f\x0c\x96\x0b\x52\xc9\x68\x5b\x0d\x0a\xa7\x88\x65\xd0\x3e\xb6\x2f\x7e\x2e\x34\x83\x0f\xda\x05\x1d\xd7\x20\x0d\x32\x03\x2f\x5c\x32\x0a\x4a\x82\xc4\xa4\x60\xee\x42\x1f\x07\x78\xa3\x0d\xbc\x53\x0c\xce\x9a\x75\xde\xf5\xc1\xf7\xce\xc5\x43\xe3\xe1\x78\x0b\x88\x0e\xdb\x42\x06\xdd\xcc\x83\x39\xab\xac\x35\x9a\x44\x05\xdc\x7f\xec\xff\xce\xe9\xdc\x67\x50\x60\x08\x15\x05\x41\x86\x64\x63\xde\x43\xe1\xc2\xfd\x79\x04\x18\x37\x37\x87\x53\x2c\x9d\x9d\xeb\x6a\x85\xfe\xc8\x26\xdb\x6e\xd1\xc7\xed\x4a\x1b\xac\xdd\xc1\x42\x7d\x5a\x79\xdd\x2e\x3e\x31\xfc\xe4\x3e\x3d\x88\xf3\x22\xe7\x54\x93\x8d\x17\x63\xd2\xcb\x60\x34\x56\xe0\x2b\xe3\xd0\x28\x80\x46\x1b\xf3\xfa\x50\xf4\x7f\x00\x88\xd3\x74\x4c\xc7\x0d\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/download.yaml"].(os.FileInfo),
+		fs["/helm"].(os.FileInfo),
+	}
+	fs["/helm"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/helm/templates"].(os.FileInfo),
+	}
+	fs["/helm/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/helm/templates/configmap.yaml"].(os.FileInfo),
+		fs["/helm/templates/deployment.yaml"].(os.FileInfo),
+		fs["/helm/templates/namespace.yaml"].(os.FileInfo),
+		fs["/helm/templates/openshift-scc.yaml"].(os.FileInfo),
+		fs["/helm/templates/rbac.yaml"].(os.FileInfo),
 	}
 
 	return fs
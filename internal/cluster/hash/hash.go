@@ -38,3 +38,25 @@ func GetNodeHashFrom(labels map[string]string, taints []*corev1.Taint, unschedul
 	h.Write([]byte(finalHashString))
 	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
+
+// Namespace Hash is calculated from labels and annotations of the namespace
+// reported by the cluster, used to track changes in namespace inventory
+func GetNamespaceHashFrom(labels, annotations map[string]string) string {
+	finalHashString := fmt.Sprintf("labels:%s,annotations:%s", sortedPairs(labels), sortedPairs(annotations))
+	h := sha256.New()
+	h.Write([]byte(finalHashString))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func sortedPairs(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := ""
+	for _, k := range keys {
+		pairs += fmt.Sprintf("%s:%s,", k, m[k])
+	}
+	return pairs
+}
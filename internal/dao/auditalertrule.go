@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListAuditAlertRules returns the alert rules of the organization
+func ListAuditAlertRules(ctx context.Context, db bun.IDB, orgID uuid.UUID) ([]models.AuditAlertRule, error) {
+	var rules []models.AuditAlertRule
+	err := db.NewSelect().Model(&rules).
+		Where("organization_id = ?", orgID).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ListEnabledAuditAlertRules returns the enabled alert rules of all the
+// organizations
+func ListEnabledAuditAlertRules(ctx context.Context, db bun.IDB) ([]models.AuditAlertRule, error) {
+	var rules []models.AuditAlertRule
+	err := db.NewSelect().Model(&rules).
+		Where("disabled = ?", false).
+		Where("trash = ?", false).
+		Order("organization_id", "name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -2,10 +2,13 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/auditql"
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/uptrace/bun"
 )
@@ -54,88 +57,279 @@ func GetAuditLogAggregations(ctx context.Context, db *bun.DB, tag, field string,
 			Where("tag = ?", tag).GroupExpr("data->>'m'")
 	}
 
+	auditQuery, err := auditql.Compile(filters.GetQueryString(), auditql.ForTag(tag))
+	if err != nil {
+		return nil, err
+	}
 	// add filters
 	switch tag {
 	case audit.KUBECTL_API:
-		sq = buildRelayAuditQuery(sq, filters)
+		sq = buildRelayAuditQuery(sq, filters, auditQuery)
 	case audit.SYSTEM, audit.KUBECTL_CMD:
-		sq = buildQuery(sq, filters)
+		sq = buildQuery(sq, filters, auditQuery)
 	}
 
-	err := sq.Scan(ctx, &adata)
+	err = sq.Scan(ctx, &adata)
 	return adata, err
 }
 
-func GetAuditLogs(ctx context.Context, db *bun.DB, tag string, filters query.QueryFilters) ([]models.AuditLog, error) {
+// GetAuditLogs returns a page of audit logs of tag matching filters,
+// newest first. The cursor of the next page is returned when there are
+// more logs.
+func GetAuditLogs(ctx context.Context, db *bun.DB, tag string, filters query.QueryFilters) ([]models.AuditLog, *query.AuditCursor, error) {
+	after, err := query.DecodeAuditCursor(filters.GetPageToken())
+	if err != nil {
+		return nil, nil, err
+	}
+	limit := query.AuditPageSize(filters)
+	auditQuery, err := auditql.Compile(filters.GetQueryString(), auditql.ForTag(tag))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var logs []models.AuditLog
 	sq := db.NewSelect().Model(&logs).
 		Where("tag = ?", tag)
 
 	switch tag {
 	case audit.KUBECTL_API:
-		sq = buildRelayAuditQuery(sq, filters)
+		sq = buildRelayAuditQuery(sq, filters, auditQuery)
 	case audit.SYSTEM, audit.KUBECTL_CMD:
-		sq = buildQuery(sq, filters)
+		sq = buildQuery(sq, filters, auditQuery)
+	}
+	if after != nil {
+		sq.Where("(time, id) < (?, ?)", after.Time, after.ID)
+	}
+	err = sq.Order("time DESC", "id DESC").Limit(limit + 1).Scan(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(logs) <= limit {
+		return logs, nil, nil
 	}
-	err := sq.Order("time desc").Scan(ctx)
+	logs = logs[:limit]
+	last := logs[limit-1]
+	return logs, &query.AuditCursor{Time: last.Time, ID: last.ID}, nil
+}
+
+// GetRelaySessionLogs returns up to limit relay api calls and kubectl
+// commands matching filters, newest first. The query of filters is
+// compiled for each of the streams, a stream whose fields it does not
+// know is left out.
+func GetRelaySessionLogs(ctx context.Context, db bun.IDB, filters query.QueryFilters, limit int) ([]models.AuditLog, error) {
+	apiQuery, apiErr := auditql.Compile(filters.GetQueryString(), auditql.RelayAPI)
+	cmdQuery, cmdErr := auditql.Compile(filters.GetQueryString(), auditql.Events)
+	if apiErr != nil && cmdErr != nil {
+		return nil, apiErr
+	}
+
+	var logs []models.AuditLog
+	err := db.NewSelect().Model(&logs).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			if apiErr == nil {
+				q.WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return buildRelayAuditQuery(q.Where("tag = ?", audit.KUBECTL_API), filters, apiQuery)
+				})
+			}
+			if cmdErr == nil {
+				q.WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return buildQuery(q.Where("tag = ?", audit.KUBECTL_CMD), filters, cmdQuery)
+				})
+			}
+			return q
+		}).
+		Order("time DESC", "id DESC").Limit(limit).
+		Scan(ctx)
 	return logs, err
 }
 
-func buildRelayAuditQuery(query *bun.SelectQuery, filters query.QueryFilters) *bun.SelectQuery {
+func buildRelayAuditQuery(sq *bun.SelectQuery, filters query.QueryFilters, auditQuery *auditql.Query) *bun.SelectQuery {
 	if filters.GetUser() != "" {
-		query.Where("data->>'un' = ?", filters.GetUser())
+		sq.Where("data->>'un' = ?", filters.GetUser())
 	}
 	if filters.GetKind() != "" {
-		query.Where("data->>'k' = ?", filters.GetKind())
+		sq.Where("data->>'k' = ?", filters.GetKind())
 	}
 	if filters.GetMethod() != "" {
-		query.Where("data->>'m' = ?", filters.GetMethod())
+		sq.Where("data->>'m' = ?", filters.GetMethod())
 	}
 	if filters.GetNamespace() != "" {
-		query.Where("data->>'ns' = ?", filters.GetNamespace())
-	}
-	if filters.GetCluster() != "" {
-		query.Where("data->>'cn' = ?", filters.GetCluster())
+		sq.Where("data->>'ns' = ?", filters.GetNamespace())
 	}
-	if filters.GetTimefrom() != "" {
-		diff := strings.Split(filters.GetTimefrom(), "-")[1]
-		query.Where("time between now() - interval ? and now()", diff)
+	if clusters := query.AuditClusters(filters); len(clusters) > 0 {
+		sq.Where("data->>'cn' IN (?)", bun.In(clusters))
 	}
+	sq = buildTimeRange(sq, filters)
 	if len(filters.GetProjects()) > 0 {
-		for _, project := range filters.GetProjects() {
-			query.Where("data->>'pr' = ?", project)
-		}
+		sq.Where("data->>'pr' IN (?)", bun.In(filters.GetProjects()))
 	}
-	return query
+	return buildAuditQuery(sq, auditQuery)
 }
 
-func buildQuery(query *bun.SelectQuery, filters query.QueryFilters) *bun.SelectQuery {
+func buildQuery(sq *bun.SelectQuery, filters query.QueryFilters, auditQuery *auditql.Query) *bun.SelectQuery {
 	if len(filters.GetProjects()) > 0 {
-		for _, project := range filters.GetProjects() {
-			query.Where("data->>'project' = ?", project)
-		}
+		sq.Where("data->>'project' IN (?)", bun.In(filters.GetProjects()))
 	}
 
 	if filters.GetType() != "" {
-		query.Where("data->>'type' = ?", filters.GetType())
+		sq.Where("data->>'type' = ?", filters.GetType())
 	}
 
 	if filters.GetUser() != "" {
-		query.Where("data->'actor'->'account'->>'username' = ?", filters.GetUser())
+		sq.Where("data->'actor'->'account'->>'username' = ?", filters.GetUser())
 	}
 
-	if filters.GetCluster() != "" {
-		query.Where("data->'detail'->'meta'->>'cluster_name' = ?", filters.GetCluster())
+	if clusters := query.AuditClusters(filters); len(clusters) > 0 {
+		sq.Where("data->'detail'->'meta'->>'cluster_name' IN (?)", bun.In(clusters))
 	}
 
 	if filters.GetClient() != "" {
-		query.Where("data->'client'->>'type' = ?", filters.GetClient())
+		sq.Where("data->'client'->>'type' = ?", filters.GetClient())
+	}
+
+	return buildAuditQuery(buildTimeRange(sq, filters), auditQuery)
+}
+
+// buildAuditQuery adds the predicate of the compiled queryString filter
+func buildAuditQuery(sq *bun.SelectQuery, auditQuery *auditql.Query) *bun.SelectQuery {
+	if auditQuery == nil {
+		return sq
 	}
+	predicate, args := auditQuery.SQL()
+	return sq.Where(predicate, args...)
+}
 
+// buildTimeRange adds the relative timefrom and the absolute from and to
+// bounds of filters
+func buildTimeRange(sq *bun.SelectQuery, filters query.QueryFilters) *bun.SelectQuery {
 	if filters.GetTimefrom() != "" {
 		diff := strings.Split(filters.GetTimefrom(), "-")[1]
-		query.Where("time between now() - interval ? and now()", diff)
+		sq.Where("time between now() - interval ? and now()", diff)
+	}
+	if filters.GetFrom() != nil {
+		sq.Where("time >= ?", filters.GetFrom().AsTime())
 	}
+	if filters.GetTo() != nil {
+		sq.Where("time < ?", filters.GetTo().AsTime())
+	}
+	return sq
+}
 
-	return query
+// GetAuditChainSeqRange returns the lowest and highest sequence numbers of
+// the chained events of organization in the time range, zero times leave
+// the range open. Both are zero when there are no events.
+func GetAuditChainSeqRange(ctx context.Context, db bun.IDB, org string, from, to time.Time) (int64, int64, error) {
+	var r struct {
+		Min sql.NullInt64
+		Max sql.NullInt64
+	}
+	sq := db.NewSelect().Table("audit_logs").
+		ColumnExpr("min(seq) AS min, max(seq) AS max").
+		Where("organization = ?", org).
+		Where("seq IS NOT NULL")
+	if !from.IsZero() {
+		sq.Where("time >= ?", from)
+	}
+	if !to.IsZero() {
+		sq.Where("time < ?", to)
+	}
+	if err := sq.Scan(ctx, &r); err != nil {
+		return 0, 0, err
+	}
+	return r.Min.Int64, r.Max.Int64, nil
+}
+
+// GetAuditChain returns up to limit chained events of organization with
+// sequence numbers from first to last, in chain order
+func GetAuditChain(ctx context.Context, db bun.IDB, org string, first, last int64, limit int) ([]models.AuditLog, error) {
+	var logs []models.AuditLog
+	err := db.NewSelect().Model(&logs).
+		Where("organization = ?", org).
+		Where("seq BETWEEN ? AND ?", first, last).
+		Order("seq").Limit(limit).
+		Scan(ctx)
+	return logs, err
+}
+
+// GetAuditChainHeads returns the heads of the audit chains of all the
+// organizations
+func GetAuditChainHeads(ctx context.Context, db bun.IDB) ([]models.AuditChainHead, error) {
+	var heads []models.AuditChainHead
+	err := db.NewSelect().Model(&heads).Order("organization").Scan(ctx)
+	return heads, err
+}
+
+// GetAuditChainHead returns the head of the audit chain of organization
+func GetAuditChainHead(ctx context.Context, db bun.IDB, org string) (*models.AuditChainHead, error) {
+	var head models.AuditChainHead
+	err := db.NewSelect().Model(&head).Where("organization = ?", org).Scan(ctx)
+	return &head, err
+}
+
+// GetLastAuditCheckpoint returns the latest checkpoint of the audit chain
+// of organization
+func GetLastAuditCheckpoint(ctx context.Context, db bun.IDB, org string) (*models.AuditCheckpoint, error) {
+	var cp models.AuditCheckpoint
+	err := db.NewSelect().Model(&cp).
+		Where("organization = ?", org).
+		Order("seq DESC", "id DESC").Limit(1).
+		Scan(ctx)
+	return &cp, err
+}
+
+// GetAuditCheckpoints returns the checkpoints of the audit chain of
+// organization at sequence numbers from first to last or created in the
+// time range, zero times leave the range open
+func GetAuditCheckpoints(ctx context.Context, db bun.IDB, org string, first, last int64, from, to time.Time) ([]models.AuditCheckpoint, error) {
+	var cps []models.AuditCheckpoint
+	err := db.NewSelect().Model(&cps).
+		Where("organization = ?", org).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq.Where("seq BETWEEN ? AND ?", first, last)
+			return sq.WhereOr("created_at >= ? AND created_at < ?", from, timeOrMax(to))
+		}).
+		Order("seq", "id").
+		Scan(ctx)
+	return cps, err
+}
+
+// timeOrMax returns t or the far future when t is zero
+func timeOrMax(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return t
+}
+
+// GetLastAuditLogID returns the highest id of the audit logs, zero when
+// there are none
+func GetLastAuditLogID(ctx context.Context, db bun.IDB) (int64, error) {
+	var id sql.NullInt64
+	err := db.NewSelect().Table("audit_logs").ColumnExpr("max(id)").Scan(ctx, &id)
+	return id.Int64, err
+}
+
+// GetAuditLogsAfter returns up to limit audit logs of the tags with ids
+// above id, in insertion order
+func GetAuditLogsAfter(ctx context.Context, db bun.IDB, tags []string, id int64, limit int) ([]models.AuditLog, error) {
+	var logs []models.AuditLog
+	err := db.NewSelect().Model(&logs).
+		Where("tag IN (?)", bun.In(tags)).
+		Where("id > ?", id).
+		Order("id").Limit(limit).
+		Scan(ctx)
+	return logs, err
+}
+
+// GetRecentAuditLogs returns the latest limit system and relay audit logs
+// of organization, newest first. Relay audits carry the organization in
+// the data only.
+func GetRecentAuditLogs(ctx context.Context, db bun.IDB, org string, limit int) ([]models.AuditLog, error) {
+	var logs []models.AuditLog
+	err := db.NewSelect().Model(&logs).
+		Where("tag IN (?)", bun.In([]string{audit.SYSTEM, audit.KUBECTL_API, audit.KUBECTL_CMD})).
+		Where("CASE WHEN tag = ? THEN data->>'o' ELSE data->>'organization' END = ?", audit.KUBECTL_API, org).
+		Order("time DESC", "id DESC").Limit(limit).
+		Scan(ctx)
+	return logs, err
 }
@@ -0,0 +1,156 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/uptrace/bun"
+)
+
+// auditLogPartitionPrefix names the daily partitions of audit_logs, the
+// day is appended as YYYYMMDD
+const auditLogPartitionPrefix = "audit_logs_p"
+
+// auditLogDefaultPartition takes the events of days without a partition
+const auditLogDefaultPartition = "audit_logs_default"
+
+// AuditLogPartition returns the name of the partition of audit_logs
+// holding the events of day
+func AuditLogPartition(day time.Time) string {
+	return auditLogPartitionPrefix + day.UTC().Format("20060102")
+}
+
+// CreateAuditLogPartition creates the partition of audit_logs for the
+// events of day, in UTC
+func CreateAuditLogPartition(ctx context.Context, db bun.IDB, day time.Time) error {
+	from := day.UTC().Truncate(24 * time.Hour)
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS ? PARTITION OF audit_logs FOR VALUES FROM (?) TO (?)",
+		bun.Ident(AuditLogPartition(from)), from.Format("2006-01-02"), from.AddDate(0, 0, 1).Format("2006-01-02"))
+	return err
+}
+
+// CreateAuditLogPartitionFromDefault creates the partition of audit_logs
+// for the events of day when the default partition already holds events
+// of the day. The default partition is detached, the events of the day
+// are moved to the new partition and the default partition is attached
+// again. db should be a transaction.
+func CreateAuditLogPartitionFromDefault(ctx context.Context, db bun.IDB, day time.Time) error {
+	from := day.UTC().Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, 1)
+	partition := bun.Ident(AuditLogPartition(from))
+	def := bun.Ident(auditLogDefaultPartition)
+
+	if _, err := db.ExecContext(ctx, "ALTER TABLE audit_logs DETACH PARTITION ?", def); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, "CREATE TABLE ? PARTITION OF audit_logs FOR VALUES FROM (?) TO (?)",
+		partition, from.Format("2006-01-02"), to.Format("2006-01-02")); err != nil {
+		return err
+	}
+	// the default partition was the table before partitioning, its
+	// columns may be in another order
+	columns := "tag, time, data, id, organization, seq, prev_hash, hash"
+	if _, err := db.ExecContext(ctx, "INSERT INTO ? ("+columns+") SELECT "+columns+" FROM ? WHERE time >= ? AND time < ?",
+		partition, def, from, to); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, "DELETE FROM ? WHERE time >= ? AND time < ?", def, from, to); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, "ALTER TABLE audit_logs ATTACH PARTITION ? DEFAULT", def)
+	return err
+}
+
+// GetAuditLogPartitions returns the days of the daily partitions of
+// audit_logs, oldest first
+func GetAuditLogPartitions(ctx context.Context, db bun.IDB) ([]time.Time, error) {
+	var names []string
+	err := db.NewSelect().TableExpr("pg_inherits AS i").
+		Join("JOIN pg_class AS c ON c.oid = i.inhrelid").
+		ColumnExpr("c.relname").
+		Where("i.inhparent = 'audit_logs'::regclass").
+		Where("c.relname LIKE ?", auditLogPartitionPrefix+"%").
+		OrderExpr("c.relname").
+		Scan(ctx, &names)
+	if err != nil {
+		return nil, err
+	}
+	var days []time.Time
+	for _, name := range names {
+		day, err := time.Parse("20060102", strings.TrimPrefix(name, auditLogPartitionPrefix))
+		if err != nil {
+			// not created by retention
+			continue
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// GetAuditLogPartitionLogs returns up to limit events of the partition
+// of day with ids above id, in insertion order
+func GetAuditLogPartitionLogs(ctx context.Context, db bun.IDB, day time.Time, id int64, limit int) ([]models.AuditLog, error) {
+	var logs []models.AuditLog
+	err := db.NewSelect().Model(&logs).
+		ModelTableExpr("? AS auditlog", bun.Ident(AuditLogPartition(day))).
+		Where("id > ?", id).
+		Order("id").Limit(limit).
+		Scan(ctx)
+	return logs, err
+}
+
+// DropAuditLogPartition drops the partition of audit_logs of day with
+// its events
+func DropAuditLogPartition(ctx context.Context, db bun.IDB, day time.Time) error {
+	_, err := db.NewDropTable().Table(AuditLogPartition(day)).IfExists().Exec(ctx)
+	return err
+}
+
+// auditLogOrganization returns the expression of the organization of the
+// events of tag
+func auditLogOrganization(tag string) string {
+	if tag == audit.KUBECTL_API {
+		return "coalesce(data->>'o', '')"
+	}
+	return "coalesce(data->>'organization', '')"
+}
+
+// DeleteAuditLogs deletes up to limit events of tag older than before and
+// returns them. The events are of the organizations in orgs or, with
+// exclude, of all the other organizations. The batch is matched on tag,
+// time and id so that only the partitions older than before are scanned,
+// through their tag, time and id index.
+func DeleteAuditLogs(ctx context.Context, db bun.IDB, tag string, orgs []string, exclude bool, before time.Time, limit int) ([]models.AuditLog, error) {
+	sq := db.NewSelect().Table("audit_logs").Column("time", "id").
+		Where("tag = ?", tag).
+		Where("time < ?", before)
+	switch {
+	case !exclude:
+		sq.Where(auditLogOrganization(tag)+" IN (?)", bun.In(orgs))
+	case len(orgs) > 0:
+		sq.Where(auditLogOrganization(tag)+" NOT IN (?)", bun.In(orgs))
+	}
+	sq.Order("time", "id").Limit(limit)
+
+	var logs []models.AuditLog
+	_, err := db.NewDelete().Model(&logs).
+		Where("tag = ?", tag).
+		Where("time < ?", before).
+		Where("(time, id) IN (?)", sq).
+		Returning("*").
+		Exec(ctx)
+	return logs, err
+}
+
+// SetAuditChainPurged records that the events of the chain of
+// organization up to seq were removed
+func SetAuditChainPurged(ctx context.Context, db bun.IDB, org string, seq int64) error {
+	_, err := db.NewUpdate().Model((*models.AuditChainHead)(nil)).
+		Set("purged_seq = greatest(purged_seq, ?)", seq).
+		Where("organization = ?", org).
+		Exec(ctx)
+	return err
+}
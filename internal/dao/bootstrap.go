@@ -15,6 +15,18 @@ import (
 	"github.com/uptrace/bun"
 )
 
+var (
+	// ErrBootstrapTokenExpired is returned when an unregistered token is
+	// registered after its expiry
+	ErrBootstrapTokenExpired = errors.New("bootstrap token expired")
+	// ErrBootstrapFingerprintMismatch is returned when a registered token
+	// is registered again by a different agent
+	ErrBootstrapFingerprintMismatch = errors.New("bootstrap token registered by another agent")
+	// ErrBootstrapTokenUsed is returned when a registered token is
+	// registered again and the template does not allow it
+	ErrBootstrapTokenUsed = errors.New("bootstrap token already registered")
+)
+
 func CreateOrUpdateBootstrapInfra(ctx context.Context, db bun.IDB, infra *models.BootstrapInfra) error {
 
 	_, err := db.NewInsert().On("CONFLICT (name) DO UPDATE").
@@ -43,6 +55,17 @@ func CreateOrUpdateBootstrapAgentTemplate(ctx context.Context, db bun.IDB, templ
 	return err
 }
 
+// DeleteBootstrapAgentTemplate deletes the template along with the
+// agents created for it
+func DeleteBootstrapAgentTemplate(ctx context.Context, db bun.IDB, name string) error {
+	_, err := db.NewDelete().Model((*models.BootstrapAgent)(nil)).Where("template_ref = ?", name).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = db.NewDelete().Model((*models.BootstrapAgentTemplate)(nil)).Where("name = ?", name).Exec(ctx)
+	return err
+}
+
 func GetBootstrapAgentTemplateForToken(ctx context.Context, db bun.IDB, token string) (*models.BootstrapAgentTemplate, error) {
 	var template models.BootstrapAgentTemplate
 	err := db.NewSelect().Model(&template).Where("token = ?", token).Scan(ctx)
@@ -145,12 +168,17 @@ func RegisterBootstrapAgent(ctx context.Context, db bun.Tx, token, ip, fingerpri
 
 	switch ba.TokenState {
 	case sentry.BootstrapAgentState_NotRegistered.String():
+		if !ba.TokenExpiresAt.IsZero() && time.Now().After(ba.TokenExpiresAt) {
+			return ErrBootstrapTokenExpired
+		}
 		ba.TokenState = sentry.BootstrapAgentState_Approved.String()
 	case sentry.BootstrapAgentState_NotApproved.String(), sentry.BootstrapAgentState_Approved.String():
+		// the token is used, only the agent it was first registered
+		// by can register again
 		if !bat.IgnoreMultipleRegister {
-			return fmt.Errorf("cannot register token %s state is %s", token, ba.TokenState)
-		} else if ba.Fingerprint != fingerprint {
-			return fmt.Errorf("fingerprint mismatch for token %s", token)
+			return ErrBootstrapTokenUsed
+		} else if ba.Fingerprint == "" || ba.Fingerprint != fingerprint {
+			return ErrBootstrapFingerprintMismatch
 		}
 	default:
 		return fmt.Errorf("invalid token state %s", ba.TokenState)
@@ -166,6 +194,28 @@ func RegisterBootstrapAgent(ctx context.Context, db bun.Tx, token, ip, fingerpri
 	return err
 }
 
+// ResetBootstrapAgent marks the agent as not registered so that its
+// token can be registered again before expiresAt. The token is replaced
+// when newToken is set.
+func ResetBootstrapAgent(ctx context.Context, db bun.IDB, id uuid.UUID, newToken string, expiresAt time.Time) error {
+	var expiry *time.Time
+	if !expiresAt.IsZero() {
+		expiry = &expiresAt
+	}
+	q := db.NewUpdate().Model((*models.BootstrapAgent)(nil)).
+		Set("token_state = ?", sentry.BootstrapAgentState_NotRegistered.String()).
+		Set("fingerprint = ''").
+		Set("ip_address = ''").
+		Set("token_expires_at = ?", expiry).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", id)
+	if newToken != "" {
+		q = q.Set("token = ?", newToken)
+	}
+	_, err := q.Exec(ctx)
+	return err
+}
+
 func getBootstrapAgentForToken(ctx context.Context, db bun.IDB, token string) (*models.BootstrapAgent, error) {
 	var ba models.BootstrapAgent
 	err := db.NewSelect().Model(&ba).Where("token = ?", token).Scan(ctx)
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetCliLoginByUserCode returns the pending cli login of the user code
+func GetCliLoginByUserCode(ctx context.Context, db bun.IDB, userCode string) (*models.CliLogin, error) {
+	var cl models.CliLogin
+	err := db.NewSelect().Model(&cl).
+		Where("user_code = ?", userCode).
+		Where("expires_at > ?", time.Now()).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &cl, nil
+}
+
+// ApproveCliLogin records the account approving the pending cli login,
+// it fails with sql.ErrNoRows when the login expired or was already
+// approved
+func ApproveCliLogin(ctx context.Context, db bun.IDB, cl *models.CliLogin) error {
+	res, err := db.NewUpdate().Model(cl).
+		Column("account_id", "username", "organization_id", "partner_id", "approved_at").
+		WherePK().
+		Where("approved_at IS NULL").
+		Where("expires_at > ?", time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ClaimCliLogin deletes the cli login of the device code hash and returns
+// it, a login is claimed once
+func ClaimCliLogin(ctx context.Context, db bun.IDB, deviceCodeHash string) (*models.CliLogin, error) {
+	var cl models.CliLogin
+	res, err := db.NewDelete().Model(&cl).
+		Where("device_code_hash = ?", deviceCodeHash).
+		Where("approved_at IS NOT NULL").
+		Where("expires_at > ?", time.Now()).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &cl, nil
+}
+
+// GetCliLoginByDeviceCodeHash returns the unexpired cli login of the device
+// code hash
+func GetCliLoginByDeviceCodeHash(ctx context.Context, db bun.IDB, deviceCodeHash string) (*models.CliLogin, error) {
+	var cl models.CliLogin
+	err := db.NewSelect().Model(&cl).
+		Where("device_code_hash = ?", deviceCodeHash).
+		Where("expires_at > ?", time.Now()).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &cl, nil
+}
+
+// DeleteExpiredCliLogins deletes the cli logins expired before t
+func DeleteExpiredCliLogins(ctx context.Context, db bun.IDB, t time.Time) error {
+	_, err := db.NewDelete().Model((*models.CliLogin)(nil)).
+		Where("expires_at < ?", t).
+		Exec(ctx)
+	return err
+}
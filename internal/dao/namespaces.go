@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/match"
 	"github.com/uptrace/bun"
 )
 
@@ -33,7 +34,9 @@ func GetProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID)
 	return cns, err
 }
 
-func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]string, error) {
+// GetAccountProjectNamespaces returns the namespaces of the account in the
+// project, bindings limited to clusters not matching clusterLabels are skipped
+func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error) {
 	var cns []string
 
 	var panr []models.ProjectAccountNamespaceRole
@@ -42,13 +45,19 @@ func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid
 		return nil, err
 	}
 	for _, nr := range panr {
+		if !match.Labels(nr.ClusterSelector, clusterLabels) {
+			continue
+		}
 		cns = append(cns, nr.Namespace)
 	}
 
 	return cns, err
 }
 
-func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]string, error) {
+// GetGroupProjectNamespaces returns the namespaces of the groups of the
+// account in the project, bindings limited to clusters not matching
+// clusterLabels are skipped
+func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error) {
 	var cns []string
 
 	var pgnr []models.ProjectGroupNamespaceRole
@@ -61,6 +70,9 @@ func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.U
 		return nil, err
 	}
 	for _, nr := range pgnr {
+		if !match.Labels(nr.ClusterSelector, clusterLabels) {
+			continue
+		}
 		cns = append(cns, nr.Namespace)
 	}
 
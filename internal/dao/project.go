@@ -76,7 +76,7 @@ func GetFileteredProjects(ctx context.Context, db bun.IDB, account, partner, org
 func GetProjectGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.ProjectNamespaceRole, error) {
 	var pr = []*userv3.ProjectNamespaceRole{}
 	err := db.NewSelect().Table("authsrv_projectgrouprole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgrouprole.role_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgrouprole.group_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgrouprole.project_id`).
@@ -89,7 +89,7 @@ func GetProjectGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*use
 
 	var pnr = []*userv3.ProjectNamespaceRole{}
 	err = db.NewSelect().Table("authsrv_projectgroupnamespacerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, namespace").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, namespace, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgroupnamespacerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgroupnamespacerole.project_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgroupnamespacerole.group_id`).
@@ -107,7 +107,7 @@ func GetProjectUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*user
 
 	var ur = []*userv3.UserRole{}
 	err := db.NewSelect().Table("authsrv_projectaccountresourcerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountresourcerole.role_id`).
 		Join(`JOIN identities ON identities.id=authsrv_projectaccountresourcerole.account_id`).
 		Where("authsrv_projectaccountresourcerole.project_id = ?", id).
@@ -119,7 +119,7 @@ func GetProjectUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*user
 
 	var unr = []*userv3.UserRole{}
 	err = db.NewSelect().Table("authsrv_projectaccountnamespacerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, namespace").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, namespace, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id`).
 		Join(`JOIN identities ON identities.id=authsrv_projectaccountnamespacerole.account_id`).
 		Where("authsrv_projectaccountnamespacerole.project_id = ?", id).
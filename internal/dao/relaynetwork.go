@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListRelayNetworks returns the relay networks of the organization
+func ListRelayNetworks(ctx context.Context, db bun.IDB, orgID uuid.UUID) ([]models.RelayNetwork, error) {
+	var networks []models.RelayNetwork
+	err := db.NewSelect().Model(&networks).
+		Where("organization_id = ?", orgID).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return networks, nil
+}
+
+// ListRelayNetworkClusters returns the clusters of the organization which
+// are either one of clusterIDs or are part of one of projectIDs
+func ListRelayNetworkClusters(ctx context.Context, db bun.IDB, orgID uuid.UUID, projectIDs, clusterIDs []uuid.UUID) ([]models.Cluster, error) {
+	var clusters []models.Cluster
+	if len(projectIDs) == 0 && len(clusterIDs) == 0 {
+		return clusters, nil
+	}
+	err := db.NewSelect().Model(&clusters).
+		Where("organization_id = ?", orgID).
+		Where("trash = ?", false).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			if len(clusterIDs) > 0 {
+				q = q.WhereOr("id IN (?)", bun.In(clusterIDs))
+			}
+			if len(projectIDs) > 0 {
+				q = q.WhereOr("id IN (?)", db.NewSelect().
+					Model((*models.ProjectCluster)(nil)).
+					Column("cluster_id").
+					Where("project_id IN (?)", bun.In(projectIDs)).
+					Where("trash = ?", false))
+			}
+			return q
+		}).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clusters, nil
+}
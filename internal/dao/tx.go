@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	bun "github.com/uptrace/bun"
+)
+
+type txKey struct{}
+
+// Tx is a transaction returned by BeginTx
+type Tx interface {
+	bun.IDB
+	Commit() error
+	Rollback() error
+}
+
+// WithTx returns ctx carrying tx, the queries of the services called with
+// it run in tx so that several calls are committed or rolled back as one
+func WithTx(ctx context.Context, tx bun.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// GetDB returns the transaction of ctx, db when there is none
+func GetDB(ctx context.Context, db *bun.DB) bun.IDB {
+	if tx, ok := ctx.Value(txKey{}).(bun.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// BeginTx begins a transaction on db. When ctx carries a transaction the
+// returned one joins it, committing or rolling it back is left to the
+// caller of WithTx.
+func BeginTx(ctx context.Context, db *bun.DB) (Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(bun.Tx); ok {
+		return joinedTx{tx}, nil
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// joinedTx is a transaction part of the transaction of a context
+type joinedTx struct {
+	bun.Tx
+}
+
+func (joinedTx) Commit() error {
+	return nil
+}
+
+func (joinedTx) Rollback() error {
+	return nil
+}
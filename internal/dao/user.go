@@ -50,7 +50,7 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 
 	var pr = []*userv3.ProjectNamespaceRole{}
 	err = db.NewSelect().Table("authsrv_projectaccountresourcerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountresourcerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountresourcerole.project_id`).
 		Where("authsrv_projectaccountresourcerole.account_id = ?", id).
@@ -64,7 +64,7 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 
 	var pnr = []*userv3.ProjectNamespaceRole{}
 	err = db.NewSelect().Table("authsrv_projectaccountnamespacerole").
-		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, NULLIF(cluster_selector, '') as cluster_selector").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountnamespacerole.project_id`). // also need a namespace join
 		Where("authsrv_projectaccountnamespacerole.account_id = ?", id).
@@ -268,3 +268,29 @@ func IsSSOAccount(ctx context.Context, db bun.IDB, id uuid.UUID) (bool, error) {
 	}
 	return false, err
 }
+
+// GetOrganizationUsernames returns the usernames of the accounts with a
+// role in the organization, directly or through one of its groups
+func GetOrganizationUsernames(ctx context.Context, db bun.IDB, partnerID, orgID uuid.UUID) ([]string, error) {
+	accounts := db.NewSelect().Model((*models.AccountPermission)(nil)).
+		ColumnExpr("account_id").
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID)
+	groupAccounts := db.NewSelect().Model((*models.GroupAccount)(nil)).
+		ColumnExpr("groupaccount.account_id").
+		Join("JOIN authsrv_group AS g ON g.id = groupaccount.group_id").
+		Where("g.organization_id = ?", orgID).
+		Where("g.partner_id = ?", partnerID).
+		Where("g.trash = ?", false).
+		Where("groupaccount.trash = ?", false)
+
+	names := []string{}
+	err := db.NewSelect().Model((*models.KratosIdentities)(nil)).
+		ColumnExpr("DISTINCT traits ->> 'email' as name").
+		Where("identities.id IN (?) OR identities.id IN (?)", accounts, groupAccounts).
+		Scan(ctx, &names)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
@@ -22,4 +22,6 @@ type AccountPermission struct {
 	PermissionName string          `bun:"permission_name,type:string"`
 	BaseUrl        string          `bun:"base_url,type:string"`
 	Urls           json.RawMessage `bun:"urls,type:jsonb"`
+	// ClusterSelector limits the permission to clusters matching it
+	ClusterSelector string `bun:"cluster_selector"`
 }
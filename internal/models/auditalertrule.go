@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AuditAlertRule struct {
+	bun.BaseModel `bun:"table:audit_alert_rule,alias:aar"`
+
+	ID             uuid.UUID `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	Description    string    `bun:"description,notnull"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid,notnull"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid,notnull"`
+	Expression     string    `bun:"expression,notnull"`
+	Notifier       string    `bun:"notifier,notnull"`
+	Timezone       string    `bun:"timezone,notnull"`
+	Disabled       bool      `bun:"disabled,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,default:current_timestamp"`
+	DeletedAt      time.Time `bun:"deleted_at"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+}
@@ -13,6 +13,40 @@ type AuditLog struct {
 	Tag  string          `bun:"tag,notnull"`
 	Time time.Time       `bun:"time,notnull"`
 	Data json.RawMessage `bun:"data,type:jsonb,notnull"`
+	ID   int64           `bun:"id,autoincrement"`
+
+	// hash chain of the events written by the database sink
+	Organization string `bun:"organization"`
+	Seq          int64  `bun:"seq,nullzero"`
+	PrevHash     string `bun:"prev_hash,nullzero"`
+	Hash         string `bun:"hash,nullzero"`
+}
+
+// AuditChainHead is the last link of the audit hash chain of an
+// organization
+type AuditChainHead struct {
+	bun.BaseModel `bun:"table:audit_chain_heads,alias:ach"`
+
+	Organization string    `bun:"organization,pk"`
+	Seq          int64     `bun:"seq,notnull"`
+	Hash         string    `bun:"hash,notnull"`
+	ModifiedAt   time.Time `bun:"modified_at,nullzero,notnull,default:current_timestamp"`
+	// PurgedSeq is the last event of the chain removed by retention
+	PurgedSeq int64 `bun:"purged_seq,notnull"`
+}
+
+// AuditCheckpoint is a signed record of the audit hash chain of an
+// organization at seq
+type AuditCheckpoint struct {
+	bun.BaseModel `bun:"table:audit_checkpoints,alias:acp"`
+
+	ID           int64     `bun:"id,pk,autoincrement"`
+	Organization string    `bun:"organization,notnull"`
+	Seq          int64     `bun:"seq,notnull"`
+	Hash         string    `bun:"hash,notnull"`
+	KeyID        string    `bun:"key_id,notnull"`
+	Signature    string    `bun:"signature,notnull"`
+	CreatedAt    time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 }
 
 type AggregatorData struct {
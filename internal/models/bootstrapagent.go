@@ -29,4 +29,5 @@ type BootstrapAgent struct {
 	IPAddress      string          `bun:"ip_address,notnull"`
 	LastCheckedIn  time.Time       `bun:"last_checked_in"`
 	Fingerprint    string          `bun:"fingerprint,notnull"`
+	TokenExpiresAt time.Time       `bun:"token_expires_at,nullzero"`
 }
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type CliLogin struct {
+	bun.BaseModel `bun:"table:authsrv_cli_login,alias:cl"`
+
+	ID             uuid.UUID     `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	DeviceCodeHash string        `bun:"device_code_hash,notnull"`
+	UserCode       string        `bun:"user_code,notnull"`
+	AccountId      uuid.NullUUID `bun:"account_id,type:uuid"`
+	Username       string        `bun:"username,notnull"`
+	OrganizationId uuid.NullUUID `bun:"organization_id,type:uuid"`
+	PartnerId      uuid.NullUUID `bun:"partner_id,type:uuid"`
+	ApprovedAt     bun.NullTime  `bun:"approved_at"`
+	ExpiresAt      time.Time     `bun:"expires_at,notnull"`
+	CreatedAt      time.Time     `bun:"created_at,notnull,default:current_timestamp"`
+}
@@ -37,4 +37,6 @@ type Cluster struct {
 	Extra              json.RawMessage `bun:"extra,type:jsonb,notnull,default:'{}'"`
 	ShareMode          string          `bun:"share_mode,default:'CUSTOM'"`
 	ProxyConfig        json.RawMessage `bun:"proxy_config,type:jsonb"`
+	BootstrapOverrides json.RawMessage `bun:"bootstrap_overrides,type:jsonb"`
+	SessionsRevokedAt  time.Time       `bun:"sessions_revoked_at,nullzero"`
 }
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ClusterGroup struct {
+	bun.BaseModel `bun:"table:cluster_groups,alias:clustergroup"`
+
+	ID              uuid.UUID       `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	Name            string          `bun:"name,notnull"`
+	Description     string          `bun:"description,notnull"`
+	OrganizationId  uuid.UUID       `bun:"organization_id,type:uuid,notnull"`
+	PartnerId       uuid.UUID       `bun:"partner_id,type:uuid,notnull"`
+	ProjectId       uuid.UUID       `bun:"project_id,type:uuid,notnull"`
+	Selector        string          `bun:"selector,notnull"`
+	KubectlSettings json.RawMessage `bun:"kubectl_settings,type:jsonb,notnull,default:'{}'"`
+	Shares          json.RawMessage `bun:"shares,type:jsonb,notnull,default:'[]'"`
+	CreatedAt       time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt      time.Time       `bun:"modified_at,default:current_timestamp"`
+	DeletedAt       time.Time       `bun:"deleted_at"`
+	Trash           bool            `bun:"trash,notnull,default:false"`
+}
+
+// ClusterGroupShare is an entry of the shares of a cluster group, it has
+// the same policy as a ProjectCluster share of a single cluster
+type ClusterGroupShare struct {
+	ProjectId  string   `json:"projectID"`
+	Access     string   `json:"access"`
+	Namespaces []string `json:"namespaces"`
+}
@@ -14,7 +14,9 @@ type ClusterNamespace struct {
 	ClusterId  uuid.UUID       `bun:"cluster_id,type:uuid"`
 	Name       string          `bun:"name,notnull"`
 	Hash       string          `bun:"hash,notnull"`
-	DeletedAt  time.Time       `bun:"deleted_at"`
+	CreatedAt  time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt time.Time       `bun:"modified_at,notnull,default:current_timestamp"`
+	DeletedAt  time.Time       `bun:"deleted_at,nullzero"`
 	Type       string          `bun:"type,notnull"`
 	Namespace  json.RawMessage `bun:"namespace,type:jsonb,notnull"`
 	Conditions json.RawMessage `bun:"conditions,type:jsonb,notnull,default:'[]'"`
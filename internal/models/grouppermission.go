@@ -22,4 +22,6 @@ type GroupPermission struct {
 	BaseUrl        string          `bun:"base_url"`
 	Urls           json.RawMessage `bun:"urls,type:jsonb"`
 	ProjectName    string          `bun:"project_name"`
+	// ClusterSelector limits the permission to clusters matching it
+	ClusterSelector string `bun:"cluster_selector"`
 }
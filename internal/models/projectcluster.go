@@ -1,6 +1,9 @@
 package models
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
@@ -8,6 +11,9 @@ import (
 type ProjectCluster struct {
 	bun.BaseModel `bun:"table:cluster_project_cluster,alias:projectcluster"`
 
-	ProjectID uuid.UUID `bun:"project_id,type:uuid,notnull"`
-	ClusterID uuid.UUID `bun:"cluster_id,type:uuid,notnull"`
+	ProjectID  uuid.UUID       `bun:"project_id,type:uuid,notnull"`
+	ClusterID  uuid.UUID       `bun:"cluster_id,type:uuid,notnull"`
+	Access     string          `bun:"access,notnull,default:'FULL_ACCESS'"`
+	Namespaces json.RawMessage `bun:"namespaces,type:jsonb,notnull,default:'[]'"`
+	CreatedAt  time.Time       `bun:"created_at,notnull,default:current_timestamp"`
 }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type RelayNetwork struct {
+	bun.BaseModel `bun:"table:sentry_relay_network,alias:rn"`
+
+	ID             uuid.UUID       `bun:"id,pk,type:uuid,default:uuid_generate_v4()"`
+	Name           string          `bun:"name,notnull"`
+	Description    string          `bun:"description,notnull"`
+	OrganizationId uuid.UUID       `bun:"organization_id,type:uuid,notnull"`
+	PartnerId      uuid.UUID       `bun:"partner_id,type:uuid,notnull"`
+	UserHost       string          `bun:"user_host,notnull"`
+	ConnectorHost  string          `bun:"connector_host,notnull"`
+	Projects       json.RawMessage `bun:"projects,type:jsonb,notnull,default:'[]'"`
+	Clusters       json.RawMessage `bun:"clusters,type:jsonb,notnull,default:'[]'"`
+	CreatedAt      time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time       `bun:"modified_at,default:current_timestamp"`
+	DeletedAt      time.Time       `bun:"deleted_at"`
+	Trash          bool            `bun:"trash,notnull,default:false"`
+}
@@ -56,7 +56,7 @@ func (k *kratosAuthProvider) Create(ctx context.Context, password string, traits
 	cib.SetMetadataPublic(metadata)
 	ir, hr, err := k.kc.IdentityApi.CreateIdentity(ctx).CreateIdentityBody(*cib).Execute()
 	if err != nil {
-		_log.Error("failed to create identity ", hr)
+		_log.WithContext(ctx).Error("failed to create identity ", hr)
 		return "", err
 	}
 	return ir.Id, nil
@@ -67,7 +67,7 @@ func (k *kratosAuthProvider) Update(ctx context.Context, id string, traits map[s
 
 	ipm, err := k.GetPublicMetadata(ctx, id)
 	if err != nil {
-		_log.Error("failed to get identity public metadata ", err)
+		_log.WithContext(ctx).Error("failed to get identity public metadata ", err)
 		return err
 	}
 	ipm.ForceReset = metadata.ForceReset
@@ -75,7 +75,7 @@ func (k *kratosAuthProvider) Update(ctx context.Context, id string, traits map[s
 
 	_, hr, err := k.kc.IdentityApi.UpdateIdentity(ctx, id).UpdateIdentityBody(*uib).Execute()
 	if err != nil {
-		_log.Error("failed to update identity ", hr)
+		_log.WithContext(ctx).Error("failed to update identity ", hr)
 	}
 	return err
 }
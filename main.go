@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	goruntime "runtime"
@@ -17,16 +18,20 @@ import (
 	"github.com/paralus/paralus/internal/fixtures"
 	providers "github.com/paralus/paralus/internal/provider/kratos"
 	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/alert"
+	"github.com/paralus/paralus/pkg/audit/forward"
 	authv3 "github.com/paralus/paralus/pkg/auth/v3"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/enforcer"
 	"github.com/paralus/paralus/pkg/gateway"
 	"github.com/paralus/paralus/pkg/grpc"
+	"github.com/paralus/paralus/pkg/health"
 	"github.com/paralus/paralus/pkg/log"
 	"github.com/paralus/paralus/pkg/notify"
 	"github.com/paralus/paralus/pkg/reconcile"
 	"github.com/paralus/paralus/pkg/sentry/peering"
 	"github.com/paralus/paralus/pkg/service"
+	"github.com/paralus/paralus/pkg/tracing"
 	auditrpc "github.com/paralus/paralus/proto/rpc/audit"
 	rolerpc "github.com/paralus/paralus/proto/rpc/role"
 	schedulerrpc "github.com/paralus/paralus/proto/rpc/scheduler"
@@ -42,8 +47,6 @@ import (
 	"github.com/uptrace/bun/extra/bundebug"
 	"go.uber.org/zap"
 	_grpc "google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -72,12 +75,24 @@ const (
 	sentryBootstrapEnv        = "SENTRY_BOOTSTRAP_ADDR"
 	bootstrapKEKEnv           = "BOOTSTRAP_KEK"
 	relayImageEnv             = "RELAY_IMAGE"
+	bootstrapTokenTTLEnv      = "BOOTSTRAP_TOKEN_TTL"
 
 	// audit
 	auditLogStorageEnv         = "AUDIT_LOG_STORAGE"
 	auditFileEnv               = "AUDIT_LOG_FILE"
+	auditSinksEnv              = "AUDIT_LOG_SINKS"
+	auditSigningKeyFileEnv     = "AUDIT_SIGNING_KEY_FILE"
+	auditSigningInfraEnv       = "AUDIT_SIGNING_INFRA"
+	auditCheckpointIntervalEnv = "AUDIT_CHECKPOINT_INTERVAL"
+	auditForwardersConfigEnv   = "AUDIT_FORWARDERS_CONFIG"
+	auditAlertsConfigEnv       = "AUDIT_ALERTS_CONFIG"
+	auditRetentionEnv          = "AUDIT_RETENTION"
+	auditRetentionIntervalEnv  = "AUDIT_RETENTION_INTERVAL"
+	auditArchiveDirEnv         = "AUDIT_ARCHIVE_DIR"
+	auditEventVersionEnv       = "AUDIT_EVENT_VERSION"
 	esEndPointEnv              = "ES_END_POINT"
 	esIndexPrefixEnv           = "ES_INDEX_PREFIX"
+	esVersionEnv               = "ES_VERSION"
 	relayAuditESIndexPrefixEnv = "RELAY_AUDITS_ES_INDEX_PREFIX"
 	relayCommandESIndexPrefix  = "RELAY_COMMANDS_ES_INDEX_PREFIX"
 
@@ -89,6 +104,11 @@ const (
 	// kratos
 	kratosAddrEnv       = "KRATOS_ADDR"
 	kratosPublicAddrEnv = "KRATOS_PUB_ADDR"
+
+	// tracing
+	tracingEndpointEnv    = "TRACING_OTLP_ENDPOINT"
+	tracingInsecureEnv    = "TRACING_OTLP_INSECURE"
+	tracingSampleRatioEnv = "TRACING_SAMPLE_RATIO"
 )
 
 var (
@@ -116,12 +136,20 @@ var (
 	coreRelayUserHost      string
 	bootstrapKEK           string
 	relayImage             string
+	bootstrapTokenTTL      time.Duration
 
 	// audit
 	auditLogStorage            string
 	auditFile                  string
+	auditSinks                 []audit.Sink
+	auditForwarders            []*forward.Forwarder
+	alertEngine                *alert.Engine
+	auditCheckpointInterval    time.Duration
+	auditRetentionInterval     time.Duration
+	auditEventVersion          string
 	elasticSearchUrl           string
 	esIndexPrefix              string
+	esVersion                  string
 	relayAuditsESIndexPrefix   string
 	relayCommandsESIndexPrefix string
 	auditLogger                *zap.Logger
@@ -138,6 +166,15 @@ var (
 	kc               *kclient.APIClient
 	akc              *kclient.APIClient
 
+	// tracing
+	tracingEndpoint    string
+	tracingInsecure    bool
+	tracingSampleRatio float64
+	tracingShutdown    tracing.ShutdownFunc
+
+	// health
+	hc *health.Health
+
 	// services
 	ps    service.PartnerService
 	os    service.OrganizationService
@@ -151,17 +188,24 @@ var (
 	kcs   service.KubectlClusterSettingsService
 	as    service.AuthzService
 	cs    service.ClusterService
+	cgs   service.ClusterGroupService
+	rns   service.RelayNetworkService
 	ms    service.MetroService
 	us    service.UserService
 	ks    service.ApiKeyService
+	cls   service.CliLoginService
 	gs    service.GroupService
 	rs    service.RoleService
 	rrs   service.RolepermissionService
 	is    service.IdpService
 	oidcs service.OIDCProviderService
+	cfgs  service.ConfigService
 	aus   service.AuditLogService
 	ras   service.RelayAuditService
 	rcs   service.AuditLogService
+	acs   service.AuditChainService
+	ars   service.AuditRetentionService
+	alrs  service.AlertRuleService
 
 	schedulerPool schedulerrpc.SchedulerPool
 	schedulerAddr string
@@ -196,14 +240,21 @@ func setup() {
 	viper.SetDefault(sentryBootstrapEnv, "console.paralus.dev:443")
 	viper.SetDefault(bootstrapKEKEnv, "paralus")
 	viper.SetDefault(relayImageEnv, "paralusio/relay:v0.1.0")
+	viper.SetDefault(bootstrapTokenTTLEnv, "24h")
 
 	// audit
 	viper.SetDefault(auditLogStorageEnv, "database")
 	viper.SetDefault(esEndPointEnv, "http://127.0.0.1:9200")
 	viper.SetDefault(esIndexPrefixEnv, "ralog-system")
+	viper.SetDefault(esVersionEnv, service.ESVersionAuto)
 	viper.SetDefault(relayAuditESIndexPrefixEnv, "ralog-relay")
 	viper.SetDefault(relayCommandESIndexPrefix, "ralog-prompt")
 	viper.SetDefault(auditFileEnv, "audit.log")
+	viper.SetDefault(auditSinksEnv, "file")
+	viper.SetDefault(auditEventVersionEnv, "1")
+	viper.SetDefault(auditSigningInfraEnv, "paralus-core-relay")
+	viper.SetDefault(auditCheckpointIntervalEnv, time.Hour)
+	viper.SetDefault(auditRetentionIntervalEnv, time.Hour)
 
 	// cd relay
 	viper.SetDefault(coreCDRelayUserHostEnv, "*.user.cdrelay.paralus.local:10012")
@@ -214,6 +265,11 @@ func setup() {
 	viper.SetDefault(kratosAddrEnv, "http://localhost:4434")
 	viper.SetDefault(kratosPublicAddrEnv, "http://localhost:4433")
 
+	// tracing
+	viper.SetDefault(tracingEndpointEnv, "")
+	viper.SetDefault(tracingInsecureEnv, false)
+	viper.SetDefault(tracingSampleRatioEnv, 1.0)
+
 	viper.BindEnv(rpcPortEnv)
 	viper.BindEnv(apiPortEnv)
 	viper.BindEnv(debugPortEnv)
@@ -229,6 +285,10 @@ func setup() {
 	viper.BindEnv(kratosAddrEnv)
 	viper.BindEnv(kratosPublicAddrEnv)
 
+	viper.BindEnv(tracingEndpointEnv)
+	viper.BindEnv(tracingInsecureEnv)
+	viper.BindEnv(tracingSampleRatioEnv)
+
 	viper.BindEnv(sentryPeeringHostEnv)
 	viper.BindEnv(coreRelayConnectorHostEnv)
 	viper.BindEnv(coreRelayUserHostEnv)
@@ -237,12 +297,24 @@ func setup() {
 	viper.BindEnv(coreCDRelayConnectorHostEnv)
 	viper.BindEnv(coreCDRelayUserHostEnv)
 	viper.BindEnv(relayImageEnv)
+	viper.BindEnv(bootstrapTokenTTLEnv)
 	viper.BindEnv(schedulerNamespaceEnv)
 
 	viper.BindEnv(auditLogStorageEnv)
 	viper.BindEnv(auditFileEnv)
+	viper.BindEnv(auditSinksEnv)
+	viper.BindEnv(auditSigningKeyFileEnv)
+	viper.BindEnv(auditSigningInfraEnv)
+	viper.BindEnv(auditCheckpointIntervalEnv)
+	viper.BindEnv(auditForwardersConfigEnv)
+	viper.BindEnv(auditAlertsConfigEnv)
+	viper.BindEnv(auditRetentionEnv)
+	viper.BindEnv(auditRetentionIntervalEnv)
+	viper.BindEnv(auditArchiveDirEnv)
+	viper.BindEnv(auditEventVersionEnv)
 	viper.BindEnv(esEndPointEnv)
 	viper.BindEnv(esIndexPrefixEnv)
+	viper.BindEnv(esVersionEnv)
 	viper.BindEnv(relayAuditESIndexPrefixEnv)
 	viper.BindEnv(relayCommandESIndexPrefix)
 
@@ -261,6 +333,10 @@ func setup() {
 	kratosAddr = viper.GetString(kratosAddrEnv)
 	kratosPublicAddr = viper.GetString(kratosPublicAddrEnv)
 
+	tracingEndpoint = viper.GetString(tracingEndpointEnv)
+	tracingInsecure = viper.GetBool(tracingInsecureEnv)
+	tracingSampleRatio = viper.GetFloat64(tracingSampleRatioEnv)
+
 	bootstrapKEK = viper.GetString(bootstrapKEKEnv)
 	sentryPeeringHost = viper.GetString(sentryPeeringHostEnv)
 	coreRelayConnectorHost = viper.GetString(coreRelayConnectorHostEnv)
@@ -268,26 +344,45 @@ func setup() {
 	coreCDRelayConnectorHost = viper.GetString(coreCDRelayConnectorHostEnv)
 	coreCDRelayUserHost = viper.GetString(coreCDRelayUserHostEnv)
 	relayImage = viper.GetString(relayImageEnv)
+	bootstrapTokenTTL = viper.GetDuration(bootstrapTokenTTLEnv)
 	schedulerNamespace = viper.GetString(schedulerNamespaceEnv)
 	sentryBootstrapAddr = viper.GetString(sentryBootstrapEnv)
 
 	auditLogStorage = viper.GetString(auditLogStorageEnv)
 	auditFile = viper.GetString(auditFileEnv)
+	auditCheckpointInterval = viper.GetDuration(auditCheckpointIntervalEnv)
+	auditRetentionInterval = viper.GetDuration(auditRetentionIntervalEnv)
+	auditEventVersion = viper.GetString(auditEventVersionEnv)
 	elasticSearchUrl = viper.GetString(esEndPointEnv)
 	esIndexPrefix = viper.GetString(esIndexPrefixEnv)
+	esVersion = viper.GetString(esVersionEnv)
 	relayAuditsESIndexPrefix = viper.GetString(relayAuditESIndexPrefixEnv)
 	relayCommandsESIndexPrefix = viper.GetString(relayCommandESIndexPrefix)
 
 	rpcRelayPeeringPort = rpcPort + 1
 
+	// tracing setup
+	var err error
+	tracingShutdown, err = tracing.Init(context.Background(), &tracing.Options{
+		ServiceName: "paralus",
+		Endpoint:    tracingEndpoint,
+		Insecure:    tracingInsecure,
+		SampleRatio: tracingSampleRatio,
+	})
+	if err != nil {
+		_log.Fatalw("unable to initialize tracing", "error", err)
+	}
+
 	// Kratos client setup for authentication
 	kratosConfig := kclient.NewConfiguration()
 	kratosConfig.Servers[0].URL = kratosPublicAddr
+	kratosConfig.HTTPClient = tracing.NewHTTPClient()
 	kc = kclient.NewAPIClient(kratosConfig)
 
 	// Kratos client setup for admin purpose
 	kratosAdminConfig := kclient.NewConfiguration()
 	kratosAdminConfig.Servers[0].URL = kratosAddr
+	kratosAdminConfig.HTTPClient = tracing.NewHTTPClient()
 	akc = kclient.NewAPIClient(kratosAdminConfig)
 
 	// db setup
@@ -296,6 +391,7 @@ func setup() {
 	}
 	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dbDSN)))
 	db = bun.NewDB(sqldb, pgdialect.New())
+	db.AddQueryHook(tracing.NewQueryHook())
 
 	if dev {
 		db.AddQueryHook(bundebug.NewQueryHook(
@@ -316,7 +412,56 @@ func setup() {
 		MaxBackups: 10, // Should we let sidecar do rotation?
 		MaxAgeDays: 10, // Make these configurable via env
 	}
-	auditLogger = audit.GetAuditLogger(&ao)
+	for _, name := range strings.Split(viper.GetString(auditSinksEnv), ",") {
+		switch strings.TrimSpace(name) {
+		case "file":
+			auditSinks = append(auditSinks, audit.NewFileSink(&ao))
+		case "database":
+			auditSinks = append(auditSinks, audit.NewDatabaseSink(db, audit.DatabaseSinkOptions{}))
+		case "":
+		default:
+			_log.Fatalw("unknown audit log sink", "sink", name)
+		}
+	}
+	if len(auditSinks) == 0 {
+		_log.Fatalw("no audit log sinks configured", "env", auditSinksEnv)
+	}
+	if path := viper.GetString(auditForwardersConfigEnv); path != "" {
+		cfg, err := forward.LoadConfig(path)
+		if err != nil {
+			_log.Fatalw("unable to load audit forwarders", "error", err)
+		}
+		if auditLogStorage != audit.DATABASE {
+			for _, fc := range cfg.Forwarders {
+				if fc.Filter.SelectsRelayAudits() {
+					_log.Fatalw("relay audits are forwarded only with database audit storage", "forwarder", fc.Name, "storage", auditLogStorage)
+				}
+			}
+		}
+		auditForwarders, err = forward.Build(cfg, "")
+		if err != nil {
+			_log.Fatalw("unable to create audit forwarders", "error", err)
+		}
+		for _, f := range auditForwarders {
+			auditSinks = append(auditSinks, f)
+		}
+	}
+	// the engine evaluates the alert rules on every event, it has no
+	// notifiers when they are not configured
+	var alertNotifiers map[string]*forward.Forwarder
+	if path := viper.GetString(auditAlertsConfigEnv); path != "" {
+		cfg, err := alert.LoadConfig(path)
+		if err != nil {
+			_log.Fatalw("unable to load audit alert notifiers", "error", err)
+		}
+		alertNotifiers, err = alert.Build(cfg)
+		if err != nil {
+			_log.Fatalw("unable to create audit alert notifiers", "error", err)
+		}
+	}
+	alertEngine = alert.NewEngine(alertNotifiers)
+	auditSinks = append(auditSinks, alertEngine)
+	auditLogger = audit.NewAuditLogger(auditSinks...)
 
 	// authz services
 	gormDb, err := gorm.Open(postgres.New(postgres.Config{
@@ -349,6 +494,7 @@ func setup() {
 	}
 	ks = service.NewApiKeyService(db, auditLogger)
 	us = service.NewUserService(providers.NewKratosAuthProvider(akc), db, as, ks, cc, auditLogger, dev)
+	cls = service.NewCliLoginService(db, us)
 	gs = service.NewGroupService(db, as, auditLogger)
 	rs = service.NewRoleService(db, as, auditLogger)
 	rrs = service.NewRolepermissionService(db)
@@ -356,9 +502,10 @@ func setup() {
 	oidcs = service.NewOIDCProviderService(db, sentryBootstrapAddr, auditLogger)
 
 	//sentry related services
-	bs = service.NewBootstrapService(db)
+	bs = service.NewBootstrapService(db, service.WithBootstrapTokenTTL(bootstrapTokenTTL))
 	krs = service.NewKubeconfigRevocationService(db, auditLogger)
 	kss = service.NewKubeconfigSettingService(db)
+	cfgs = service.NewConfigService(db, rs, pps, gs, us, oidcs, kss, as, auditLogger)
 	ns = service.NewNamespaceService(db)
 	kcs = service.NewkubectlClusterSettingsService(db)
 	aps = service.NewAccountPermissionService(db)
@@ -397,7 +544,7 @@ func setup() {
 		}
 	case audit.ELASTICSEARCH:
 		// audit services
-		aus, err = service.NewAuditLogElasticSearchService(elasticSearchUrl, esIndexPrefix+"-*", "AuditLog API: ", db)
+		aus, err = service.NewAuditLogElasticSearchService(elasticSearchUrl, esIndexPrefix+"-*", "AuditLog API: ", esVersion, db)
 		if err != nil {
 			if dev && strings.Contains(err.Error(), "connect: connection refused") {
 				// This is primarily from ES not being available. ES being
@@ -409,7 +556,7 @@ func setup() {
 				_log.Fatalw("unable to create auditLog service", "error", err)
 			}
 		}
-		ras, err = service.NewRelayAuditElasticSearchService(elasticSearchUrl, relayAuditsESIndexPrefix+"-*", "RelayAudit API: ", db)
+		ras, err = service.NewRelayAuditElasticSearchService(elasticSearchUrl, relayAuditsESIndexPrefix+"-*", "RelayAudit API: ", esVersion, db)
 		if err != nil {
 			if dev && strings.Contains(err.Error(), "connect: connection refused") {
 				_log.Warn("unable to create relayAudit service: ", err)
@@ -417,7 +564,7 @@ func setup() {
 				_log.Fatalw("unable to create relayAudit service", "error", err)
 			}
 		}
-		rcs, err = service.NewAuditLogElasticSearchService(elasticSearchUrl, relayCommandsESIndexPrefix+"-*", "RelayCommand API: ", db)
+		rcs, err = service.NewAuditLogElasticSearchService(elasticSearchUrl, relayCommandsESIndexPrefix+"-*", "RelayCommand API: ", esVersion, db)
 		if err != nil {
 			if dev && strings.Contains(err.Error(), "connect: connection refused") {
 				_log.Warn("unable to create auditLog service:", err)
@@ -429,17 +576,66 @@ func setup() {
 		_log.Warn("unable to create audit log service: invalid storage option ! should be either %s or %s", audit.DATABASE, audit.ELASTICSEARCH)
 	}
 
+	// checkpoints of the audit hash chain are signed with a dedicated
+	// key when provided, the ca key of a bootstrap infra otherwise
+	auditSigner := service.AuditSignerFromInfra(bs, viper.GetString(auditSigningInfraEnv), kekFunc)
+	if f := viper.GetString(auditSigningKeyFileEnv); f != "" {
+		key, err := ioutil.ReadFile(f)
+		if err != nil {
+			_log.Fatalw("unable to read audit signing key", "error", err)
+		}
+		auditSigner = service.AuditSignerFromPEM(key, kekFunc)
+	}
+	acs = service.NewAuditChainService(db, auditSigner)
+
+	// partitions and retention of audit_logs are only managed when the
+	// audit logs are read from the database
+	if auditLogStorage == audit.DATABASE {
+		days, err := service.ParseAuditRetention(viper.GetString(auditRetentionEnv))
+		if err != nil {
+			_log.Fatalw("unable to parse audit retention", "error", err)
+		}
+		ars = service.NewAuditRetentionService(db, auditLogger, service.AuditRetentionOptions{
+			Days:       days,
+			ArchiveDir: viper.GetString(auditArchiveDirEnv),
+		})
+	}
+
 	// cluster bootstrap
 	downloadData = &common.DownloadData{
+		ControlAddr:     sentryBootstrapAddr,
 		APIAddr:         apiAddr,
 		RelayAgentImage: relayImage,
 	}
 
-	cs = service.NewClusterService(db, downloadData, bs, auditLogger)
+	cs = service.NewClusterService(db, downloadData, bs, as, auditLogger)
+	cgs = service.NewClusterGroupService(db, cs, auditLogger)
+	rns = service.NewRelayNetworkService(db, bs, auditLogger)
+	alrs = service.NewAlertRuleService(db, alertEngine, auditLogger, auditLogStorage == audit.DATABASE)
 	ms = service.NewMetroService(db)
 
 	notify.Init(cs)
 
+	// health checks of dependencies; failure of non critical ones
+	// only degrades the service
+	hc = health.New()
+	hc.Register(health.Database, health.NewDBChecker(db), true)
+	hc.Register(health.KratosPublic, health.NewKratosChecker(kc), true)
+	hc.Register(health.KratosAdmin, health.NewKratosChecker(akc), false)
+	switch auditLogStorage {
+	case audit.DATABASE:
+		hc.Register(health.Audit, health.NewTableChecker(db, "audit_logs"), false)
+	case audit.ELASTICSEARCH:
+		hc.Register(health.Audit, health.NewHTTPChecker(elasticSearchUrl), false)
+	}
+	hc.Register(health.Peering, nil, false)
+	hc.Set(health.Peering, errors.New("waiting for peering server creds"))
+	hc.AddService(auditrpc.AuditLogService_ServiceDesc.ServiceName, health.Database, health.Audit)
+	hc.AddService(auditrpc.RelayAuditService_ServiceDesc.ServiceName, health.Database, health.Audit)
+	hc.AddService(auditrpc.AlertRuleService_ServiceDesc.ServiceName, health.Database)
+	hc.AddService(sentryrpc.RelayPeerService_ServiceDesc.ServiceName, health.Database, health.Peering)
+	hc.AddService(userrpc.UserService_ServiceDesc.ServiceName, health.Database, health.KratosAdmin)
+
 	_log.Infow("queried number of cpus", "numCPUs", goruntime.NumCPU())
 }
 
@@ -463,19 +659,10 @@ func run() {
 
 	fixtures.Load(ctx, bs, replace, kekFunc)
 
-	healthServer, err := grpc.NewServer()
-	if err != nil {
-		_log.Infow("failed to initialize grpc for health server")
-	}
-	// health server
-	_log.Infow("registering grpc health server")
-	hs := health.NewServer()
-	hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
-	grpc_health_v1.RegisterHealthServer(healthServer, hs)
-	_log.Infow("registered grpc health server")
+	go hc.Run(ctx.Done())
 
 	var wg sync.WaitGroup
-	wg.Add(6)
+	wg.Add(10)
 
 	go runAPI(&wg, ctx)
 	go runRPC(&wg, ctx)
@@ -483,10 +670,24 @@ func run() {
 	go runDebug(&wg, ctx)
 	go runEventHandlers(&wg, ctx)
 	go runIdpGroupSync(&wg, ctx)
+	go runAuditCheckpoints(&wg, ctx)
+	go runRelayAuditForwarding(&wg, ctx)
+	go runAuditRetention(&wg, ctx)
+	go runAlertRuleSync(&wg, ctx)
 
 	<-ctx.Done()
 	_log.Infow("shutting down, waiting for children to die")
 	wg.Wait()
+
+	if err := audit.CloseSinks(auditSinks); err != nil {
+		_log.Warnw("unable to flush audit logs", "error", err)
+	}
+
+	sctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	if err := tracingShutdown(sctx); err != nil {
+		_log.Warnw("unable to flush traces", "error", err)
+	}
 }
 
 func runAPI(wg *sync.WaitGroup, ctx context.Context) {
@@ -508,6 +709,7 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 		sentryrpc.RegisterKubectlClusterSettingsServiceHandlerFromEndpoint,
 		sentryrpc.RegisterClusterAuthorizationServiceHandlerFromEndpoint,
 		schedulerrpc.RegisterClusterServiceHandlerFromEndpoint,
+		schedulerrpc.RegisterClusterGroupServiceHandlerFromEndpoint,
 		systemrpc.RegisterLocationServiceHandlerFromEndpoint,
 		userrpc.RegisterUserServiceHandlerFromEndpoint,
 		userrpc.RegisterGroupServiceHandlerFromEndpoint,
@@ -515,13 +717,17 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 		rolerpc.RegisterRolepermissionServiceHandlerFromEndpoint,
 		systemrpc.RegisterIdpServiceHandlerFromEndpoint,
 		systemrpc.RegisterOIDCProviderServiceHandlerFromEndpoint,
+		systemrpc.RegisterConfigServiceHandlerFromEndpoint,
 		auditrpc.RegisterAuditLogServiceHandlerFromEndpoint,
 		auditrpc.RegisterRelayAuditServiceHandlerFromEndpoint,
+		auditrpc.RegisterAlertRuleServiceHandlerFromEndpoint,
 	)
 	if err != nil {
 		_log.Fatalw("unable to create gateway", "error", err)
 	}
 	mux.Handle("/", gwHandler)
+	mux.Handle("/healthz", hc.LivenessHandler())
+	mux.Handle("/readyz", hc.ReadinessHandler())
 
 	s := http.Server{
 		Addr:    fmt.Sprintf(":%d", apiPort),
@@ -543,24 +749,47 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 func runRelayPeerRPC(wg *sync.WaitGroup, ctx context.Context) {
 	defer wg.Done()
 
-	_log.Infow("waiting to fetch peering server creds")
-	time.Sleep(time.Second * 25)
-	cert, key, ca, err := peering.GetPeeringServerCreds(context.Background(), bs, rpcPort, sentryPeeringHost)
-	if err != nil {
-		_log.Fatalw("unable to get peering server cerds", "error", err)
+	// peering server creds are registered through the rpc server, keep
+	// retrying until it is up instead of exiting the process
+	var cert, key, ca []byte
+	backoff := time.Second * 5
+	for {
+		_log.Infow("waiting to fetch peering server creds", "after", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		var err error
+		cert, key, ca, err = peering.GetPeeringServerCreds(ctx, bs, rpcPort, sentryPeeringHost)
+		if err == nil {
+			break
+		}
+		_log.Warnw("unable to get peering server creds", "error", err)
+		hc.Set(health.Peering, fmt.Errorf("unable to get peering server creds: %w", err))
+		if backoff < time.Minute {
+			backoff *= 2
+		}
 	}
 
 	relayPeerService, err := server.NewRelayPeerService()
 	if err != nil {
-		_log.Fatalw("unable to get create relay peer service")
+		_log.Errorw("unable to get create relay peer service", "error", err)
+		hc.Set(health.Peering, err)
+		return
 	}
-	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns)
+	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns, cs, cgs)
 	auditInfoServer := server.NewAuditInfoServer(bs, aps, pps)
+	clusterNamespaceServer := server.NewClusterNamespaceServer(bs, ns)
+	clusterDecommissionServer := server.NewClusterDecommissionServer(bs, cs)
+	clusterPreflightServer := server.NewClusterPreflightServer(bs, cs)
 
 	s, err := grpc.NewSecureServerWithPEM(cert, key, ca)
 	if err != nil {
-		_log.Fatalw("cannot grpc secure server failed", "error", err)
-
+		_log.Errorw("cannot grpc secure server failed", "error", err)
+		hc.Set(health.Peering, err)
+		return
 	}
 
 	go func() {
@@ -573,18 +802,25 @@ func runRelayPeerRPC(wg *sync.WaitGroup, ctx context.Context) {
 	sentryrpc.RegisterRelayPeerServiceServer(s, relayPeerService)
 	sentryrpc.RegisterClusterAuthorizationServiceServer(s, clusterAuthzServer)
 	sentryrpc.RegisterAuditInformationServiceServer(s, auditInfoServer)
+	sentryrpc.RegisterClusterNamespaceServiceServer(s, clusterNamespaceServer)
+	sentryrpc.RegisterClusterDecommissionServiceServer(s, clusterDecommissionServer)
+	sentryrpc.RegisterClusterPreflightServiceServer(s, clusterPreflightServer)
+	hc.RegisterServer(s)
 
 	l, err := net.Listen("tcp", fmt.Sprintf(":%d", rpcRelayPeeringPort))
 	if err != nil {
-		_log.Fatalw("failed to listen relay peer service port", "port", rpcRelayPeeringPort, "error", err)
+		_log.Errorw("failed to listen relay peer service port", "port", rpcRelayPeeringPort, "error", err)
+		hc.Set(health.Peering, err)
 		return
 	}
 
 	go server.RunRelaySurveyHandler(ctx.Done(), relayPeerService)
 
 	_log.Infow("started relay rpc service ", "port", rpcRelayPeeringPort)
+	hc.Set(health.Peering, nil)
 	if err = s.Serve(l); err != nil {
-		_log.Fatalw("failed to serve relay peer service", "error", err)
+		_log.Errorw("failed to serve relay peer service", "error", err)
+		hc.Set(health.Peering, err)
 	}
 
 }
@@ -598,23 +834,25 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	organizationServer := server.NewOrganizationServer(os)
 	projectServer := server.NewProjectServer(pps)
 
-	bootstrapServer := server.NewBootstrapServer(bs, kekFunc, cs)
+	bootstrapServer := server.NewBootstrapServer(bs, kekFunc, cs, rns, downloadData, auditLogger)
 	kubeConfigServer := server.NewKubeConfigServer(bs, aps, gps, kss, krs, kekFunc, ks, os, ps, auditLogger)
 	auditInfoServer := server.NewAuditInfoServer(bs, aps, pps)
-	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns)
+	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns, cs, cgs)
 	kubectlClusterSettingsServer := server.NewKubectlClusterSettingsServer(bs, kcs)
-	crpc := server.NewClusterServer(cs, downloadData)
+	crpc := server.NewClusterServer(cs, ns, downloadData)
+	cgrpc := server.NewClusterGroupServer(cgs)
 	mserver := server.NewLocationServer(ms)
 
-	userServer := server.NewUserServer(us, ks)
+	userServer := server.NewUserServer(us, ks, cls)
 	groupServer := server.NewGroupServer(gs)
 	roleServer := server.NewRoleServer(rs)
 	rolepermissionServer := server.NewRolePermissionServer(rrs)
 	idpServer := server.NewIdpServer(is)
 	oidcProviderServer := server.NewOIDCServer(oidcs)
+	configServer := server.NewConfigServer(cfgs)
 
 	// audit
-	auditLogServer, err := server.NewAuditLogServer(aus)
+	auditLogServer, err := server.NewAuditLogServer(aus, acs)
 	if err != nil {
 		_log.Fatalw("unable to create auditLog server", "error", err)
 	}
@@ -622,6 +860,7 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	if err != nil {
 		_log.Fatalw("unable to create relayAudit server", "error", err)
 	}
+	alertRuleServer := server.NewAlertRuleServer(alrs)
 
 	l, err := net.Listen("tcp", fmt.Sprintf(":%d", rpcPort))
 	if err != nil {
@@ -638,15 +877,29 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 			"/paralus.dev.sentry.rpc.KubeConfigService/GetForClusterWebSession", //TODO: enable auth from prompt
 			"/paralus.dev.rpc.auth.v3.AuthService/IsRequestAllowed",
 			"/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook",
+			"/paralus.dev.rpc.user.v3.UserService/StartCliLogin",
+			"/paralus.dev.rpc.user.v3.UserService/GetCliLoginVerification",
+			"/paralus.dev.rpc.user.v3.UserService/GetCliLoginConfig",
+			"/grpc.health.v1.Health/Check",
+			"/grpc.health.v1.Health/Watch",
 		},
 		ExcludeAuthzMethods: []string{
 			"/paralus.dev.rpc.user.v3.UserService/GetUserInfo",
 			"/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset",
 		},
 	}
-	opts = append(opts, _grpc.UnaryInterceptor(
-		ac.NewAuthUnaryInterceptor(o),
-	))
+	interceptors := []_grpc.UnaryServerInterceptor{ac.NewAuthUnaryInterceptor(o)}
+	switch auditEventVersion {
+	case "1":
+		// v1 events are created by the services
+	case "2":
+		// v2 events of the mutating rpcs replace the v1 events of the
+		// services, after auth has set the session
+		interceptors = append(interceptors, audit.NewUnaryInterceptor(auditLogger))
+	default:
+		_log.Fatalw("invalid audit event version", "version", auditEventVersion)
+	}
+	opts = append(opts, _grpc.ChainUnaryInterceptor(interceptors...))
 	s, err := grpc.NewServer(opts...)
 	if err != nil {
 		_log.Fatalw("unable to create grpc server", "error", err)
@@ -673,6 +926,7 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	sentryrpc.RegisterAuditInformationServiceServer(s, auditInfoServer)
 	sentryrpc.RegisterKubectlClusterSettingsServiceServer(s, kubectlClusterSettingsServer)
 	schedulerrpc.RegisterClusterServiceServer(s, crpc)
+	schedulerrpc.RegisterClusterGroupServiceServer(s, cgrpc)
 	systemrpc.RegisterLocationServiceServer(s, mserver)
 	userrpc.RegisterUserServiceServer(s, userServer)
 	userrpc.RegisterGroupServiceServer(s, groupServer)
@@ -680,11 +934,14 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	rolerpc.RegisterRolepermissionServiceServer(s, rolepermissionServer)
 	systemrpc.RegisterIdpServiceServer(s, idpServer)
 	systemrpc.RegisterOIDCProviderServiceServer(s, oidcProviderServer)
+	systemrpc.RegisterConfigServiceServer(s, configServer)
 	auditrpc.RegisterAuditLogServiceServer(s, auditLogServer)
 	auditrpc.RegisterRelayAuditServiceServer(s, relayAuditServer)
+	auditrpc.RegisterAlertRuleServiceServer(s, alertRuleServer)
 
 	authServer := server.NewAuthServer(asv)
 	authrpc.RegisterAuthServiceServer(s, authServer)
+	hc.RegisterServer(s)
 
 	_log.Infow("starting rpc server", "port", rpcPort)
 	err = s.Serve(l)
@@ -754,6 +1011,88 @@ listen:
 	<-ctx.Done()
 }
 
+// runAuditCheckpoints periodically signs the heads of the audit hash
+// chains
+func runAuditCheckpoints(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	if auditCheckpointInterval <= 0 {
+		return
+	}
+	t := time.NewTicker(auditCheckpointInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := acs.Checkpoint(ctx); err != nil {
+				_log.Warnw("unable to checkpoint audit logs", "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runAuditRetention maintains the partitions of audit_logs and purges the
+// expired audit logs, starting right away so that the partitions of the
+// upcoming days exist
+func runAuditRetention(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	if ars == nil || auditRetentionInterval <= 0 {
+		return
+	}
+	t := time.NewTicker(auditRetentionInterval)
+	defer t.Stop()
+	for {
+		if err := ars.Run(ctx); err != nil {
+			_log.Warnw("unable to apply audit retention", "error", err)
+		}
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runAlertRuleSync periodically reloads the alert rules so that the
+// changes made through other instances are picked up
+func runAlertRuleSync(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	t := time.NewTicker(30 * time.Second)
+	defer t.Stop()
+	for {
+		if err := alrs.Sync(ctx); err != nil {
+			_log.Warnw("unable to sync audit alert rules", "error", err)
+		}
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runRelayAuditForwarding forwards the relay audits ingested into the
+// database to the audit forwarders and the alert engine
+func runRelayAuditForwarding(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	receivers := make([]forward.Receiver, 0, len(auditForwarders)+1)
+	for _, f := range auditForwarders {
+		receivers = append(receivers, f)
+	}
+	if len(alertEngine.Notifiers()) > 0 {
+		receivers = append(receivers, alertEngine)
+	}
+	if len(receivers) == 0 {
+		return
+	}
+	if auditLogStorage != audit.DATABASE {
+		_log.Warnw("relay audits are forwarded and alerted on only from database storage", "storage", auditLogStorage)
+		return
+	}
+	forward.TailRelayAudits(ctx, db, 5*time.Second, receivers)
+}
+
 func main() {
 	setup()
 	run()
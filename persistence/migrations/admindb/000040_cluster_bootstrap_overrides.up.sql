@@ -0,0 +1 @@
+ALTER TABLE cluster_clusters ADD COLUMN IF NOT EXISTS bootstrap_overrides jsonb;
@@ -0,0 +1 @@
+ALTER TABLE sentry_bootstrap_agent ADD COLUMN IF NOT EXISTS token_expires_at timestamp with time zone;
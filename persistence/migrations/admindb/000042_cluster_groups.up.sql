@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS cluster_groups (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name varchar NOT NULL,
+    description varchar NOT NULL default '',
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    project_id uuid NOT NULL,
+    selector varchar NOT NULL,
+    kubectl_settings jsonb NOT NULL DEFAULT '{}'::jsonb,
+    shares jsonb NOT NULL DEFAULT '[]'::jsonb,
+    created_at timestamp WITH time zone NOT NULL default current_timestamp,
+    modified_at timestamp WITH time zone,
+    deleted_at timestamp WITH time zone,
+    trash boolean NOT NULL default false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS cluster_groups_name_project_id_key ON cluster_groups USING btree (name, project_id) WHERE trash = false;
@@ -0,0 +1 @@
+ALTER TABLE cluster_clusters DROP COLUMN IF EXISTS sessions_revoked_at;
@@ -0,0 +1 @@
+ALTER TABLE cluster_clusters ADD COLUMN IF NOT EXISTS sessions_revoked_at timestamp WITH time zone;
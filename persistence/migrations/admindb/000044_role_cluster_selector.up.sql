@@ -0,0 +1,260 @@
+ALTER TABLE authsrv_projectaccountresourcerole ADD COLUMN IF NOT EXISTS cluster_selector varchar NOT NULL DEFAULT '';
+ALTER TABLE authsrv_projectaccountnamespacerole ADD COLUMN IF NOT EXISTS cluster_selector varchar NOT NULL DEFAULT '';
+ALTER TABLE authsrv_projectgrouprole ADD COLUMN IF NOT EXISTS cluster_selector varchar NOT NULL DEFAULT '';
+ALTER TABLE authsrv_projectgroupnamespacerole ADD COLUMN IF NOT EXISTS cluster_selector varchar NOT NULL DEFAULT '';
+
+CREATE OR REPLACE VIEW sentry_account_permission AS
+SELECT
+    apr.account_id,
+    apr.group_id,
+    apr.project_id,
+    apr.organization_id,
+    apr.partner_id,
+    rbu.role_name, -- could be dropped in future
+    rbu.role_id,
+    rbu.is_global,
+    rbu.scope,
+    rbu.permission_name,
+    rbu.base_url,
+    rbu.urls,
+    apr.cluster_selector
+FROM (
+    SELECT
+        ga.account_id,
+        gr.group_id,
+        uuid_nil() project_id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id,
+        '' AS cluster_selector
+    FROM
+        authsrv_groupaccount ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+    UNION
+    SELECT
+        ga.account_id,
+        gr.group_id,
+	p.id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id,
+        '' AS cluster_selector
+    FROM
+        authsrv_groupaccount ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+        INNER JOIN authsrv_resourcerole rr ON rr.id = gr.role_id AND rr.scope = 'organization'
+        INNER JOIN authsrv_project p ON p.organization_id = gr.organization_id AND p.partner_id = gr.partner_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        uuid_nil() project_id,
+        role_id,
+        organization_id,
+        partner_id,
+        '' AS cluster_selector
+    FROM
+        authsrv_accountresourcerole
+    WHERE
+        trash = FALSE
+    UNION
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgr.project_id,
+        pgr.role_id,
+        pgr.organization_id,
+        pgr.partner_id,
+        pgr.cluster_selector
+    FROM
+        authsrv_projectgrouprole pgr
+        INNER JOIN authsrv_groupaccount ga ON pgr.group_id = ga.group_id
+    WHERE
+        pgr.trash = FALSE
+        AND ga.trash = FALSE
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id,
+        cluster_selector
+    FROM
+        authsrv_projectaccountresourcerole
+    WHERE
+        trash = FALSE
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id,
+        cluster_selector
+    FROM
+        authsrv_projectaccountnamespacerole
+    WHERE
+        trash = FALSE
+    UNION    
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgnr.project_id,
+        pgnr.role_id,
+        pgnr.organization_id,
+        pgnr.partner_id,
+        pgnr.cluster_selector
+    FROM
+        authsrv_projectgroupnamespacerole pgnr
+        INNER JOIN authsrv_groupaccount ga ON pgnr.group_id = ga.group_id
+    WHERE
+        pgnr.trash = FALSE
+        AND ga.trash = FALSE) AS apr
+    INNER JOIN (
+        SELECT
+            rp.role_id,
+            rr.role_name,
+            rr.is_global,
+            rr.scope,
+            p.permission_name,
+            p.base_url,
+            p.urls
+        FROM (
+            SELECT
+                resource_role_id AS role_id,
+                resource_permission_id AS permission_id
+            FROM
+                authsrv_resourcerolepermission
+            WHERE
+                trash = FALSE) rp
+            INNER JOIN (
+                SELECT
+                    rp.id AS permission_id,
+                    rp.base_url,
+                    rp.name AS permission_name,
+                    rp.resource_urls || rp.resource_action_urls AS urls
+                FROM
+                    authsrv_resourcepermission rp) p ON rp.permission_id = p.permission_id
+                INNER JOIN (
+                    SELECT
+                        id,
+                        name AS role_name,
+                        is_global,
+                        scope
+                    FROM
+                        authsrv_resourcerole
+                    WHERE
+                        trash = FALSE) rr ON rr.id = rp.role_id) rbu ON apr.role_id = rbu.role_id
+    INNER JOIN identities ON identities.id = apr.account_id
+WHERE
+    lower(identities.state) = 'active';
+
+CREATE OR REPLACE VIEW sentry_group_permission AS
+SELECT
+    gpr.group_id,
+    gpr.project_id,
+    gpr.organization_id,
+    gpr.partner_id,
+    gpr.group_name,
+    rbu.role_name,
+    rbu.is_global,
+    rbu.scope,
+    rbu.permission_name,
+    rbu.base_url,
+    rbu.urls,
+    gpr.project_name,
+    gpr.cluster_selector
+FROM (
+    SELECT
+        gr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        null project_id,
+        '' AS project_name,
+        gr.role_id,
+        '' AS cluster_selector
+    FROM
+        authsrv_group g
+        INNER JOIN authsrv_grouprole gr ON g.id = gr.group_id
+    WHERE
+        g.trash = FALSE
+        AND gr.trash = FALSE
+    UNION
+    SELECT
+        pgr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        pgr.project_id::text,
+        pj.name AS project_name,
+        pgr.role_id,
+        pgr.cluster_selector
+    FROM
+        authsrv_projectgrouprole pgr
+        INNER JOIN authsrv_group g ON pgr.group_id = g.id
+        INNER JOIN authsrv_project pj ON pgr.project_id = pj.id
+    WHERE
+        pgr.trash = FALSE
+        AND g.trash = FALSE    
+    UNION    
+    SELECT
+        pgnr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        pgnr.project_id::text,
+        pj.name AS project_name,
+        pgnr.role_id,
+        pgnr.cluster_selector
+    FROM
+        authsrv_projectgroupnamespacerole pgnr
+        INNER JOIN authsrv_group g ON pgnr.group_id = g.id
+        INNER JOIN authsrv_project pj ON pgnr.project_id = pj.id
+    WHERE
+        pgnr.trash = FALSE
+        AND g.trash = FALSE) AS gpr
+    INNER JOIN (
+        SELECT
+            rp.role_id,
+            rr.role_name,
+            rr.is_global,
+            rr.scope,
+            p.permission_name,
+            p.base_url,
+            p.urls
+        FROM (
+            SELECT
+                resource_role_id AS role_id,
+                resource_permission_id AS permission_id
+            FROM
+                authsrv_resourcerolepermission
+            WHERE
+                trash = FALSE) rp
+            INNER JOIN (
+                SELECT
+                    rp.id AS permission_id,
+                    rp.base_url,
+                    rp.name AS permission_name,
+                    rp.resource_urls || rp.resource_action_urls AS urls
+                FROM
+                    authsrv_resourcepermission rp) p ON rp.permission_id = p.permission_id
+                INNER JOIN (
+                    SELECT
+                        id,
+                        name AS role_name,
+                        is_global,
+                        scope
+                    FROM
+                        authsrv_resourcerole
+                    WHERE
+                        trash = FALSE) rr ON rr.id = rp.role_id) rbu ON gpr.role_id = rbu.role_id;
@@ -0,0 +1,2 @@
+ALTER TABLE cluster_namespaces DROP COLUMN IF EXISTS modified_at;
+ALTER TABLE cluster_namespaces DROP COLUMN IF EXISTS created_at;
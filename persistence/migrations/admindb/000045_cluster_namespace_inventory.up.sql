@@ -0,0 +1,2 @@
+ALTER TABLE cluster_namespaces ADD COLUMN IF NOT EXISTS created_at timestamp WITH time zone NOT NULL default current_timestamp;
+ALTER TABLE cluster_namespaces ADD COLUMN IF NOT EXISTS modified_at timestamp WITH time zone NOT NULL default current_timestamp;
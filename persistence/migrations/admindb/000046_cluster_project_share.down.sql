@@ -0,0 +1,3 @@
+ALTER TABLE cluster_project_cluster DROP COLUMN IF EXISTS created_at;
+ALTER TABLE cluster_project_cluster DROP COLUMN IF EXISTS namespaces;
+ALTER TABLE cluster_project_cluster DROP COLUMN IF EXISTS access;
@@ -0,0 +1,3 @@
+ALTER TABLE cluster_project_cluster ADD COLUMN IF NOT EXISTS access varchar NOT NULL DEFAULT 'FULL_ACCESS';
+ALTER TABLE cluster_project_cluster ADD COLUMN IF NOT EXISTS namespaces jsonb NOT NULL DEFAULT '[]';
+ALTER TABLE cluster_project_cluster ADD COLUMN IF NOT EXISTS created_at timestamp WITH time zone NOT NULL default current_timestamp;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS sentry_relay_network (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name varchar NOT NULL,
+    description varchar NOT NULL default '',
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    user_host varchar NOT NULL,
+    connector_host varchar NOT NULL,
+    projects jsonb NOT NULL DEFAULT '[]'::jsonb,
+    clusters jsonb NOT NULL DEFAULT '[]'::jsonb,
+    created_at timestamp WITH time zone NOT NULL default current_timestamp,
+    modified_at timestamp WITH time zone,
+    deleted_at timestamp WITH time zone,
+    trash boolean NOT NULL default false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sentry_relay_network_name_organization_id_key ON sentry_relay_network USING btree (name, organization_id) WHERE trash = false;
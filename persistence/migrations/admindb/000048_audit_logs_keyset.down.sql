@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS audit_logs_tag_time_id_idx;
+
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS id;
@@ -0,0 +1,12 @@
+-- audit_logs is written by fluent-bit which creates the table on first
+-- use; columns it does not know about are appended so its inserts keep
+-- working
+CREATE TABLE IF NOT EXISTS audit_logs (
+    tag varchar,
+    time timestamp WITHOUT time zone,
+    data jsonb
+);
+
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS id bigserial;
+
+CREATE INDEX IF NOT EXISTS audit_logs_tag_time_id_idx ON audit_logs (tag, time DESC, id DESC);
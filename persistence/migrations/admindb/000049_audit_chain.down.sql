@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS audit_checkpoints;
+
+DROP TABLE IF EXISTS audit_chain_heads;
+
+DROP INDEX IF EXISTS audit_logs_organization_seq_key;
+
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS hash;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS prev_hash;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS seq;
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS organization;
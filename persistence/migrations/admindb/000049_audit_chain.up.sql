@@ -0,0 +1,27 @@
+-- events written by the database sink are hash chained per organization,
+-- rows shipped by fluent-bit leave the chain columns empty
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS organization varchar;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS seq bigint;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS prev_hash varchar;
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS hash varchar;
+
+CREATE UNIQUE INDEX IF NOT EXISTS audit_logs_organization_seq_key ON audit_logs (organization, seq) WHERE seq IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS audit_chain_heads (
+    organization varchar PRIMARY KEY,
+    seq bigint NOT NULL,
+    hash varchar NOT NULL,
+    modified_at timestamp WITH time zone NOT NULL default current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS audit_checkpoints (
+    id bigserial PRIMARY KEY,
+    organization varchar NOT NULL,
+    seq bigint NOT NULL,
+    hash varchar NOT NULL,
+    key_id varchar NOT NULL,
+    signature varchar NOT NULL,
+    created_at timestamp WITH time zone NOT NULL default current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS audit_checkpoints_organization_seq_idx ON audit_checkpoints (organization, seq);
@@ -0,0 +1,22 @@
+ALTER TABLE audit_chain_heads DROP COLUMN IF EXISTS purged_seq;
+
+CREATE TABLE audit_logs_unpartitioned (
+    tag varchar,
+    time timestamp WITHOUT time zone,
+    data jsonb,
+    id bigint NOT NULL DEFAULT nextval('audit_logs_id_seq'),
+    organization varchar,
+    seq bigint,
+    prev_hash varchar,
+    hash varchar
+);
+
+INSERT INTO audit_logs_unpartitioned (tag, time, data, id, organization, seq, prev_hash, hash)
+    SELECT tag, time, data, id, organization, seq, prev_hash, hash FROM audit_logs;
+
+ALTER SEQUENCE audit_logs_id_seq OWNED BY audit_logs_unpartitioned.id;
+DROP TABLE audit_logs;
+ALTER TABLE audit_logs_unpartitioned RENAME TO audit_logs;
+
+CREATE INDEX IF NOT EXISTS audit_logs_tag_time_id_idx ON audit_logs (tag, time DESC, id DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS audit_logs_organization_seq_key ON audit_logs (organization, seq) WHERE seq IS NOT NULL;
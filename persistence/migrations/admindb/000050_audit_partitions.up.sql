@@ -0,0 +1,29 @@
+-- audit_logs is partitioned by day so that expired events are purged by
+-- dropping their partition; the existing events become the default
+-- partition which also takes events of days without a partition
+ALTER TABLE audit_logs RENAME TO audit_logs_default;
+ALTER INDEX IF EXISTS audit_logs_tag_time_id_idx RENAME TO audit_logs_default_tag_time_id_idx;
+
+-- unique indexes of partitioned tables have to include the partition key,
+-- the sequence of a chain is kept unique by the lock on its head
+DROP INDEX IF EXISTS audit_logs_organization_seq_key;
+
+CREATE TABLE audit_logs (
+    tag varchar,
+    time timestamp WITHOUT time zone,
+    data jsonb,
+    id bigint NOT NULL DEFAULT nextval('audit_logs_id_seq'),
+    organization varchar,
+    seq bigint,
+    prev_hash varchar,
+    hash varchar
+) PARTITION BY RANGE (time);
+
+ALTER SEQUENCE audit_logs_id_seq OWNED BY audit_logs.id;
+ALTER TABLE audit_logs ATTACH PARTITION audit_logs_default DEFAULT;
+
+CREATE INDEX IF NOT EXISTS audit_logs_tag_time_id_idx ON audit_logs (tag, time DESC, id DESC);
+CREATE INDEX IF NOT EXISTS audit_logs_organization_seq_idx ON audit_logs (organization, seq) WHERE seq IS NOT NULL;
+
+-- events of a chain up to purged_seq were removed by retention
+ALTER TABLE audit_chain_heads ADD COLUMN IF NOT EXISTS purged_seq bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS audit_alert_rule (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name varchar NOT NULL,
+    description varchar NOT NULL default '',
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    expression text NOT NULL,
+    notifier varchar NOT NULL,
+    timezone varchar NOT NULL default 'UTC',
+    disabled boolean NOT NULL default false,
+    created_at timestamp WITH time zone NOT NULL default current_timestamp,
+    modified_at timestamp WITH time zone,
+    deleted_at timestamp WITH time zone,
+    trash boolean NOT NULL default false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS audit_alert_rule_name_organization_id_key ON audit_alert_rule USING btree (name, organization_id) WHERE trash = false;
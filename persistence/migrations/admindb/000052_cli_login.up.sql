@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS authsrv_cli_login (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    device_code_hash varchar NOT NULL,
+    user_code varchar NOT NULL,
+    account_id uuid,
+    username varchar NOT NULL default '',
+    organization_id uuid,
+    partner_id uuid,
+    approved_at timestamp WITH time zone,
+    expires_at timestamp WITH time zone NOT NULL,
+    created_at timestamp WITH time zone NOT NULL default current_timestamp
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_cli_login_device_code_hash_key ON authsrv_cli_login USING btree (device_code_hash);
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_cli_login_user_code_key ON authsrv_cli_login USING btree (user_code);
@@ -0,0 +1,125 @@
+package alert
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit/forward"
+	"sigs.k8s.io/yaml"
+)
+
+// types of notifiers
+const (
+	TypeWebhook = "webhook"
+	TypeMail    = "mail"
+)
+
+// Config is the YAML configuration of the alert notifiers, rules name
+// the notifier their alerts are sent to, e.g.
+//
+//	notifiers:
+//	- name: oncall
+//	  type: webhook
+//	  webhook:
+//	    url: https://hooks.example.com/paralus-alerts
+//	    secretFile: /etc/paralus/alert-secret
+//	- name: secops
+//	  type: mail
+//	  mail:
+//	    path: /var/spool/paralus/alerts.mbox
+//	    to: [secops@example.com]
+type Config struct {
+	Notifiers []NotifierConfig `json:"notifiers"`
+}
+
+// NotifierConfig configures one notifier
+type NotifierConfig struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+
+	QueueSize    int              `json:"queueSize,omitempty"`
+	MaxRetries   int              `json:"maxRetries,omitempty"`
+	RetryBackoff forward.Duration `json:"retryBackoff,omitempty"`
+	Timeout      forward.Duration `json:"timeout,omitempty"`
+
+	Webhook *forward.WebhookConfig `json:"webhook,omitempty"`
+	Mail    *MailConfig            `json:"mail,omitempty"`
+}
+
+// MailConfig configures a mail notifier
+type MailConfig struct {
+	Path string   `json:"path"`
+	From string   `json:"from,omitempty"`
+	To   []string `json:"to,omitempty"`
+}
+
+// LoadConfig reads the notifier configuration at path
+func LoadConfig(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid audit alert config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Build returns the notifiers of cfg by name. Notifiers are forwarders
+// of the alerts, they queue and retry the alerts like the audit
+// forwarders.
+func Build(cfg *Config) (map[string]*forward.Forwarder, error) {
+	dests := make(map[string]forward.Destination)
+	closeAll := func() {
+		for _, d := range dests {
+			d.Close()
+		}
+	}
+	for _, nc := range cfg.Notifiers {
+		if nc.Name == "" {
+			closeAll()
+			return nil, fmt.Errorf("alert notifier without name")
+		}
+		if _, ok := dests[nc.Name]; ok {
+			closeAll()
+			return nil, fmt.Errorf("duplicate alert notifier %s", nc.Name)
+		}
+		dest, err := nc.destination()
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("alert notifier %s: %w", nc.Name, err)
+		}
+		dests[nc.Name] = dest
+	}
+
+	notifiers := make(map[string]*forward.Forwarder)
+	for _, nc := range cfg.Notifiers {
+		// the metrics of the notifiers are published next to the ones of
+		// the forwarders
+		notifiers[nc.Name] = forward.New("alert."+nc.Name, dests[nc.Name], forward.Filter{}, forward.Options{
+			QueueSize:    nc.QueueSize,
+			MaxRetries:   nc.MaxRetries,
+			RetryBackoff: time.Duration(nc.RetryBackoff),
+			Timeout:      time.Duration(nc.Timeout),
+		})
+	}
+	return notifiers, nil
+}
+
+func (nc *NotifierConfig) destination() (forward.Destination, error) {
+	switch nc.Type {
+	case TypeWebhook:
+		if nc.Webhook == nil {
+			return nil, fmt.Errorf("webhook url is required")
+		}
+		return nc.Webhook.Destination()
+	case TypeMail:
+		if nc.Mail == nil || nc.Mail.Path == "" {
+			return nil, fmt.Errorf("mail path is required")
+		}
+		return NewMail(MailOptions{Path: nc.Mail.Path, From: nc.Mail.From, To: nc.Mail.To}), nil
+	}
+	return nil, fmt.Errorf("unknown type %q", nc.Type)
+}
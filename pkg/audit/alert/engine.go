@@ -0,0 +1,184 @@
+package alert
+
+import (
+	"encoding/json"
+	"expvar"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/forward"
+	logv2 "github.com/paralus/paralus/pkg/log"
+)
+
+var (
+	_log = logv2.GetLogger()
+
+	// metrics counts the evaluated events and the alerts, published at
+	// /debug/vars of the debug server
+	metrics = expvar.NewMap("audit_alerts")
+)
+
+// alert counters
+const (
+	metricEvaluated = "evaluated"
+	metricMatched   = "matched"
+	metricUnrouted  = "unrouted"
+)
+
+// SourceAlert is the source of the alerts handed to notifiers
+const SourceAlert = "alert"
+
+// AlertType is the type of the alert documents
+const AlertType = "audit.alert.triggered"
+
+// Rule is an enabled alert rule of an organization
+type Rule struct {
+	ID           string
+	Name         string
+	Organization string
+	// Notifier is the name of the notifier the alerts are sent to
+	Notifier string
+	Expr     *Expr
+	// Location of the hour and weekday fields
+	Location *time.Location
+}
+
+// Alert is the JSON document sent to notifiers when an event matches a
+// rule
+type Alert struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Type         string    `json:"type"`
+	Organization string    `json:"organization"`
+	Rule         struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		Expression string `json:"expression"`
+	} `json:"rule"`
+	// Source is the tag of the event, system, kubectl_api or kubectl_cmd
+	Source string          `json:"source"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// Engine evaluates the alert rules against audit events in process and
+// queues the alerts of matching rules to their notifier. It is added to
+// the audit sinks for system events and to the relay audit tail for
+// relay events.
+type Engine struct {
+	notifiers map[string]*forward.Forwarder
+
+	mu sync.RWMutex
+	// rules by organization, replaced as a whole by SetRules
+	rules map[string][]*Rule
+}
+
+var (
+	_ audit.Sink       = (*Engine)(nil)
+	_ forward.Receiver = (*Engine)(nil)
+)
+
+// NewEngine returns engine sending alerts to notifiers by name
+func NewEngine(notifiers map[string]*forward.Forwarder) *Engine {
+	if notifiers == nil {
+		notifiers = make(map[string]*forward.Forwarder)
+	}
+	return &Engine{notifiers: notifiers, rules: make(map[string][]*Rule)}
+}
+
+// Name returns the name of the engine as a relay audit receiver
+func (e *Engine) Name() string {
+	return "alerts"
+}
+
+// Notifiers returns the names of the configured notifiers
+func (e *Engine) Notifiers() []string {
+	names := make([]string, 0, len(e.notifiers))
+	for name := range e.notifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasNotifier returns true when notifier is configured
+func (e *Engine) HasNotifier(name string) bool {
+	_, ok := e.notifiers[name]
+	return ok
+}
+
+// SetRules replaces the rules evaluated by the engine
+func (e *Engine) SetRules(rules []*Rule) {
+	byOrg := make(map[string][]*Rule)
+	for _, r := range rules {
+		byOrg[r.Organization] = append(byOrg[r.Organization], r)
+	}
+	e.mu.Lock()
+	e.rules = byOrg
+	e.mu.Unlock()
+}
+
+// Write evaluates an event of audit.CreateEvent, it implements
+// audit.Sink so that the engine can be added to the audit logger
+func (e *Engine) Write(p []byte) error {
+	return e.Forward(audit.SYSTEM, p)
+}
+
+// Forward evaluates the rules of the organization of the event of source
+// against it
+func (e *Engine) Forward(source string, data []byte) error {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	ev, err := forward.NewEvent(source, data)
+	if err != nil {
+		return err
+	}
+	metrics.Add(metricEvaluated, 1)
+	for _, r := range rules[ev.Organization] {
+		if r.Expr.Match(ev, r.Location) {
+			e.notify(r, ev)
+		}
+	}
+	return nil
+}
+
+func (e *Engine) notify(r *Rule, ev *forward.Event) {
+	metrics.Add(metricMatched, 1)
+	n, ok := e.notifiers[r.Notifier]
+	if !ok {
+		metrics.Add(metricUnrouted, 1)
+		_log.Warnw("alert rule notifier is not configured", "rule", r.Name, "notifier", r.Notifier)
+		return
+	}
+
+	a := Alert{
+		Timestamp:    time.Now().UTC(),
+		Type:         AlertType,
+		Organization: r.Organization,
+		Source:       ev.Source,
+		Event:        ev.Data,
+	}
+	a.Rule.ID, a.Rule.Name, a.Rule.Expression = r.ID, r.Name, r.Expr.String()
+	b, err := json.Marshal(a)
+	if err != nil {
+		_log.Warnw("unable to encode alert", "rule", r.Name, "error", err)
+		return
+	}
+	if err := n.Forward(SourceAlert, b); err != nil {
+		_log.Warnw("unable to queue alert", "rule", r.Name, "notifier", r.Notifier, "error", err)
+	}
+}
+
+// Close delivers the queued alerts and closes the notifiers
+func (e *Engine) Close() error {
+	var notifiers []audit.Sink
+	for _, n := range e.notifiers {
+		notifiers = append(notifiers, n)
+	}
+	return audit.CloseSinks(notifiers)
+}
@@ -0,0 +1,121 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/forward"
+)
+
+type recordingDestination struct {
+	mu     sync.Mutex
+	events []*forward.Event
+}
+
+func (d *recordingDestination) Deliver(ctx context.Context, ev *forward.Event) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func (d *recordingDestination) Close() error { return nil }
+
+func TestEngine(t *testing.T) {
+	dest := &recordingDestination{}
+	e := NewEngine(map[string]*forward.Forwarder{
+		"oncall": forward.New("test.oncall", dest, forward.Filter{}, forward.Options{}),
+	})
+
+	exec, err := Compile(`url matches "*/exec"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin, err := Compile(`type == "user.role.created"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.SetRules([]*Rule{
+		{ID: "r1", Name: "prod-exec", Organization: "o1", Notifier: "oncall", Expr: exec, Location: time.UTC},
+		{ID: "r2", Name: "new-admin", Organization: "o1", Notifier: "oncall", Expr: admin, Location: time.UTC},
+		// rules of other organizations do not see the events
+		{ID: "r3", Name: "other", Organization: "o2", Notifier: "oncall", Expr: admin, Location: time.UTC},
+		{ID: "r4", Name: "unrouted", Organization: "o1", Notifier: "missing", Expr: admin, Location: time.UTC},
+	})
+
+	if err := e.Forward(audit.KUBECTL_API, []byte(`{"ts":"2022-01-01T22:30:00Z","o":"o1","m":"POST","url":"/api/v1/namespaces/web/pods/web-0/exec"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Forward(audit.KUBECTL_API, []byte(`{"ts":"2022-01-01T22:31:00Z","o":"o1","m":"GET","url":"/api/v1/pods"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Write([]byte(`{"timestamp":"2022-01-01T22:32:00Z","type":"user.role.created","organization":"o1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dest.events) != 2 {
+		t.Fatalf("expected 2 alerts, got %d", len(dest.events))
+	}
+	var a Alert
+	if err := json.Unmarshal(dest.events[0].Data, &a); err != nil {
+		t.Fatal(err)
+	}
+	if dest.events[0].Source != SourceAlert || a.Type != AlertType || a.Rule.Name != "prod-exec" ||
+		a.Rule.Expression != `url matches "*/exec"` || a.Source != audit.KUBECTL_API || a.Organization != "o1" {
+		t.Errorf("unexpected alert %+v", a)
+	}
+	if err := json.Unmarshal(dest.events[1].Data, &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Rule.ID != "r2" || a.Source != audit.SYSTEM {
+		t.Errorf("unexpected alert %+v", a)
+	}
+}
+
+func TestMailNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.mbox")
+	d := NewMail(MailOptions{Path: path, To: []string{"secops@example.com"}})
+
+	a := Alert{
+		Timestamp:    time.Date(2022, 1, 1, 22, 30, 0, 0, time.UTC),
+		Type:         AlertType,
+		Organization: "o1",
+		Source:       audit.KUBECTL_CMD,
+		Event: json.RawMessage(`{"timestamp":"2022-01-01T22:30:00Z","actor":{"account":{"username":"dev@paralus.local"}},` +
+			`"detail":{"message":"kubectl exec -it web-0 -- sh","meta":{"cluster_name":"prod"}}}`),
+	}
+	a.Rule.ID, a.Rule.Name, a.Rule.Expression = "r1", "prod-exec", `message matches "kubectl exec*"`
+	b, _ := json.Marshal(a)
+	for i := 0; i < 2; i++ {
+		if err := d.Deliver(context.Background(), &forward.Event{Source: SourceAlert, Data: b}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mbox, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(mbox), "\nFrom paralus@localhost ") + 1; !strings.HasPrefix(string(mbox), "From paralus@localhost Sat Jan  1 22:30:00 2022\n") || n != 2 {
+		t.Errorf("expected 2 mails, got %d:\n%s", n, mbox)
+	}
+	for _, want := range []string{
+		"To: secops@example.com\n",
+		"Subject: [paralus] prod-exec: dev@paralus.local kubectl exec -it web-0 -- sh prod\n",
+		`Rule:         message matches "kubectl exec*"`,
+	} {
+		if !strings.Contains(string(mbox), want) {
+			t.Errorf("expected mail to contain %q:\n%s", want, mbox)
+		}
+	}
+}
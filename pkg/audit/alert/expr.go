@@ -0,0 +1,480 @@
+package alert
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/paralus/paralus/pkg/audit/forward"
+)
+
+// Expr is a compiled rule expression. Expressions compare the fields of
+// audit events and combine the comparisons with and, or, not and
+// parentheses, e.g.
+//
+//	source == "kubectl_api" and url matches "*/exec" and namespace matches "prod*"
+//	kind == "secrets" and method == "GET" and (hour < 9 or hour >= 18 or weekday in ["sat", "sun"])
+//	type == "user.role.created" and meta.roles_name == "ADMIN"
+//
+// String fields support ==, !=, in, not in and matches, a shell pattern
+// whose * matches any characters. Strings compare case insensitively.
+// Number fields support ==, !=, <, <=, >, >=, in and not in.
+type Expr struct {
+	src  string
+	root node
+}
+
+// string fields of events
+var stringFields = map[string]func(ev *forward.Event) string{
+	"source":       func(ev *forward.Event) string { return ev.Source },
+	"type":         func(ev *forward.Event) string { return ev.Type },
+	"actor":        func(ev *forward.Event) string { return ev.Username },
+	"client":       func(ev *forward.Event) string { return ev.ClientIP },
+	"project":      func(ev *forward.Event) string { return ev.Project },
+	"organization": func(ev *forward.Event) string { return ev.Organization },
+	"cluster":      func(ev *forward.Event) string { return ev.Cluster },
+	"namespace":    func(ev *forward.Event) string { return ev.Namespace },
+	"kind":         func(ev *forward.Event) string { return ev.Kind },
+	"method":       func(ev *forward.Event) string { return ev.Method },
+	"name":         func(ev *forward.Event) string { return ev.Name },
+	"url":          func(ev *forward.Event) string { return ev.URL },
+	"message":      func(ev *forward.Event) string { return ev.Message },
+}
+
+// metaPrefix selects a field of the detail of system events, e.g.
+// meta.cluster_name
+const metaPrefix = "meta."
+
+// time fields are of the event time in the time zone of the rule
+const (
+	fieldHour    = "hour"
+	fieldWeekday = "weekday"
+	fieldStatus  = "status"
+)
+
+// weekdays are the values of the weekday field
+var weekdays = [...]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Compile parses the rule expression src
+func Compile(src string) (*Expr, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+// String returns the source of the expression
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Match returns true when ev satisfies the expression, hour and weekday
+// are of the event time in loc
+func (e *Expr) Match(ev *forward.Event, loc *time.Location) bool {
+	return e.root.eval(&env{ev: ev, t: ev.Time.In(loc)})
+}
+
+// Fields returns the values of the fields of ev as seen by expressions
+func Fields(ev *forward.Event, loc *time.Location) map[string]string {
+	e := &env{ev: ev, t: ev.Time.In(loc)}
+	fields := make(map[string]string, len(stringFields)+len(ev.Meta)+3)
+	for name := range stringFields {
+		fields[name] = e.str(name)
+	}
+	for k, v := range ev.Meta {
+		fields[metaPrefix+k] = v
+	}
+	fields[fieldWeekday] = e.str(fieldWeekday)
+	fields[fieldHour] = strconv.Itoa(e.num(fieldHour))
+	fields[fieldStatus] = strconv.Itoa(e.num(fieldStatus))
+	return fields
+}
+
+// FieldNames returns the fields expressions can compare, meta fields
+// are not listed
+func FieldNames() []string {
+	names := []string{fieldHour, fieldWeekday, fieldStatus}
+	for name := range stringFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// env is the event an expression is evaluated against
+type env struct {
+	ev *forward.Event
+	t  time.Time
+}
+
+func (e *env) str(field string) string {
+	if f, ok := stringFields[field]; ok {
+		return f(e.ev)
+	}
+	if field == fieldWeekday {
+		return weekdays[e.t.Weekday()]
+	}
+	return e.ev.Meta[strings.TrimPrefix(field, metaPrefix)]
+}
+
+func (e *env) num(field string) int {
+	if field == fieldHour {
+		return e.t.Hour()
+	}
+	return e.ev.StatusCode
+}
+
+func isNumberField(field string) bool {
+	return field == fieldHour || field == fieldStatus
+}
+
+func isStringField(field string) bool {
+	_, ok := stringFields[field]
+	return ok || field == fieldWeekday ||
+		(strings.HasPrefix(field, metaPrefix) && len(field) > len(metaPrefix))
+}
+
+type node interface {
+	eval(e *env) bool
+}
+
+type andNode struct{ l, r node }
+
+func (n *andNode) eval(e *env) bool { return n.l.eval(e) && n.r.eval(e) }
+
+type orNode struct{ l, r node }
+
+func (n *orNode) eval(e *env) bool { return n.l.eval(e) || n.r.eval(e) }
+
+type notNode struct{ x node }
+
+func (n *notNode) eval(e *env) bool { return !n.x.eval(e) }
+
+// stringCmp compares a string field with one of values or a pattern
+type stringCmp struct {
+	field   string
+	op      string
+	values  []string
+	pattern *regexp.Regexp
+}
+
+func (n *stringCmp) eval(e *env) bool {
+	v := e.str(n.field)
+	switch n.op {
+	case "matches":
+		return n.pattern.MatchString(v)
+	case "!=":
+		return !strings.EqualFold(v, n.values[0])
+	}
+	// == and in
+	for _, want := range n.values {
+		if strings.EqualFold(v, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// numberCmp compares a number field with one of values
+type numberCmp struct {
+	field  string
+	op     string
+	values []int
+}
+
+func (n *numberCmp) eval(e *env) bool {
+	v, want := e.num(n.field), n.values[0]
+	switch n.op {
+	case "!=":
+		return v != want
+	case "<":
+		return v < want
+	case "<=":
+		return v <= want
+	case ">":
+		return v > want
+	case ">=":
+		return v >= want
+	}
+	// == and in
+	for _, want := range n.values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
+// globPattern compiles a shell pattern, * matches any characters and ?
+// one character
+func globPattern(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits src into tokens, keywords are returned as identifiers
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(src) && src[j] != c; j++ {
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				b.WriteByte(src[j])
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			toks = append(toks, token{kind: tokString, text: b.String(), pos: i})
+			i = j + 1
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && src[j] >= '0' && src[j] <= '9' {
+				j++
+			}
+			toks = append(toks, token{kind: tokNumber, text: src[i:j], pos: i})
+			i = j
+		case c == '_' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] == '.' || unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j]))) {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, text: src[i:j], pos: i})
+			i = j
+		default:
+			op := ""
+			for _, o := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","} {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at position %d", c, i+1)
+			}
+			toks = append(toks, token{kind: tokOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(toks, token{kind: tokEOF, pos: len(src)}), nil
+}
+
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+// is returns true when t is the operator or keyword s
+func (t token) is(s ...string) bool {
+	for _, v := range s {
+		if (t.kind == tokOp && t.text == v) || (t.kind == tokIdent && strings.EqualFold(t.text, v)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	if t.kind == tokEOF {
+		return fmt.Errorf("%s at end of expression", fmt.Sprintf(format, args...))
+	}
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), t.pos+1)
+}
+
+func (p *parser) parseOr() (node, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("or", "||") {
+		p.next()
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = &orNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is("and", "&&") {
+		p.next()
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = &andNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	switch {
+	case t.is("not", "!"):
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x}, nil
+	case t.is("("):
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); !t.is(")") {
+			return nil, p.errorf(t, "expected )")
+		}
+		return x, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	f := p.next()
+	if f.kind != tokIdent {
+		return nil, p.errorf(f, "expected field")
+	}
+	field := strings.ToLower(f.text)
+	number := isNumberField(field)
+	if !number && !isStringField(field) {
+		return nil, p.errorf(f, "unknown field %q", f.text)
+	}
+
+	t := p.next()
+	op := strings.ToLower(t.text)
+	negate := false
+	switch {
+	case t.is("not") && p.peek().is("in"):
+		p.next()
+		op, negate = "in", true
+	case t.is("==", "!=", "in"):
+	case t.is("<", "<=", ">", ">="):
+		if !number {
+			return nil, p.errorf(t, "%s cannot be compared with %s", field, t.text)
+		}
+	case t.is("matches"):
+		if number {
+			return nil, p.errorf(t, "%s cannot be matched against a pattern", field)
+		}
+	default:
+		return nil, p.errorf(t, "expected comparison operator")
+	}
+
+	var values []token
+	if op == "in" {
+		if t := p.next(); !t.is("[") {
+			return nil, p.errorf(t, "expected [")
+		}
+		for {
+			v := p.next()
+			values = append(values, v)
+			if t := p.next(); t.is("]") {
+				break
+			} else if !t.is(",") {
+				return nil, p.errorf(t, "expected , or ]")
+			}
+		}
+	} else {
+		values = append(values, p.next())
+	}
+
+	var n node
+	if number {
+		c := &numberCmp{field: field, op: op}
+		for _, v := range values {
+			if v.kind != tokNumber {
+				return nil, p.errorf(v, "expected number")
+			}
+			i, err := strconv.Atoi(v.text)
+			if err != nil {
+				return nil, p.errorf(v, "invalid number %q", v.text)
+			}
+			c.values = append(c.values, i)
+		}
+		n = c
+	} else {
+		c := &stringCmp{field: field, op: op}
+		for _, v := range values {
+			if v.kind != tokString {
+				return nil, p.errorf(v, "expected quoted string")
+			}
+			c.values = append(c.values, v.text)
+		}
+		if op == "matches" {
+			c.pattern = globPattern(c.values[0])
+		}
+		n = c
+	}
+	if negate {
+		n = &notNode{n}
+	}
+	return n, nil
+}
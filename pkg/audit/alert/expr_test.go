@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/forward"
+)
+
+func relayEvent(t *testing.T, data string) *forward.Event {
+	ev, err := forward.NewEvent(audit.KUBECTL_API, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ev
+}
+
+func TestExprMatch(t *testing.T) {
+	// Saturday 22:30 UTC
+	exec := relayEvent(t, `{"ts":"2022-01-01T22:30:00Z","un":"dev@paralus.local","cn":"prod-eu","o":"o1",`+
+		`"m":"POST","k":"pods","ns":"prod-web","n":"web-0","url":"/api/v1/namespaces/prod-web/pods/web-0/exec","sc":101}`)
+	secret := relayEvent(t, `{"ts":"2022-01-03T10:00:00Z","un":"dev@paralus.local","cn":"prod-eu","o":"o1",`+
+		`"m":"GET","k":"secrets","ns":"prod-web","url":"/api/v1/namespaces/prod-web/secrets","sc":200}`)
+	admin, err := forward.NewEvent(audit.SYSTEM, []byte(`{"timestamp":"2022-01-03T10:00:00Z","type":"user.role.created",`+
+		`"organization":"o1","detail":{"message":"Role ADMIN added to user ops@paralus.local","meta":{"roles_name":"ADMIN"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	afterHours := `kind == "secrets" and method == "GET" and (hour < 9 or hour >= 18 or weekday in ["sat", "sun"])`
+
+	for _, tc := range []struct {
+		expr  string
+		ev    *forward.Event
+		loc   string
+		match bool
+	}{
+		{`source == "kubectl_api" and url matches "*/exec" and namespace matches "prod*"`, exec, "UTC", true},
+		{`url matches "*/exec" and namespace matches "staging*"`, exec, "UTC", false},
+		{`actor == "DEV@paralus.local" && cluster in ["prod-eu", "prod-us"]`, exec, "UTC", true},
+		{`cluster not in ["prod-eu"] or status >= 400`, exec, "UTC", false},
+		{`!(status == 200)`, exec, "UTC", true},
+		{afterHours, exec, "UTC", false},
+		{afterHours, secret, "UTC", false},
+		// 10:00 UTC is 19:00 in Tokyo
+		{afterHours, secret, "Asia/Tokyo", true},
+		{`type == "user.role.created" and meta.roles_name == "admin"`, admin, "UTC", true},
+		{`type == "user.role.created" and meta.roles_name == "ADMIN" and not message matches "*ops@*"`, admin, "UTC", false},
+	} {
+		e, err := Compile(tc.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.expr, err)
+		}
+		loc, _ := time.LoadLocation(tc.loc)
+		if got := e.Match(tc.ev, loc); got != tc.match {
+			t.Errorf("%s in %s: expected %v, got %v", tc.expr, tc.loc, tc.match, got)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		expr, err string
+	}{
+		{``, "expected field at end of expression"},
+		{`user == "x"`, `unknown field "user" at position 1`},
+		{`type = "x"`, "unexpected '=' at position 6"},
+		{`hour matches "1*"`, "hour cannot be matched against a pattern at position 6"},
+		{`kind < "x"`, "kind cannot be compared with < at position 6"},
+		{`status == "200"`, "expected number at position 11"},
+		{`type == x`, "expected quoted string at position 9"},
+		{`type in ["a" "b"]`, "expected , or ] at position 14"},
+		{`(type == "a"`, "expected ) at end of expression"},
+		{`type == "a" cluster == "b"`, `unexpected "cluster" at position 13`},
+		{`type == "a`, "unterminated string at position 9"},
+	} {
+		_, err := Compile(tc.expr)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: expected error %q, got %v", tc.expr, tc.err, err)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	ev := relayEvent(t, `{"ts":"2022-01-03T10:00:00Z","un":"dev@paralus.local","m":"GET","k":"secrets","sc":200}`)
+	fields := Fields(ev, time.UTC)
+	if fields["actor"] != "dev@paralus.local" || fields["kind"] != "secrets" || fields["hour"] != "10" ||
+		fields["weekday"] != "mon" || fields["status"] != "200" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+}
@@ -0,0 +1,114 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit/forward"
+)
+
+// MailOptions configure a mail notifier
+type MailOptions struct {
+	// Path of the mbox file the mails are appended to
+	Path string
+	From string
+	To   []string
+}
+
+// mailDestination is a local stand-in for a mail relay, it appends the
+// alert mails to an mbox file which mail clients and tests can read
+type mailDestination struct {
+	opts MailOptions
+	mu   sync.Mutex
+}
+
+// NewMail returns destination appending alerts as mails to an mbox file
+func NewMail(opts MailOptions) forward.Destination {
+	if opts.From == "" {
+		opts.From = "paralus@localhost"
+	}
+	return &mailDestination{opts: opts}
+}
+
+func (d *mailDestination) Deliver(ctx context.Context, ev *forward.Event) error {
+	var a Alert
+	if err := json.Unmarshal(ev.Data, &a); err != nil {
+		return err
+	}
+	msg := FormatMail(&a, d.opts.From, d.opts.To)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(d.opts.Path), 0o750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(d.opts.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(msg); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (d *mailDestination) Close() error {
+	return nil
+}
+
+// FormatMail returns the alert as an mbox entry, the From_ line followed
+// by the RFC 5322 message
+func FormatMail(a *Alert, from string, to []string) []byte {
+	ev, _ := forward.NewEvent(a.Source, a.Event)
+	var what []string
+	if ev != nil {
+		for _, s := range []string{ev.Username, ev.Message, ev.Cluster} {
+			if s != "" {
+				what = append(what, s)
+			}
+		}
+	}
+	subject := "[paralus] " + a.Rule.Name
+	if len(what) > 0 {
+		subject += ": " + strings.Join(what, " ")
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "Audit alert rule %s matched a %s event.\n\n", a.Rule.Name, a.Source)
+	fmt.Fprintf(&body, "Rule:         %s\n", a.Rule.Expression)
+	fmt.Fprintf(&body, "Organization: %s\n", a.Organization)
+	if ev != nil {
+		fmt.Fprintf(&body, "Time:         %s\n", ev.Time.UTC().Format(time.RFC3339))
+	}
+	body.WriteString("\nEvent:\n")
+	if err := json.Indent(&body, a.Event, "", "  "); err != nil {
+		body.Write(a.Event)
+	}
+	body.WriteString("\n")
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From %s %s\n", from, a.Timestamp.UTC().Format(time.ANSIC))
+	fmt.Fprintf(&b, "From: %s\n", from)
+	fmt.Fprintf(&b, "To: %s\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(subject))
+	fmt.Fprintf(&b, "Date: %s\n", a.Timestamp.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=utf-8\n")
+	fmt.Fprintf(&b, "X-Paralus-Rule: %s\n\n", a.Rule.ID)
+	for _, line := range strings.SplitAfter(body.String(), "\n") {
+		// mbox quoting of lines looking like the From_ line
+		if strings.HasPrefix(strings.TrimLeft(line, ">"), "From ") {
+			b.WriteString(">")
+		}
+		b.WriteString(line)
+	}
+	b.WriteString("\n")
+	return b.Bytes()
+}
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/paralus/paralus/internal/models"
+)
+
+// ArchiveRecord is an audit event as written to an archive, one per line
+// with the chain columns so that archived chains can still be verified
+type ArchiveRecord struct {
+	Tag          string          `json:"tag"`
+	Time         time.Time       `json:"time"`
+	ID           int64           `json:"id"`
+	Organization string          `json:"organization,omitempty"`
+	Seq          int64           `json:"seq,omitempty"`
+	PrevHash     string          `json:"prev_hash,omitempty"`
+	Hash         string          `json:"hash,omitempty"`
+	Data         json.RawMessage `json:"data"`
+}
+
+// Archive writes audit events as gzip compressed NDJSON. It is written
+// under a temporary name and renamed by Close, an archive without the
+// temporary suffix is complete.
+type Archive struct {
+	path string
+	f    *os.File
+	buf  *bufio.Writer
+	gz   *gzip.Writer
+	enc  *json.Encoder
+	n    int
+}
+
+// CreateArchive creates the archive name.ndjson.gz in dir
+func CreateArchive(dir, name string) (*Archive, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, name+".ndjson.gz")
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(f)
+	gz := gzip.NewWriter(buf)
+	return &Archive{path: path, f: f, buf: buf, gz: gz, enc: json.NewEncoder(gz)}, nil
+}
+
+// Path returns the path of the completed archive
+func (a *Archive) Path() string {
+	return a.path
+}
+
+// Len returns the number of events written
+func (a *Archive) Len() int {
+	return a.n
+}
+
+// Write appends l to the archive
+func (a *Archive) Write(l *models.AuditLog) error {
+	a.n++
+	return a.enc.Encode(ArchiveRecord{
+		Tag:          l.Tag,
+		Time:         l.Time,
+		ID:           l.ID,
+		Organization: l.Organization,
+		Seq:          l.Seq,
+		PrevHash:     l.PrevHash,
+		Hash:         l.Hash,
+		Data:         l.Data,
+	})
+}
+
+// Close flushes the archive to disk and renames it to its final name,
+// the events may be removed from the database once it returns
+func (a *Archive) Close() error {
+	err := a.gz.Close()
+	if err == nil {
+		err = a.buf.Flush()
+	}
+	if err == nil {
+		err = a.f.Sync()
+	}
+	if cerr := a.f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(a.f.Name())
+		return err
+	}
+	return os.Rename(a.f.Name(), a.path)
+}
+
+// Abort removes the incomplete archive
+func (a *Archive) Abort() {
+	a.f.Close()
+	os.Remove(a.f.Name())
+}
@@ -0,0 +1,264 @@
+package auditql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Query is a query compiled against the fields of a schema
+type Query struct {
+	root node
+}
+
+// node is a node of a compiled query
+type node interface {
+	sql(b *strings.Builder, args *[]interface{})
+	es(prefix string) map[string]interface{}
+}
+
+type andNode []node
+
+type orNode []node
+
+type notNode struct {
+	node node
+}
+
+// leafNode matches a field against the parts of a term
+type leafNode struct {
+	field Field
+	term  *Term
+	// number is the value of exact terms of number fields
+	number int64
+}
+
+// Compile parses query src and resolves its fields in schema s, the query
+// is nil when src is blank
+func Compile(src string, s *Schema) (*Query, error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, nil
+	}
+	root, err := compile(n, s)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{root: root}, nil
+}
+
+func compile(n Node, s *Schema) (node, error) {
+	switch n := n.(type) {
+	case *And:
+		nodes, err := compileNodes(n.Nodes, s)
+		return andNode(nodes), err
+	case *Or:
+		nodes, err := compileNodes(n.Nodes, s)
+		return orNode(nodes), err
+	case *Not:
+		c, err := compile(n.Node, s)
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{node: c}, nil
+	case *Term:
+		return compileTerm(n, s)
+	}
+	return nil, fmt.Errorf("unexpected node %T", n)
+}
+
+func compileNodes(nodes []Node, s *Schema) ([]node, error) {
+	compiled := make([]node, len(nodes))
+	for i, n := range nodes {
+		c, err := compile(n, s)
+		if err != nil {
+			return nil, err
+		}
+		compiled[i] = c
+	}
+	return compiled, nil
+}
+
+func compileTerm(t *Term, s *Schema) (node, error) {
+	f, err := s.Field(t.Field)
+	if err != nil {
+		return nil, fmt.Errorf("%v at position %d", err, t.Pos)
+	}
+	leaf := &leafNode{field: f, term: t}
+	if f.Type != Number || t.Exists() {
+		return leaf, nil
+	}
+	if len(t.Parts) > 1 {
+		return nil, fmt.Errorf("field %s is a number, it can not be matched against a pattern at position %d", t.Field, t.Pos)
+	}
+	leaf.number, err = strconv.ParseInt(t.Parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("value of field %s is not a number at position %d", t.Field, t.Pos)
+	}
+	return leaf, nil
+}
+
+// SQL returns the predicate of the query on the data column of audit_logs
+// with its arguments
+func (q *Query) SQL() (string, []interface{}) {
+	var b strings.Builder
+	var args []interface{}
+	q.root.sql(&b, &args)
+	return b.String(), args
+}
+
+// ES returns the bool query of the query, prefix is the prefix of the
+// fields in the documents
+func (q *Query) ES(prefix string) map[string]interface{} {
+	return q.root.es(prefix)
+}
+
+func (n andNode) sql(b *strings.Builder, args *[]interface{}) {
+	joinSQL(b, args, n, " AND ")
+}
+
+func (n orNode) sql(b *strings.Builder, args *[]interface{}) {
+	joinSQL(b, args, n, " OR ")
+}
+
+func joinSQL(b *strings.Builder, args *[]interface{}, nodes []node, sep string) {
+	b.WriteByte('(')
+	for i, n := range nodes {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		n.sql(b, args)
+	}
+	b.WriteByte(')')
+}
+
+func (n *notNode) sql(b *strings.Builder, args *[]interface{}) {
+	b.WriteString("NOT ")
+	_, group := n.node.(*leafNode)
+	if group {
+		b.WriteByte('(')
+	}
+	n.node.sql(b, args)
+	if group {
+		b.WriteByte(')')
+	}
+}
+
+// sql writes the predicate of the leaf, which is false rather than null
+// when the field is missing so that NOT matches the events without it
+func (n *leafNode) sql(b *strings.Builder, args *[]interface{}) {
+	parts := n.term.Parts
+	path := "{" + strings.Join(n.field.Path, ",") + "}"
+	if n.field.Type == StringArray {
+		if len(parts) == 1 {
+			value, _ := json.Marshal([]string{parts[0]})
+			b.WriteString("COALESCE(data #> ? @> ?::jsonb, FALSE)")
+			*args = append(*args, path, string(value))
+			return
+		}
+		b.WriteString("EXISTS (SELECT 1 FROM jsonb_array_elements_text(CASE WHEN jsonb_typeof(data #> ?) = 'array' THEN data #> ? END) AS e WHERE e LIKE ?)")
+		*args = append(*args, path, path, likePattern(parts))
+		return
+	}
+	switch {
+	case n.term.Exists():
+		b.WriteString("data #>> ? IS NOT NULL")
+		*args = append(*args, path)
+	case len(parts) > 1:
+		b.WriteString("COALESCE(data #>> ? LIKE ?, FALSE)")
+		*args = append(*args, path, likePattern(parts))
+	case n.field.Type == Number:
+		// numbers are compared in their canonical text form
+		b.WriteString("COALESCE(data #>> ? = ?, FALSE)")
+		*args = append(*args, path, strconv.FormatInt(n.number, 10))
+	default:
+		b.WriteString("COALESCE(data #>> ? = ?, FALSE)")
+		*args = append(*args, path, parts[0])
+	}
+}
+
+// likePattern returns the LIKE pattern of the parts with the default
+// escape character
+func likePattern(parts []string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = r.Replace(p)
+	}
+	return strings.Join(escaped, "%")
+}
+
+func (n andNode) es(prefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": esNodes(n, prefix),
+		},
+	}
+}
+
+func (n orNode) es(prefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should":               esNodes(n, prefix),
+			"minimum_should_match": 1,
+		},
+	}
+}
+
+func esNodes(nodes []node, prefix string) []interface{} {
+	queries := make([]interface{}, len(nodes))
+	for i, n := range nodes {
+		queries[i] = n.es(prefix)
+	}
+	return queries
+}
+
+func (n *notNode) es(prefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must_not": []interface{}{n.node.es(prefix)},
+		},
+	}
+}
+
+func (n *leafNode) es(prefix string) map[string]interface{} {
+	field := prefix + strings.Join(n.field.Path, ".")
+	parts := n.term.Parts
+	if n.term.Exists() {
+		return map[string]interface{}{
+			"exists": map[string]interface{}{"field": field},
+		}
+	}
+	if p, ok := n.term.Prefix(); ok {
+		return map[string]interface{}{
+			"prefix": map[string]interface{}{field: p},
+		}
+	}
+	switch {
+	case len(parts) > 1:
+		return map[string]interface{}{
+			"wildcard": map[string]interface{}{field: wildcardPattern(parts)},
+		}
+	case n.field.Type == Number:
+		return map[string]interface{}{
+			"term": map[string]interface{}{field: n.number},
+		}
+	}
+	return map[string]interface{}{
+		"term": map[string]interface{}{field: parts[0]},
+	}
+}
+
+// wildcardPattern returns the wildcard query pattern of the parts
+func wildcardPattern(parts []string) string {
+	r := strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = r.Replace(p)
+	}
+	return strings.Join(escaped, "*")
+}
@@ -0,0 +1,122 @@
+package auditql
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompileSQL(t *testing.T) {
+	tests := []struct {
+		query  string
+		schema *Schema
+		sql    string
+		args   []interface{}
+	}{
+		{`type:user.login`, Events, `COALESCE(data #>> ? = ?, FALSE)`, []interface{}{"{type}", "user.login"}},
+		{`user:bob`, Events, `COALESCE(data #>> ? = ?, FALSE)`, []interface{}{"{actor,account,username}", "bob"}},
+		{`user:bob`, RelayAPI, `COALESCE(data #>> ? = ?, FALSE)`, []interface{}{"{un}", "bob"}},
+		{`cluster:prod*`, Events, `COALESCE(data #>> ? LIKE ?, FALSE)`, []interface{}{"{detail,meta,cluster_name}", "prod%"}},
+		{`detail.meta.a_b:*`, Events, `data #>> ? IS NOT NULL`, []interface{}{"{detail,meta,a_b}"}},
+		{`url:*100%_\\*`, RelayAPI, `COALESCE(data #>> ? LIKE ?, FALSE)`, []interface{}{"{url}", `%100\%\_\\%`}},
+		{`status:200`, RelayAPI, `COALESCE(data #>> ? = ?, FALSE)`, []interface{}{"{sc}", "200"}},
+		{`status:0200`, RelayAPI, `COALESCE(data #>> ? = ?, FALSE)`, []interface{}{"{sc}", "200"}},
+		{`actor.groups:admins`, Events, `COALESCE(data #> ? @> ?::jsonb, FALSE)`, []interface{}{"{actor,groups}", `["admins"]`}},
+		{`actor.groups:adm*`, Events,
+			`EXISTS (SELECT 1 FROM jsonb_array_elements_text(CASE WHEN jsonb_typeof(data #> ?) = 'array' THEN data #> ? END) AS e WHERE e LIKE ?)`,
+			[]interface{}{"{actor,groups}", "{actor,groups}", "adm%"}},
+		{`user:bob AND NOT (type:a OR type:b)`, Events,
+			`(COALESCE(data #>> ? = ?, FALSE) AND NOT (COALESCE(data #>> ? = ?, FALSE) OR COALESCE(data #>> ? = ?, FALSE)))`,
+			[]interface{}{"{actor,account,username}", "bob", "{type}", "a", "{type}", "b"}},
+		{`NOT cluster:*`, Events, `NOT (data #>> ? IS NOT NULL)`, []interface{}{"{detail,meta,cluster_name}"}},
+		{`message:"it's'); DROP TABLE audit_logs; --"`, Events, `COALESCE(data #>> ? = ?, FALSE)`,
+			[]interface{}{"{detail,message}", "it's'); DROP TABLE audit_logs; --"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			q, err := Compile(tc.query, tc.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.SQL()
+			if sql != tc.sql {
+				t.Errorf("expected sql %s, got %s", tc.sql, sql)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Errorf("expected args %v, got %v", tc.args, args)
+			}
+		})
+	}
+}
+
+func TestCompileES(t *testing.T) {
+	tests := []struct {
+		query  string
+		schema *Schema
+		es     string
+	}{
+		{`type:user.login`, Events, `{"term":{"json.type":"user.login"}}`},
+		{`cluster:prod*`, Events, `{"prefix":{"json.detail.meta.cluster_name":"prod"}}`},
+		{`cluster:*`, Events, `{"exists":{"field":"json.detail.meta.cluster_name"}}`},
+		{`url:*/exec?\**`, RelayAPI, `{"wildcard":{"json.url":"*/exec\\?\\**"}}`},
+		{`status:404`, RelayAPI, `{"term":{"json.sc":404}}`},
+		{`actor.groups:admins`, Events, `{"term":{"json.actor.groups":"admins"}}`},
+		{`user:bob cluster:c1`, Events,
+			`{"bool":{"must":[{"term":{"json.actor.account.username":"bob"}},{"term":{"json.detail.meta.cluster_name":"c1"}}]}}`},
+		{`method:GET OR NOT kind:Pod`, RelayAPI,
+			`{"bool":{"minimum_should_match":1,"should":[{"term":{"json.m":"GET"}},{"bool":{"must_not":[{"term":{"json.k":"Pod"}}]}}]}}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			q, err := Compile(tc.query, tc.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(q.ES("json."))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tc.es {
+				t.Errorf("expected %s, got %s", tc.es, b)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		query  string
+		schema *Schema
+		err    string
+	}{
+		{`user:bob foo:bar`, Events, `unknown field "foo" of events at position 10`},
+		{`method:GET`, Events, `unknown field "method" of events`},
+		{`detail.meta.a,b:x`, Events, `expected field:value`},
+		{`detail.meta.a.b:x`, Events, `unknown field "detail.meta.a.b"`},
+		{`detail.meta:x`, Events, `unknown field "detail.meta"`},
+		{`un:bob`, Events, `unknown field "un"`},
+		{`type:x`, RelayAPI, `unknown field "type" of relay api calls`},
+		{`status:2*`, RelayAPI, `field status is a number, it can not be matched against a pattern at position 1`},
+		{`sc:ok`, RelayAPI, `value of field sc is not a number at position 1`},
+		{`user:(bob`, Events, `unexpected '('`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			_, err := Compile(tc.query, tc.schema)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error %q, got %q", tc.err, err)
+			}
+		})
+	}
+
+	if q, err := Compile(" ", Events); q != nil || err != nil {
+		t.Errorf("expected no query, got %v %v", q, err)
+	}
+	if _, err := Compile(`status:*`, RelayAPI); err != nil {
+		t.Errorf("expected exists on number to compile, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Package auditql implements the query language of the queryString filter of
+the audit log and relay audit APIs. A query is parsed into an AST and
+compiled to a JSONB predicate on the data column of audit_logs or to an
+Elasticsearch bool query, with the same semantics on both backends.
+
+A query is a combination of terms of the form field:value, e.g.
+
+	actor.username:"bob" AND detail.meta.cluster_name:prod* AND NOT type:user.login
+
+# Values
+
+  - a quoted value or a bare value without * matches the field exactly,
+    case sensitively: type:user.login, detail.message:"user logged in"
+  - * in a bare value matches any characters: cluster:prod*, url:*exec
+  - a lone * matches events having the field: detail.meta.cluster_name:*
+  - \ escapes the next character in bare and quoted values: url:\*
+
+# Operators
+
+  - AND, OR and NOT in capitals, NOT binds tighter than AND which binds
+    tighter than OR
+  - terms next to each other are combined with AND
+  - parentheses group terms: type:user.login AND (user:bob OR user:alice)
+
+A term on an event without the field is false, so NOT user:bob matches the
+events without a user as well.
+
+# Fields
+
+The fields are the JSON paths of the events of the stream, with a few
+aliases. System events and kubectl commands have
+
+	version, category, origin, portal, type, project, organization,
+	trace_id, actor.type, actor.account.username, actor.groups,
+	client.type, client.ip, client.user_agent, client.host,
+	detail.message and detail.meta.<key>
+
+version 2.0 events also have
+
+	request_id, actor.partner_id, actor.organization_id, resource.kind,
+	resource.id and resource.name
+
+and the aliases user and actor.username for actor.account.username,
+cluster for detail.meta.cluster_name, message for detail.message and ip
+for client.ip.
+
+Kubectl api calls of the relays have
+
+	un, cn, pr, o, ra, m, k, n, ns, url, q and sc
+
+and the aliases user and actor.username for un, cluster for cn, project for
+pr, organization for o, ip for ra, method for m, kind for k, name for n,
+namespace for ns, query for q and status for sc. The status is a number,
+it can not be matched against a pattern.
+
+The time range of the events is set with the timefrom, from and to
+filters, it is not part of the query.
+*/
+package auditql
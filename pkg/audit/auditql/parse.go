@@ -0,0 +1,354 @@
+package auditql
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxLength is the longest query accepted
+	MaxLength = 1024
+	// MaxTerms is the highest number of terms of a query
+	MaxTerms = 32
+	// maxDepth bounds the nesting of parentheses and NOT
+	maxDepth = 16
+)
+
+// Node is a node of the AST of a query
+type Node interface {
+	String() string
+}
+
+// And matches when all of its nodes match
+type And struct {
+	Nodes []Node
+}
+
+// Or matches when any of its nodes matches
+type Or struct {
+	Nodes []Node
+}
+
+// Not matches when its node does not match
+type Not struct {
+	Node Node
+}
+
+// Term matches the events whose field matches the value
+type Term struct {
+	Field string
+	// Parts of the value separated by the unescaped *, a single part is
+	// matched exactly
+	Parts []string
+	// Pos is the position of the field in the query, starting at 1
+	Pos int
+}
+
+func (n *And) String() string {
+	return "(" + joinNodes(n.Nodes, " AND ") + ")"
+}
+
+func (n *Or) String() string {
+	return "(" + joinNodes(n.Nodes, " OR ") + ")"
+}
+
+func (n *Not) String() string {
+	return "NOT " + n.Node.String()
+}
+
+func (t *Term) String() string {
+	if t.Exists() {
+		return t.Field + ":*"
+	}
+	if len(t.Parts) == 1 {
+		return t.Field + ":" + quote(t.Parts[0])
+	}
+	var b strings.Builder
+	for i, p := range t.Parts {
+		if i > 0 {
+			b.WriteByte('*')
+		}
+		for _, r := range p {
+			if strings.ContainsRune(` "():*\`, r) {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+	}
+	return t.Field + ":" + b.String()
+}
+
+// Exists returns true for the terms matching the events having the field
+func (t *Term) Exists() bool {
+	return len(t.Parts) == 2 && t.Parts[0] == "" && t.Parts[1] == ""
+}
+
+// Prefix returns the prefix of the terms matching a prefix of the field
+func (t *Term) Prefix() (string, bool) {
+	if len(t.Parts) == 2 && t.Parts[0] != "" && t.Parts[1] == "" {
+		return t.Parts[0], true
+	}
+	return "", false
+}
+
+func joinNodes(nodes []Node, sep string) string {
+	s := make([]string, len(nodes))
+	for i, n := range nodes {
+		s[i] = n.String()
+	}
+	return strings.Join(s, sep)
+}
+
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// Parse returns the AST of query src, nil when it is blank
+func Parse(src string) (Node, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("query is longer than %d characters", MaxLength)
+	}
+	p := &parser{src: src}
+	p.skipSpace()
+	if p.pos == len(p.src) {
+		return nil, nil
+	}
+	n, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %s", p.describe())
+	}
+	return n, nil
+}
+
+type parser struct {
+	src   string
+	pos   int
+	terms int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	if p.pos >= len(p.src) {
+		return fmt.Errorf(format+" at end of query", args...)
+	}
+	return fmt.Errorf(format+" at position %d", append(args, p.pos+1)...)
+}
+
+// describe returns the token at the current position for errors
+func (p *parser) describe() string {
+	if p.pos >= len(p.src) {
+		return "end of query"
+	}
+	if strings.IndexByte("():\"", p.src[p.pos]) >= 0 {
+		return fmt.Sprintf("'%c'", p.src[p.pos])
+	}
+	end := p.pos
+	for end < len(p.src) && !isSpace(p.src[end]) && strings.IndexByte("()", p.src[end]) < 0 {
+		end++
+	}
+	return fmt.Sprintf("%q", p.src[p.pos:end])
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// keyword consumes the operator kw when it is next
+func (p *parser) keyword(kw string) bool {
+	end := p.pos + len(kw)
+	if end > len(p.src) || p.src[p.pos:end] != kw {
+		return false
+	}
+	if end < len(p.src) && !isSpace(p.src[end]) && p.src[end] != '(' {
+		return false
+	}
+	p.pos = end
+	p.skipSpace()
+	return true
+}
+
+// atTermStart returns true when a term, NOT or a group follows
+func (p *parser) atTermStart() bool {
+	if p.pos >= len(p.src) || p.src[p.pos] == ')' {
+		return false
+	}
+	for _, kw := range []string{"AND", "OR"} {
+		end := p.pos + len(kw)
+		if end <= len(p.src) && p.src[p.pos:end] == kw && (end == len(p.src) || isSpace(p.src[end]) || p.src[end] == '(') {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *parser) parseOr(depth int) (Node, error) {
+	n, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node{n}
+	for p.keyword("OR") {
+		n, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return &Or{Nodes: nodes}, nil
+}
+
+func (p *parser) parseAnd(depth int) (Node, error) {
+	n, err := p.parseNot(depth)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node{n}
+	for {
+		// terms next to each other are combined with AND
+		if !p.keyword("AND") && !p.atTermStart() {
+			break
+		}
+		n, err := p.parseNot(depth)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return &And{Nodes: nodes}, nil
+}
+
+func (p *parser) parseNot(depth int) (Node, error) {
+	if depth > maxDepth {
+		return nil, p.errorf("query is nested too deeply")
+	}
+	if p.keyword("NOT") {
+		n, err := p.parseNot(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Node: n}, nil
+	}
+	return p.parsePrimary(depth)
+}
+
+func (p *parser) parsePrimary(depth int) (Node, error) {
+	if p.pos < len(p.src) && p.src[p.pos] == '(' {
+		p.pos++
+		p.skipSpace()
+		n, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.src) || p.src[p.pos] != ')' {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+		p.skipSpace()
+		return n, nil
+	}
+	return p.parseTerm()
+}
+
+func isFieldChar(c byte, first bool) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		return true
+	case c >= '0' && c <= '9', c == '.', c == '-':
+		return !first
+	}
+	return false
+}
+
+func (p *parser) parseTerm() (Node, error) {
+	start := p.pos
+	for p.pos < len(p.src) && isFieldChar(p.src[p.pos], p.pos == start) {
+		p.pos++
+	}
+	if p.pos == start {
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("expected field:value")
+		}
+		return nil, p.errorf("expected field:value, found %s", p.describe())
+	}
+	field := p.src[start:p.pos]
+	if p.pos >= len(p.src) || p.src[p.pos] != ':' {
+		p.pos = start
+		if word := strings.ToUpper(field); word != field && (word == "AND" || word == "OR" || word == "NOT") {
+			return nil, p.errorf("expected field:value, found %s, operators are written in capitals", p.describe())
+		}
+		return nil, p.errorf("expected field:value, found %s", p.describe())
+	}
+	p.pos++
+
+	p.terms++
+	if p.terms > MaxTerms {
+		return nil, fmt.Errorf("query has more than %d terms", MaxTerms)
+	}
+	parts, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	return &Term{Field: field, Parts: parts, Pos: start + 1}, nil
+}
+
+// parseValue returns the parts of the value separated by the unescaped *
+// of bare values
+func (p *parser) parseValue() ([]string, error) {
+	if p.pos >= len(p.src) || isSpace(p.src[p.pos]) || p.src[p.pos] == ')' {
+		return nil, p.errorf("expected value")
+	}
+	if p.src[p.pos] == '"' {
+		start := p.pos
+		p.pos++
+		var b strings.Builder
+		for p.pos < len(p.src) && p.src[p.pos] != '"' {
+			if p.src[p.pos] == '\\' && p.pos+1 < len(p.src) {
+				p.pos++
+			}
+			b.WriteByte(p.src[p.pos])
+			p.pos++
+		}
+		if p.pos >= len(p.src) {
+			p.pos = start
+			return nil, p.errorf("unterminated string")
+		}
+		p.pos++
+		return []string{b.String()}, nil
+	}
+
+	var parts []string
+	var b strings.Builder
+	for p.pos < len(p.src) && !isSpace(p.src[p.pos]) && p.src[p.pos] != ')' {
+		switch c := p.src[p.pos]; c {
+		case '(', '"':
+			return nil, p.errorf("unexpected '%c' in value, quote the value", c)
+		case '\\':
+			if p.pos+1 < len(p.src) {
+				p.pos++
+			}
+			b.WriteByte(p.src[p.pos])
+		case '*':
+			parts = append(parts, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+		p.pos++
+	}
+	return append(parts, b.String()), nil
+}
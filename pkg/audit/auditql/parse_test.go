@@ -0,0 +1,125 @@
+package auditql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`type:user.login`, `type:"user.login"`},
+		{`  type:user.login  `, `type:"user.login"`},
+		{`detail.message:"user logged in"`, `detail.message:"user logged in"`},
+		{`detail.message:"say \"hi\" \\ bye"`, `detail.message:"say \"hi\" \\ bye"`},
+		{`url:\*`, `url:"*"`},
+		{`url:"*"`, `url:"*"`},
+		{`cluster:*`, `cluster:*`},
+		{`cluster:prod*`, `cluster:prod*`},
+		{`url:*/exec*`, `url:*/exec*`},
+		{`url:a\ b*`, `url:a\ b*`},
+		{`user:bob type:user.login`, `(user:"bob" AND type:"user.login")`},
+		{`user:bob AND type:user.login`, `(user:"bob" AND type:"user.login")`},
+		{`user:bob OR user:alice`, `(user:"bob" OR user:"alice")`},
+		{`a:1 OR b:2 AND c:3`, `(a:"1" OR (b:"2" AND c:"3"))`},
+		{`a:1 AND b:2 OR c:3`, `((a:"1" AND b:"2") OR c:"3")`},
+		{`NOT a:1 AND b:2`, `(NOT a:"1" AND b:"2")`},
+		{`NOT (a:1 AND b:2)`, `NOT (a:"1" AND b:"2")`},
+		{`NOT(a:1 OR b:2)`, `NOT (a:"1" OR b:"2")`},
+		{`NOT NOT a:1`, `NOT NOT a:"1"`},
+		{`(a:1 OR b:2) c:3`, `((a:"1" OR b:"2") AND c:"3")`},
+		{`((a:1))`, `a:"1"`},
+		{`type:user.login AND (user:bob OR user:alice)`, `(type:"user.login" AND (user:"bob" OR user:"alice"))`},
+		{`ANDROID:x`, `ANDROID:"x"`},
+		{`a:AND`, `a:"AND"`},
+		{`a:b:c`, `a:"b:c"`},
+		{"a:1\tb:2\nc:3", `(a:"1" AND b:"2" AND c:"3")`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			n, err := Parse(tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := n.String(); got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+			// the canonical form parses to the same query
+			again, err := Parse(n.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again.String() != tc.want {
+				t.Errorf("expected %s after reparsing, got %s", tc.want, again.String())
+			}
+		})
+	}
+}
+
+func TestParseBlank(t *testing.T) {
+	for _, q := range []string{"", "  ", "\t\n"} {
+		n, err := Parse(q)
+		if n != nil || err != nil {
+			t.Errorf("%q: expected no query, got %v %v", q, n, err)
+		}
+	}
+}
+
+func TestParseTerm(t *testing.T) {
+	n, err := Parse(`user:bob  cluster:prod*`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	and := n.(*And)
+	user, cluster := and.Nodes[0].(*Term), and.Nodes[1].(*Term)
+	if user.Pos != 1 || cluster.Pos != 11 {
+		t.Errorf("unexpected positions %d and %d", user.Pos, cluster.Pos)
+	}
+	if p, ok := cluster.Prefix(); !ok || p != "prod" {
+		t.Errorf("expected prefix prod, got %q %t", p, ok)
+	}
+	if _, ok := user.Prefix(); ok || user.Exists() {
+		t.Error("expected exact term")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{`bob`, `expected field:value, found "bob" at position 1`},
+		{`user:bob and type:x`, `expected field:value, found "and", operators are written in capitals at position 10`},
+		{`user:bob or type:x`, `operators are written in capitals`},
+		{`user:`, `expected value at end of query`},
+		{`user: bob`, `expected value at position 6`},
+		{`user:bob AND`, `expected field:value at end of query`},
+		{`OR user:bob`, `expected field:value, found "OR" at position 1`},
+		{`(user:bob`, `expected ) at end of query`},
+		{`user:bob)`, `unexpected ')' at position 9`},
+		{`()`, `expected field:value, found ')' at position 2`},
+		{`user:"bob`, `unterminated string at position 6`},
+		{`user:b(ob`, `unexpected '(' in value, quote the value at position 7`},
+		{`user:b"ob"`, `unexpected '"' in value, quote the value at position 7`},
+		{`1user:bob`, `expected field:value, found "1user:bob" at position 1`},
+		{`:bob`, `expected field:value, found ':' at position 1`},
+		{strings.Repeat("(", 20) + "a:1" + strings.Repeat(")", 20), `query is nested too deeply`},
+		{strings.Repeat("NOT ", 20) + "a:1", `query is nested too deeply`},
+		{strings.Repeat("a:1 ", MaxTerms+1), fmt.Sprintf("query has more than %d terms", MaxTerms)},
+		{"a:" + strings.Repeat("x", MaxLength), fmt.Sprintf("query is longer than %d characters", MaxLength)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			_, err := Parse(tc.query)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error %q, got %q", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package auditql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+// FieldType is the JSON type of a field
+type FieldType int
+
+// field types
+const (
+	String FieldType = iota
+	Number
+	// StringArray fields match when any of their elements matches
+	StringArray
+)
+
+// Field is a field of the events of a stream
+type Field struct {
+	// Path of the field in the event
+	Path []string
+	Type FieldType
+}
+
+// Schema lists the fields of the events of a stream
+type Schema struct {
+	name    string
+	fields  map[string]FieldType
+	aliases map[string]string
+	// maps is the prefix of the fields with arbitrary keys
+	maps []string
+}
+
+// metaKey is the form of the keys of the map fields
+var metaKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Events is the schema of the system events and of the kubectl commands
+var Events = &Schema{
+	name: "events",
+	fields: map[string]FieldType{
+		"version":                String,
+		"category":               String,
+		"origin":                 String,
+		"portal":                 String,
+		"type":                   String,
+		"project":                String,
+		"organization":           String,
+		"trace_id":               String,
+		"actor.type":             String,
+		"actor.account.username": String,
+		"actor.groups":           StringArray,
+		"client.type":            String,
+		"client.ip":              String,
+		"client.user_agent":      String,
+		"client.host":            String,
+		"detail.message":         String,
+		// set in version 2.0 events
+		"request_id":            String,
+		"actor.partner_id":      String,
+		"actor.organization_id": String,
+		"resource.kind":         String,
+		"resource.id":           String,
+		"resource.name":         String,
+	},
+	aliases: map[string]string{
+		"user":           "actor.account.username",
+		"actor.username": "actor.account.username",
+		"cluster":        "detail.meta.cluster_name",
+		"message":        "detail.message",
+		"ip":             "client.ip",
+	},
+	maps: []string{"detail", "meta"},
+}
+
+// RelayAPI is the schema of the kubectl api calls of the relays
+var RelayAPI = &Schema{
+	name: "relay api calls",
+	fields: map[string]FieldType{
+		"un":  String,
+		"cn":  String,
+		"pr":  String,
+		"o":   String,
+		"ra":  String,
+		"m":   String,
+		"k":   String,
+		"n":   String,
+		"ns":  String,
+		"url": String,
+		"q":   String,
+		"sc":  Number,
+	},
+	aliases: map[string]string{
+		"user":           "un",
+		"actor.username": "un",
+		"cluster":        "cn",
+		"project":        "pr",
+		"organization":   "o",
+		"ip":             "ra",
+		"method":         "m",
+		"kind":           "k",
+		"name":           "n",
+		"namespace":      "ns",
+		"query":          "q",
+		"status":         "sc",
+	},
+}
+
+// Field returns the field of name, which is a path or an alias
+func (s *Schema) Field(name string) (Field, error) {
+	path := name
+	if alias, ok := s.aliases[name]; ok {
+		path = alias
+	}
+	if typ, ok := s.fields[path]; ok {
+		return Field{Path: strings.Split(path, "."), Type: typ}, nil
+	}
+	if prefix := strings.Join(s.maps, "."); prefix != "" && strings.HasPrefix(path, prefix+".") {
+		key := strings.TrimPrefix(path, prefix+".")
+		if metaKey.MatchString(key) {
+			return Field{Path: append(append([]string{}, s.maps...), key), Type: String}, nil
+		}
+	}
+	return Field{}, fmt.Errorf("unknown field %q of %s", name, s.name)
+}
+
+// ForTag returns the schema of the events of audit_logs with tag
+func ForTag(tag string) *Schema {
+	if tag == audit.KUBECTL_API {
+		return RelayAPI
+	}
+	return Events
+}
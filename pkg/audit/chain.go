@@ -0,0 +1,217 @@
+package audit
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCheckpointSignature is returned when the signature of a
+// checkpoint does not match its content
+var ErrInvalidCheckpointSignature = errors.New("invalid checkpoint signature")
+
+// CanonicalJSON returns data re-encoded with sorted keys, no
+// insignificant whitespace and numbers in the plain notation of postgres
+// numeric, so that the encoding stored in a jsonb column hashes the same
+// as the one written
+func CanonicalJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	v, err := canonicalNumbers(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonicalNumbers replaces the numbers of v by their numeric notation
+func canonicalNumbers(v interface{}) (interface{}, error) {
+	var err error
+	switch t := v.(type) {
+	case json.Number:
+		return numericNotation(t)
+	case map[string]interface{}:
+		for k, e := range t {
+			if t[k], err = canonicalNumbers(e); err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		for i, e := range t {
+			if t[i], err = canonicalNumbers(e); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v, nil
+}
+
+// maxNumericExponent bounds the exponent of the numbers expanded by
+// numericNotation, well above the range of float64
+const maxNumericExponent = 1000
+
+// numericNotation returns n the way postgres prints a jsonb number: a
+// plain decimal without exponent, keeping the scale of the input, 1e+21
+// is printed as 1000000000000000000000 and 1.50 as 1.50
+func numericNotation(n json.Number) (json.Number, error) {
+	s := string(n)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(strings.TrimPrefix(s[i+1:], "+"))
+		if err != nil || e > maxNumericExponent || e < -maxNumericExponent {
+			return "", fmt.Errorf("invalid number %s", n)
+		}
+		s, exp = s[:i], e
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+	switch {
+	case point <= 0:
+		intPart, fracPart = "0", strings.Repeat("0", -point)+digits
+	case point >= len(digits):
+		intPart, fracPart = digits+strings.Repeat("0", point-len(digits)), ""
+	default:
+		intPart, fracPart = digits[:point], digits[point:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	var b strings.Builder
+	// numeric has no negative zero
+	if neg && strings.Trim(intPart+fracPart, "0") != "" {
+		b.WriteByte('-')
+	}
+	b.WriteString(intPart)
+	if fracPart != "" {
+		b.WriteByte('.')
+		b.WriteString(fracPart)
+	}
+	return json.Number(b.String()), nil
+}
+
+// ChainHash returns the hex encoded hash of the event at seq of a chain
+// linking it to the hash of the previous event, prevHash is empty for the
+// first event
+func ChainHash(prevHash string, seq int64, tag string, t time.Time, data []byte) (string, error) {
+	cdata, err := CanonicalJSON(data)
+	if err != nil {
+		return "", fmt.Errorf("unable to canonicalize event %d: %w", seq, err)
+	}
+	h := sha256.New()
+	for _, f := range [][]byte{
+		[]byte(prevHash),
+		[]byte(strconv.FormatInt(seq, 10)),
+		[]byte(tag),
+		[]byte(chainTime(t)),
+		cdata,
+	} {
+		h.Write(f)
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// chainTime formats t as stored by postgres, timestamps are kept with
+// microsecond precision
+func chainTime(t time.Time) string {
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// checkpointMessage is the content signed by a checkpoint
+func checkpointMessage(organization string, seq int64, hash string) []byte {
+	return []byte(fmt.Sprintf("paralus-audit-checkpoint\n%s\n%d\n%s\n", organization, seq, hash))
+}
+
+// SignCheckpoint signs the head of the chain of organization at seq, the
+// signature is base64 encoded
+func SignCheckpoint(signer crypto.Signer, organization string, seq int64, hash string) (string, error) {
+	msg := checkpointMessage(organization, seq, hash)
+	var (
+		sig []byte
+		err error
+	)
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(msg)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return "", fmt.Errorf("unsupported checkpoint signing key %T", signer.Public())
+	}
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// VerifyCheckpoint checks signature of the head of the chain of
+// organization at seq
+func VerifyCheckpoint(pub crypto.PublicKey, organization string, seq int64, hash, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidCheckpointSignature
+	}
+	msg := checkpointMessage(organization, seq, hash)
+	digest := sha256.Sum256(msg)
+
+	var ok bool
+	switch k := pub.(type) {
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, msg, sig)
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return fmt.Errorf("unsupported checkpoint signing key %T", pub)
+	}
+	if !ok {
+		return ErrInvalidCheckpointSignature
+	}
+	return nil
+}
+
+// CheckpointKeyID returns the identifier of the checkpoint signing key,
+// the hex encoded hash of its public key
+func CheckpointKeyID(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
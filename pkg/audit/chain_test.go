@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestChainHash(t *testing.T) {
+	ts := time.Date(2022, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	h, err := ChainHash("prev", 2, SYSTEM, ts, []byte(`{"b":1,"a":{"y":"é","x":[1.50,true]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		prev string
+		seq  int64
+		t    time.Time
+		data string
+		same bool
+	}{
+		{"jsonb encoding", "prev", 2, ts, `{"a": {"x": [1.50, true], "y": "é"}, "b": 1}`, true},
+		{"stored precision", "prev", 2, ts.Truncate(time.Microsecond).In(time.FixedZone("x", 3600)), `{"a":{"x":[1.50,true],"y":"é"},"b":1}`, true},
+		{"modified data", "prev", 2, ts, `{"a":{"x":[1.50,false],"y":"é"},"b":1}`, false},
+		{"other link", "other", 2, ts, `{"a":{"x":[1.50,true],"y":"é"},"b":1}`, false},
+		{"other seq", "prev", 3, ts, `{"a":{"x":[1.50,true],"y":"é"},"b":1}`, false},
+		{"other time", "prev", 2, ts.Add(time.Second), `{"a":{"x":[1.50,true],"y":"é"},"b":1}`, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ChainHash(tc.prev, tc.seq, SYSTEM, tc.t, []byte(tc.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (got == h) != tc.same {
+				t.Errorf("expected same hash %t, got %s and %s", tc.same, got, h)
+			}
+		})
+	}
+
+	if _, err := ChainHash("", 1, SYSTEM, ts, []byte(`{"a":`)); err == nil {
+		t.Error("expected invalid json to fail")
+	}
+}
+
+func TestCanonicalJSONJsonbRoundTrip(t *testing.T) {
+	// data as written by the database sink and as read back from the
+	// jsonb column it is stored in
+	written, err := json.Marshal(map[string]interface{}{
+		"large":    1e21,
+		"small":    1e-7,
+		"float":    1.5,
+		"negative": -2.25,
+		"zero":     math.Copysign(0, -1),
+		"int":      42,
+		"text":     "é <b> & \u2028",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := `{"int": 42, "text": "é <b> & \u2028", "zero": 0, "float": 1.5, "large": 1000000000000000000000, "small": 0.0000001, "negative": -2.25}`
+
+	w, err := CanonicalJSON(written)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := CanonicalJSON([]byte(stored))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w, s) {
+		t.Errorf("canonical encodings differ:\n%s\n%s", w, s)
+	}
+}
+
+func TestNumericNotation(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{"0", "0"},
+		{"-0", "0"},
+		{"-0.00", "0.00"},
+		{"1.50", "1.50"},
+		{"1e+21", "1000000000000000000000"},
+		{"1.5E2", "150"},
+		{"1.25e1", "12.5"},
+		{"1e-07", "0.0000001"},
+		{"-1.5e-3", "-0.0015"},
+		{"123.456e-1", "12.3456"},
+		{"0.001", "0.001"},
+	}
+	for _, tc := range tests {
+		got, err := numericNotation(json.Number(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.out {
+			t.Errorf("expected %s for %s, got %s", tc.out, tc.in, got)
+		}
+	}
+	if _, err := numericNotation("1e100000"); err == nil {
+		t.Error("expected huge exponent to fail")
+	}
+}
+
+func TestCheckpointSignature(t *testing.T) {
+	ec, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, signer := range []crypto.Signer{ec, ed} {
+		sig, err := SignCheckpoint(signer, "org", 42, "abc")
+		if err != nil {
+			t.Fatalf("unable to sign with %T: %s", signer, err)
+		}
+		if err := VerifyCheckpoint(signer.Public(), "org", 42, "abc", sig); err != nil {
+			t.Errorf("expected %T signature to verify: %s", signer, err)
+		}
+		if err := VerifyCheckpoint(signer.Public(), "org", 42, "abd", sig); err != ErrInvalidCheckpointSignature {
+			t.Errorf("expected %T signature of other hash to fail, got %v", signer, err)
+		}
+		if err := VerifyCheckpoint(signer.Public(), "other", 42, "abc", sig); err != ErrInvalidCheckpointSignature {
+			t.Errorf("expected %T signature of other organization to fail, got %v", signer, err)
+		}
+	}
+
+	ecID, _ := CheckpointKeyID(ec.Public())
+	edID, _ := CheckpointKeyID(ed.Public())
+	if ecID == "" || ecID == edID {
+		t.Errorf("expected distinct key ids, got %q and %q", ecID, edID)
+	}
+}
@@ -0,0 +1,265 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// DatabaseSinkOptions tune batching and retries of the database sink, zero
+// values use the defaults
+type DatabaseSinkOptions struct {
+	// Tag of the audit_logs rows, defaults to SYSTEM
+	Tag string
+	// QueueSize is the number of events buffered before writers are
+	// blocked, defaults to 10000
+	QueueSize int
+	// BatchSize is the maximum number of events inserted at once,
+	// defaults to 100
+	BatchSize int
+	// FlushInterval is the longest an event waits for its batch to fill,
+	// defaults to 1s
+	FlushInterval time.Duration
+	// MaxRetries of a failed batch insert before its events are dropped,
+	// defaults to 5
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry and doubles with
+	// every retry, defaults to 500ms
+	RetryBackoff time.Duration
+	// EnqueueTimeout is the longest a writer is blocked on a full queue
+	// before its event is dropped, defaults to 1s
+	EnqueueTimeout time.Duration
+}
+
+func (o *DatabaseSinkOptions) setDefaults() {
+	if o.Tag == "" {
+		o.Tag = SYSTEM
+	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = 10000
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 100
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = time.Second
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	} else if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = 500 * time.Millisecond
+	}
+	if o.EnqueueTimeout <= 0 {
+		o.EnqueueTimeout = time.Second
+	}
+}
+
+type databaseSink struct {
+	db      *bun.DB
+	opts    DatabaseSinkOptions
+	queue   chan models.AuditLog
+	done    chan struct{}
+	mu      sync.RWMutex
+	closed  bool
+	dropped uint64
+}
+
+var _ Sink = (*databaseSink)(nil)
+
+// NewDatabaseSink returns sink inserting events into the audit_logs table
+// in batches from a bounded queue. Writers are blocked while the queue is
+// full, failed inserts are retried with backoff. Events are hash chained
+// per organization as they are inserted.
+func NewDatabaseSink(db *bun.DB, opts DatabaseSinkOptions) Sink {
+	opts.setDefaults()
+	s := &databaseSink{
+		db:    db,
+		opts:  opts,
+		queue: make(chan models.AuditLog, opts.QueueSize),
+		done:  make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *databaseSink) Write(p []byte) error {
+	data := bytes.TrimSpace(p)
+	ts, org := eventFields(data)
+	entry := models.AuditLog{
+		Tag:          s.opts.Tag,
+		Time:         ts,
+		Data:         append(json.RawMessage(nil), data...),
+		Organization: org,
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return fmt.Errorf("audit sink is closed")
+	}
+	select {
+	case s.queue <- entry:
+		return nil
+	default:
+	}
+
+	// queue is full, slow down the writer until the batches catch up
+	t := time.NewTimer(s.opts.EnqueueTimeout)
+	defer t.Stop()
+	select {
+	case s.queue <- entry:
+		return nil
+	case <-t.C:
+		atomic.AddUint64(&s.dropped, 1)
+		return ErrSinkQueueFull
+	}
+}
+
+func (s *databaseSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+
+	if n := atomic.LoadUint64(&s.dropped); n > 0 {
+		return fmt.Errorf("%d audit events were dropped", n)
+	}
+	return nil
+}
+
+func (s *databaseSink) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]models.AuditLog, 0, s.opts.BatchSize)
+	for {
+		select {
+		case entry, ok := <-s.queue:
+			if !ok {
+				s.insert(batch)
+				return
+			}
+			batch = append(batch, entry)
+			if len(batch) >= s.opts.BatchSize {
+				s.insert(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.insert(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// insert writes batch retrying failures, the events are dropped once the
+// retries are exhausted
+func (s *databaseSink) insert(batch []models.AuditLog) {
+	if len(batch) == 0 {
+		return
+	}
+	backoff := s.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return insertChained(ctx, tx, batch)
+		})
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt >= s.opts.MaxRetries {
+			atomic.AddUint64(&s.dropped, uint64(len(batch)))
+			_log.Errorw("dropping audit events", "count", len(batch), "error", err)
+			return
+		}
+		_log.Warnw("unable to insert audit events, retrying", "count", len(batch), "attempt", attempt+1, "error", err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// insertChained links batch to the hash chains of their organizations
+// and inserts it, the chain heads are locked until tx completes so that
+// concurrent writers extend the chains in turn
+func insertChained(ctx context.Context, tx bun.Tx, batch []models.AuditLog) error {
+	var orgs []string
+	heads := map[string]*models.AuditChainHead{}
+	for _, e := range batch {
+		if _, ok := heads[e.Organization]; !ok {
+			heads[e.Organization] = nil
+			orgs = append(orgs, e.Organization)
+		}
+	}
+	// lock in a stable order to avoid deadlocks between writers
+	sort.Strings(orgs)
+	for _, org := range orgs {
+		head := &models.AuditChainHead{Organization: org}
+		// events without organization are chained under the empty one,
+		// which bun would otherwise insert as the column default
+		_, err := tx.NewInsert().Model(head).Value("organization", "?", org).
+			On("CONFLICT (organization) DO NOTHING").Returning("NULL").Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = tx.NewSelect().Model(head).Where("organization = ?", org).For("UPDATE").Scan(ctx)
+		if err != nil {
+			return err
+		}
+		heads[org] = head
+	}
+
+	for i := range batch {
+		e := &batch[i]
+		head := heads[e.Organization]
+		hash, err := ChainHash(head.Hash, head.Seq+1, e.Tag, e.Time, e.Data)
+		if err != nil {
+			return err
+		}
+		e.Seq, e.PrevHash, e.Hash = head.Seq+1, head.Hash, hash
+		head.Seq, head.Hash = e.Seq, e.Hash
+	}
+
+	if _, err := tx.NewInsert().Model(&batch).Returning("NULL").Exec(ctx); err != nil {
+		return err
+	}
+	for _, org := range orgs {
+		_, err := tx.NewUpdate().Model(heads[org]).
+			Set("seq = ?seq").Set("hash = ?hash").Set("modified_at = current_timestamp").
+			Where("organization = ?", org).Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventFields returns the timestamp and organization of the encoded
+// event, the current time is used when the timestamp is missing
+func eventFields(data []byte) (time.Time, string) {
+	var ev struct {
+		Timestamp    time.Time `json:"timestamp"`
+		Organization string    `json:"organization"`
+	}
+	json.Unmarshal(data, &ev)
+	if ev.Timestamp.IsZero() {
+		ev.Timestamp = time.Now()
+	}
+	// stored with microsecond precision, the chain hashes the stored time
+	return ev.Timestamp.UTC().Truncate(time.Microsecond), ev.Organization
+}
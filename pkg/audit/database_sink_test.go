@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.uber.org/zap"
+)
+
+func getDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	sqldb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal("unable to create sqlmock:", err)
+	}
+	return bun.NewDB(sqldb, pgdialect.New()), mock
+}
+
+// expectChainHead expects the head of the chain of org to be locked in a
+// new transaction
+func expectChainHead(mock sqlmock.Sqlmock, org string, seq int64, hash string) {
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "audit_chain_heads" AS "ach" \("organization", "seq", "hash", "modified_at", "purged_seq"\) VALUES \('` + org + `', 0, '', DEFAULT, 0\) ON CONFLICT \(organization\) DO NOTHING$`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT "ach"."organization", "ach"."seq", "ach"."hash", "ach"."modified_at", "ach"."purged_seq" FROM "audit_chain_heads" AS "ach" WHERE \(organization = '` + org + `'\) FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"organization", "seq", "hash"}).AddRow(org, seq, hash))
+}
+
+// expectChainUpdate expects the head of the chain of org to be moved to
+// seq and the transaction committed
+func expectChainUpdate(mock sqlmock.Sqlmock, org string, seq int64) {
+	mock.ExpectExec(`UPDATE "audit_chain_heads" AS "ach" SET seq = ` + strconv.FormatInt(seq, 10) + `, hash = '[0-9a-f]{64}', modified_at = current_timestamp WHERE \(organization = '` + org + `'\)$`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+func TestDatabaseSinkBatches(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	first := `{"timestamp":"2022-01-02T03:04:05Z","n":1}`
+	firstHash, err := ChainHash("", 1, SYSTEM, time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC), []byte(first))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectChainHead(mock, "", 0, "")
+	mock.ExpectExec(`INSERT INTO "audit_logs" \("tag", "time", "data", "id", "organization", "seq", "prev_hash", "hash"\) VALUES ` +
+		`\('system', '2022-01-02 03:04:05\+00:00', '` + first + `', DEFAULT, '', 1, DEFAULT, '` + firstHash + `'\), ` +
+		`\('system', [^)]*'{"n":2}', DEFAULT, '', 2, '` + firstHash + `', '[0-9a-f]{64}'\), ` +
+		`\('system', [^)]*'{"n":3}', DEFAULT, '', 3, '[0-9a-f]{64}', '[0-9a-f]{64}'\)$`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	expectChainUpdate(mock, "", 3)
+
+	// chains are kept per organization
+	expectChainHead(mock, "o1", 7, "abc")
+	mock.ExpectExec(`INSERT INTO "audit_logs" \("tag", "time", "data", "id", "organization", "seq", "prev_hash", "hash"\) VALUES ` +
+		`\('system', [^)]*'{"organization":"o1","n":4}', DEFAULT, 'o1', 8, 'abc', '[0-9a-f]{64}'\)$`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChainUpdate(mock, "o1", 8)
+
+	sink := NewDatabaseSink(db, DatabaseSinkOptions{BatchSize: 3, FlushInterval: time.Hour})
+	for _, ev := range []string{
+		first + "\n",
+		`{"n":2}` + "\n",
+		`{"n":3}` + "\n",
+		`{"organization":"o1","n":4}` + "\n",
+	} {
+		if err := sink.Write([]byte(ev)); err != nil {
+			t.Fatal("unable to write event:", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal("unable to close sink:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseSinkRetries(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	expectChainHead(mock, "", 0, "")
+	mock.ExpectExec(`INSERT INTO "audit_logs"`).WillReturnError(errors.New("connection refused"))
+	mock.ExpectRollback()
+	expectChainHead(mock, "", 0, "")
+	mock.ExpectExec(`INSERT INTO "audit_logs" .*, '', 1, DEFAULT, '[0-9a-f]{64}'\)$`).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChainUpdate(mock, "", 1)
+
+	sink := NewDatabaseSink(db, DatabaseSinkOptions{RetryBackoff: time.Millisecond})
+	if err := sink.Write([]byte(`{"n":1}`)); err != nil {
+		t.Fatal("unable to write event:", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal("unable to close sink:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseSinkDropsAfterRetries(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+	mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+
+	sink := NewDatabaseSink(db, DatabaseSinkOptions{MaxRetries: 1, RetryBackoff: time.Millisecond})
+	if err := sink.Write([]byte(`{"n":1}`)); err != nil {
+		t.Fatal("unable to write event:", err)
+	}
+	if err := sink.Close(); err == nil {
+		t.Error("expected dropped events to be reported on close")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDatabaseSinkBackpressure(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	// hold the first batch so the queue fills up behind it
+	expectChainHead(mock, "", 0, "")
+	mock.ExpectExec(`INSERT INTO "audit_logs"`).WillDelayFor(time.Second).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChainUpdate(mock, "", 1)
+	expectChainHead(mock, "", 1, "")
+	mock.ExpectExec(`INSERT INTO "audit_logs"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChainUpdate(mock, "", 2)
+
+	sink := NewDatabaseSink(db, DatabaseSinkOptions{
+		QueueSize:      1,
+		BatchSize:      1,
+		EnqueueTimeout: 10 * time.Millisecond,
+	})
+
+	var full bool
+	for i := 0; i < 5; i++ {
+		if err := sink.Write([]byte(`{"n":1}`)); errors.Is(err, ErrSinkQueueFull) {
+			full = true
+			break
+		}
+	}
+	if !full {
+		t.Error("expected writes to be rejected once the queue is full")
+	}
+	sink.Close()
+
+	if err := sink.Write([]byte(`{"n":1}`)); err == nil {
+		t.Error("expected write after close to fail")
+	}
+}
+
+// TestDatabaseSinkDelivery inserts into a real database, set
+// AUDIT_TEST_DATABASE_URL to a postgres DSN to run it
+func TestDatabaseSinkDelivery(t *testing.T) {
+	dsn := os.Getenv("AUDIT_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("AUDIT_TEST_DATABASE_URL not set")
+	}
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+	defer db.Close()
+
+	ctx := context.Background()
+	for _, m := range []interface{}{(*models.AuditLog)(nil), (*models.AuditChainHead)(nil)} {
+		if _, err := db.NewCreateTable().Model(m).IfNotExists().Exec(ctx); err != nil {
+			t.Fatal("unable to create table:", err)
+		}
+	}
+	tag := "test-" + time.Now().Format("20060102150405.000000000")
+	defer db.NewDelete().Model((*models.AuditLog)(nil)).Where("tag = ?", tag).Exec(ctx)
+
+	sink := NewDatabaseSink(db, DatabaseSinkOptions{Tag: tag, BatchSize: 7})
+	logger := NewAuditLogger(sink)
+	for i := 0; i < 25; i++ {
+		logger.Info("audit", zap.Int("n", i))
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal("unable to close sink:", err)
+	}
+
+	n, err := db.NewSelect().Model((*models.AuditLog)(nil)).Where("tag = ?", tag).Count(ctx)
+	if err != nil {
+		t.Fatal("unable to count audit_logs:", err)
+	}
+	if n != 25 {
+		t.Errorf("expected 25 delivered events, got %d", n)
+	}
+}
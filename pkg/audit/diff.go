@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"encoding/json"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// maxDiffChanges bounds the changes recorded in an event
+	maxDiffChanges = 200
+	// maxDiffValue bounds the JSON values of a change
+	maxDiffValue = 1024
+	// redacted replaces the values of the sensitive fields
+	redacted = `"[REDACTED]"`
+	// redactedString is redacted as a JSON value
+	redactedString = "[REDACTED]"
+)
+
+// ignoredPaths are the fields left out of the diff, they change with every
+// update
+var ignoredPaths = map[string]bool{
+	"status":              true,
+	"metadata.createdAt":  true,
+	"metadata.modifiedAt": true,
+}
+
+// Diff returns the changed fields between resources before and after,
+// either of which may be nil. The values of the fields annotated with
+// debug_redact are redacted.
+func Diff(before, after proto.Message) ([]EventChange, error) {
+	b, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+	var md protoreflect.MessageDescriptor
+	for _, m := range []proto.Message{before, after} {
+		if m != nil {
+			md = m.ProtoReflect().Descriptor()
+		}
+	}
+	var changes []EventChange
+	diffValues("", jsonType{md: md}, b, a, false, &changes)
+	if len(changes) > maxDiffChanges {
+		changes = append(changes[:maxDiffChanges], EventChange{Path: "...", After: `"more changes omitted"`})
+	}
+	return changes, nil
+}
+
+func toJSONValue(m proto.Message) (interface{}, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	err = json.Unmarshal(b, &v)
+	return v, err
+}
+
+// jsonType is the message of a JSON object, isMap is set for the objects
+// of map fields whose values are of message md
+type jsonType struct {
+	md    protoreflect.MessageDescriptor
+	isMap bool
+}
+
+// field returns the type of the value of key k of an object of type t and
+// whether it is sensitive
+func (t jsonType) field(k string) (jsonType, bool) {
+	if t.isMap || t.md == nil {
+		return jsonType{md: t.md}, false
+	}
+	fd := t.md.Fields().ByJSONName(k)
+	if fd == nil {
+		return jsonType{}, false
+	}
+	opts, _ := fd.Options().(*descriptorpb.FieldOptions)
+	sensitive := opts.GetDebugRedact()
+	if fd.IsMap() {
+		return jsonType{md: fd.MapValue().Message(), isMap: true}, sensitive
+	}
+	return jsonType{md: fd.Message()}, sensitive
+}
+
+// diffValues appends the changes of the leaves of objects b and a of type
+// t, arrays are compared as a whole
+func diffValues(path string, t jsonType, b, a interface{}, sensitive bool, changes *[]EventChange) {
+	if ignoredPaths[path] {
+		return
+	}
+	bm, bok := b.(map[string]interface{})
+	am, aok := a.(map[string]interface{})
+	if (bok || b == nil) && (aok || a == nil) && (bok || aok) {
+		keys := map[string]bool{}
+		for k := range bm {
+			keys[k] = true
+		}
+		for k := range am {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			ft, s := t.field(k)
+			diffValues(p, ft, bm[k], am[k], sensitive || s, changes)
+		}
+		return
+	}
+
+	if encodeValue(b) == encodeValue(a) {
+		return
+	}
+	before, after := encodeValue(redactJSON(t, b)), encodeValue(redactJSON(t, a))
+	if sensitive {
+		before, after = redactValue(before), redactValue(after)
+	}
+	*changes = append(*changes, EventChange{Path: path, Before: before, After: after})
+}
+
+// redactJSON returns a copy of v of type t with the values of its
+// sensitive fields redacted, arrays of messages are recorded as a whole
+func redactJSON(t jsonType, v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		r := make([]interface{}, len(v))
+		for i, e := range v {
+			r[i] = redactJSON(t, e)
+		}
+		return r
+	case map[string]interface{}:
+		r := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			ft, sensitive := t.field(k)
+			if sensitive {
+				r[k] = redactedString
+				continue
+			}
+			r[k] = redactJSON(ft, e)
+		}
+		return r
+	}
+	return v
+}
+
+// encodeValue returns the JSON of v, empty for absent fields
+func encodeValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	if len(b) > maxDiffValue {
+		return string(b[:maxDiffValue]) + "..."
+	}
+	return string(b)
+}
+
+func redactValue(v string) string {
+	if v == "" {
+		return ""
+	}
+	return redacted
+}
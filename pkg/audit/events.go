@@ -7,7 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/gateway"
 	logv2 "github.com/paralus/paralus/pkg/log"
+	"github.com/paralus/paralus/pkg/tracing"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
@@ -32,6 +35,7 @@ type (
 const (
 	RawLogsEventsTopic EventTopic    = "dp-raw-logs-events"
 	VersionV1          EventVersion  = "1.0"
+	VersionV2          EventVersion  = "2.0"
 	OriginCore         EventOrigin   = "core"
 	OriginCluster      EventOrigin   = "cluster"
 	AuditCategory      EventCategory = "AUDIT"
@@ -45,11 +49,12 @@ type EventActorAccount struct {
 // EventActor Event's initiator
 type EventActor struct {
 	Type string `json:"type"`
-	// Add org and partner id once we have multi-org support
-	// PartnerID      string            `json:"partner_id"`
-	// OrganizationID string            `json:"organization_id"`
-	Account EventActorAccount `json:"account"`
-	Groups  []string          `json:"groups"`
+	// PartnerID and OrganizationID are the tenant of the actor, set in
+	// v2 events
+	PartnerID      string            `json:"partner_id,omitempty"`
+	OrganizationID string            `json:"organization_id,omitempty"`
+	Account        EventActorAccount `json:"account"`
+	Groups         []string          `json:"groups"`
 }
 
 // EventClient Event's client
@@ -66,8 +71,26 @@ type EventDetail struct {
 	Meta    map[string]string `json:"meta"`
 }
 
+// EventResource is the resource changed by a v2 event
+type EventResource struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// EventChange is a changed field of the resource of a v2 event, the values
+// are JSON encoded and empty when the field is absent
+type EventChange struct {
+	Path   string `json:"path"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
 // Event is struct to hold event data
 type Event struct {
+	// ID uniquely identifies the event, it breaks ties of equal
+	// timestamps when paging through the events
+	ID        string        `json:"id"`
 	Version   EventVersion  `json:"version"`
 	Category  EventCategory `json:"category"`
 	Origin    EventOrigin   `json:"origin"`
@@ -78,6 +101,14 @@ type Event struct {
 	Client    *EventClient  `json:"client"`
 	Detail    *EventDetail  `json:"detail"`
 	Timestamp string        `json:"timestamp"`
+	TraceID   string        `json:"trace_id,omitempty"`
+	// RequestID is the id of the api request set by the gateway
+	RequestID string         `json:"request_id,omitempty"`
+	Resource  *EventResource `json:"resource,omitempty"`
+	Diff      []EventChange  `json:"diff,omitempty"`
+
+	// Organization scopes the hash chain of the event
+	Organization string `json:"organization"`
 }
 
 type createEventOptions struct {
@@ -86,6 +117,7 @@ type createEventOptions struct {
 	category  EventCategory
 	topic     EventTopic
 	project   string
+	org       string
 	ctx       context.Context
 	accountID string
 	username  string
@@ -127,6 +159,13 @@ func WithProject(project string) CreateEventOption {
 	}
 }
 
+// WithOrganization sets organization id for audit event
+func WithOrganization(org string) CreateEventOption {
+	return func(opts *createEventOptions) {
+		opts.org = org
+	}
+}
+
 // WithContext sets context for audit event
 func WithContext(ctx context.Context) CreateEventOption {
 	return func(opts *createEventOptions) {
@@ -165,6 +204,9 @@ func CreateEvent(al *zap.Logger, event *Event, opts ...CreateEventOption) error
 	for _, opt := range opts {
 		opt(&cOpts)
 	}
+	if cOpts.version != VersionV2 && isGenerated(cOpts.ctx) {
+		return nil
+	}
 
 	t := time.Now()
 	dateArray := strings.Fields(t.String())
@@ -178,6 +220,11 @@ func CreateEvent(al *zap.Logger, event *Event, opts ...CreateEventOption) error
 	event.Origin = cOpts.origin
 
 	event.Project = cOpts.project
+	if cOpts.org != "" {
+		event.Organization = cOpts.org
+	}
+	event.TraceID = tracing.TraceID(cOpts.ctx)
+	event.RequestID = RequestID(cOpts.ctx)
 
 	if event.Client == nil {
 		event.Client = getEventClientFromContext(cOpts.ctx)
@@ -281,12 +328,20 @@ func GetEvent(r *http.Request, sd *commonv3.SessionData, detail *EventDetail, ev
 		Type:    eventType,
 		Portal:  "OPS",
 		Project: project,
+		TraceID: tracing.TraceID(r.Context()),
+
+		Organization: sd.GetOrganization(),
 	}
 
 	return event
 }
 
-func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail, eventType string, project string) error {
+// CreateV1Event creates a v1 event of the actor of sd, it is dropped
+// when ctx is a request for which a v2 event is generated
+func CreateV1Event(ctx context.Context, al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail, eventType string, project string) error {
+	if isGenerated(ctx) {
+		return nil
+	}
 	actor := GetActorFromSessionData(sd)
 	client := GetClientFromSessionData(sd)
 
@@ -300,6 +355,9 @@ func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail
 		Type:     eventType,
 		Portal:   "OPS",
 		Project:  project,
+
+		RequestID:    RequestID(ctx),
+		Organization: sd.GetOrganization(),
 	}
 
 	go WriteEvent(event, al)
@@ -307,8 +365,11 @@ func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail
 }
 
 func WriteEvent(event *Event, al *zap.Logger) {
-	al.Info(
-		"audit",
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
+	fields := []zap.Field{
+		zap.String("id", event.ID),
 		zap.String("version", string(event.Version)),
 		zap.String("category", string(event.Category)),
 		zap.String("origin", string(event.Origin)),
@@ -318,5 +379,29 @@ func WriteEvent(event *Event, al *zap.Logger) {
 		zap.String("type", event.Type),
 		zap.String("portal", event.Portal),
 		zap.String("project", event.Project),
-	)
+		zap.String("organization", event.Organization),
+		zap.String("trace_id", event.TraceID),
+	}
+	if event.RequestID != "" {
+		fields = append(fields, zap.String("request_id", event.RequestID))
+	}
+	if event.Resource != nil {
+		fields = append(fields, zap.Reflect("resource", event.Resource))
+	}
+	if len(event.Diff) > 0 {
+		fields = append(fields, zap.Reflect("diff", event.Diff))
+	}
+	al.Info("audit", fields...)
+}
+
+// RequestID returns the id of the api request of ctx set by the gateway
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(gateway.RequestID); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
 }
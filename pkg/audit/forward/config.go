@@ -0,0 +1,241 @@
+package forward
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// types of forwarders
+const (
+	TypeSyslog  = "syslog"
+	TypeWebhook = "webhook"
+	TypeFile    = "file"
+)
+
+// Config is the YAML configuration of the audit forwarders. Relay audits
+// are read from the audit_logs table, they are forwarded only with the
+// database audit storage, e.g.
+//
+//	forwarders:
+//	- name: siem
+//	  type: syslog
+//	  filter:
+//	    sources: [system]
+//	    types: ["user.*", "cluster.*"]
+//	  syslog:
+//	    address: siem.example.com:6514
+//	    tls: true
+//	- name: hooks
+//	  type: webhook
+//	  webhook:
+//	    url: https://hooks.example.com/paralus
+//	    secretFile: /etc/paralus/webhook-secret
+type Config struct {
+	Forwarders []ForwarderConfig `json:"forwarders"`
+}
+
+// ForwarderConfig configures one forwarder
+type ForwarderConfig struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Filter Filter `json:"filter,omitempty"`
+
+	QueueSize    int      `json:"queueSize,omitempty"`
+	MaxRetries   int      `json:"maxRetries,omitempty"`
+	RetryBackoff Duration `json:"retryBackoff,omitempty"`
+	Timeout      Duration `json:"timeout,omitempty"`
+
+	Syslog  *SyslogConfig  `json:"syslog,omitempty"`
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+	File    *FileConfig    `json:"file,omitempty"`
+}
+
+// SyslogConfig configures a syslog forwarder
+type SyslogConfig struct {
+	Address  string `json:"address"`
+	Facility int    `json:"facility,omitempty"`
+	AppName  string `json:"appName,omitempty"`
+	// Format is cef or json
+	Format string `json:"format,omitempty"`
+	TLS    bool   `json:"tls,omitempty"`
+	// CAFile verifies the receiver instead of the system roots
+	CAFile             string `json:"caFile,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// WebhookConfig configures a webhook forwarder
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// SecretFile holds the HMAC secret, preferred over Secret
+	SecretFile string            `json:"secretFile,omitempty"`
+	Secret     string            `json:"secret,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+// FileConfig configures a file forwarder
+type FileConfig struct {
+	Path       string `json:"path"`
+	MaxSizeMB  int    `json:"maxSizeMB,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty"`
+	MaxAgeDays int    `json:"maxAgeDays,omitempty"`
+}
+
+// Duration is a time.Duration configured as a string, e.g. 30s
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// LoadConfig reads the forwarder configuration at path
+func LoadConfig(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid audit forwarder config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Build returns the forwarders of cfg, version is reported in the CEF
+// header of syslog messages
+func Build(cfg *Config, version string) ([]*Forwarder, error) {
+	var (
+		forwarders []*Forwarder
+		dests      []Destination
+	)
+	names := make(map[string]bool)
+	for _, fc := range cfg.Forwarders {
+		if fc.Name == "" {
+			return nil, fmt.Errorf("audit forwarder without name")
+		}
+		if names[fc.Name] {
+			return nil, fmt.Errorf("duplicate audit forwarder %s", fc.Name)
+		}
+		names[fc.Name] = true
+
+		dest, err := fc.destination(version)
+		if err != nil {
+			for _, d := range dests {
+				d.Close()
+			}
+			return nil, fmt.Errorf("audit forwarder %s: %w", fc.Name, err)
+		}
+		dests = append(dests, dest)
+	}
+
+	for i, fc := range cfg.Forwarders {
+		forwarders = append(forwarders, New(fc.Name, dests[i], fc.Filter, Options{
+			QueueSize:    fc.QueueSize,
+			MaxRetries:   fc.MaxRetries,
+			RetryBackoff: time.Duration(fc.RetryBackoff),
+			Timeout:      time.Duration(fc.Timeout),
+		}))
+	}
+	return forwarders, nil
+}
+
+func (fc *ForwarderConfig) destination(version string) (Destination, error) {
+	switch fc.Type {
+	case TypeSyslog:
+		c := fc.Syslog
+		if c == nil || c.Address == "" {
+			return nil, fmt.Errorf("syslog address is required")
+		}
+		switch c.Format {
+		case "", SyslogFormatCEF, SyslogFormatJSON:
+		default:
+			return nil, fmt.Errorf("unknown syslog format %s", c.Format)
+		}
+		opts := SyslogOptions{
+			Address:  c.Address,
+			Facility: c.Facility,
+			AppName:  c.AppName,
+			Format:   c.Format,
+			Version:  version,
+		}
+		if c.TLS {
+			tc, err := c.tlsConfig()
+			if err != nil {
+				return nil, err
+			}
+			opts.TLSConfig = tc
+		}
+		return NewSyslog(opts), nil
+
+	case TypeWebhook:
+		if fc.Webhook == nil {
+			return nil, fmt.Errorf("webhook url is required")
+		}
+		return fc.Webhook.Destination()
+
+	case TypeFile:
+		c := fc.File
+		if c == nil || c.Path == "" {
+			return nil, fmt.Errorf("file path is required")
+		}
+		return NewFile(FileOptions{
+			Path:       c.Path,
+			MaxSizeMB:  c.MaxSizeMB,
+			MaxBackups: c.MaxBackups,
+			MaxAgeDays: c.MaxAgeDays,
+		}), nil
+	}
+	return nil, fmt.Errorf("unknown type %q", fc.Type)
+}
+
+// Destination returns the webhook destination configured by c, the secret
+// file is read once
+func (c *WebhookConfig) Destination() (Destination, error) {
+	secret := c.Secret
+	if c.SecretFile != "" {
+		b, err := ioutil.ReadFile(c.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read webhook secret: %w", err)
+		}
+		secret = strings.TrimSpace(string(b))
+	}
+	return NewWebhook(WebhookOptions{URL: c.URL, Secret: secret, Headers: c.Headers})
+}
+
+func (c *SyslogConfig) tlsConfig() (*tls.Config, error) {
+	tc := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		b, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read syslog ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates in syslog ca %s", c.CAFile)
+		}
+		tc.RootCAs = pool
+	}
+	return tc, nil
+}
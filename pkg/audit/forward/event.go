@@ -0,0 +1,179 @@
+package forward
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+// Event is an audit event as forwarded to destinations
+type Event struct {
+	// Source is the tag of the event, system for the events of
+	// audit.CreateEvent and kubectl_api or kubectl_cmd for relay audits.
+	// kubectl_cmd events are shaped like system events.
+	Source string
+	// Data is the JSON document of the event
+	Data json.RawMessage
+
+	Time         time.Time
+	Type         string
+	Project      string
+	Organization string
+	Username     string
+	ClientIP     string
+	Cluster      string
+	Message      string
+	// Meta is the detail of system events
+	Meta map[string]string
+
+	// request of relay api audits
+	Method     string
+	Kind       string
+	Namespace  string
+	Name       string
+	URL        string
+	StatusCode int
+}
+
+// eventFields holds the fields of the system and the relay audit events
+// used for filtering and formatting
+type eventFields struct {
+	Timestamp    string `json:"timestamp"`
+	Type         string `json:"type"`
+	Project      string `json:"project"`
+	Organization string `json:"organization"`
+	Actor        *struct {
+		Account struct {
+			Username string `json:"username"`
+		} `json:"account"`
+	} `json:"actor"`
+	Client *struct {
+		IP string `json:"ip"`
+	} `json:"client"`
+	Detail *struct {
+		Message string            `json:"message"`
+		Meta    map[string]string `json:"meta"`
+	} `json:"detail"`
+
+	// relay api audits
+	Ts     string `json:"ts"`
+	Un     string `json:"un"`
+	Cn     string `json:"cn"`
+	Pr     string `json:"pr"`
+	O      string `json:"o"`
+	Ra     string `json:"ra"`
+	Method string `json:"m"`
+	Kind   string `json:"k"`
+	Name   string `json:"n"`
+	Ns     string `json:"ns"`
+	URL    string `json:"url"`
+	Query  string `json:"q"`
+	Status int    `json:"sc"`
+}
+
+// NewEvent parses the JSON document of an event of source
+func NewEvent(source string, data []byte) (*Event, error) {
+	data = bytes.TrimSpace(data)
+	var f eventFields
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	ev := &Event{
+		Source:       source,
+		Data:         append(json.RawMessage(nil), data...),
+		Type:         f.Type,
+		Project:      f.Project,
+		Organization: f.Organization,
+	}
+	ts := f.Timestamp
+	if f.Actor != nil {
+		ev.Username = f.Actor.Account.Username
+	}
+	if f.Client != nil {
+		ev.ClientIP = f.Client.IP
+	}
+	if f.Detail != nil {
+		ev.Message = f.Detail.Message
+		ev.Meta = f.Detail.Meta
+		ev.Cluster = f.Detail.Meta["cluster_name"]
+	}
+
+	if source == audit.KUBECTL_API {
+		ts = f.Ts
+		ev.Username, ev.Cluster, ev.Project, ev.ClientIP = f.Un, f.Cn, f.Pr, f.Ra
+		ev.Organization = f.O
+		ev.Method, ev.Kind, ev.Namespace, ev.Name = f.Method, f.Kind, f.Ns, f.Name
+		ev.URL, ev.StatusCode = f.URL, f.Status
+		// relay api audits are typed by their source and verb
+		ev.Type = source
+		if f.Method != "" {
+			ev.Type = source + "." + f.Method
+		}
+		ev.Message = strings.TrimSpace(f.Method + " " + f.URL)
+		if f.Query != "" {
+			ev.Message += "?" + f.Query
+		}
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+		ev.Time = t
+	} else {
+		ev.Time = time.Now()
+	}
+	return ev, nil
+}
+
+// Filter selects the events forwarded to a destination, empty fields
+// match all events
+type Filter struct {
+	// Sources are the tags of the events, system, kubectl_api or
+	// kubectl_cmd. Relay audits are forwarded only when the audit logs
+	// are stored in the database.
+	Sources []string `json:"sources,omitempty"`
+	// Types are shell patterns of event types, e.g. cluster.*
+	Types         []string `json:"types,omitempty"`
+	Projects      []string `json:"projects,omitempty"`
+	Organizations []string `json:"organizations,omitempty"`
+	Clusters      []string `json:"clusters,omitempty"`
+}
+
+// SelectsRelayAudits returns true when the filter explicitly selects the
+// relay audits
+func (f *Filter) SelectsRelayAudits() bool {
+	for _, source := range f.Sources {
+		if source == audit.KUBECTL_API || source == audit.KUBECTL_CMD {
+			return true
+		}
+	}
+	return false
+}
+
+// Match returns true when ev is selected by the filter
+func (f *Filter) Match(ev *Event) bool {
+	return matchAny(f.Sources, ev.Source, false) &&
+		matchAny(f.Types, ev.Type, true) &&
+		matchAny(f.Projects, ev.Project, false) &&
+		matchAny(f.Organizations, ev.Organization, false) &&
+		matchAny(f.Clusters, ev.Cluster, false)
+}
+
+func matchAny(patterns []string, v string, glob bool) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if p == v {
+			return true
+		}
+		if glob {
+			if ok, _ := path.Match(p, v); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package forward
+
+import (
+	"context"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// FileOptions configure a file destination
+type FileOptions struct {
+	Path       string
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+}
+
+type fileDestination struct {
+	l *lumberjack.Logger
+}
+
+// NewFile returns destination writing events as newline delimited JSON
+// to a rotated file
+func NewFile(opts FileOptions) Destination {
+	return &fileDestination{
+		l: &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB, // megabytes
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAgeDays, // days
+		},
+	}
+}
+
+func (d *fileDestination) Deliver(ctx context.Context, ev *Event) error {
+	_, err := d.l.Write(append(append([]byte(nil), ev.Data...), '\n'))
+	return err
+}
+
+func (d *fileDestination) Close() error {
+	return d.l.Close()
+}
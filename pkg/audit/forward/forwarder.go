@@ -0,0 +1,209 @@
+package forward
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+	logv2 "github.com/paralus/paralus/pkg/log"
+)
+
+var (
+	_log = logv2.GetLogger()
+
+	// metrics holds the delivery counters of the forwarders, published
+	// at /debug/vars of the debug server
+	metrics = expvar.NewMap("audit_forwarders")
+)
+
+// delivery counters of a forwarder
+const (
+	metricQueued    = "queued"
+	metricDelivered = "delivered"
+	metricRetried   = "retried"
+	metricFailed    = "failed"
+	metricDropped   = "dropped"
+)
+
+// Destination delivers events to an external system
+type Destination interface {
+	// Deliver sends one event, errors are retried by the forwarder
+	Deliver(ctx context.Context, ev *Event) error
+	// Close releases the connections of the destination
+	Close() error
+}
+
+// Options tune the queue and retries of a forwarder, zero values use the
+// defaults
+type Options struct {
+	// QueueSize is the number of events buffered for the destination,
+	// events are dropped when it is full. Defaults to 1000.
+	QueueSize int
+	// MaxRetries of an event before it is dropped, defaults to 5
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry and doubles with
+	// every retry up to a minute, defaults to 1s
+	RetryBackoff time.Duration
+	// Timeout of a delivery, defaults to 10s
+	Timeout time.Duration
+}
+
+func (o *Options) setDefaults() {
+	if o.QueueSize <= 0 {
+		o.QueueSize = 1000
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	} else if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = time.Second
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+}
+
+// Forwarder queues the events selected by its filter and delivers them
+// to its destination in the background. Forwarding never blocks the
+// writer of the event, events are dropped when the queue is full.
+type Forwarder struct {
+	name   string
+	dest   Destination
+	filter Filter
+	opts   Options
+
+	queue   chan *Event
+	done    chan struct{}
+	stop    chan struct{}
+	mu      sync.RWMutex
+	closed  bool
+	metrics *expvar.Map
+}
+
+var _ audit.Sink = (*Forwarder)(nil)
+
+// New returns forwarder of the events matching filter to dest
+func New(name string, dest Destination, filter Filter, opts Options) *Forwarder {
+	opts.setDefaults()
+	m := new(expvar.Map).Init()
+	metrics.Set(name, m)
+	f := &Forwarder{
+		name:    name,
+		dest:    dest,
+		filter:  filter,
+		opts:    opts,
+		queue:   make(chan *Event, opts.QueueSize),
+		done:    make(chan struct{}),
+		stop:    make(chan struct{}),
+		metrics: m,
+	}
+	go f.run()
+	return f
+}
+
+// Name returns the name of the forwarder
+func (f *Forwarder) Name() string {
+	return f.name
+}
+
+// Write forwards an event of audit.CreateEvent, it implements audit.Sink
+// so that forwarders can be added to the audit logger
+func (f *Forwarder) Write(p []byte) error {
+	return f.Forward(audit.SYSTEM, p)
+}
+
+// Forward queues the JSON document of an event of source if it matches
+// the filter of the forwarder
+func (f *Forwarder) Forward(source string, data []byte) error {
+	ev, err := NewEvent(source, data)
+	if err != nil {
+		return fmt.Errorf("unable to parse audit event: %w", err)
+	}
+	if !f.filter.Match(ev) {
+		return nil
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.closed {
+		return fmt.Errorf("audit forwarder %s is closed", f.name)
+	}
+	select {
+	case f.queue <- ev:
+		f.metrics.Add(metricQueued, 1)
+		return nil
+	default:
+		f.metrics.Add(metricDropped, 1)
+		return audit.ErrSinkQueueFull
+	}
+}
+
+// Close delivers the queued events and closes the destination, retries
+// are abandoned
+func (f *Forwarder) Close() error {
+	f.mu.Lock()
+	if !f.closed {
+		f.closed = true
+		close(f.queue)
+	}
+	f.mu.Unlock()
+
+	// pending retries are not waited for
+	select {
+	case <-f.done:
+	case <-time.After(f.opts.Timeout):
+		close(f.stop)
+		<-f.done
+	}
+	return f.dest.Close()
+}
+
+func (f *Forwarder) run() {
+	defer close(f.done)
+	for ev := range f.queue {
+		f.deliver(ev)
+	}
+}
+
+// deliver sends ev retrying failures with backoff, the event is dropped
+// once retries are exhausted
+func (f *Forwarder) deliver(ev *Event) {
+	backoff := f.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), f.opts.Timeout)
+		err := f.dest.Deliver(ctx, ev)
+		cancel()
+		if err == nil {
+			f.metrics.Add(metricDelivered, 1)
+			return
+		}
+		if attempt >= f.opts.MaxRetries || errors.Is(err, errPermanent) {
+			f.metrics.Add(metricFailed, 1)
+			_log.Errorw("dropping audit event", "forwarder", f.name, "type", ev.Type, "error", err)
+			return
+		}
+		f.metrics.Add(metricRetried, 1)
+		_log.Warnw("unable to forward audit event, retrying", "forwarder", f.name, "attempt", attempt+1, "error", err)
+
+		t := time.NewTimer(backoff)
+		select {
+		case <-t.C:
+		case <-f.stop:
+			t.Stop()
+			f.metrics.Add(metricFailed, 1)
+			return
+		}
+		if backoff *= 2; backoff > time.Minute {
+			backoff = time.Minute
+		}
+	}
+}
+
+// errPermanent marks delivery errors which are not retried
+var errPermanent = errors.New("permanent delivery failure")
@@ -0,0 +1,192 @@
+package forward
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+type recordingDestination struct {
+	mu       sync.Mutex
+	events   []*Event
+	failures int
+	err      error
+}
+
+func (d *recordingDestination) Deliver(ctx context.Context, ev *Event) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.failures > 0 {
+		d.failures--
+		return d.err
+	}
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func (d *recordingDestination) Close() error { return nil }
+
+func counter(f *Forwarder, name string) string {
+	if v := f.metrics.Get(name); v != nil {
+		return v.String()
+	}
+	return "0"
+}
+
+func TestNewEvent(t *testing.T) {
+	ev, err := NewEvent(audit.SYSTEM, []byte(`{"timestamp":"2022-01-02T03:04:05Z","type":"cluster.create.success","project":"p1",`+
+		`"organization":"o1","actor":{"account":{"username":"admin@paralus.local"}},"client":{"ip":"10.0.0.1"},`+
+		`"detail":{"message":"cluster c1 created","meta":{"cluster_name":"c1"}}}`+"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != "cluster.create.success" || ev.Project != "p1" || ev.Organization != "o1" ||
+		ev.Username != "admin@paralus.local" || ev.ClientIP != "10.0.0.1" || ev.Cluster != "c1" ||
+		ev.Message != "cluster c1 created" || !ev.Time.Equal(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected system event %+v", ev)
+	}
+
+	ev, err = NewEvent(audit.KUBECTL_API, []byte(`{"ts":"2022-01-02T03:04:05Z","un":"dev@paralus.local","cn":"c1",`+
+		`"pr":"p1","o":"o1","ra":"10.0.0.2","m":"DELETE","url":"/api/v1/namespaces/default/pods/web","q":"timeout=32s"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != "kubectl_api.DELETE" || ev.Username != "dev@paralus.local" || ev.Cluster != "c1" ||
+		ev.Organization != "o1" || ev.Message != "DELETE /api/v1/namespaces/default/pods/web?timeout=32s" {
+		t.Errorf("unexpected relay event %+v", ev)
+	}
+
+	// relay commands are shaped like system events
+	ev, err = NewEvent(audit.KUBECTL_CMD, []byte(`{"timestamp":"2022-01-02T03:04:05Z","type":"kubectl.command.detail",`+
+		`"project":"p1","actor":{"account":{"username":"dev@paralus.local"}},"detail":{"message":"kubectl get all","meta":{"cluster_name":"c1"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != "kubectl.command.detail" || ev.Username != "dev@paralus.local" || ev.Cluster != "c1" ||
+		ev.Message != "kubectl get all" || ev.Time.IsZero() || ev.Time.Year() != 2022 {
+		t.Errorf("unexpected relay command %+v", ev)
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	ev := &Event{Source: audit.SYSTEM, Type: "cluster.create.success", Project: "p1", Organization: "o1", Cluster: "c1"}
+	tt := []struct {
+		name   string
+		filter Filter
+		match  bool
+	}{
+		{"empty", Filter{}, true},
+		{"source", Filter{Sources: []string{audit.KUBECTL_API}}, false},
+		{"type glob", Filter{Types: []string{"user.*", "cluster.*"}}, true},
+		{"type", Filter{Types: []string{"cluster.delete.success"}}, false},
+		{"project", Filter{Projects: []string{"p1", "p2"}}, true},
+		{"cluster", Filter{Clusters: []string{"c2"}}, false},
+		{"all", Filter{Sources: []string{audit.SYSTEM}, Organizations: []string{"o1"}, Clusters: []string{"c1"}}, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if m := tc.filter.Match(ev); m != tc.match {
+				t.Errorf("expected match %v, got %v", tc.match, m)
+			}
+		})
+	}
+}
+
+func TestFilterSelectsRelayAudits(t *testing.T) {
+	if (&Filter{}).SelectsRelayAudits() {
+		t.Error("expected empty filter not to select relay audits explicitly")
+	}
+	if (&Filter{Sources: []string{audit.SYSTEM}}).SelectsRelayAudits() {
+		t.Error("expected system filter not to select relay audits")
+	}
+	if !(&Filter{Sources: []string{audit.SYSTEM, audit.KUBECTL_CMD}}).SelectsRelayAudits() {
+		t.Error("expected kubectl_cmd filter to select relay audits")
+	}
+}
+
+func TestForwarderRetries(t *testing.T) {
+	dest := &recordingDestination{failures: 2, err: errors.New("connection refused")}
+	f := New("test-retries", dest, Filter{Types: []string{"user.*"}}, Options{RetryBackoff: time.Millisecond})
+
+	for _, ev := range []string{
+		`{"type":"user.login.success"}`,
+		`{"type":"cluster.create.success"}`,
+	} {
+		if err := f.Write([]byte(ev)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dest.events) != 1 || dest.events[0].Type != "user.login.success" {
+		t.Errorf("expected filtered event to be delivered, got %v", dest.events)
+	}
+	for name, expected := range map[string]string{
+		metricQueued: "1", metricRetried: "2", metricDelivered: "1", metricFailed: "0",
+	} {
+		if v := counter(f, name); v != expected {
+			t.Errorf("expected %s %s, got %s", name, expected, v)
+		}
+	}
+	if err := f.Write([]byte(`{"type":"user.login.success"}`)); err == nil {
+		t.Error("expected write after close to fail")
+	}
+}
+
+func TestForwarderFailures(t *testing.T) {
+	dest := &recordingDestination{failures: 10, err: errors.New("connection refused")}
+	f := New("test-failures", dest, Filter{}, Options{MaxRetries: 1, RetryBackoff: time.Millisecond})
+	f.Write([]byte(`{"type":"user.login.success"}`))
+
+	// permanent failures are not retried
+	perm := &recordingDestination{failures: 10, err: errPermanent}
+	p := New("test-permanent", perm, Filter{}, Options{RetryBackoff: time.Hour})
+	p.Write([]byte(`{"type":"user.login.success"}`))
+
+	f.Close()
+	p.Close()
+	if v := counter(f, metricFailed); v != "1" {
+		t.Errorf("expected failed event, got %s", v)
+	}
+	if v := counter(f, metricRetried); v != "1" {
+		t.Errorf("expected one retry, got %s", v)
+	}
+	if v := counter(p, metricRetried); v != "0" || counter(p, metricFailed) != "1" {
+		t.Errorf("expected permanent failure without retries, got %s retries", v)
+	}
+}
+
+func TestForwarderDropsWhenFull(t *testing.T) {
+	block := make(chan struct{})
+	dest := &blockingDestination{block: block}
+	f := New("test-drops", dest, Filter{}, Options{QueueSize: 1})
+
+	var dropped bool
+	for i := 0; i < 5; i++ {
+		if err := f.Write([]byte(`{"type":"user.login.success"}`)); errors.Is(err, audit.ErrSinkQueueFull) {
+			dropped = true
+		}
+	}
+	close(block)
+	f.Close()
+	if !dropped || counter(f, metricDropped) == "0" {
+		t.Error("expected events to be dropped once the queue is full")
+	}
+}
+
+type blockingDestination struct {
+	block chan struct{}
+}
+
+func (d *blockingDestination) Deliver(ctx context.Context, ev *Event) error {
+	<-d.block
+	return nil
+}
+
+func (d *blockingDestination) Close() error { return nil }
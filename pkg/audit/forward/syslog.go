@@ -0,0 +1,217 @@
+package forward
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// syslog formats of the message
+const (
+	SyslogFormatCEF  = "cef"
+	SyslogFormatJSON = "json"
+)
+
+// syslog severities
+const (
+	severityWarning = 4
+	severityNotice  = 5
+	severityInfo    = 6
+)
+
+// SyslogOptions configure a syslog destination
+type SyslogOptions struct {
+	// Address of the syslog receiver, host:port
+	Address string
+	// TLSConfig enables TLS when set
+	TLSConfig *tls.Config
+	// Facility of the messages, defaults to 13 (log audit)
+	Facility int
+	// AppName of the messages, defaults to paralus
+	AppName string
+	// Format of the message, cef (default) or json
+	Format string
+	// Version reported in the CEF header
+	Version string
+}
+
+type syslogDestination struct {
+	opts     SyslogOptions
+	hostname string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslog returns destination sending events as RFC5424 messages over
+// TCP, optionally TLS, framed by octet counting (RFC6587)
+func NewSyslog(opts SyslogOptions) Destination {
+	if opts.Facility == 0 {
+		opts.Facility = 13
+	}
+	if opts.AppName == "" {
+		opts.AppName = "paralus"
+	}
+	if opts.Format == "" {
+		opts.Format = SyslogFormatCEF
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogDestination{opts: opts, hostname: hostname}
+}
+
+func (d *syslogDestination) Deliver(ctx context.Context, ev *Event) error {
+	msg := d.format(ev)
+	frame := strconv.Itoa(len(msg)) + " " + msg
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn == nil {
+		conn, err := d.dial(ctx)
+		if err != nil {
+			return err
+		}
+		d.conn = conn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		d.conn.SetWriteDeadline(deadline)
+	}
+	if _, err := d.conn.Write([]byte(frame)); err != nil {
+		// reconnect on the next delivery
+		d.conn.Close()
+		d.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (d *syslogDestination) dial(ctx context.Context) (net.Conn, error) {
+	if d.opts.TLSConfig != nil {
+		td := &tls.Dialer{Config: d.opts.TLSConfig}
+		return td.DialContext(ctx, "tcp", d.opts.Address)
+	}
+	var nd net.Dialer
+	return nd.DialContext(ctx, "tcp", d.opts.Address)
+}
+
+func (d *syslogDestination) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn == nil {
+		return nil
+	}
+	err := d.conn.Close()
+	d.conn = nil
+	return err
+}
+
+// format returns the RFC5424 message of ev
+func (d *syslogDestination) format(ev *Event) string {
+	severity := eventSeverity(ev)
+	msg := string(ev.Data)
+	if d.opts.Format == SyslogFormatCEF {
+		msg = FormatCEF(ev, d.opts.Version)
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s - %s - %s",
+		d.opts.Facility*8+severity,
+		ev.Time.UTC().Format(time.RFC3339Nano),
+		syslogHeaderField(d.hostname, 255),
+		syslogHeaderField(d.opts.AppName, 48),
+		syslogHeaderField(ev.Source, 32),
+		msg,
+	)
+}
+
+// syslogHeaderField returns v as printable ascii of at most n
+// characters, - when empty
+func syslogHeaderField(v string, n int) string {
+	var b strings.Builder
+	for _, r := range v {
+		if r > 32 && r < 127 {
+			b.WriteRune(r)
+		}
+		if b.Len() == n {
+			break
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
+
+// eventSeverity returns the syslog severity of ev, failures and
+// deletions are raised above informational events
+func eventSeverity(ev *Event) int {
+	t := strings.ToLower(ev.Type)
+	switch {
+	case strings.Contains(t, "fail"), strings.Contains(t, "reject"), strings.Contains(t, "revoke"):
+		return severityWarning
+	case strings.Contains(t, "delete"):
+		return severityNotice
+	}
+	return severityInfo
+}
+
+// FormatCEF returns ev in the ArcSight common event format
+func FormatCEF(ev *Event, version string) string {
+	if version == "" {
+		version = "-"
+	}
+	// CEF severity is 0-10, higher is more severe
+	severity := map[int]int{severityWarning: 7, severityNotice: 5, severityInfo: 3}[eventSeverity(ev)]
+	name := ev.Message
+	if name == "" {
+		name = ev.Type
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "CEF:0|Paralus|Paralus|%s|%s|%s|%d|",
+		cefHeader(version), cefHeader(ev.Type), cefHeader(name), severity)
+	ext := func(k, v string) {
+		if v == "" {
+			return
+		}
+		if !strings.HasSuffix(b.String(), "|") {
+			b.WriteByte(' ')
+		}
+		b.WriteString(k + "=" + cefExtension(v))
+	}
+	ext("rt", strconv.FormatInt(ev.Time.UnixMilli(), 10))
+	ext("suser", ev.Username)
+	ext("src", ev.ClientIP)
+	for i, cs := range [][2]string{
+		{"project", ev.Project},
+		{"organization", ev.Organization},
+		{"cluster", ev.Cluster},
+	} {
+		if cs[1] != "" {
+			ext(fmt.Sprintf("cs%dLabel", i+1), cs[0])
+			ext(fmt.Sprintf("cs%d", i+1), cs[1])
+		}
+	}
+	ext("cat", ev.Source)
+	ext("msg", ev.Message)
+	return b.String()
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+func cefHeader(v string) string {
+	return cefHeaderEscaper.Replace(v)
+}
+
+func cefExtension(v string) string {
+	return cefExtensionEscaper.Replace(v)
+}
@@ -0,0 +1,95 @@
+package forward
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+func TestFormatCEF(t *testing.T) {
+	ev := &Event{
+		Source:       audit.SYSTEM,
+		Time:         time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+		Type:         "user.delete.success",
+		Project:      "p1",
+		Organization: "o1",
+		Username:     "admin@paralus.local",
+		ClientIP:     "10.0.0.1",
+		Message:      "user a|b deleted, role=admin",
+	}
+	expected := `CEF:0|Paralus|Paralus|v0.1.0|user.delete.success|user a\|b deleted, role=admin|5|` +
+		`rt=1641092645000 suser=admin@paralus.local src=10.0.0.1 cs1Label=project cs1=p1 ` +
+		`cs2Label=organization cs2=o1 cat=system msg=user a|b deleted, role\=admin`
+	if cef := FormatCEF(ev, "v0.1.0"); cef != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, cef)
+	}
+}
+
+// readFrame reads one octet counted syslog message
+func readFrame(r *bufio.Reader) (string, error) {
+	l, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(l))
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return string(b), err
+}
+
+func TestSyslogDeliver(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	frames := make(chan string, 2)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for i := 0; i < 2; i++ {
+			f, err := readFrame(r)
+			if err != nil {
+				return
+			}
+			frames <- f
+		}
+	}()
+
+	d := NewSyslog(SyslogOptions{Address: ln.Addr().String(), AppName: "paralus", Format: SyslogFormatJSON})
+	defer d.Close()
+	ev, _ := NewEvent(audit.KUBECTL_CMD, []byte(`{"timestamp":"2022-01-02T03:04:05Z","detail":{"message":"kubectl get pods"}}`))
+	for i := 0; i < 2; i++ {
+		if err := d.Deliver(context.Background(), ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case f := <-frames:
+			prefix := "<110>1 2022-01-02T03:04:05Z "
+			suffix := ` paralus - kubectl_cmd - {"timestamp":"2022-01-02T03:04:05Z","detail":{"message":"kubectl get pods"}}`
+			if !strings.HasPrefix(f, prefix) || !strings.HasSuffix(f, suffix) {
+				t.Errorf("unexpected syslog message %q", f)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for syslog message")
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package forward
+
+import (
+	"context"
+	"time"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/uptrace/bun"
+)
+
+const tailBatchSize = 500
+
+// tailGracePeriod is how long the ids below a read relay audit are
+// re-read. Ids are taken when an insert starts, so a transaction
+// committing late makes a lower id appear after higher ones.
+const tailGracePeriod = time.Minute
+
+// Receiver is handed the relay audits read by TailRelayAudits
+type Receiver interface {
+	Name() string
+	Forward(source string, data []byte) error
+}
+
+var _ Receiver = (*Forwarder)(nil)
+
+// TailRelayAudits hands the relay audits ingested into the audit_logs
+// table after the tail started to receivers, polling every interval until
+// ctx is done. Relay audits stored in Elasticsearch are not tailed.
+func TailRelayAudits(ctx context.Context, db bun.IDB, interval time.Duration, receivers []Receiver) {
+	t := &relayAuditTail{db: db, receivers: receivers, grace: tailGracePeriod}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		t.poll(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayAuditTail reads the relay audits above a watermark. Audits read
+// within the grace period stay above it, so that the ones committed out
+// of order in between are read on a later poll.
+type relayAuditTail struct {
+	db        bun.IDB
+	receivers []Receiver
+	grace     time.Duration
+
+	started bool
+	// ids up to last were handed to the receivers or skipped
+	last int64
+	// ids above last handed to the receivers and when they were read
+	seen map[int64]time.Time
+}
+
+func (t *relayAuditTail) poll(ctx context.Context, now time.Time) {
+	if !t.started {
+		id, err := dao.GetLastAuditLogID(ctx, t.db)
+		if err != nil {
+			_log.WithContext(ctx).Warnw("unable to get last audit log id", "error", err)
+			return
+		}
+		t.last, t.started, t.seen = id, true, make(map[int64]time.Time)
+	}
+
+	tags := []string{audit.KUBECTL_API, audit.KUBECTL_CMD}
+	for after := t.last; ; {
+		logs, err := dao.GetAuditLogsAfter(ctx, t.db, tags, after, tailBatchSize)
+		if err != nil {
+			_log.WithContext(ctx).Warnw("unable to get relay audits", "error", err)
+			return
+		}
+		for _, l := range logs {
+			after = l.ID
+			if _, ok := t.seen[l.ID]; ok {
+				continue
+			}
+			t.seen[l.ID] = now
+			for _, r := range t.receivers {
+				if err := r.Forward(l.Tag, l.Data); err != nil {
+					_log.WithContext(ctx).Debugw("unable to forward relay audit", "receiver", r.Name(), "error", err)
+				}
+			}
+		}
+		if len(logs) < tailBatchSize {
+			break
+		}
+	}
+
+	// ids missing below an audit read before the grace period are no
+	// longer waited for
+	for id, read := range t.seen {
+		if id > t.last && now.Sub(read) >= t.grace {
+			t.last = id
+		}
+	}
+	for id := range t.seen {
+		if id <= t.last {
+			delete(t.seen, id)
+		}
+	}
+}
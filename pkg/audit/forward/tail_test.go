@@ -0,0 +1,78 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+type recordingReceiver struct {
+	data []string
+}
+
+func (r *recordingReceiver) Name() string { return "recording" }
+
+func (r *recordingReceiver) Forward(source string, data []byte) error {
+	r.data = append(r.data, string(data))
+	return nil
+}
+
+func relayAuditRows(ids ...int64) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "tag", "data"})
+	for _, id := range ids {
+		rows.AddRow(id, "kubectl_api", fmt.Sprintf(`{"id":%d}`, id))
+	}
+	return rows
+}
+
+func expectRelayAuditsAfter(mock sqlmock.Sqlmock, after int64, ids ...int64) {
+	mock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(tag IN \('kubectl_api', 'kubectl_cmd'\)\) AND \(id > %d\) ORDER BY "id" LIMIT 500`, after)).
+		WillReturnRows(relayAuditRows(ids...))
+}
+
+func TestRelayAuditTailOutOfOrder(t *testing.T) {
+	sqldb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := bun.NewDB(sqldb, pgdialect.New())
+	defer db.Close()
+
+	r := &recordingReceiver{}
+	tail := &relayAuditTail{db: db, receivers: []Receiver{r}, grace: time.Minute}
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT max\(id\) FROM "audit_logs"`).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(10))
+	// 12 is committed before 11
+	expectRelayAuditsAfter(mock, 10, 12)
+	tail.poll(context.Background(), now)
+
+	// 11 shows up on the next poll, 12 is not forwarded again
+	expectRelayAuditsAfter(mock, 10, 11, 12)
+	tail.poll(context.Background(), now.Add(5*time.Second))
+
+	// past the grace period the watermark moves up to 12, 13 is read
+	// within the grace period
+	expectRelayAuditsAfter(mock, 10, 11, 12, 13)
+	tail.poll(context.Background(), now.Add(2*time.Minute))
+	if tail.last != 12 {
+		t.Errorf("expected watermark 12, got %d", tail.last)
+	}
+
+	expectRelayAuditsAfter(mock, 12, 13)
+	tail.poll(context.Background(), now.Add(3*time.Minute))
+
+	expected := []string{`{"id":12}`, `{"id":11}`, `{"id":13}`}
+	if fmt.Sprint(r.data) != fmt.Sprint(expected) {
+		t.Errorf("expected forwarded audits %v, got %v", expected, r.data)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
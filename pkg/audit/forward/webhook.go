@@ -0,0 +1,106 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// headers of the webhook requests
+const (
+	WebhookTimestampHeader = "X-Paralus-Timestamp"
+	WebhookSignatureHeader = "X-Paralus-Signature"
+	WebhookSourceHeader    = "X-Paralus-Source"
+)
+
+// WebhookOptions configure a webhook destination
+type WebhookOptions struct {
+	// URL the events are posted to, https is required
+	URL string
+	// Secret signs the requests, the signature is the hex HMAC-SHA256 of
+	// the timestamp header, a dot and the body
+	Secret string
+	// Headers added to the requests
+	Headers map[string]string
+	// Client used for the requests, defaults to http.DefaultClient
+	Client *http.Client
+}
+
+type webhookDestination struct {
+	opts WebhookOptions
+}
+
+// NewWebhook returns destination posting each event as JSON to an https
+// endpoint
+func NewWebhook(opts WebhookOptions) (Destination, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("webhook url %s is not https", opts.URL)
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	return &webhookDestination{opts: opts}, nil
+}
+
+// WebhookSignature returns the signature of a webhook request, receivers
+// compare it against the signature header after checking the timestamp
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (d *webhookDestination) Deliver(ctx context.Context, ev *Event) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.opts.URL, bytes.NewReader(ev.Data))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPermanent, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range d.opts.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set(WebhookSourceHeader, ev.Source)
+	if d.opts.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, ts)
+		req.Header.Set(WebhookSignatureHeader, WebhookSignature(d.opts.Secret, ts, ev.Data))
+	}
+
+	resp, err := d.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("webhook responded %s", resp.Status)
+	// other client errors will not succeed on retry
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %v", errPermanent, err)
+	}
+	return err
+}
+
+func (d *webhookDestination) Close() error {
+	d.opts.Client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,62 @@
+package forward
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+func TestWebhookDeliver(t *testing.T) {
+	var (
+		body      []byte
+		signature string
+		status    = http.StatusOK
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		signature = WebhookSignature("secret", r.Header.Get(WebhookTimestampHeader), body)
+		if r.Header.Get(WebhookSignatureHeader) != signature || r.Header.Get("X-Tenant") != "t1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	d, err := NewWebhook(WebhookOptions{
+		URL:     srv.URL,
+		Secret:  "secret",
+		Headers: map[string]string{"X-Tenant": "t1"},
+		Client:  srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, _ := NewEvent(audit.SYSTEM, []byte(`{"type":"user.login.success"}`+"\n"))
+	if err := d.Deliver(context.Background(), ev); err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"type":"user.login.success"}` {
+		t.Errorf("unexpected body %s", body)
+	}
+
+	status = http.StatusServiceUnavailable
+	if err := d.Deliver(context.Background(), ev); err == nil || errors.Is(err, errPermanent) {
+		t.Errorf("expected retryable error, got %v", err)
+	}
+	status = http.StatusBadRequest
+	if err := d.Deliver(context.Background(), ev); !errors.Is(err, errPermanent) {
+		t.Errorf("expected permanent error, got %v", err)
+	}
+}
+
+func TestNewWebhookRequiresHTTPS(t *testing.T) {
+	if _, err := NewWebhook(WebhookOptions{URL: "http://hooks.example.com"}); err == nil {
+		t.Error("expected http url to be rejected")
+	}
+}
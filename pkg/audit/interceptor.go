@@ -0,0 +1,227 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/paralus/paralus/pkg/common"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// mutatingVerbs are the words of the names of the rpcs changing resources
+var mutatingVerbs = map[string]bool{
+	"Create":  true,
+	"Update":  true,
+	"Delete":  true,
+	"Apply":   true,
+	"Patch":   true,
+	"Approve": true,
+	"Reject":  true,
+	"Reset":   true,
+	"Revoke":  true,
+	"Rotate":  true,
+	"Share":   true,
+	"Unshare": true,
+}
+
+type generatedKey struct{}
+
+// withGenerated marks ctx as a request for which a v2 event is generated
+func withGenerated(ctx context.Context) context.Context {
+	return context.WithValue(ctx, generatedKey{}, true)
+}
+
+// isGenerated returns true when a v2 event is generated for the request of
+// ctx, the v1 events created while handling it are then dropped
+func isGenerated(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	generated, _ := ctx.Value(generatedKey{}).(bool)
+	return generated
+}
+
+type hasMetadata interface {
+	GetMetadata() *commonv3.Metadata
+}
+
+type hasName interface {
+	GetName() string
+}
+
+// NewUnaryInterceptor returns the interceptor creating a v2 event for
+// every mutating rpc of an authenticated user. The rpcs are those whose
+// name has a verb of mutatingVerbs, e.g. UpdateProject. The state of the
+// resource before and after the rpc is read with the Get<Kind> rpc of the
+// service taking the same request when there is one, the response is the
+// state after the rpc otherwise.
+func NewUnaryInterceptor(al *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		sd, _ := ctx.Value(common.SessionDataKey).(*commonv3.SessionData)
+		verb, kind, ok := mutatingMethod(info.FullMethod, req)
+		if !ok || sd == nil {
+			return handler(ctx, req)
+		}
+
+		get := getter(info.Server, kind, req)
+		var before proto.Message
+		if get != nil && verb != "Create" {
+			before = get(ctx)
+		}
+		res, err := handler(withGenerated(ctx), req)
+		var after proto.Message
+		if err == nil && verb != "Delete" {
+			if get != nil {
+				after = get(ctx)
+			} else {
+				after, _ = res.(proto.Message)
+			}
+		}
+
+		event := newEvent(sd, verb, kind, req, before, after, err)
+		event.Detail.Meta["method"] = info.FullMethod
+		if err := CreateEvent(al, event,
+			WithVersion(VersionV2),
+			WithOrigin(OriginCore),
+			WithCategory(AuditCategory),
+			WithProject(event.Project),
+			WithOrganization(sd.GetOrganization()),
+			WithContext(ctx),
+		); err != nil {
+			_log.WithContext(ctx).Warnw("unable to create audit event", "method", info.FullMethod, "error", err)
+		}
+		return res, err
+	}
+}
+
+// mutatingMethod returns the verb and the resource kind of mutating rpc
+// method. The kind is the message of requests with metadata, e.g. Cluster
+// for RevokeClusterSessions, the words of the name other than the verb
+// otherwise.
+func mutatingMethod(method string, req interface{}) (string, string, bool) {
+	name := method[strings.LastIndex(method, "/")+1:]
+	words := splitWords(name)
+	for i, w := range words {
+		if !mutatingVerbs[w] {
+			continue
+		}
+		if _, ok := req.(hasMetadata); ok {
+			if m, ok := req.(proto.Message); ok {
+				return w, string(m.ProtoReflect().Descriptor().Name()), true
+			}
+		}
+		return w, strings.Join(words[:i], "") + strings.Join(words[i+1:], ""), true
+	}
+	return "", "", false
+}
+
+// splitWords splits a camel case name in its words
+func splitWords(name string) []string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if i > start && unicode.IsUpper(r) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	if start < len(name) {
+		words = append(words, name[start:])
+	}
+	return words
+}
+
+// getter returns the function reading the resource of req with the
+// Get<kind> method of server, nil when there is none
+func getter(server interface{}, kind string, req interface{}) func(context.Context) proto.Message {
+	if server == nil {
+		return nil
+	}
+	m := reflect.ValueOf(server).MethodByName("Get" + kind)
+	if !m.IsValid() {
+		return nil
+	}
+	t := m.Type()
+	ctxType := reflect.TypeOf((*context.Context)(nil)).Elem()
+	msgType := reflect.TypeOf((*proto.Message)(nil)).Elem()
+	if t.NumIn() != 2 || t.In(0) != ctxType || t.In(1) != reflect.TypeOf(req) ||
+		t.NumOut() != 2 || !t.Out(0).Implements(msgType) {
+		return nil
+	}
+	return func(ctx context.Context) proto.Message {
+		// the request is cloned as handlers may fill it in
+		out := m.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(proto.Clone(req.(proto.Message)))})
+		if !out[1].IsNil() || out[0].IsNil() {
+			return nil
+		}
+		return out[0].Interface().(proto.Message)
+	}
+}
+
+// newEvent returns the v2 event of the rpc of verb on a resource of kind
+// which failed with err or changed the resource from before to after
+func newEvent(sd *commonv3.SessionData, verb, kind string, req interface{}, before, after proto.Message, err error) *Event {
+	resource := &EventResource{Kind: kind}
+	var project string
+	for _, m := range []interface{}{after, before, req} {
+		if r, ok := m.(hasMetadata); ok && r.GetMetadata() != nil {
+			meta := r.GetMetadata()
+			resource.ID, resource.Name, project = meta.Id, meta.Name, meta.Project
+			break
+		}
+		if r, ok := m.(hasName); ok && r.GetName() != "" {
+			resource.Name = r.GetName()
+			break
+		}
+	}
+	if kind == "Project" {
+		project = resource.Name
+	}
+
+	actor := GetActorFromSessionData(sd)
+	actor.PartnerID = sd.GetPartner()
+	actor.OrganizationID = sd.GetOrganization()
+
+	action := strings.ToLower(verb)
+	meta := map[string]string{
+		"kind":                    kind,
+		snakeCase(kind) + "_name": resource.Name,
+	}
+	event := &Event{
+		Actor:    actor,
+		Portal:   "OPS",
+		Project:  project,
+		Resource: resource,
+		Detail: &EventDetail{
+			Message: fmt.Sprintf("%s %s %s", verb, kind, resource.Name),
+			Meta:    meta,
+		},
+	}
+	if err != nil {
+		st := status.Convert(err)
+		meta["code"] = st.Code().String()
+		event.Type = fmt.Sprintf("%s.%s.failure", strings.ToLower(kind), action)
+		event.Detail.Message += " failed: " + st.Message()
+		return event
+	}
+	event.Type = fmt.Sprintf("%s.%s.success", strings.ToLower(kind), action)
+	diff, derr := Diff(before, after)
+	if derr != nil {
+		_log.Warnw("unable to diff audited resource", "kind", kind, "error", derr)
+	}
+	event.Diff = diff
+	return event
+}
+
+// snakeCase returns name in snake case, e.g. cluster_group for
+// ClusterGroup
+func snakeCase(name string) string {
+	return strings.ToLower(strings.Join(splitWords(name), "_"))
+}
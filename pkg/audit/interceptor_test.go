@@ -0,0 +1,300 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// projectServer keeps projects by name
+type projectServer struct {
+	al       *zap.Logger
+	projects map[string]*systemv3.Project
+}
+
+func (s *projectServer) GetProject(ctx context.Context, req *systemv3.Project) (*systemv3.Project, error) {
+	p, ok := s.projects[req.GetMetadata().GetName()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	return proto.Clone(p).(*systemv3.Project), nil
+}
+
+func (s *projectServer) UpdateProject(ctx context.Context, req *systemv3.Project) (*systemv3.Project, error) {
+	// dropped as the v2 event is generated
+	CreateV1Event(ctx, s.al, &commonv3.SessionData{}, &EventDetail{}, "project.update.success", "")
+	s.projects[req.Metadata.Name] = req
+	return req, nil
+}
+
+func auditedContext() context.Context {
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{
+		Username:     "bob",
+		Partner:      "partner-id",
+		Organization: "org-id",
+		Groups:       []string{"admins"},
+	})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(gateway.RequestID, "req-1"))
+}
+
+// loggedEvent returns the fields of the only event of logs
+func loggedEvent(t *testing.T, logs *observer.ObservedLogs) map[string]interface{} {
+	t.Helper()
+	// events are written asynchronously
+	deadline := time.Now().Add(time.Second)
+	for logs.Len() == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if logs.Len() != 1 {
+		t.Fatalf("expected 1 event, got %d", logs.Len())
+	}
+	return logs.All()[0].ContextMap()
+}
+
+func TestUnaryInterceptorUpdate(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := NewUnaryInterceptor(zap.New(core))
+	s := &projectServer{al: zap.New(core), projects: map[string]*systemv3.Project{
+		"p1": {Metadata: &commonv3.Metadata{Name: "p1", Id: "id-1", Description: "old"}},
+	}}
+	info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/paralus.dev.rpc.system.v3.ProjectService/UpdateProject"}
+	req := &systemv3.Project{Metadata: &commonv3.Metadata{Name: "p1", Id: "id-1", Description: "new"}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.UpdateProject(ctx, req.(*systemv3.Project))
+	}
+
+	if _, err := interceptor(auditedContext(), req, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	ev := loggedEvent(t, logs)
+	if ev["version"] != string(VersionV2) || ev["type"] != "project.update.success" ||
+		ev["project"] != "p1" || ev["organization"] != "org-id" || ev["request_id"] != "req-1" {
+		t.Errorf("unexpected event %v", ev)
+	}
+	actor := ev["actor"].(*EventActor)
+	if actor.PartnerID != "partner-id" || actor.OrganizationID != "org-id" || actor.Account.Username != "bob" {
+		t.Errorf("unexpected actor %+v", actor)
+	}
+	if r := ev["resource"].(*EventResource); *r != (EventResource{Kind: "Project", ID: "id-1", Name: "p1"}) {
+		t.Errorf("unexpected resource %+v", r)
+	}
+	diff := ev["diff"].([]EventChange)
+	expected := []EventChange{{Path: "metadata.description", Before: `"old"`, After: `"new"`}}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("expected diff %v, got %v", expected, diff)
+	}
+	if meta := ev["detail"].(*EventDetail).Meta; meta["project_name"] != "p1" || meta["kind"] != "Project" {
+		t.Errorf("unexpected meta %v", meta)
+	}
+}
+
+// infraServer keeps a bootstrap infra
+type infraServer struct {
+	infra *sentry.BootstrapInfra
+}
+
+func (s *infraServer) GetBootstrapInfra(ctx context.Context, req *sentry.BootstrapInfra) (*sentry.BootstrapInfra, error) {
+	return proto.Clone(s.infra).(*sentry.BootstrapInfra), nil
+}
+
+func (s *infraServer) PatchBootstrapInfra(ctx context.Context, req *sentry.BootstrapInfra) (*sentry.BootstrapInfra, error) {
+	s.infra = req
+	return req, nil
+}
+
+func TestUnaryInterceptorRedacts(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := NewUnaryInterceptor(zap.New(core))
+	s := &infraServer{infra: &sentry.BootstrapInfra{
+		Metadata: &commonv3.Metadata{Name: "paralus-core-relay-agent"},
+		Spec:     &sentry.BootstrapInfraSpec{CaCert: "cert-1", CaKey: "private-key-1", CaKeyPass: "pass-1"},
+	}}
+	info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/paralus.dev.sentry.rpc.BootstrapService/PatchBootstrapInfra"}
+	req := &sentry.BootstrapInfra{
+		Metadata: &commonv3.Metadata{Name: "paralus-core-relay-agent"},
+		Spec:     &sentry.BootstrapInfraSpec{CaCert: "cert-2", CaKey: "private-key-2", CaKeyPass: "pass-2"},
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.PatchBootstrapInfra(ctx, req.(*sentry.BootstrapInfra))
+	}
+
+	if _, err := interceptor(auditedContext(), req, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	ev := loggedEvent(t, logs)
+	if ev["type"] != "bootstrapinfra.patch.success" {
+		t.Errorf("unexpected event %v", ev)
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"private-key", "pass-"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %s to be redacted, got %s", secret, b)
+		}
+	}
+	diff := ev["diff"].([]EventChange)
+	expected := []EventChange{
+		{Path: "spec.caCert", Before: `"cert-1"`, After: `"cert-2"`},
+		{Path: "spec.caKey", Before: redacted, After: redacted},
+		{Path: "spec.caKeyPass", Before: redacted, After: redacted},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("expected diff %v, got %v", expected, diff)
+	}
+}
+
+func TestUnaryInterceptorFailure(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := NewUnaryInterceptor(zap.New(core))
+	info := &grpc.UnaryServerInfo{Server: &projectServer{}, FullMethod: "/paralus.dev.rpc.system.v3.ProjectService/DeleteProject"}
+	req := &systemv3.Project{Metadata: &commonv3.Metadata{Name: "p1"}}
+	_, err := interceptor(auditedContext(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed")
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected the error of the handler, got %v", err)
+	}
+	ev := loggedEvent(t, logs)
+	if ev["type"] != "project.delete.failure" || ev["diff"] != nil {
+		t.Errorf("unexpected event %v", ev)
+	}
+	if meta := ev["detail"].(*EventDetail).Meta; meta["code"] != "PermissionDenied" {
+		t.Errorf("unexpected meta %v", meta)
+	}
+}
+
+func TestUnaryInterceptorSkips(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor := NewUnaryInterceptor(zap.New(core))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		if isGenerated(ctx) {
+			t.Error("unexpected audited request")
+		}
+		return req, nil
+	}
+	req := &systemv3.Project{Metadata: &commonv3.Metadata{Name: "p1"}}
+	// reads and unauthenticated requests are not audited
+	interceptor(auditedContext(), req, &grpc.UnaryServerInfo{FullMethod: "/s.ProjectService/GetProject"}, handler)
+	interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/s.ProjectService/CreateProject"}, handler)
+	time.Sleep(20 * time.Millisecond)
+	if logs.Len() != 0 {
+		t.Errorf("expected no events, got %d", logs.Len())
+	}
+}
+
+func TestMutatingMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		req    interface{}
+		verb   string
+		kind   string
+	}{
+		{"/s.ProjectService/CreateProject", &systemv3.Project{}, "Create", "Project"},
+		{"/s.ClusterService/RevokeClusterSessions", &systemv3.Project{}, "Revoke", "Project"},
+		{"/s.UserService/UserDeleteApiKeys", &commonv3.SessionData{}, "Delete", "UserApiKeys"},
+		{"/s.KubeConfigService/UpdateOrganizationSetting", &commonv3.SessionData{}, "Update", "OrganizationSetting"},
+	}
+	for _, tc := range tests {
+		verb, kind, ok := mutatingMethod(tc.method, tc.req)
+		if !ok || verb != tc.verb || kind != tc.kind {
+			t.Errorf("%s: expected %s %s, got %s %s %t", tc.method, tc.verb, tc.kind, verb, kind, ok)
+		}
+	}
+	for _, method := range []string{"/s.ProjectService/GetProjects", "/s.AuditService/VerifyAuditLog", "/s.BootstrapService/RegisterBootstrapAgent", "/s.UserService/Updater"} {
+		if _, _, ok := mutatingMethod(method, nil); ok {
+			t.Errorf("%s: expected not mutating", method)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := &systemv3.OIDCProvider{
+		Metadata: &commonv3.Metadata{Name: "idp", Labels: map[string]string{"a": "1"}},
+		Spec:     &systemv3.OIDCProviderSpec{ClientId: "id", ClientSecret: "s1", Scopes: []string{"email"}},
+	}
+	after := proto.Clone(before).(*systemv3.OIDCProvider)
+	after.Metadata.Labels = map[string]string{"b": "2"}
+	after.Spec.ClientSecret = "s2"
+	after.Spec.Scopes = []string{"email", "profile"}
+
+	changes, err := Diff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []EventChange{
+		{Path: "metadata.labels.a", Before: `"1"`},
+		{Path: "metadata.labels.b", After: `"2"`},
+		{Path: "spec.clientSecret", Before: redacted, After: redacted},
+		{Path: "spec.scopes", Before: `["email"]`, After: `["email","profile"]`},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+
+	changes, err = Diff(nil, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(changes)
+	if len(changes) != 5 || changes[2] != (EventChange{Path: "spec.clientId", After: `"id"`}) {
+		t.Errorf("unexpected changes of a created resource %s", b)
+	}
+	if changes, _ := Diff(before, proto.Clone(before)); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiffClusterProxy(t *testing.T) {
+	before := &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{Name: "c1"},
+		Spec:     &infrav3.ClusterSpec{ProxyConfig: &infrav3.ProxyConfig{Enabled: true, HttpsProxy: "http://proxy:3128", ProxyAuth: "user:s1"}},
+	}
+	after := proto.Clone(before).(*infrav3.Cluster)
+	after.Spec.ProxyConfig.ProxyAuth = "user:s2"
+
+	changes, err := Diff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []EventChange{
+		{Path: "spec.proxyConfig.proxyAuth", Before: redacted, After: redacted},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+
+	changes, err = Diff(nil, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(changes)
+	var found bool
+	for _, c := range changes {
+		if c.Path == "spec.proxyConfig.proxyAuth" {
+			found = c.After == redacted
+		}
+	}
+	if !found {
+		t.Errorf("expected proxy credentials of a created cluster to be redacted, got %s", b)
+	}
+}
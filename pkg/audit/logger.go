@@ -14,21 +14,46 @@ type AuditOptions struct {
 	MaxAgeDays int
 }
 
+// GetAuditLogger returns audit logger writing events to the file sink
 func GetAuditLogger(opts *AuditOptions) *zap.Logger {
+	return NewAuditLogger(NewFileSink(opts))
+}
+
+// NewAuditLogger returns audit logger delivering every event to all the
+// sinks as a JSON document
+func NewAuditLogger(sinks ...Sink) *zap.Logger {
 	encoder := zapcore.EncoderConfig{
 		TimeKey:    "timestamp",
 		EncodeTime: zapcore.RFC3339NanoTimeEncoder,
 	}
-	logger := zap.New(zapcore.NewCore(
-		zapcore.NewJSONEncoder(encoder),
-		zapcore.AddSync(&lumberjack.Logger{
+	var cores []zapcore.Core
+	for _, s := range sinks {
+		cores = append(cores, zapcore.NewCore(
+			zapcore.NewJSONEncoder(encoder),
+			sinkWriter{s},
+			zap.InfoLevel,
+		))
+	}
+	return zap.New(zapcore.NewTee(cores...))
+}
+
+// NewFileSink returns sink writing events as JSON lines to a rotated file
+func NewFileSink(opts *AuditOptions) Sink {
+	return &fileSink{
+		Logger: &lumberjack.Logger{
 			Filename:   opts.LogPath,
 			MaxSize:    opts.MaxSizeMB, // megabytes
 			MaxBackups: opts.MaxBackups,
 			MaxAge:     opts.MaxAgeDays, // days
-		}),
-		zap.InfoLevel,
-	))
+		},
+	}
+}
+
+type fileSink struct {
+	*lumberjack.Logger
+}
 
-	return logger
+func (s *fileSink) Write(p []byte) error {
+	_, err := s.Logger.Write(p)
+	return err
 }
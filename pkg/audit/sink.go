@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"errors"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ErrSinkQueueFull is returned when a sink could not accept an event
+// before timing out
+var ErrSinkQueueFull = errors.New("audit sink queue is full")
+
+// Sink delivers JSON encoded audit events to a storage backend
+type Sink interface {
+	// Write delivers one event, p is reused by the caller once Write
+	// returns and must be copied if retained
+	Write(p []byte) error
+	// Close delivers pending events and releases the sink
+	Close() error
+}
+
+// sinkWriter adapts a sink to the writer of zap cores
+type sinkWriter struct {
+	s Sink
+}
+
+var _ zapcore.WriteSyncer = sinkWriter{}
+
+func (w sinkWriter) Write(p []byte) (int, error) {
+	if err := w.s.Write(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w sinkWriter) Sync() error {
+	return nil
+}
+
+// CloseSinks closes all the sinks returning their errors
+func CloseSinks(sinks []Sink) error {
+	var err error
+	for _, s := range sinks {
+		err = errors.Join(err, s.Close())
+	}
+	return err
+}
@@ -64,13 +64,13 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 			Id: req.XApiKey,
 		})
 		if err != nil {
-			_log.Infow("unable to get api key", "key", req.XApiKey, "error", err)
+			_log.WithContext(ctx).Infow("unable to get api key", "key", req.XApiKey, "error", err)
 			return false, ErrInvalidAPIKey
 		}
 		if !(req.XApiToken == getTokenCheckSum([]byte(resp.Secret))) {
 			return false, ErrInvalidSignature
 		}
-		_log.Info("successfully validated api key ", req.XApiKey)
+		_log.WithContext(ctx).Info("successfully validated api key ", req.XApiKey)
 		res.Status = commonv3.RequestStatus_RequestAllowed
 		res.SessionData.Username = resp.Name
 		res.SessionData.Account = resp.AccountID.String()
@@ -112,12 +112,12 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 
 		res, err := ac.IsRequestAllowed(ctx, acReq)
 		if err != nil {
-			_log.Errorf("Failed to authenticate a request: %s", err)
+			_log.WithContext(ctx).Errorf("Failed to authenticate a request: %s", err)
 			return nil, status.Error(codes.Internal, codes.Internal.String())
 		}
 
 		s := res.GetStatus()
-		_log.Debug("user authentication status ", s)
+		_log.WithContext(ctx).Debug("user authentication status ", s)
 		switch s {
 		case commonv3.RequestStatus_RequestAllowed:
 			sd := res.SessionData
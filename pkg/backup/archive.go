@@ -0,0 +1,220 @@
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/segmentio/encoding/json"
+)
+
+const (
+	// Version is the current version of the archive format
+	Version = 1
+
+	archiveKind     = "OrganizationBackup"
+	signaturePrefix = "hmac-sha256:"
+	encryptedPrefix = "enc:"
+
+	signingKeyInfo    = "paralus backup signing key"
+	encryptionKeyInfo = "paralus backup encryption key"
+)
+
+var (
+	// ErrInvalidSignature is returned when archive was not signed with
+	// the given KEK or was modified after signing
+	ErrInvalidSignature = errors.New("invalid archive signature")
+	// ErrUnsupportedVersion is returned for archives of unknown version
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+)
+
+// Archive is the snapshot of a single organization
+type Archive struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// SecretsEncrypted is set when secrets in the archive are encrypted
+	// under the KEK
+	SecretsEncrypted bool `json:"secretsEncrypted"`
+
+	Partner           *systemv3.Partner         `json:"partner"`
+	Organization      *systemv3.Organization    `json:"organization"`
+	Roles             []*rolev3.Role            `json:"roles,omitempty"`
+	Projects          []*systemv3.Project       `json:"projects,omitempty"`
+	Groups            []*userv3.Group           `json:"groups,omitempty"`
+	Users             []*userv3.User            `json:"users,omitempty"`
+	Idps              []*systemv3.Idp           `json:"idps,omitempty"`
+	OIDCProviders     []*systemv3.OIDCProvider  `json:"oidcProviders,omitempty"`
+	KubeconfigSetting *sentry.KubeconfigSetting `json:"kubeconfigSetting,omitempty"`
+	Clusters          []*infrav3.Cluster        `json:"clusters,omitempty"`
+	BootstrapAgents   []*sentry.BootstrapAgent  `json:"bootstrapAgents,omitempty"`
+}
+
+// envelope is the on disk format of an archive; signature is computed
+// over the exact bytes of payload
+type envelope struct {
+	Kind      string          `json:"kind"`
+	Version   int             `json:"version"`
+	Signature string          `json:"signature"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// secrets returns pointers to every secret held in the archive
+func (a *Archive) secrets() []*string {
+	var s []*string
+	for _, p := range a.OIDCProviders {
+		if p.GetSpec() != nil {
+			s = append(s, &p.Spec.ClientSecret)
+		}
+	}
+	for _, ba := range a.BootstrapAgents {
+		if ba.GetSpec() != nil {
+			s = append(s, &ba.Spec.Token)
+		}
+	}
+	return s
+}
+
+func deriveKey(kek []byte, info string) []byte {
+	mac := hmac.New(sha256.New, kek)
+	mac.Write([]byte(info))
+	return mac.Sum(nil)
+}
+
+func sign(kek, payload []byte) string {
+	mac := hmac.New(sha256.New, deriveKey(kek, signingKeyInfo))
+	mac.Write(payload)
+	return signaturePrefix + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newAEAD(kek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey(kek, encryptionKeyInfo))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptSecrets(a *Archive, kek []byte) error {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return err
+	}
+	for _, s := range a.secrets() {
+		if *s == "" {
+			continue
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return err
+		}
+		sealed := aead.Seal(nonce, nonce, []byte(*s), nil)
+		*s = encryptedPrefix + base64.StdEncoding.EncodeToString(sealed)
+	}
+	return nil
+}
+
+func decryptSecrets(a *Archive, kek []byte) error {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return err
+	}
+	for _, s := range a.secrets() {
+		if !strings.HasPrefix(*s, encryptedPrefix) {
+			continue
+		}
+		sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(*s, encryptedPrefix))
+		if err != nil {
+			return fmt.Errorf("unable to decode secret: %w", err)
+		}
+		if len(sealed) < aead.NonceSize() {
+			return fmt.Errorf("unable to decrypt secret: invalid length")
+		}
+		plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt secret: %w", err)
+		}
+		*s = string(plain)
+	}
+	return nil
+}
+
+// Encode returns signed archive; secrets are encrypted under kek when
+// encrypt is set. a is not modified.
+func Encode(a *Archive, kek []byte, encrypt bool) ([]byte, error) {
+	if len(kek) == 0 {
+		return nil, errors.New("empty KEK")
+	}
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	if encrypt {
+		var c Archive
+		if err := json.Unmarshal(payload, &c); err != nil {
+			return nil, err
+		}
+		if err := encryptSecrets(&c, kek); err != nil {
+			return nil, fmt.Errorf("unable to encrypt secrets: %w", err)
+		}
+		c.SecretsEncrypted = true
+		if payload, err = json.Marshal(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(&envelope{
+		Kind:      archiveKind,
+		Version:   a.Version,
+		Signature: sign(kek, payload),
+		Payload:   payload,
+	})
+}
+
+// Decode verifies signature of archive b using kek and returns it with
+// secrets decrypted
+func Decode(b []byte, kek []byte) (*Archive, error) {
+	if len(kek) == 0 {
+		return nil, errors.New("empty KEK")
+	}
+	var env envelope
+	if err := json.Unmarshal(b, &env); err != nil {
+		return nil, fmt.Errorf("unable to parse archive: %w", err)
+	}
+	if env.Kind != archiveKind {
+		return nil, fmt.Errorf("unexpected archive kind %q", env.Kind)
+	}
+	if env.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, env.Version)
+	}
+	if !hmac.Equal([]byte(env.Signature), []byte(sign(kek, env.Payload))) {
+		return nil, ErrInvalidSignature
+	}
+
+	var a Archive
+	if err := json.Unmarshal(env.Payload, &a); err != nil {
+		return nil, fmt.Errorf("unable to parse archive payload: %w", err)
+	}
+	if a.Version != env.Version {
+		return nil, fmt.Errorf("%w: payload version %d", ErrUnsupportedVersion, a.Version)
+	}
+	if a.SecretsEncrypted {
+		if err := decryptSecrets(&a, kek); err != nil {
+			return nil, err
+		}
+		a.SecretsEncrypted = false
+	}
+	return &a, nil
+}
@@ -0,0 +1,118 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+func testArchive() *Archive {
+	return &Archive{
+		Version:      Version,
+		CreatedAt:    time.Now().UTC(),
+		Partner:      &systemv3.Partner{Metadata: &commonv3.Metadata{Name: "partner"}},
+		Organization: &systemv3.Organization{Metadata: &commonv3.Metadata{Name: "org"}},
+		OIDCProviders: []*systemv3.OIDCProvider{{
+			Metadata: &commonv3.Metadata{Name: "github"},
+			Spec:     &systemv3.OIDCProviderSpec{ClientId: "client", ClientSecret: "s3cret"},
+		}},
+		BootstrapAgents: []*sentry.BootstrapAgent{{
+			Metadata: &commonv3.Metadata{Name: "cluster-id"},
+			Spec:     &sentry.BootstrapAgentSpec{Token: "agent-token"},
+		}},
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	kek := []byte("paralus")
+	for _, encrypt := range []bool{false, true} {
+		a := testArchive()
+		b, err := Encode(a, kek, encrypt)
+		if err != nil {
+			t.Fatal("unable to encode archive:", err)
+		}
+		if a.SecretsEncrypted || a.OIDCProviders[0].Spec.ClientSecret != "s3cret" {
+			t.Error("encode should not modify archive")
+		}
+		if contains := bytes.Contains(b, []byte("s3cret")); contains == encrypt {
+			t.Errorf("encrypt=%v: secret in archive: %v", encrypt, contains)
+		}
+
+		d, err := Decode(b, kek)
+		if err != nil {
+			t.Fatalf("encrypt=%v: unable to decode archive: %s", encrypt, err)
+		}
+		if d.OIDCProviders[0].Spec.ClientSecret != "s3cret" || d.BootstrapAgents[0].Spec.Token != "agent-token" {
+			t.Errorf("encrypt=%v: secrets not restored: %v", encrypt, d.secrets())
+		}
+		if d.Organization.Metadata.Name != "org" {
+			t.Errorf("encrypt=%v: unexpected organization %q", encrypt, d.Organization.Metadata.Name)
+		}
+	}
+}
+
+func TestArchiveVerify(t *testing.T) {
+	b, err := Encode(testArchive(), []byte("paralus"), true)
+	if err != nil {
+		t.Fatal("unable to encode archive:", err)
+	}
+
+	if _, err := Decode(b, []byte("other")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected invalid signature for different KEK, got %v", err)
+	}
+
+	tampered := bytes.Replace(b, []byte(`"name":"org"`), []byte(`"name":"evil"`), 1)
+	if bytes.Equal(tampered, b) {
+		t.Fatal("unable to tamper archive")
+	}
+	if _, err := Decode(tampered, []byte("paralus")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected invalid signature for tampered archive, got %v", err)
+	}
+
+	a := testArchive()
+	a.Version = Version + 1
+	b, err = Encode(a, []byte("paralus"), false)
+	if err != nil {
+		t.Fatal("unable to encode archive:", err)
+	}
+	if _, err := Decode(b, []byte("paralus")); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("expected unsupported version, got %v", err)
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	project, namespace := "p1", "ns1"
+	scopes := map[string]string{
+		"ADMIN":               "organization",
+		"PROJECT_ADMIN":       "project",
+		"NAMESPACE_READ_ONLY": "namespace",
+		"SUPER_ADMIN":         "system",
+	}
+	ps := policies("u:user@example.com", "org", scopes, []*userv3.ProjectNamespaceRole{
+		{Role: "ADMIN"},
+		{Role: "PROJECT_ADMIN", Project: &project},
+		{Role: "NAMESPACE_READ_ONLY", Project: &project, Namespace: &namespace},
+		{Role: "SUPER_ADMIN"},
+		{Role: "UNKNOWN"},
+	})
+	expected := []string{
+		"u:user@example.com|*|*|org|ADMIN",
+		"u:user@example.com|*|p1|org|PROJECT_ADMIN",
+		"u:user@example.com|ns1|p1|org|NAMESPACE_READ_ONLY",
+		"u:user@example.com|*|*|*|SUPER_ADMIN",
+	}
+	if len(ps) != len(expected) {
+		t.Fatalf("expected %d policies, got %d", len(expected), len(ps))
+	}
+	for i, p := range ps {
+		if policyKey(p) != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], policyKey(p))
+		}
+	}
+}
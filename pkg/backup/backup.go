@@ -0,0 +1,701 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/rs/xid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Actions reported for restored resources
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionSkipped = "skipped"
+)
+
+const projectLabelPrefix = "project/"
+
+// Services are the services used to take and restore a backup
+type Services struct {
+	Partner           service.PartnerService
+	Organization      service.OrganizationService
+	Project           service.ProjectService
+	Role              service.RoleService
+	Group             service.GroupService
+	User              service.UserService
+	Idp               service.IdpService
+	OIDCProvider      service.OIDCProviderService
+	KubeconfigSetting service.KubeconfigSettingService
+	Cluster           service.ClusterService
+	Bootstrap         service.BootstrapService
+	Authz             service.AuthzService
+}
+
+// RestoreOptions customize restore of an archive
+type RestoreOptions struct {
+	// Partner and Organization restore the archive under different names,
+	// e.g. for staging clones; names from archive are used when empty
+	Partner      string
+	Organization string
+}
+
+// ReportItem is the outcome of restoring a single resource
+type ReportItem struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// Report is the outcome of a restore
+type Report struct {
+	Items []ReportItem `json:"items"`
+}
+
+func (r *Report) add(kind, name, action string) {
+	r.Items = append(r.Items, ReportItem{Kind: kind, Name: name, Action: action})
+}
+
+// Export takes snapshot of organization org of partner
+func Export(ctx context.Context, s *Services, partner, org string) (*Archive, error) {
+	a := &Archive{Version: Version, CreatedAt: time.Now().UTC()}
+
+	var err error
+	if a.Partner, err = s.Partner.GetByName(ctx, partner); err != nil {
+		return nil, fmt.Errorf("unable to get partner %q: %w", partner, err)
+	}
+	if a.Organization, err = s.Organization.GetByName(ctx, org); err != nil {
+		return nil, fmt.Errorf("unable to get organization %q: %w", org, err)
+	}
+	meta := &commonv3.Metadata{Partner: partner, Organization: org}
+
+	rl, err := s.Role.List(ctx, &rolev3.Role{Metadata: meta})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list roles: %w", err)
+	}
+	a.Roles = rl.GetItems()
+
+	pl, err := s.Project.List(ctx, &systemv3.Project{Metadata: meta})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list projects: %w", err)
+	}
+	a.Projects = pl.GetItems()
+
+	gl, err := s.Group.List(ctx, query.WithMeta(meta))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list groups: %w", err)
+	}
+	a.Groups = gl.GetItems()
+
+	ul, err := s.User.List(ctx, query.WithMeta(meta))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users: %w", err)
+	}
+	for _, u := range ul.GetItems() {
+		if u.GetSpec() != nil {
+			u.Spec.Password = ""
+			u.Spec.RecoveryUrl = nil
+		}
+		a.Users = append(a.Users, u)
+	}
+
+	il, err := s.Idp.List(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list idps: %w", err)
+	}
+	a.Idps = il.GetItems()
+
+	ol, err := s.OIDCProvider.List(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list oidc providers: %w", err)
+	}
+	a.OIDCProviders = ol.GetItems()
+
+	a.KubeconfigSetting, err = s.KubeconfigSetting.Get(ctx, a.Organization.GetMetadata().GetId(), "", false)
+	if err != nil && !errors.Is(err, constants.ErrNotFound) {
+		return nil, fmt.Errorf("unable to get kubeconfig setting: %w", err)
+	}
+
+	// clusters shared with several projects are listed once per project
+	seen := make(map[string]bool)
+	for _, p := range a.Projects {
+		cl, err := s.Cluster.List(ctx, query.WithMeta(&commonv3.Metadata{
+			Partner:      partner,
+			Organization: org,
+			Project:      p.GetMetadata().GetName(),
+		}))
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, fmt.Errorf("unable to list clusters of project %q: %w", p.GetMetadata().GetName(), err)
+		}
+		for _, c := range cl.GetItems() {
+			if seen[c.GetMetadata().GetId()] {
+				continue
+			}
+			seen[c.GetMetadata().GetId()] = true
+			a.Clusters = append(a.Clusters, c)
+
+			ba, err := s.Bootstrap.GetBootstrapAgentForClusterID(ctx, c.GetMetadata().GetId(), a.Organization.GetMetadata().GetId())
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				return nil, fmt.Errorf("unable to get bootstrap agent of cluster %q: %w", c.GetMetadata().GetName(), err)
+			}
+			a.BootstrapAgents = append(a.BootstrapAgents, ba)
+		}
+	}
+
+	return a, nil
+}
+
+// restorer holds state of a single restore
+type restorer struct {
+	s       *Services
+	a       *Archive
+	partner string
+	org     string
+	report  *Report
+
+	partnerID string
+	orgID     string
+	// projectIDs maps project ids of the archive to restored ones
+	projectIDs map[string]string
+	// clusterIDs maps cluster ids of the archive to restored ones
+	clusterIDs map[string]string
+}
+
+// Restore restores archive a. Resources which already exist are updated
+// and builtin roles are left as is, so restore can be safely repeated
+// after a failure. IDs of projects and clusters are remapped for the
+// bootstrap agents and authz policies are reconciled at the end.
+func Restore(ctx context.Context, s *Services, a *Archive, opts RestoreOptions) (*Report, error) {
+	if a.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, a.Version)
+	}
+	if a.SecretsEncrypted {
+		return nil, errors.New("archive secrets are encrypted")
+	}
+	r := &restorer{
+		s:          s,
+		a:          a,
+		partner:    a.Partner.GetMetadata().GetName(),
+		org:        a.Organization.GetMetadata().GetName(),
+		report:     &Report{},
+		projectIDs: make(map[string]string),
+		clusterIDs: make(map[string]string),
+	}
+	if opts.Partner != "" {
+		r.partner = opts.Partner
+	}
+	if opts.Organization != "" {
+		r.org = opts.Organization
+	}
+
+	// this is used to figure out if the request originated internally so
+	// as to not override `builtin`
+	ctx = context.WithValue(ctx, common.SessionInternalKey, true)
+
+	steps := []struct {
+		name string
+		fn   func(ctx context.Context) error
+	}{
+		{"partner", r.restorePartner},
+		{"organization", r.restoreOrganization},
+		{"roles", r.restoreRoles},
+		{"projects", r.restoreProjects},
+		{"groups", r.restoreGroups},
+		{"users", r.restoreUsers},
+		{"identity providers", r.restoreIdentityProviders},
+		{"kubeconfig setting", r.restoreKubeconfigSetting},
+		{"clusters", r.restoreClusters},
+		{"authz", r.reconcileAuthz},
+	}
+	for _, step := range steps {
+		if err := step.fn(ctx); err != nil {
+			return r.report, fmt.Errorf("unable to restore %s: %w", step.name, err)
+		}
+	}
+	return r.report, nil
+}
+
+func (r *restorer) meta(m *commonv3.Metadata) *commonv3.Metadata {
+	if m == nil {
+		m = &commonv3.Metadata{}
+	}
+	m.Id = ""
+	m.CreatedAt = nil
+	m.ModifiedAt = nil
+	m.Partner = r.partner
+	m.Organization = r.org
+	return m
+}
+
+func (r *restorer) restorePartner(ctx context.Context) error {
+	p := r.a.Partner
+	p.Metadata = r.meta(p.Metadata)
+	p.Metadata.Name = r.partner
+	p.Metadata.Partner = ""
+	p.Metadata.Organization = ""
+	p.Status = nil
+
+	if existing, err := r.s.Partner.GetByName(ctx, r.partner); err == nil {
+		r.partnerID = existing.GetMetadata().GetId()
+		r.report.add("Partner", r.partner, ActionSkipped)
+		return nil
+	}
+	created, err := r.s.Partner.Create(ctx, p)
+	if err != nil {
+		return err
+	}
+	r.partnerID = created.GetMetadata().GetId()
+	r.report.add("Partner", r.partner, ActionCreated)
+	return nil
+}
+
+func (r *restorer) restoreOrganization(ctx context.Context) error {
+	o := r.a.Organization
+	o.Metadata = r.meta(o.Metadata)
+	o.Metadata.Name = r.org
+	o.Metadata.Organization = ""
+	o.Status = nil
+
+	action := ActionUpdated
+	if _, err := r.s.Organization.GetByName(ctx, r.org); err != nil {
+		if _, err := r.s.Organization.Create(ctx, o); err != nil {
+			return err
+		}
+		action = ActionCreated
+	} else if _, err := r.s.Organization.Update(ctx, o); err != nil {
+		return err
+	}
+
+	// organization response of create does not carry the id
+	restored, err := r.s.Organization.GetByName(ctx, r.org)
+	if err != nil {
+		return err
+	}
+	r.orgID = restored.GetMetadata().GetId()
+	if r.partnerID == "" {
+		partner, err := r.s.Partner.GetByName(ctx, r.partner)
+		if err != nil {
+			return err
+		}
+		r.partnerID = partner.GetMetadata().GetId()
+	}
+	r.report.add("Organization", r.org, action)
+	return nil
+}
+
+func (r *restorer) restoreRoles(ctx context.Context) error {
+	for _, role := range r.a.Roles {
+		role.Metadata = r.meta(role.Metadata)
+		role.Status = nil
+		name := role.Metadata.Name
+
+		existing, err := r.s.Role.GetByName(ctx, &rolev3.Role{Metadata: r.meta(&commonv3.Metadata{Name: name})})
+		if err != nil {
+			if _, err := r.s.Role.Create(ctx, role); err != nil {
+				return fmt.Errorf("role %q: %w", name, err)
+			}
+			r.report.add("Role", name, ActionCreated)
+			continue
+		}
+		if existing.GetSpec().GetBuiltin() {
+			r.report.add("Role", name, ActionSkipped)
+			continue
+		}
+		if _, err := r.s.Role.Update(ctx, role); err != nil {
+			return fmt.Errorf("role %q: %w", name, err)
+		}
+		r.report.add("Role", name, ActionUpdated)
+	}
+	return nil
+}
+
+func (r *restorer) restoreProjects(ctx context.Context) error {
+	for _, project := range r.a.Projects {
+		oldID := project.GetMetadata().GetId()
+		project.Metadata = r.meta(project.Metadata)
+		project.Status = nil
+		name := project.Metadata.Name
+		// role associations are restored with users and groups
+		if project.Spec != nil {
+			project.Spec.ProjectNamespaceRoles = nil
+			project.Spec.UserRoles = nil
+		}
+
+		action := ActionUpdated
+		if _, err := r.s.Project.GetByName(ctx, name); err != nil {
+			if _, err := r.s.Project.Create(ctx, project); err != nil {
+				return fmt.Errorf("project %q: %w", name, err)
+			}
+			action = ActionCreated
+		} else if _, err := r.s.Project.Update(ctx, project); err != nil {
+			return fmt.Errorf("project %q: %w", name, err)
+		}
+
+		restored, err := r.s.Project.GetByName(ctx, name)
+		if err != nil {
+			return fmt.Errorf("project %q: %w", name, err)
+		}
+		r.projectIDs[oldID] = restored.GetMetadata().GetId()
+		r.report.add("Project", name, action)
+	}
+	return nil
+}
+
+func (r *restorer) restoreGroups(ctx context.Context) error {
+	for _, group := range r.a.Groups {
+		group.Metadata = r.meta(group.Metadata)
+		group.Status = nil
+		name := group.Metadata.Name
+		// membership is restored with users as they do not exist yet
+		g := &userv3.Group{
+			Metadata: group.Metadata,
+			Spec: &userv3.GroupSpec{
+				Type:                  group.GetSpec().GetType(),
+				ProjectNamespaceRoles: group.GetSpec().GetProjectNamespaceRoles(),
+			},
+		}
+
+		existing, err := r.s.Group.GetByName(ctx, &userv3.Group{Metadata: r.meta(&commonv3.Metadata{Name: name})})
+		if err != nil {
+			if _, err := r.s.Group.Create(ctx, g); err != nil {
+				return fmt.Errorf("group %q: %w", name, err)
+			}
+			r.report.add("Group", name, ActionCreated)
+			continue
+		}
+		g.Spec.Users = existing.GetSpec().GetUsers()
+		if _, err := r.s.Group.Update(ctx, g); err != nil {
+			return fmt.Errorf("group %q: %w", name, err)
+		}
+		r.report.add("Group", name, ActionUpdated)
+	}
+	return nil
+}
+
+func (r *restorer) restoreUsers(ctx context.Context) error {
+	for _, user := range r.a.Users {
+		user.Metadata = r.meta(user.Metadata)
+		user.Status = nil
+		name := user.Metadata.Name
+		if user.Spec == nil {
+			user.Spec = &userv3.UserSpec{}
+		}
+		// roles inherited from groups are restored with the groups
+		var roles []*userv3.ProjectNamespaceRole
+		for _, pnr := range user.Spec.ProjectNamespaceRoles {
+			if pnr.Group == nil {
+				roles = append(roles, pnr)
+			}
+		}
+		user.Spec.ProjectNamespaceRoles = roles
+		user.Spec.Password = ""
+		user.Spec.LastLogin = ""
+
+		if _, err := r.s.User.GetByName(ctx, &userv3.User{Metadata: r.meta(&commonv3.Metadata{Name: name})}); err != nil {
+			// passwords are not part of the backup, users of the
+			// restored organization have to reset them
+			user.Spec.ForceReset = true
+			if _, err := r.s.User.Create(ctx, user); err != nil {
+				return fmt.Errorf("user %q: %w", name, err)
+			}
+			r.report.add("User", name, ActionCreated)
+			continue
+		}
+		if _, err := r.s.User.Update(ctx, user); err != nil {
+			return fmt.Errorf("user %q: %w", name, err)
+		}
+		r.report.add("User", name, ActionUpdated)
+	}
+	return nil
+}
+
+func (r *restorer) restoreIdentityProviders(ctx context.Context) error {
+	for _, idp := range r.a.Idps {
+		idp.Metadata = r.meta(idp.Metadata)
+		idp.Status = nil
+		name := idp.Metadata.Name
+
+		if _, err := r.s.Idp.GetByName(ctx, &systemv3.Idp{Metadata: r.meta(&commonv3.Metadata{Name: name})}); err != nil {
+			if _, err := r.s.Idp.Create(ctx, idp); err != nil {
+				return fmt.Errorf("idp %q: %w", name, err)
+			}
+			r.report.add("Idp", name, ActionCreated)
+			continue
+		}
+		if _, err := r.s.Idp.Update(ctx, idp); err != nil {
+			return fmt.Errorf("idp %q: %w", name, err)
+		}
+		r.report.add("Idp", name, ActionUpdated)
+	}
+
+	for _, provider := range r.a.OIDCProviders {
+		provider.Metadata = r.meta(provider.Metadata)
+		provider.Status = nil
+		name := provider.Metadata.Name
+
+		if _, err := r.s.OIDCProvider.GetByName(ctx, &systemv3.OIDCProvider{Metadata: r.meta(&commonv3.Metadata{Name: name})}); err != nil {
+			if _, err := r.s.OIDCProvider.Create(ctx, provider); err != nil {
+				return fmt.Errorf("oidc provider %q: %w", name, err)
+			}
+			r.report.add("OIDCProvider", name, ActionCreated)
+			continue
+		}
+		if _, err := r.s.OIDCProvider.Update(ctx, provider); err != nil {
+			return fmt.Errorf("oidc provider %q: %w", name, err)
+		}
+		r.report.add("OIDCProvider", name, ActionUpdated)
+	}
+	return nil
+}
+
+func (r *restorer) restoreKubeconfigSetting(ctx context.Context) error {
+	ks := r.a.KubeconfigSetting
+	if ks == nil {
+		return nil
+	}
+	ks.Id = ""
+	ks.OrganizationID = r.orgID
+	ks.PartnerID = r.partnerID
+	ks.AccountID = ""
+	ks.CreatedAt = nil
+	ks.ModifiedAt = nil
+	ks.DeletedAt = nil
+	if err := r.s.KubeconfigSetting.Patch(ctx, ks); err != nil {
+		return err
+	}
+	r.report.add("KubeconfigSetting", r.org, ActionUpdated)
+	return nil
+}
+
+func (r *restorer) restoreClusters(ctx context.Context) error {
+	for _, cluster := range r.a.Clusters {
+		oldID := cluster.GetMetadata().GetId()
+		name := cluster.GetMetadata().GetName()
+		project := cluster.GetMetadata().GetProject()
+
+		existing, err := r.s.Cluster.Select(ctx, &infrav3.Cluster{
+			Metadata: r.meta(&commonv3.Metadata{Name: name, Project: project}),
+		}, false)
+		if err == nil && existing.GetMetadata().GetId() != "" {
+			r.clusterIDs[oldID] = existing.GetMetadata().GetId()
+			r.report.add("Cluster", name, ActionSkipped)
+			continue
+		}
+
+		// labels in paralus domain are added back by the cluster service
+		labels := make(map[string]string)
+		for k, v := range cluster.GetMetadata().GetLabels() {
+			if !strings.HasPrefix(k, "paralus.dev/") {
+				labels[k] = v
+			}
+		}
+		c := &infrav3.Cluster{
+			Metadata: r.meta(&commonv3.Metadata{
+				Name:        name,
+				Description: cluster.GetMetadata().GetDescription(),
+				Project:     project,
+				Labels:      labels,
+				Annotations: cluster.GetMetadata().GetAnnotations(),
+			}),
+			Spec: &infrav3.ClusterSpec{
+				ClusterType:        cluster.GetSpec().GetClusterType(),
+				Metro:              cluster.GetSpec().GetMetro(),
+				Params:             cluster.GetSpec().GetParams(),
+				ProxyConfig:        cluster.GetSpec().GetProxyConfig(),
+				BootstrapOverrides: cluster.GetSpec().GetBootstrapOverrides(),
+			},
+		}
+		created, err := r.s.Cluster.Create(ctx, c)
+		if err != nil {
+			return fmt.Errorf("cluster %q: %w", name, err)
+		}
+		r.clusterIDs[oldID] = created.GetMetadata().GetId()
+		r.report.add("Cluster", name, ActionCreated)
+	}
+
+	for _, agent := range r.a.BootstrapAgents {
+		clusterID, ok := r.clusterIDs[agent.GetMetadata().GetName()]
+		if !ok {
+			continue
+		}
+		if _, err := r.s.Bootstrap.GetBootstrapAgentForClusterID(ctx, clusterID, r.orgID); err == nil {
+			r.report.add("BootstrapAgent", agent.GetMetadata().GetDisplayName(), ActionSkipped)
+			continue
+		}
+
+		labels := make(map[string]string)
+		for k, v := range agent.GetMetadata().GetLabels() {
+			if strings.HasPrefix(k, projectLabelPrefix) {
+				if id, ok := r.projectIDs[strings.TrimPrefix(k, projectLabelPrefix)]; ok {
+					k = projectLabelPrefix + id
+				}
+			}
+			labels[k] = v
+		}
+		token := agent.GetSpec().GetToken()
+		if token == "" {
+			token = xid.New().String()
+		}
+		ba := &sentry.BootstrapAgent{
+			Metadata: &commonv3.Metadata{
+				Name:         clusterID,
+				DisplayName:  agent.GetMetadata().GetDisplayName(),
+				Labels:       labels,
+				Annotations:  agent.GetMetadata().GetAnnotations(),
+				Partner:      r.partnerID,
+				Organization: r.orgID,
+				Project:      r.projectIDs[agent.GetMetadata().GetProject()],
+			},
+			Spec: &sentry.BootstrapAgentSpec{
+				Token:       token,
+				TemplateRef: agent.GetSpec().GetTemplateRef(),
+				AgentMode:   agent.GetSpec().GetAgentMode(),
+			},
+		}
+		if err := r.s.Bootstrap.CreateBootstrapAgent(ctx, ba); err != nil {
+			return fmt.Errorf("bootstrap agent of cluster %q: %w", agent.GetMetadata().GetDisplayName(), err)
+		}
+		r.report.add("BootstrapAgent", agent.GetMetadata().GetDisplayName(), ActionCreated)
+	}
+	return nil
+}
+
+// policies returns authz policies of sub for given role bindings
+func policies(sub, org string, scopes map[string]string, pnrs []*userv3.ProjectNamespaceRole) []*authzv1.Policy {
+	var ps []*authzv1.Policy
+	for _, pnr := range pnrs {
+		p := &authzv1.Policy{Sub: sub, Ns: "*", Proj: "*", Org: org, Obj: pnr.GetRole()}
+		switch scopes[pnr.GetRole()] {
+		case "system":
+			p.Org = "*"
+		case "organization":
+		case "project":
+			p.Proj = pnr.GetProject()
+		case "namespace":
+			p.Proj = pnr.GetProject()
+			p.Ns = pnr.GetNamespace()
+		default:
+			continue
+		}
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+func policyKey(p *authzv1.Policy) string {
+	return strings.Join([]string{p.Sub, p.Ns, p.Proj, p.Org, p.Obj}, "|")
+}
+
+// reconcileAuthz creates authz policies and user group mappings of the
+// archive which are missing after restore
+func (r *restorer) reconcileAuthz(ctx context.Context) error {
+	scopes := make(map[string]string)
+	for _, role := range r.a.Roles {
+		scopes[role.GetMetadata().GetName()] = strings.ToLower(role.GetSpec().GetScope())
+	}
+
+	bindings := make(map[string][]*userv3.ProjectNamespaceRole)
+	memberships := make(map[string]map[string]bool)
+	addMembership := func(user, group string) {
+		if memberships[user] == nil {
+			memberships[user] = make(map[string]bool)
+		}
+		memberships[user][group] = true
+	}
+	for _, g := range r.a.Groups {
+		bindings["g:"+g.GetMetadata().GetName()] = g.GetSpec().GetProjectNamespaceRoles()
+		for _, u := range g.GetSpec().GetUsers() {
+			addMembership(u, g.GetMetadata().GetName())
+		}
+	}
+	for _, u := range r.a.Users {
+		var direct []*userv3.ProjectNamespaceRole
+		for _, pnr := range u.GetSpec().GetProjectNamespaceRoles() {
+			if pnr.Group == nil {
+				direct = append(direct, pnr)
+			}
+		}
+		bindings["u:"+u.GetMetadata().GetName()] = direct
+		for _, g := range u.GetSpec().GetGroups() {
+			addMembership(u.GetMetadata().GetName(), g)
+		}
+	}
+
+	subs := make([]string, 0, len(bindings))
+	for sub := range bindings {
+		subs = append(subs, sub)
+	}
+	sort.Strings(subs)
+
+	var missing []*authzv1.Policy
+	for _, sub := range subs {
+		current, err := r.s.Authz.ListPolicies(ctx, &authzv1.Policy{Sub: sub})
+		if err != nil {
+			return err
+		}
+		have := make(map[string]bool)
+		for _, p := range current.GetPolicies() {
+			have[policyKey(p)] = true
+		}
+		for _, p := range policies(sub, r.org, scopes, bindings[sub]) {
+			if !have[policyKey(p)] {
+				have[policyKey(p)] = true
+				missing = append(missing, p)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		if _, err := r.s.Authz.CreatePolicies(ctx, &authzv1.Policies{Policies: missing}); err != nil {
+			return err
+		}
+	}
+
+	var missingGroups []*authzv1.UserGroup
+	for user, groups := range memberships {
+		current, err := r.s.Authz.ListUserGroups(ctx, &authzv1.UserGroup{User: "u:" + user})
+		if err != nil {
+			return err
+		}
+		have := make(map[string]bool)
+		for _, ug := range current.GetUserGroups() {
+			have[ug.GetGrp()] = true
+		}
+		for g := range groups {
+			if !have["g:"+g] {
+				missingGroups = append(missingGroups, &authzv1.UserGroup{User: "u:" + user, Grp: "g:" + g})
+			}
+		}
+	}
+	if len(missingGroups) > 0 {
+		if _, err := r.s.Authz.CreateUserGroups(ctx, &authzv1.UserGroups{UserGroups: missingGroups}); err != nil {
+			return err
+		}
+	}
+
+	r.report.add("Authz", r.org, fmt.Sprintf("%d policies and %d user groups added", len(missing), len(missingGroups)))
+	return nil
+}
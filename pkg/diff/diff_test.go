@@ -0,0 +1,25 @@
+package diff
+
+import "testing"
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{"added", "", "a\n", "+ a\n"},
+		{"removed", "a\n", "", "- a\n"},
+		{"changed", "a\nb\nc\n", "a\nx\nc\n", "  a\n- b\n+ x\n  c\n"},
+		{"appended", "a\n", "a\nb\n", "  a\n+ b\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lines(tt.a, tt.b); got != tt.want {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
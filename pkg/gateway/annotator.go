@@ -19,6 +19,9 @@ const (
 	UserAgent            = "x-gateway-user-agent"
 	Host                 = "x-gateway-host"
 	RemoteAddr           = "x-gateway-remote-addr"
+	// RequestID identifies a request in the audit events, it is taken from
+	// the X-Request-Id header or generated
+	RequestID = "x-request-id"
 )
 
 // paralusGatewayAnnotator adds paralus gateway specific annotations
@@ -33,5 +36,6 @@ var paralusGatewayAnnotator = func(ctx context.Context, r *http.Request) metadat
 		UserAgent:      r.UserAgent(),
 		Host:           r.Host,
 		RemoteAddr:     r.RemoteAddr,
+		RequestID:      r.Header.Get(RequestID),
 	})
 }
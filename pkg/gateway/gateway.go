@@ -5,9 +5,11 @@ import (
 	"errors"
 	"net/http"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/paralus/paralus/pkg/tracing"
 )
 
 // ErrNoHandlers returned when not handlers are passed to gateway
@@ -35,7 +37,13 @@ func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.
 		return nil, ErrNoHandlers
 	}
 
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+	// trace context of the incoming http request is carried over to
+	// the grpc metadata by the client interceptor
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
 
 	for _, handler := range handlers {
 		err := handler(ctx, mux, endpoint, opts)
@@ -44,5 +52,5 @@ func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.
 		}
 	}
 
-	return mux, nil
+	return tracing.NewHandler(requestIDHandler(mux), "gateway"), nil
 }
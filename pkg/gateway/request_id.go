@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// validRequestID bounds the request ids accepted from clients
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestIDHandler sets the X-Request-Id header of the requests to the id
+// sent by the client, or to a new id when it is missing or invalid, and
+// returns it in the response
+func requestIDHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestID)
+		if !validRequestID.MatchString(id) {
+			id = uuid.New().String()
+			r.Header.Set(RequestID, id)
+		}
+		w.Header().Set(RequestID, id)
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,45 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestIDHandler(t *testing.T) {
+	var got string
+	h := requestIDHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(RequestID)
+	}))
+
+	tests := []struct {
+		name  string
+		id    string
+		keeps bool
+	}{
+		{"client id", "req-123.a:b_c", true},
+		{"missing", "", false},
+		{"invalid", "bad id\n", false},
+		{"too long", strings.Repeat("a", 129), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.id != "" {
+				r.Header.Set(RequestID, tc.id)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if got == "" || w.Header().Get(RequestID) != got {
+				t.Fatalf("expected request id %q in the response, got %q", got, w.Header().Get(RequestID))
+			}
+			if (got == tc.id) != tc.keeps {
+				t.Errorf("expected client id kept %t, got %q", tc.keeps, got)
+			}
+			if r.Header.Get(RequestID) != got || paralusGatewayAnnotator(r.Context(), r).Get(RequestID)[0] != got {
+				t.Errorf("expected request id %q forwarded", got)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -21,6 +22,8 @@ var (
 			Timeout:             time.Second * 30,
 			PermitWithoutStream: true,
 		}),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	}
 )
 
@@ -100,6 +103,8 @@ func NewGrpcClientClientConn(ctx context.Context, serverHost string, serverPort
 			Timeout:             time.Second * 30,
 			PermitWithoutStream: true,
 		}),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 
 	return cc, err
@@ -124,6 +129,8 @@ func NewGrpcClientClientConnWithTimeout(ctx context.Context, serverHost string,
 			PermitWithoutStream: true,
 		}),
 		//grpc.WithBalancer(serverBalancer),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 
 	return cc, err
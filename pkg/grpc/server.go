@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc/peer"
 
 	"google.golang.org/grpc"
@@ -29,10 +30,25 @@ var (
 		}),
 	}
 
+	// serverTracingOpts are prepended to server options so that tracing
+	// interceptors run before any interceptor passed by the caller
+	serverTracingOpts = []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	}
+
 	// ErrInvalidClient is returned when client cert is not present in peer context
 	ErrInvalidClient = errors.New("client has not presented certificate")
 )
 
+// withServerDefaults returns opts surrounded by tracing and default options
+func withServerDefaults(opts []grpc.ServerOption) []grpc.ServerOption {
+	sOpts := make([]grpc.ServerOption, 0, len(serverTracingOpts)+len(opts)+len(serverDefaultOpts))
+	sOpts = append(sOpts, serverTracingOpts...)
+	sOpts = append(sOpts, opts...)
+	return append(sOpts, serverDefaultOpts...)
+}
+
 // NewSecureServerWithPEM creates a secure gRPC service with give PEM encoded cert, key and ca
 func NewSecureServerWithPEM(cert, key, ca []byte, opts ...grpc.ServerOption) (*grpc.Server, error) {
 	certificate, err := tls.X509KeyPair(cert, key)
@@ -48,7 +64,7 @@ func NewSecureServerWithPEM(cert, key, ca []byte, opts ...grpc.ServerOption) (*g
 		Certificates: []tls.Certificate{certificate},
 		ClientCAs:    certPool,
 	})
-	opts = append(opts, serverDefaultOpts...)
+	opts = withServerDefaults(opts)
 	opts = append([]grpc.ServerOption{grpc.Creds(creds)}, opts...)
 
 	return grpc.NewServer(opts...), nil
@@ -75,7 +91,7 @@ func NewSecureServer(certPath, keyPath, caPath string, opts ...grpc.ServerOption
 		ClientCAs:    certPool,
 	})
 
-	opts = append(opts, serverDefaultOpts...)
+	opts = withServerDefaults(opts)
 	opts = append([]grpc.ServerOption{grpc.Creds(creds)}, opts...)
 
 	return grpc.NewServer(opts...), nil
@@ -83,7 +99,7 @@ func NewSecureServer(certPath, keyPath, caPath string, opts ...grpc.ServerOption
 
 // NewServer returns new unsecured grpc server
 func NewServer(opts ...grpc.ServerOption) (*grpc.Server, error) {
-	opts = append(opts, serverDefaultOpts...)
+	opts = withServerDefaults(opts)
 	return grpc.NewServer(opts...), nil
 }
 
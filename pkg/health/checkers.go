@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	kclient "github.com/ory/kratos-client-go"
+	logv2 "github.com/paralus/paralus/pkg/log"
+	"github.com/uptrace/bun"
+)
+
+var _log = logv2.GetLogger()
+
+// NewDBChecker returns checker which pings the database
+func NewDBChecker(db *bun.DB) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	})
+}
+
+// NewTableChecker returns checker which verifies table is queryable
+func NewTableChecker(db *bun.DB, table string) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		_, err := db.NewSelect().TableExpr("?", bun.Ident(table)).ColumnExpr("1").Limit(1).Exec(ctx)
+		return err
+	})
+}
+
+// NewKratosChecker returns checker which calls readiness endpoint of
+// kratos api served by kc
+func NewKratosChecker(kc *kclient.APIClient) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		_, hr, err := kc.MetadataApi.IsReady(ctx).Execute()
+		if err != nil {
+			if hr != nil {
+				return fmt.Errorf("kratos not ready: %s", hr.Status)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// NewHTTPChecker returns checker which expects a non error response
+// for a GET request to url
+func NewHTTPChecker(url string) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("unexpected response from %s: %s", url, resp.Status)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,347 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Component names of paralus dependencies
+const (
+	Database     = "database"
+	KratosAdmin  = "kratos-admin"
+	KratosPublic = "kratos-public"
+	Audit        = "audit"
+	Peering      = "peering"
+)
+
+// Liveness is the grpc health service name reporting liveness of the
+// process; empty service name reports readiness
+const Liveness = "liveness"
+
+// Status is the health status of a component or of the whole process
+type Status string
+
+// Status constants
+const (
+	StatusUp       Status = "up"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+var (
+	// ErrNotChecked is reported by a component which is yet to be checked
+	ErrNotChecked = errors.New("not checked yet")
+)
+
+// Checker checks health of a single dependency
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc is an adapter to use plain functions as Checker
+type CheckerFunc func(ctx context.Context) error
+
+// Check calls f(ctx)
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// ComponentReport is the health of a single component
+type ComponentReport struct {
+	Status    Status    `json:"status"`
+	Critical  bool      `json:"critical"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt,omitempty"`
+}
+
+// Report is the aggregated health of all components
+type Report struct {
+	Status     Status                     `json:"status"`
+	Components map[string]ComponentReport `json:"components"`
+}
+
+type component struct {
+	checker   Checker
+	critical  bool
+	err       error
+	checkedAt time.Time
+}
+
+// Health tracks health of paralus dependencies and exposes it over
+// grpc health service and http
+type Health struct {
+	mu         sync.RWMutex
+	components map[string]*component
+	services   map[string][]string
+	lastRun    time.Time
+
+	interval time.Duration
+	timeout  time.Duration
+	hs       *grpchealth.Server
+}
+
+// Option is the functional arg for creating Health
+type Option func(h *Health)
+
+// WithInterval sets interval between two checks
+func WithInterval(interval time.Duration) Option {
+	return func(h *Health) {
+		h.interval = interval
+	}
+}
+
+// WithTimeout sets timeout of a single check
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Health) {
+		h.timeout = timeout
+	}
+}
+
+// New returns new Health; process is reported as not serving until
+// first round of checks complete
+func New(opts ...Option) *Health {
+	h := &Health{
+		components: make(map[string]*component),
+		services:   make(map[string][]string),
+		interval:   time.Second * 10,
+		timeout:    time.Second * 5,
+		hs:         grpchealth.NewServer(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	h.hs.SetServingStatus(Liveness, grpc_health_v1.HealthCheckResponse_SERVING)
+	return h
+}
+
+// Register registers a component which is periodically checked using
+// checker. Failure of a critical component makes the process not ready,
+// failure of other components only degrades it.
+func (h *Health) Register(name string, checker Checker, critical bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.components[name] = &component{
+		checker:  checker,
+		critical: critical,
+		err:      ErrNotChecked,
+	}
+	h.hs.SetServingStatus(name, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+}
+
+// Set reports err for a component registered without a checker; nil
+// err marks the component healthy
+func (h *Health) Set(name string, err error) {
+	h.mu.Lock()
+	c, ok := h.components[name]
+	if ok {
+		c.err = err
+		c.checkedAt = time.Now()
+	}
+	h.mu.Unlock()
+
+	if ok {
+		h.update()
+	}
+}
+
+// AddService makes grpc health status of service depend on given
+// components
+func (h *Health) AddService(service string, components ...string) {
+	h.mu.Lock()
+	h.services[service] = components
+	h.mu.Unlock()
+	h.update()
+}
+
+// RegisterServer registers grpc health service on s
+func (h *Health) RegisterServer(s *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(s, h.hs)
+}
+
+// Run periodically checks registered components until stop is closed
+func (h *Health) Run(stop <-chan struct{}) {
+	t := time.NewTicker(h.interval)
+	defer t.Stop()
+
+	for {
+		h.check()
+		select {
+		case <-stop:
+			h.hs.Shutdown()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (h *Health) check() {
+	h.mu.RLock()
+	checkers := make(map[string]Checker, len(h.components))
+	for name, c := range h.components {
+		if c.checker != nil {
+			checkers[name] = c.checker
+		}
+	}
+	h.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	var rmu sync.Mutex
+	results := make(map[string]error, len(checkers))
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+			defer cancel()
+			err := checker.Check(ctx)
+			rmu.Lock()
+			results[name] = err
+			rmu.Unlock()
+		}(name, checker)
+	}
+	wg.Wait()
+
+	now := time.Now()
+	h.mu.Lock()
+	for name, err := range results {
+		if c, ok := h.components[name]; ok {
+			if err != nil && c.err == nil {
+				_log.Warnw("health check failed", "component", name, "error", err)
+			} else if err == nil && c.err != nil && c.err != ErrNotChecked {
+				_log.Infow("health check recovered", "component", name)
+			}
+			c.err = err
+			c.checkedAt = now
+		}
+	}
+	h.lastRun = now
+	h.mu.Unlock()
+
+	h.update()
+}
+
+// update syncs grpc health statuses with the current report
+func (h *Health) update() {
+	r := h.Report()
+
+	h.mu.RLock()
+	services := make(map[string][]string, len(h.services))
+	for s, deps := range h.services {
+		services[s] = deps
+	}
+	h.mu.RUnlock()
+
+	for name, cr := range r.Components {
+		h.hs.SetServingStatus(name, servingStatus(cr.Status != StatusDown))
+	}
+	for service, deps := range services {
+		serving := true
+		for _, dep := range deps {
+			if cr, ok := r.Components[dep]; ok && cr.Status == StatusDown {
+				serving = false
+				break
+			}
+		}
+		h.hs.SetServingStatus(service, servingStatus(serving))
+	}
+	h.hs.SetServingStatus("", servingStatus(r.Status != StatusDown))
+}
+
+func servingStatus(serving bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if serving {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// Report returns current health of all components
+func (h *Health) Report() Report {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	r := Report{
+		Status:     StatusUp,
+		Components: make(map[string]ComponentReport, len(h.components)),
+	}
+	for name, c := range h.components {
+		cr := ComponentReport{
+			Status:    StatusUp,
+			Critical:  c.critical,
+			CheckedAt: c.checkedAt,
+		}
+		if c.err != nil {
+			cr.Status = StatusDown
+			cr.Error = c.err.Error()
+			if c.critical {
+				r.Status = StatusDown
+			} else if r.Status == StatusUp {
+				r.Status = StatusDegraded
+			}
+		}
+		r.Components[name] = cr
+	}
+	return r
+}
+
+// Failing returns names of components which are currently failing
+func (r Report) Failing() []string {
+	var names []string
+	for name, cr := range r.Components {
+		if cr.Status == StatusDown {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// alive reports whether checks are still running on schedule
+func (h *Health) alive() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.lastRun.IsZero() {
+		return true
+	}
+	return time.Since(h.lastRun) < 3*h.interval+h.timeout
+}
+
+// LivenessHandler returns http handler reporting liveness of the
+// process; it does not depend on health of any component
+func (h *Health) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.alive() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]Status{"status": StatusDown})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]Status{"status": StatusUp})
+	})
+}
+
+// ReadinessHandler returns http handler reporting readiness with
+// health of every component; degraded process is still ready
+func (h *Health) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rep := h.Report()
+		code := http.StatusOK
+		if rep.Status == StatusDown {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, rep)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
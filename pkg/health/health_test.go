@@ -0,0 +1,80 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatusOf(t *testing.T, h *Health, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	resp, err := h.hs.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("unable to check %q: %s", service, err)
+	}
+	return resp.Status
+}
+
+func TestHealthReadiness(t *testing.T) {
+	var dbErr, auditErr error
+	h := New()
+	h.Register(Database, CheckerFunc(func(ctx context.Context) error { return dbErr }), true)
+	h.Register(Audit, CheckerFunc(func(ctx context.Context) error { return auditErr }), false)
+	h.Register(Peering, nil, false)
+	h.AddService("paralus.dev.rpc.audit.v1.AuditLogService", Database, Audit)
+
+	if got := servingStatusOf(t, h, ""); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected not serving before first check, got %s", got)
+	}
+
+	h.check()
+	h.Set(Peering, nil)
+	if r := h.Report(); r.Status != StatusUp {
+		t.Errorf("expected %s, got %s: %v", StatusUp, r.Status, r.Failing())
+	}
+	if got := servingStatusOf(t, h, ""); got != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("expected serving, got %s", got)
+	}
+
+	auditErr = errors.New("connection refused")
+	h.check()
+	r := h.Report()
+	if r.Status != StatusDegraded {
+		t.Errorf("expected %s, got %s", StatusDegraded, r.Status)
+	}
+	if got := servingStatusOf(t, h, ""); got != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("expected degraded process to be serving, got %s", got)
+	}
+	if got := servingStatusOf(t, h, "paralus.dev.rpc.audit.v1.AuditLogService"); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected audit service not serving, got %s", got)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected %d for degraded readiness, got %d", http.StatusOK, rec.Code)
+	}
+
+	dbErr = errors.New("connection refused")
+	h.check()
+	if r := h.Report(); r.Status != StatusDown {
+		t.Errorf("expected %s, got %s", StatusDown, r.Status)
+	}
+	rec = httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	h.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected process to be alive, got %d", rec.Code)
+	}
+	if got := servingStatusOf(t, h, Liveness); got != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("expected liveness serving, got %s", got)
+	}
+}
@@ -1,8 +1,10 @@
 package log
 
 import (
+	"context"
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -76,6 +78,21 @@ func (lg *Logger) ChangeLevel(lc <-chan string) {
 	}
 }
 
+// WithContext returns logger which adds trace_id and span_id of the
+// span in ctx to every log line
+func (lg *Logger) WithContext(ctx context.Context) *Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return lg
+	}
+	return &Logger{
+		SugaredLogger: lg.SugaredLogger.With(
+			"trace_id", sc.TraceID().String(),
+			"span_id", sc.SpanID().String(),
+		),
+	}
+}
+
 var zl *Logger
 var lo sync.Once
 
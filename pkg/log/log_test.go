@@ -1,8 +1,13 @@
 package log
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestLogger(t *testing.T) {
@@ -21,3 +26,33 @@ func TestLogger(t *testing.T) {
 	log.Debugw("test debug", "key", "value")
 
 }
+
+func TestLoggerWithContext(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	log := &Logger{SugaredLogger: zap.New(core).Sugar()}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+		SpanID:     trace.SpanID{0x04, 0x05},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	log.WithContext(context.Background()).Infow("no span")
+	log.WithContext(ctx).Infow("in span", "key", "value")
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log lines, got %d", len(entries))
+	}
+	if _, ok := entries[0].ContextMap()["trace_id"]; ok {
+		t.Errorf("expected no trace_id outside a span")
+	}
+	fields := entries[1].ContextMap()
+	if fields["trace_id"] != sc.TraceID().String() {
+		t.Errorf("expected trace_id %s, got %v", sc.TraceID(), fields["trace_id"])
+	}
+	if fields["span_id"] != sc.SpanID().String() {
+		t.Errorf("expected span_id %s, got %v", sc.SpanID(), fields["span_id"])
+	}
+}
@@ -67,3 +67,16 @@ func (m *matcher) Match(meta commonv3.Metadata) bool {
 
 	return true
 }
+
+// Labels returns true if the labels match the selector, an empty selector
+// matches all labels and an invalid one matches none
+func Labels(selector string, lbls map[string]string) bool {
+	if selector == "" {
+		return true
+	}
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return false
+	}
+	return sel.Matches(labels.Set(lbls))
+}
@@ -1,6 +1,23 @@
 package query
 
-import v1 "github.com/paralus/paralus/proto/rpc/audit"
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultAuditPageSize is the number of audit hits returned per page
+	// when not requested
+	DefaultAuditPageSize = 500
+	// MaxAuditPageSize caps the number of audit hits returned per page
+	MaxAuditPageSize = 1000
+)
 
 type QueryFilters interface {
 	GetType() string
@@ -14,7 +31,87 @@ type QueryFilters interface {
 	GetMethod() string
 	GetQueryString() string
 	GetProjects() []string
+	GetClusters() []string
+	GetFrom() *timestamppb.Timestamp
+	GetTo() *timestamppb.Timestamp
+	GetPageSize() int32
+	GetPageToken() string
 }
 
 var _ QueryFilters = (*v1.AuditLogQueryFilter)(nil)
 var _ QueryFilters = (*v1.RelayAuditQueryFilter)(nil)
+
+// AuditCursor is the position after the last hit of an audit page. Time
+// and ID are used by the database backend, SortValues hold the sort
+// values of the last hit for the elasticsearch backend.
+type AuditCursor struct {
+	Time       time.Time     `json:"t,omitempty"`
+	ID         int64         `json:"id,omitempty"`
+	SortValues []interface{} `json:"sa,omitempty"`
+}
+
+// AuditPageSize returns the page size requested in filters bounded to
+// MaxAuditPageSize
+func AuditPageSize(filters QueryFilters) int {
+	size := int(filters.GetPageSize())
+	if size <= 0 {
+		return DefaultAuditPageSize
+	}
+	if size > MaxAuditPageSize {
+		return MaxAuditPageSize
+	}
+	return size
+}
+
+// AuditClusters returns the clusters any of which the audit events
+// should belong to
+func AuditClusters(filters QueryFilters) []string {
+	clusters := filters.GetClusters()
+	if filters.GetCluster() != "" {
+		clusters = append([]string{filters.GetCluster()}, clusters...)
+	}
+	return clusters
+}
+
+// ValidateAuditTimeRange checks the absolute time range of filters
+func ValidateAuditTimeRange(filters QueryFilters) error {
+	from, to := filters.GetFrom(), filters.GetTo()
+	if from != nil && !from.IsValid() {
+		return fmt.Errorf("invalid from timestamp")
+	}
+	if to != nil && !to.IsValid() {
+		return fmt.Errorf("invalid to timestamp")
+	}
+	if from != nil && to != nil && !from.AsTime().Before(to.AsTime()) {
+		return fmt.Errorf("from has to be before to")
+	}
+	return nil
+}
+
+// EncodeAuditCursor returns the opaque page token of cursor
+func EncodeAuditCursor(c *AuditCursor) string {
+	if c == nil {
+		return ""
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeAuditCursor parses page token, nil is returned for the first page
+func DecodeAuditCursor(token string) (*AuditCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	var c AuditCursor
+	// keep sort values as numbers to pass them back to elasticsearch as is
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&c); err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	return &c, nil
+}
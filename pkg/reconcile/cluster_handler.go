@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	clstrutil "github.com/paralus/paralus/internal/cluster"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/event"
 	"github.com/paralus/paralus/pkg/query"
@@ -184,6 +185,11 @@ func (h *clusterEventHandler) handleClusterEvent(ev event.Resource) {
 		_log.Infow("unable to reconcile cluster", "error", err, "event", "ev")
 		return
 	}
+
+	// check again for the agent acknowledging uninstall or timing out
+	if clstrutil.IsClusterDeleteInProgress(cluster) {
+		h.cwq.AddAfter(resourceToKey(ev), clusterDecommissionRecheck)
+	}
 	_log.Debugw("successfully reconciled cluster for event", "event", ev)
 }
 
@@ -10,18 +10,21 @@ import (
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
-	sentryutil "github.com/paralus/paralus/pkg/sentry/util"
 	"github.com/paralus/paralus/pkg/service"
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 	ctypesv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
-	"github.com/paralus/paralus/proto/types/sentry"
 	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
 )
 
 const (
 	clusterCoolDown = time.Minute
+	// time the agent of a deleted cluster has to acknowledge uninstalling
+	// itself before the cluster is purged anyway
+	clusterDecommissionTimeout = time.Minute * 10
+	// interval clusters waiting for the agent to uninstall are checked at
+	clusterDecommissionRecheck = time.Second * 30
 )
 
 // ClusterReconciler reconciles cluster state
@@ -57,6 +60,8 @@ func canReconcileClusterDelete(c *infrav3.Cluster) bool {
 	switch {
 	case clstrutil.IsClusterDeletePending(c):
 		return true
+	case clstrutil.IsClusterDeleteInProgress(c):
+		return true
 	case clstrutil.IsClusterDeleteRetry(c) && clstrutil.IsClusterDeleteCooledDown(c, clusterCoolDown):
 		return true
 	default:
@@ -75,139 +80,109 @@ func canReconcileClusterBootstrapAgent(c *infrav3.Cluster) bool {
 	}
 }
 
-// DeleteForCluster delete bootstrap agent
-func (r *clusterReconciler) deleteBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error {
-
-	resp, err := r.bs.SelectBootstrapAgentTemplates(ctx, query.WithOptions(&ctypesv3.QueryOptions{
-		GlobalScope: true,
-		Selector:    "paralus.dev/defaultRelay=true",
-	}))
-	if err != nil {
-		return err
-	}
-
-	for _, bat := range resp.Items {
+// handleClusterDelete asks the agent of a registered cluster to uninstall
+// itself and purges the cluster once the agent acknowledges, see
+// service.AckClusterDecommission, or the acknowledgement times out.
+// Clusters without an agent and failed uninstalls fall back to deleting
+// the relay agent through the relay.
+func (r *clusterReconciler) handleClusterDelete(ctx context.Context, cluster *infrav3.Cluster) error {
+	_log.Infow("handling cluster delete", "cluster.Name", cluster.Metadata.Name)
 
-		agent := &sentry.BootstrapAgent{
-			Metadata: &ctypesv3.Metadata{
-				Id:           cluster.Metadata.Id,
-				Name:         cluster.Metadata.Name,
-				Partner:      cluster.Metadata.Partner,
-				Organization: cluster.Metadata.Organization,
-				Project:      cluster.Metadata.Project,
-			},
-			Spec: &sentry.BootstrapAgentSpec{
-				TemplateRef: fmt.Sprintf("template/%s", bat.Metadata.Name),
-			},
+	switch {
+	case clstrutil.IsClusterDeleteInProgress(cluster):
+		if !clstrutil.IsClusterDeleteTimedOut(cluster, clusterDecommissionTimeout) {
+			return nil
 		}
-
-		templateRef, err := sentryutil.GetTemplateScope(agent.Spec.TemplateRef)
-		if err != nil {
-			return err
+		_log.Infow("cluster agent did not acknowledge uninstall", "name", cluster.Metadata.Name, "timeout", clusterDecommissionTimeout)
+		if err := r.deleteRelayAgent(ctx, cluster); err != nil {
+			_log.Infow("unable to delete relay agent", "name", cluster.Metadata.Name, "error", err)
 		}
+		return r.purgeCluster(cluster, fmt.Sprintf("agent did not acknowledge uninstall within %s", clusterDecommissionTimeout))
+	case clstrutil.IsClusterDeletePending(cluster) && clstrutil.IsClusterRegisterd(cluster):
+		return r.setClusterDeleteCondition(cluster, constants.InProgress, "waiting for agent to uninstall")
+	}
 
-		err = r.bs.DeleteBootstrapAgent(ctx, templateRef, query.WithMeta(agent.Metadata))
-		if err != nil {
-			return err
+	if err := r.deleteRelayAgent(ctx, cluster); err != nil {
+		_log.Infow("unable to delete cluster", "name", cluster.Metadata.Name, "error", err)
+		err = errors.Wrap(err, "unable to delete cluster")
+		sErr := r.setClusterDeleteCondition(cluster, constants.Retry, err.Error())
+		if sErr != nil {
+			_log.Infow("unable to update status", "error", err)
 		}
+		return err
+	}
+
+	if err := r.purgeCluster(cluster, "cluster deleted"); err != nil {
+		_log.Infow("unable to update status", "error", err)
 	}
 
 	return nil
 }
 
-func (r *clusterReconciler) handleClusterDelete(ctx context.Context, cluster *infrav3.Cluster) error {
-	_log.Infow("handling cluster delete", "cluster.Name", cluster.Metadata.Name)
-	try := func() error {
-		_log.Debugw("no relay networks to disassociate", "cluster name", cluster.Metadata.Name)
-
-		sd, ok := service.GetSessionDataFromContext(ctx)
-		if !ok {
-			return errors.New("failed to get session data")
-		}
+// deleteRelayAgent deletes the relay agent through the relay
+func (r *clusterReconciler) deleteRelayAgent(ctx context.Context, cluster *infrav3.Cluster) error {
+	sd, ok := service.GetSessionDataFromContext(ctx)
+	if !ok {
+		return errors.New("failed to get session data")
+	}
 
-		in := &sentryrpc.GetForClusterRequest{
-			Namespace:  "paralus-system",
-			SystemUser: false,
-			Opts: &ctypesv3.QueryOptions{
-				Name:         cluster.Metadata.Name,
-				ClusterID:    cluster.Metadata.Id,
-				Organization: cluster.Metadata.Organization,
-				Partner:      cluster.Metadata.Partner,
-				Username:     sd.Username,
-				Account:      sd.Account,
-			},
-		}
+	in := &sentryrpc.GetForClusterRequest{
+		Namespace:  "paralus-system",
+		SystemUser: false,
+		Opts: &ctypesv3.QueryOptions{
+			Name:         cluster.Metadata.Name,
+			ClusterID:    cluster.Metadata.Id,
+			Organization: cluster.Metadata.Organization,
+			Partner:      cluster.Metadata.Partner,
+			Username:     sd.Username,
+			Account:      sd.Account,
+		},
+	}
 
-		kss := service.NewKubeconfigSettingService(r.db)
-		config, err := kubeconfig.GetConfigForCluster(ctx, r.bs, in, r.pf, kss, kubeconfig.ParalusSystem)
-		if err != nil {
-			return err
-		}
+	kss := service.NewKubeconfigSettingService(r.db)
+	config, err := kubeconfig.GetConfigForCluster(ctx, r.bs, in, r.pf, kss, kubeconfig.ParalusSystem)
+	if err != nil {
+		return err
+	}
 
-		status := service.DeleteRelayAgent(ctx, config, "paralus-system")
+	status := service.DeleteRelayAgent(ctx, config, "paralus-system")
 
-		_log.Infof("deleted relay agent in cluster with status: ", fmt.Sprint(status))
+	_log.Infof("deleted relay agent in cluster with status: ", fmt.Sprint(status))
 
-		return nil
-	}
+	return nil
+}
 
-	onSuccess := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
+// purgeCluster deletes the bootstrap agents of the cluster and marks it
+// deleted, which removes it from the database
+func (r *clusterReconciler) purgeCluster(cluster *infrav3.Cluster, reason string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
 
-		err := r.deleteBootstrapAgentForCluster(ctx, cluster)
-		if err != nil {
-			return err
-		}
-		_log.Info("deleted bootstrap agent for cluster")
-
-		err = r.cs.UpdateStatus(ctx, &infrav3.Cluster{
-			Metadata: cluster.Metadata,
-			Spec: &infrav3.ClusterSpec{
-				ClusterData: &infrav3.ClusterData{
-					ClusterStatus: &infrav3.ClusterStatus{
-						Conditions: []*infrav3.ClusterCondition{
-							clstrutil.NewClusterDelete(constants.Success, "cluster deleted")},
-					},
-				},
-			},
-		}, query.WithMeta(cluster.Metadata))
+	err := r.cs.DeleteBootstrapAgentsForCluster(ctx, cluster)
+	if err != nil {
 		return err
 	}
+	_log.Info("deleted bootstrap agent for cluster")
 
-	onFailure := func(reason string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
+	return r.setClusterDeleteCondition(cluster, constants.Success, reason)
+}
 
-		err := r.cs.UpdateStatus(ctx, &infrav3.Cluster{
-			Metadata: cluster.Metadata,
-			Spec: &infrav3.ClusterSpec{
-				ClusterData: &infrav3.ClusterData{
-					ClusterStatus: &infrav3.ClusterStatus{
-						Conditions: []*infrav3.ClusterCondition{
-							clstrutil.NewClusterDelete(constants.Retry, reason)},
-					},
+func (r *clusterReconciler) setClusterDeleteCondition(cluster *infrav3.Cluster, status ctypesv3.ParalusConditionStatus, reason string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	err := r.cs.UpdateStatus(ctx, &infrav3.Cluster{
+		Metadata: cluster.Metadata,
+		Spec: &infrav3.ClusterSpec{
+			ClusterData: &infrav3.ClusterData{
+				ClusterStatus: &infrav3.ClusterStatus{
+					Conditions: []*infrav3.ClusterCondition{
+						clstrutil.NewClusterDelete(status, reason)},
 				},
 			},
-		}, query.WithMeta(cluster.Metadata))
-		return err
-	}
-
-	if err := try(); err != nil {
-		_log.Infow("unable to delete cluster", "name", cluster.Metadata.Name, "error", err)
-		err = errors.Wrap(err, "unable to delete cluster")
-		sErr := onFailure(err.Error())
-		if sErr != nil {
-			_log.Infow("unable to update status", "error", err)
-		}
-		return err
-	}
-
-	if err := onSuccess(); err != nil {
-		_log.Infow("unable to update status", "error", err)
-	}
-
-	return nil
+		},
+	}, query.WithMeta(cluster.Metadata))
+	return err
 }
 
 func (r *clusterReconciler) handleClusterBootstrapAgent(ctx context.Context, cluster *infrav3.Cluster) error {
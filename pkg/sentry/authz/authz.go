@@ -11,13 +11,17 @@ import (
 	"github.com/paralus/paralus/internal/constants"
 	"github.com/paralus/paralus/pkg/controller/runtime"
 	"github.com/paralus/paralus/pkg/log"
+	"github.com/paralus/paralus/pkg/match"
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
 	"github.com/paralus/paralus/pkg/service"
+	"github.com/paralus/paralus/pkg/tracing"
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/paralus/paralus/proto/types/controller"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 )
@@ -69,9 +73,9 @@ func getAuthzLabels(userName, saValidityDuration string) map[string]string {
 	}
 }
 
-func getAccountProjectNamespace(ctx context.Context, projectID, accountID string, pns service.NamespaceService) ([]string, error) {
+func getAccountProjectNamespace(ctx context.Context, projectID, accountID string, clusterLabels map[string]string, pns service.NamespaceService) ([]string, error) {
 
-	apns, err := pns.GetAccountProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID))
+	apns, err := pns.GetAccountProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID), clusterLabels)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +83,9 @@ func getAccountProjectNamespace(ctx context.Context, projectID, accountID string
 	return apns, nil
 }
 
-func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID string, apn service.NamespaceService) ([]string, error) {
+func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID string, clusterLabels map[string]string, apn service.NamespaceService) ([]string, error) {
 
-	apns, err := apn.GetGroupProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID))
+	apns, err := apn.GetGroupProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID), clusterLabels)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +93,7 @@ func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID s
 	return apns, nil
 }
 
-func getProjectPermissions(ctx context.Context, projects []string, accountID, orgID, partnerID string, aps service.AccountPermissionService) (map[string][]string, string, error) {
+func getProjectPermissions(ctx context.Context, projects []string, accountID, orgID, partnerID string, clusterLabels map[string]string, aps service.AccountPermissionService) (map[string][]string, string, error) {
 	projects = append(projects, "")
 	accountPermissions, err := aps.GetAccountPermissionsByProjectIDPermissions(ctx, accountID, orgID, partnerID, projects, permissions)
 	if err != nil {
@@ -104,6 +108,10 @@ func getProjectPermissions(ctx context.Context, projects []string, accountID, or
 	projectPermissions := make(map[string][]string)
 OUTER:
 	for _, accountPermission := range accountPermissions {
+		// bindings limited to clusters with other labels do not apply here
+		if !match.Labels(accountPermission.ClusterSelector, clusterLabels) {
+			continue
+		}
 		p := accountPermission.ProjectID
 		if projectPermissions[p] == nil {
 			projectPermissions[p] = []string{}
@@ -118,7 +126,7 @@ OUTER:
 	return projectPermissions, accountData.Username, nil
 }
 
-func getSSOProjectPermissions(ctx context.Context, projects []string, orgID, partnerID, accountID string, aps service.AccountPermissionService, gps service.GroupPermissionService) (map[string][]string, string, []string, error) {
+func getSSOProjectPermissions(ctx context.Context, projects []string, orgID, partnerID, accountID string, clusterLabels map[string]string, aps service.AccountPermissionService, gps service.GroupPermissionService) (map[string][]string, string, []string, error) {
 	acc, err := aps.GetAccount(ctx, accountID)
 	if err != nil {
 		return nil, "", nil, err
@@ -136,6 +144,9 @@ func getSSOProjectPermissions(ctx context.Context, projects []string, orgID, par
 	projectPermissions := make(map[string][]string)
 OUTER:
 	for _, groupPermission := range groupPermissions {
+		if !match.Labels(groupPermission.ClusterSelector, clusterLabels) {
+			continue
+		}
 		p := groupPermission.ProjectID
 		if projectPermissions[p] == nil {
 			projectPermissions[p] = []string{}
@@ -289,6 +300,80 @@ func getProjectsFromLabels(labels map[string]string) ([]string, error) {
 	return projects, nil
 }
 
+// appendProjects appends the projects not already present
+func appendProjects(projects []string, more []string) []string {
+	for _, p := range more {
+		found := false
+		for _, e := range projects {
+			if e == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			projects = append(projects, p)
+		}
+	}
+	return projects
+}
+
+// shareNamespacePermission maps cluster scoped permissions to namespace
+// scoped ones for shares restricted to namespaces
+var shareNamespacePermission = map[string]string{
+	sentry.KubectlFullAccessPermission:   sentry.KubectlNamespaceWritePermission,
+	sentry.KubectlClusterWritePermission: sentry.KubectlNamespaceWritePermission,
+	sentry.KubectlClusterReadPermission:  sentry.KubectlNamespaceReadPermission,
+}
+
+// shareReadPermission maps write permissions to read ones for read only
+// shares
+var shareReadPermission = map[string]string{
+	sentry.KubectlFullAccessPermission:     sentry.KubectlClusterReadPermission,
+	sentry.KubectlClusterWritePermission:   sentry.KubectlClusterReadPermission,
+	sentry.KubectlNamespaceWritePermission: sentry.KubectlNamespaceReadPermission,
+}
+
+// applySharePolicy intersects the permissions and namespaces of a user in
+// a project the cluster is shared with with the policy of the share
+func applySharePolicy(share *infrav3.ProjectCluster, permissions, namespaces []string) ([]string, []string) {
+	if share == nil {
+		return permissions, namespaces
+	}
+	restricted := len(share.Namespaces) > 0
+	readOnly := share.Access == infrav3.ClusterShareAccess_READ_ONLY
+
+	clusterScope := false
+	limited := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if p, ok := shareNamespacePermission[permission]; ok && restricted {
+			clusterScope = true
+			permission = p
+		}
+		if p, ok := shareReadPermission[permission]; ok && readOnly {
+			permission = p
+		}
+		limited = appendProjects(limited, []string{permission})
+	}
+	if !restricted {
+		return limited, namespaces
+	}
+
+	// cluster scoped permissions extend to all namespaces of the share
+	if clusterScope {
+		return limited, append([]string{}, share.Namespaces...)
+	}
+	allowed := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		for _, n := range share.Namespaces {
+			if n == namespace {
+				allowed = append(allowed, namespace)
+				break
+			}
+		}
+	}
+	return limited, allowed
+}
+
 // GetAuthorization returns authorization for user, cluster
 // The RBAC model mapped to the existing role
 // PROJECT_ADMIN:
@@ -314,7 +399,7 @@ func getProjectsFromLabels(labels map[string]string) ([]string, error) {
 // ENV_READ
 //   - NO Access to cluster scoped resources
 //   - Read Access to namespace scoped resources (only within the environment)
-func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
+func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService, cs service.ClusterService, cgs service.ClusterGroupService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
 	var userName string
 	var groups []string
 	var rolePrevilage int
@@ -322,6 +407,9 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	var enforceOrgAdminOnlySecretAccess, isOrgAdmin bool
 	const defaultSaValiditySeconds = 28800
 
+	ctx, span := tracing.Start(ctx, "authz.GetAuthorization", attribute.String("cluster.id", req.ClusterID))
+	defer func() { tracing.End(span, err) }()
+
 	resp = new(sentryrpc.GetUserAuthorizationResponse)
 
 	// get attributes from user CN
@@ -338,7 +426,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 
 	} else if err != nil {
-		_log.Errorf("unable to fetch k8s service as per org level kubectl settings for orgID:%s %v", orgID, cnAttr.IsSSO)
+		_log.WithContext(ctx).Errorf("unable to fetch k8s service as per org level kubectl settings for orgID:%s %v", orgID, cnAttr.IsSSO)
 		return nil, fmt.Errorf("unable to fetch k8s service %s", err.Error())
 	}
 
@@ -348,37 +436,57 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		return getSystemUserAuthz(cnAttr, fmtSaValidityDuration)
 	}
 
+	// no user kubeconfigs are served for clusters pending approval, rejected
+	// or whose sessions are revoked
+	if err = cs.CheckClusterAccess(ctx, req.ClusterID, req.CertIssueSeconds); err != nil {
+		_log.WithContext(ctx).Infow("kubectl denied for cluster", "cluster", req.ClusterID, "userCN", req.UserCN, "error", err)
+		return nil, err
+	}
+
+	// role bindings can be limited to clusters matching a label selector
+	clusterLabels, err := cs.GetClusterLabels(ctx, req.ClusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("unable to get cluster labels", "cluster", req.ClusterID, "error", err)
+		return nil, err
+	}
+
+	groupPolicy, err := cgs.GetClusterGroupPolicy(ctx, req.ClusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("unable to get cluster group policy", "cluster", req.ClusterID, "error", err)
+		return nil, err
+	}
+
 	isOrgAdmin, _ = aps.IsOrgAdmin(ctx, accountID, partnerID)
 
 	// Check user is partner / super admin to bypass cluster/user checks.
 	// Partner Super admins has full access.
 	isPartnerAdmin, isSuperAdmin, err := aps.IsPartnerSuperAdmin(ctx, accountID, partnerID)
 	if err != nil {
-		_log.Infow("Error getting partner/super admin permission info", "accountID", accountID, "orgID", orgID, "partnerID", partnerID, "error", err)
+		_log.WithContext(ctx).Infow("Error getting partner/super admin permission info", "accountID", accountID, "orgID", orgID, "partnerID", partnerID, "error", err)
 	}
-	_log.Infow("check for partner/super admin", " isPartnerAdmin ", isPartnerAdmin, " isSuperAdmin ", isSuperAdmin)
+	_log.WithContext(ctx).Infow("check for partner/super admin", " isPartnerAdmin ", isPartnerAdmin, " isSuperAdmin ", isSuperAdmin)
 	if !isSuperAdmin && !isPartnerAdmin {
 		existUserLevel := true
 		// get org level setting if exist
 		ksOrg, errOrg := kss.Get(ctx, orgID, "", cnAttr.IsSSO)
 		if errOrg != nil && errOrg != constants.ErrNotFound {
-			_log.Errorw("failed to fetch organization level kubectl settings for", "userCN", req.UserCN)
+			_log.WithContext(ctx).Errorw("failed to fetch organization level kubectl settings for", "userCN", req.UserCN)
 			return nil, errOrg
 		}
 		if errOrg == nil && ksOrg != nil {
 			// check for kubectl org settings
 			err = verifyKubectlSettings(cnAttr, ksOrg, "organization")
 			if err != nil {
-				_log.Errorw("kubectl denied as per org level kubectl settings for", "userCN", req.UserCN)
+				_log.WithContext(ctx).Errorw("kubectl denied as per org level kubectl settings for", "userCN", req.UserCN)
 				return nil, err
 			}
 			enforceOrgAdminOnlySecretAccess = ksOrg.EnforceOrgAdminSecretAccess
 		}
 
 		// check for kubectl cluster settings
-		err = verifyClusterKubectlSettings(ctx, bs, kcs, cnAttr, req.ClusterID, orgID)
+		err = verifyClusterKubectlSettings(ctx, bs, kcs, groupPolicy, cnAttr, req.ClusterID, orgID)
 		if err != nil {
-			_log.Errorw("failed to verify kubectl cluster settings for", "userCN", req.UserCN)
+			_log.WithContext(ctx).Errorw("failed to verify kubectl cluster settings for", "userCN", req.UserCN)
 			return nil, err
 		}
 
@@ -397,7 +505,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			// check for kubectl user settings
 			errVerify := verifyKubectlSettings(cnAttr, ks, "user")
 			if errVerify != nil {
-				_log.Errorw("kubectl denied as per user level kubectl settings for", "userCN", req.UserCN)
+				_log.WithContext(ctx).Errorw("kubectl denied as per user level kubectl settings for", "userCN", req.UserCN)
 				return nil, errVerify
 			}
 		}
@@ -412,7 +520,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			lastLogin = accountData.LastLogin
 			t1 := time.Now()
 			if t1.Sub(lastLogin) > time.Hour*12 {
-				_log.Infow("get kubectl authorization block access. user did not login to portal in last 12 Hour")
+				_log.WithContext(ctx).Infow("get kubectl authorization block access. user did not login to portal in last 12 Hour")
 				return nil, fmt.Errorf("enforce session enabled. user did not login to portal in last 12 Hour")
 			}
 		}
@@ -420,7 +528,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		// is local user active
 		if ok, _ := aps.IsSSOAccount(ctx, accountID); !ok {
 			active, err := aps.IsAccountActive(ctx, accountID, orgID)
-			_log.Infow("accountID ", accountID, "orgID ", orgID, "active ", fmt.Sprint(active))
+			_log.WithContext(ctx).Infow("accountID ", accountID, "orgID ", orgID, "active ", fmt.Sprint(active))
 			if err != nil {
 				return nil, err
 			}
@@ -460,19 +568,31 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	// get projects
 	projects, err := getProjectsFromLabels(labels)
 	if err != nil {
-		_log.Errorw("error getting projects from bootstrap agents labels", "labels", labels, "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting projects from bootstrap agents labels", "labels", labels, "error", err.Error())
+		return nil, err
+	}
+	// projects the cluster is shared with are limited by the share policy,
+	// a share of the cluster itself takes precedence over its groups
+	shares, err := cs.GetClusterShares(ctx, req.ClusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("unable to get cluster shares", "cluster", req.ClusterID, "error", err)
 		return nil, err
 	}
+	sharePolicies := make(map[string]*infrav3.ProjectCluster)
+	for _, share := range append(groupPolicy.Shares, shares...) {
+		projects = appendProjects(projects, []string{share.ProjectID})
+		sharePolicies[share.ProjectID] = share
+	}
 
 	// get permissions in the cluster's projects
 	var projectPermissions map[string][]string
 	if !cnAttr.IsSSO {
-		projectPermissions, userName, err = getProjectPermissions(ctx, projects, accountID, orgID, partnerID, aps)
+		projectPermissions, userName, err = getProjectPermissions(ctx, projects, accountID, orgID, partnerID, clusterLabels, aps)
 	} else {
-		projectPermissions, userName, groups, err = getSSOProjectPermissions(ctx, projects, orgID, partnerID, accountID, aps, gps)
+		projectPermissions, userName, groups, err = getSSOProjectPermissions(ctx, projects, orgID, partnerID, accountID, clusterLabels, aps, gps)
 	}
 	if err != nil {
-		_log.Errorw("error getting project permission", "projects", projects, "userCN", req.UserCN, "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting project permission", "projects", projects, "userCN", req.UserCN, "error", err.Error())
 		return nil, err
 	}
 
@@ -499,10 +619,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			namespaces, err := ns.GetProjectNamespaces(ctx, uuid.MustParse(project))
 
 			if err != nil {
-				_log.Infow("error ", err.Error())
+				_log.WithContext(ctx).Infow("error ", err.Error())
 			}
 			if err == nil {
-				_log.Debugw("Get namespaces ", "project", project, "namespaces", namespaces, "itemslen", len(namespaces))
+				_log.WithContext(ctx).Debugw("Get namespaces ", "project", project, "namespaces", namespaces, "itemslen", len(namespaces))
 				nsl = append(nsl, namespaces...)
 			}
 		}
@@ -510,11 +630,11 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	}()
 
 	if err != nil {
-		_log.Debugw("unable to get project namespaces", "error", err)
+		_log.WithContext(ctx).Debugw("unable to get project namespaces", "error", err)
 		return nil, err
 	}
 
-	_log.Infow("projectNamespaces", "names", projectNamespaces)
+	_log.WithContext(ctx).Infow("projectNamespaces", "names", projectNamespaces)
 
 	for _, pm := range sentry.GetKubeConfigClusterPermissions() {
 		cr, err := getClusterRole(pm)
@@ -538,18 +658,21 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	rolePrevilage = -1
 	for project, permissions := range projectPermissions {
 		var namespaces []string
-		_log.Infow("authorization", "project", project, "user", sa.Name, "permissions", permissions)
-		groups = append(groups, permissions...)
 		// need to get the namesapces assigned to this user.
-		ns1, _ := getAccountProjectNamespace(ctx, project, accountID, ns)
-		ns2, _ := getGroupAccountProjectNamespace(ctx, project, accountID, ns)
+		ns1, _ := getAccountProjectNamespace(ctx, project, accountID, clusterLabels, ns)
+		ns2, _ := getGroupAccountProjectNamespace(ctx, project, accountID, clusterLabels, ns)
 		if len(ns1) > 0 {
 			namespaces = append(namespaces, ns1...)
 		}
 		if len(ns2) > 0 {
 			namespaces = append(namespaces, ns2...)
 		}
-		_log.Infow("namespaces", "project", project, "accountID", accountID, "namespaces", namespaces)
+		if project != "" {
+			permissions, namespaces = applySharePolicy(sharePolicies[project], permissions, namespaces)
+		}
+		_log.WithContext(ctx).Infow("authorization", "project", project, "user", sa.Name, "permissions", permissions)
+		groups = append(groups, permissions...)
+		_log.WithContext(ctx).Infow("namespaces", "project", project, "accountID", accountID, "namespaces", namespaces)
 
 		// org scope
 		if project == "" {
@@ -702,7 +825,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	resp.EnforceOrgAdminOnlySecretAccess = enforceOrgAdminOnlySecretAccess
 	resp.IsOrgAdmin = isOrgAdmin
 
-	_log.Infof("username %s", userName)
+	_log.WithContext(ctx).Infof("username %s", userName)
 
 	return resp, nil
 }
@@ -766,7 +889,7 @@ func isNamespaceScopePermission(permission string) bool {
 	return false
 }
 
-func verifyClusterKubectlSettings(ctx context.Context, bs service.BootstrapService, kcs service.KubectlClusterSettingsService, cnAttr kubeconfig.CNAttributes, clusterID string, orgID string) error {
+func verifyClusterKubectlSettings(ctx context.Context, bs service.BootstrapService, kcs service.KubectlClusterSettingsService, policy *service.ClusterGroupPolicy, cnAttr kubeconfig.CNAttributes, clusterID string, orgID string) error {
 	if cnAttr.SessionType == kubeconfig.ParalusSystem {
 		// internal system sessions are always allowed
 		return nil
@@ -774,22 +897,27 @@ func verifyClusterKubectlSettings(ctx context.Context, bs service.BootstrapServi
 
 	_, err := bs.GetBootstrapAgentCountForClusterID(ctx, clusterID, orgID)
 	if err != nil {
-		_log.Infow("verify cluster kubectl settings invalid clusterid or orgid", "cluster", clusterID, "orgID", orgID)
+		_log.WithContext(ctx).Infow("verify cluster kubectl settings invalid clusterid or orgid", "cluster", clusterID, "orgID", orgID)
 		return err
 	}
 
-	kc, err := kcs.Get(ctx, orgID, clusterID)
-	if err == constants.ErrNotFound {
-		// no settings found, hence there is no restriction.
-		return nil //allow
-	} else if err != nil {
+	// settings of the cluster groups apply in addition to the cluster settings
+	kc := &sentry.KubectlClusterSettings{
+		DisableWebKubectl: policy.DisableWebKubectl,
+		DisableCLIKubectl: policy.DisableCLIKubectl,
+	}
+	ckc, err := kcs.Get(ctx, orgID, clusterID)
+	if err == nil {
+		kc.DisableWebKubectl = kc.DisableWebKubectl || ckc.DisableWebKubectl
+		kc.DisableCLIKubectl = kc.DisableCLIKubectl || ckc.DisableCLIKubectl
+	} else if err != constants.ErrNotFound {
 		return err
 	}
 
 	if cnAttr.SessionType == "" || cnAttr.SessionType == kubeconfig.TerminalShell {
 		// backward compatibility treat "" as terminal session for old kubeconfigs
 		if kc.DisableCLIKubectl {
-			_log.Infow("kubectl cli is not authorized for ", "cnAttr", cnAttr)
+			_log.WithContext(ctx).Infow("kubectl cli is not authorized for ", "cnAttr", cnAttr)
 			return fmt.Errorf("kubectl cli is not authorized") //deny
 		}
 		return nil // allow
@@ -797,13 +925,13 @@ func verifyClusterKubectlSettings(ctx context.Context, bs service.BootstrapServi
 
 	if cnAttr.SessionType == kubeconfig.WebShell {
 		if kc.DisableWebKubectl {
-			_log.Infow("browser based kubectl is not authorized for ", "cnAttr", cnAttr)
+			_log.WithContext(ctx).Infow("browser based kubectl is not authorized for ", "cnAttr", cnAttr)
 			return fmt.Errorf("browser based kubectl is not authorized") //deny
 		}
 		return nil // allow
 	}
 
-	_log.Infow("unknown kubectl ", "SessionType", cnAttr.SessionType)
+	_log.WithContext(ctx).Infow("unknown kubectl ", "SessionType", cnAttr.SessionType)
 
 	return fmt.Errorf("unknown kubectl session type is not authorized")
 }
@@ -0,0 +1,117 @@
+package authz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/sentry/util"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+)
+
+func TestApplySharePolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		share          *infrav3.ProjectCluster
+		permissions    []string
+		namespaces     []string
+		wantPerms      []string
+		wantNamespaces []string
+	}{
+		{
+			name:           "owner project",
+			share:          &infrav3.ProjectCluster{Access: infrav3.ClusterShareAccess_FULL_ACCESS},
+			permissions:    []string{sentry.KubectlFullAccessPermission},
+			wantPerms:      []string{sentry.KubectlFullAccessPermission},
+			wantNamespaces: nil,
+		},
+		{
+			name:        "read only",
+			share:       &infrav3.ProjectCluster{Access: infrav3.ClusterShareAccess_READ_ONLY},
+			permissions: []string{sentry.KubectlFullAccessPermission, sentry.KubectlClusterReadPermission, sentry.KubectlNamespaceWritePermission},
+			namespaces:  []string{"web"},
+			wantPerms:   []string{sentry.KubectlClusterReadPermission, sentry.KubectlNamespaceReadPermission},
+			// namespaces are not restricted by a read only share
+			wantNamespaces: []string{"web"},
+		},
+		{
+			name:           "cluster scope restricted to namespaces",
+			share:          &infrav3.ProjectCluster{Access: infrav3.ClusterShareAccess_FULL_ACCESS, Namespaces: []string{"web", "api"}},
+			permissions:    []string{sentry.KubectlFullAccessPermission},
+			wantPerms:      []string{sentry.KubectlNamespaceWritePermission},
+			wantNamespaces: []string{"web", "api"},
+		},
+		{
+			name:           "namespaces intersected",
+			share:          &infrav3.ProjectCluster{Access: infrav3.ClusterShareAccess_READ_ONLY, Namespaces: []string{"web"}},
+			permissions:    []string{sentry.KubectlNamespaceWritePermission},
+			namespaces:     []string{"web", "db"},
+			wantPerms:      []string{sentry.KubectlNamespaceReadPermission},
+			wantNamespaces: []string{"web"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perms, namespaces := applySharePolicy(tt.share, tt.permissions, tt.namespaces)
+			if !reflect.DeepEqual(perms, tt.wantPerms) {
+				t.Errorf("expected permissions %v, got %v", tt.wantPerms, perms)
+			}
+			if !reflect.DeepEqual(namespaces, tt.wantNamespaces) {
+				t.Errorf("expected namespaces %v, got %v", tt.wantNamespaces, namespaces)
+			}
+		})
+	}
+}
+
+func TestGetUninstallSteps(t *testing.T) {
+	steps, err := GetUninstallSteps(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// kubectl cluster roles, default cluster role and agent namespace
+	if len(steps) != 7 {
+		t.Fatalf("expected 7 steps, got %d", len(steps))
+	}
+	if steps[5].Name != "step-clusterrole-relay-default-cluster-role" {
+		t.Errorf("unexpected default cluster role step %s", steps[5].Name)
+	}
+	// agent namespace is deleted last
+	if steps[6].Name != "step-namespace-paralus-system" {
+		t.Errorf("unexpected last step %s", steps[6].Name)
+	}
+}
+
+func TestGetUninstallStepsUsers(t *testing.T) {
+	steps, err := GetUninstallSteps([]string{"user@example.com"}, []string{"ns1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]int)
+	for i, st := range steps {
+		names[st.Name] = i
+	}
+	sa, ok := names["step-serviceaccount-"+util.SanitizeUsername("user@example.com")]
+	if !ok {
+		t.Fatal("expected service account step")
+	}
+	for _, name := range []string{
+		"step-clusterrolebinding-" + getClusterRoleBindingName(util.SanitizeUsername("user@example.com"), "full-access-cluster-role"),
+		"step-rolebinding-" + getRoleBindingName(util.SanitizeUsername("user@example.com"), "paralus-ns-role-write-ns1"),
+	} {
+		i, ok := names[name]
+		if !ok {
+			t.Errorf("expected step %s", name)
+			continue
+		}
+		// bindings are deleted before the service account
+		if i > sa {
+			t.Errorf("expected %s before service account", name)
+		}
+	}
+	if _, ok := names["step-role-paralus-ns-role-read-ns1"]; !ok {
+		t.Error("expected namespace role step")
+	}
+	if steps[len(steps)-1].Name != "step-namespace-paralus-system" {
+		t.Errorf("unexpected last step %s", steps[len(steps)-1].Name)
+	}
+}
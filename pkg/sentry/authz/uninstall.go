@@ -0,0 +1,94 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/paralus/paralus/pkg/converter"
+	"github.com/paralus/paralus/pkg/sentry/util"
+	"github.com/paralus/paralus/proto/types/controller"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// UninstallSelector selects the objects created for kubectl users, which
+// are deleted along with the uninstall steps
+var UninstallSelector = fmt.Sprintf("%s=true", paralusRelayLabel)
+
+// GetUninstallSteps returns the steps deleting the objects created when
+// the relay agent was installed and the service accounts, roles and their
+// bindings created for the kubectl users in namespaces. Bindings are
+// deleted before what they refer to, the namespace the agent runs in is
+// the last step.
+func GetUninstallSteps(users, namespaces []string) ([]*controller.StepTemplate, error) {
+	var clusterRoles []*rbacv1.ClusterRole
+	for _, permission := range permissions {
+		cr, err := getClusterRole(permission)
+		if err != nil {
+			return nil, err
+		}
+		clusterRoles = append(clusterRoles, cr)
+	}
+
+	var bindings, serviceAccounts, roles []runtime.Object
+	for _, user := range users {
+		sa := getDeleteServiceAccount(util.SanitizeUsername(user))
+		for _, cr := range clusterRoles {
+			bindings = append(bindings, getDeleteClusterRoleBinding(getClusterRoleBindingName(sa.Name, cr.Name)))
+		}
+		for _, namespace := range namespaces {
+			for _, permission := range namespaceScopePermissions {
+				roleName := getRoleName(namespace, permission)
+				bindings = append(bindings, getDeleteRoleBinding(getRoleBindingName(sa.Name, roleName), namespace))
+			}
+		}
+		serviceAccounts = append(serviceAccounts, sa)
+	}
+	for _, namespace := range namespaces {
+		for _, permission := range namespaceScopePermissions {
+			r, err := getRole(permission)
+			if err != nil {
+				return nil, err
+			}
+			setRoleValues(r, namespace, permission)
+			roles = append(roles, r)
+		}
+	}
+
+	cr, err := GetDefaultClusterRole()
+	if err != nil {
+		return nil, err
+	}
+	ns, err := GetNamespace()
+	if err != nil {
+		return nil, err
+	}
+	ns.Name = "paralus-system"
+	ns.Labels = nil
+
+	objects := append(bindings, serviceAccounts...)
+	objects = append(objects, roles...)
+	for _, kcr := range clusterRoles {
+		objects = append(objects, kcr)
+	}
+	objects = append(objects, cr, ns)
+
+	var steps []*controller.StepTemplate
+	for _, o := range objects {
+		st, err := converter.ToStepTemplate(o)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, &st)
+	}
+	return steps, nil
+}
+
+func getDeleteServiceAccount(name string) *corev1.ServiceAccount {
+	sa := &corev1.ServiceAccount{}
+	sa.APIVersion = "v1"
+	sa.Kind = "ServiceAccount"
+	sa.Name = name
+	sa.Namespace = "paralus-system"
+	return sa
+}
@@ -14,6 +14,9 @@ import (
 const (
 	ecKeyType  = "EC PRIVATE KEY"
 	rsaKeyType = "RSA PRIVATE KEY"
+	// pkcs8KeyType is accepted for keys generated outside of paralus,
+	// such as ed25519 keys
+	pkcs8KeyType = "PRIVATE KEY"
 )
 
 // PasswordFunc is the signature for passing password while
@@ -111,6 +114,8 @@ func DecodePrivateKey(privKey []byte, f PasswordFunc) (crypto.PrivateKey, error)
 		return x509.ParseECPrivateKey(b)
 	case rsaKeyType:
 		return x509.ParsePKCS1PrivateKey(b)
+	case pkcs8KeyType:
+		return x509.ParsePKCS8PrivateKey(b)
 	default:
 		return nil, fmt.Errorf("type %s is not suported", p.Type)
 	}
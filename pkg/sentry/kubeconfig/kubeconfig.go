@@ -71,7 +71,7 @@ func getProjectsForSSOAccount(ctx context.Context, groups []string, orgID, partn
 	isOrgScope := false
 	groupProjectPermissions, err := gps.GetGroupProjectsByPermission(ctx, groups, orgID, partnerID, kubeconfigPermission)
 	if err != nil {
-		_log.Errorw("error getting group project permissions", "permission", kubeconfigPermission, "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting group project permissions", "permission", kubeconfigPermission, "error", err.Error())
 		return nil, isOrgScope, err
 	}
 	projectsMap := make(map[string]string)
@@ -93,7 +93,7 @@ func getProjectsForAccount(ctx context.Context, accountID, orgID, partnerID stri
 	isOrgScope := false
 	accountProjectPermissions, err := aps.GetAccountProjectsByPermission(ctx, accountID, orgID, partnerID, kubeconfigPermission)
 	if err != nil {
-		_log.Errorw("error getting account project permissions", "permission", kubeconfigPermission, "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting account project permissions", "permission", kubeconfigPermission, "error", err.Error())
 		return nil, isOrgScope, err
 	}
 	projectsMap := make(map[string]string)
@@ -121,20 +121,20 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	}
 	batl, err := bs.SelectBootstrapAgentTemplates(ctx, query.WithSelector("paralus.dev/defaultUser=true"), query.WithGlobalScope())
 	if err != nil {
-		_log.Errorw("error getting default user bootstrap agent templates", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting default user bootstrap agent templates", "error", err.Error())
 		return nil, err
 	}
 
 	if len(batl.Items) < 1 {
-		_log.Errorw("no user bootstrap agent template found")
+		_log.WithContext(ctx).Errorw("no user bootstrap agent template found")
 		return nil, fmt.Errorf("no user bootstrap agent template found")
 	}
 
-	_log.Infow("get config for user ", "opts", opts)
+	_log.WithContext(ctx).Infow("get config for user ", "opts", opts)
 
 	bi, err := bs.GetBootstrapInfra(ctx, batl.Items[0].Spec.InfraRef)
 	if err != nil {
-		_log.Errorw("error getting bootstrap infra", "infraRef", batl.Items[0].Spec.InfraRef, "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting bootstrap infra", "infraRef", batl.Items[0].Spec.InfraRef, "error", err.Error())
 		return nil, err
 	}
 	isSSOAcc := opts.GetIsSSOUser()
@@ -147,7 +147,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	if sessionUserName == "" && opts.Account != "" {
 		accountData, err := aps.GetAccount(ctx, opts.Account)
 		if err != nil {
-			_log.Errorw("error getting account data", "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting account data", "error", err.Error())
 			return nil, err
 		}
 		sessionUserName = accountData.Username
@@ -155,20 +155,20 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	} else if sessionUserName == "" && opts.ID != "" {
 		apiKey, err := ksvc.GetByKey(ctx, &rpcv3.ApiKeyRequest{Id: opts.ID})
 		if err != nil {
-			_log.Errorw("error getting account data", "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting account data", "error", err.Error())
 			return nil, err
 		}
 		sessionUserName = apiKey.Name
 		username = apiKey.Name
 		opts.Account = apiKey.AccountID.String()
 	} else if sessionUserName == "" && opts.Account == "" {
-		_log.Errorw("error getting account data", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting account data", "error", err.Error())
 		return nil, fmt.Errorf("account information not present in request")
 	}
 
 	//validate if organization id or name is given, should support both
 	if opts.Organization == "" {
-		_log.Errorw("error getting organization data", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting organization data", "error", err.Error())
 		return nil, fmt.Errorf("organization information is missing in request")
 	}
 	oid, err := uuid.Parse(opts.Organization)
@@ -176,7 +176,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		//looks like name is provided, fetch org id
 		org, err := os.GetByName(ctx, opts.Organization)
 		if err != nil {
-			_log.Errorw("error getting organization data", "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting organization data", "error", err.Error())
 			return nil, fmt.Errorf("failed to retrieve organization %s", err.Error())
 		}
 		oid = uuid.MustParse(org.Metadata.Id)
@@ -185,14 +185,14 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	}
 
 	if opts.Partner == "" {
-		_log.Errorw("error getting partner data", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting partner data", "error", err.Error())
 		return nil, fmt.Errorf("partner information is missing in request")
 	}
 	_, err = uuid.Parse(opts.Partner)
 	if err != nil {
 		part, err := ps.GetByName(ctx, opts.Partner)
 		if err != nil {
-			_log.Errorw("error getting partner data", "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting partner data", "error", err.Error())
 			return nil, fmt.Errorf("failed to retrieve partner %s", err.Error())
 		}
 		opts.Partner = part.Metadata.Id
@@ -212,6 +212,9 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	if ks != nil && ks.EnableSessionCheck {
 		enforceSession = true
 	}
+	// clusters of relay networks are reached through the network only
+	// when private relay is enabled
+	privateRelay := ks != nil && ks.EnablePrivateRelay
 	// {"account": "", "username": "", "partner": "", "org": "", "project":, "sso":,  "enforceSession"}
 	// TODO: figure out how SSO works
 	// CN=account=<aid>/partner=<pid>/orgid=<id>/username=<un>
@@ -223,7 +226,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		EnforceSession: enforceSession,
 		Username:       util.SanitizeUsername(username),
 		SessionType:    TerminalShell,
-		RelayNetwork:   false,
+		RelayNetwork:   privateRelay,
 	}
 	cn := cnAttr.GetCN()
 
@@ -233,13 +236,13 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	if !isSSOAcc {
 		projects, isOrgScope, err = getProjectsForAccount(ctx, opts.Account, opts.Organization, opts.Partner, aps)
 		if err != nil {
-			_log.Errorw("error getting project for paralus ", "account", opts.Account, "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting project for paralus ", "account", opts.Account, "error", err.Error())
 			return nil, err
 		}
 	} else {
 		projects, isOrgScope, err = getProjectsForSSOAccount(ctx, groups, opts.Organization, opts.Partner, gps)
 		if err != nil {
-			_log.Errorw("error getting project for sso ", "account", opts.Account, "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting project for sso ", "account", opts.Account, "error", err.Error())
 			return nil, err
 		}
 	}
@@ -253,7 +256,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 			query.WithIgnoreScopeDefault(),
 		)
 		if err != nil {
-			_log.Errorw("error getting bootstrap agents", "error", err.Error())
+			_log.WithContext(ctx).Errorw("error getting bootstrap agents", "error", err.Error())
 			return nil, err
 		}
 		bas = bal.Items
@@ -273,7 +276,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 				query.WithIgnoreScopeDefault(),
 			)
 			if err != nil {
-				_log.Errorw("error getting bootstrap agents", "error", err.Error())
+				_log.WithContext(ctx).Errorw("error getting bootstrap agents", "error", err.Error())
 				return nil, err
 			}
 			for _, ba := range bal.Items {
@@ -312,7 +315,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	// get cert validity setting
 	certValidity, err := getCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
 	if err != nil {
-		_log.Errorw("error getting cert validity settings", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting cert validity settings", "error", err.Error())
 		return nil, err
 	}
 
@@ -321,9 +324,9 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		certValidity = 1 * time.Second
 	}
 
-	config, err := getUserConfig(ctx, *opts, username, req.Namespace, cn, serverHost, bi, bas, pf, certValidity, bs)
+	config, err := getUserConfig(ctx, *opts, username, req.Namespace, cn, serverHost, bi, bas, pf, certValidity, bs, privateRelay)
 	if err != nil {
-		_log.Errorw("error generating kubeconfig", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error generating kubeconfig", "error", err.Error())
 		return nil, err
 	}
 
@@ -460,7 +463,7 @@ func GetConfigForCluster(ctx context.Context, bs service.BootstrapService, req *
 	} else {
 		opts.Selector = fmt.Sprintf("!paralus.dev/cdRelayAgent")
 	}
-	_log.Infow("get config for cluster ", "opts", opts, "namespace", req.Namespace, "systemUser", req.SystemUser)
+	_log.WithContext(ctx).Infow("get config for cluster ", "opts", opts, "namespace", req.Namespace, "systemUser", req.SystemUser)
 	batl, err := bs.SelectBootstrapAgentTemplates(ctx, query.WithSelector("paralus.dev/defaultUser=true"), query.WithGlobalScope())
 	if err != nil {
 		return nil, err
@@ -542,7 +545,7 @@ func GetConfigForCluster(ctx context.Context, bs service.BootstrapService, req *
 
 	certValidity, err := getCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
 	if err != nil {
-		_log.Errorw("error getting cert validity settings", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting cert validity settings", "error", err.Error())
 		return nil, err
 	}
 
@@ -554,7 +557,7 @@ func GetConfigForCluster(ctx context.Context, bs service.BootstrapService, req *
 
 	config, err := getConfig(username, req.Namespace, cn, serverHost, bi, bal.Items, pf, certValidity, opts.Name)
 	if err != nil {
-		_log.Errorw("error generating kubeconfig", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error generating kubeconfig", "error", err.Error())
 		return nil, err
 	}
 
@@ -566,7 +569,7 @@ func GetConfigForCluster(ctx context.Context, bs service.BootstrapService, req *
 
 }
 
-func getUserConfig(ctx context.Context, opts commonv3.QueryOptions, username, namespace, certCN, serverHost string, bootstrapInfra *sentry.BootstrapInfra, bootstrapAgents []*sentry.BootstrapAgent, pf cryptoutil.PasswordFunc, certValidity time.Duration, bs service.BootstrapService) (*clientcmdapiv1.Config, error) {
+func getUserConfig(ctx context.Context, opts commonv3.QueryOptions, username, namespace, certCN, serverHost string, bootstrapInfra *sentry.BootstrapInfra, bootstrapAgents []*sentry.BootstrapAgent, pf cryptoutil.PasswordFunc, certValidity time.Duration, bs service.BootstrapService, privateRelay bool) (*clientcmdapiv1.Config, error) {
 
 	if namespace == "" {
 		namespace = "default"
@@ -625,6 +628,9 @@ func getUserConfig(ctx context.Context, opts commonv3.QueryOptions, username, na
 	// if a cluster is added to custom relay then exlude it from default
 	for _, ba := range bootstrapAgents {
 		if ba.Spec.TemplateRef != "paralus-core-relay-agent" && ba.Spec.TemplateRef != "paralus-core-cd-relay-agent" {
+			if !privateRelay {
+				continue
+			}
 			baMaps[ba.Metadata.Name] = *ba
 		} else {
 			if _, ok := baMaps[ba.Metadata.Name]; !ok {
@@ -633,30 +639,46 @@ func getUserConfig(ctx context.Context, opts commonv3.QueryOptions, username, na
 		}
 	}
 
+	// user hosts of the relay networks keyed by their agent template
+	networkHosts := make(map[string]string)
+
 	for _, ba := range baMaps {
+		host := ""
 		if ba.Spec.TemplateRef != "paralus-core-relay-agent" && ba.Spec.TemplateRef != "paralus-core-cd-relay-agent" {
-			// handle custome relay network
+			// handle custom relay network
+			networkHost, ok := networkHosts[ba.Spec.TemplateRef]
+			if !ok {
+				networkHost, err = getRelayNetworkUserHost(ctx, bs, ba.Spec.TemplateRef)
+				if err != nil {
+					return nil, err
+				}
+				networkHosts[ba.Spec.TemplateRef] = networkHost
+			}
+			if networkHost == "" {
+				_log.WithContext(ctx).Infow("skipping cluster of relay network without user host", "cluster", ba.Metadata.DisplayName, "template", ba.Spec.TemplateRef)
+				continue
+			}
+			host = strings.ReplaceAll(networkHost, "*", ba.Metadata.Name)
 		} else {
-
-			host := strings.ReplaceAll(serverHost, "*", ba.Metadata.Name)
-
-			clusters = append(clusters, clientcmdapiv1.NamedCluster{
-				Name: ba.Metadata.DisplayName,
-				Cluster: clientcmdapiv1.Cluster{
-					Server:                   fmt.Sprintf("https://%s", host),
-					CertificateAuthorityData: []byte(bootstrapInfra.Spec.CaCert),
-				},
-			})
-
-			contexts = append(contexts, clientcmdapiv1.NamedContext{
-				Name: ba.Metadata.DisplayName,
-				Context: clientcmdapiv1.Context{
-					Cluster:   ba.Metadata.DisplayName,
-					AuthInfo:  name,
-					Namespace: namespace,
-				},
-			})
+			host = strings.ReplaceAll(serverHost, "*", ba.Metadata.Name)
 		}
+
+		clusters = append(clusters, clientcmdapiv1.NamedCluster{
+			Name: ba.Metadata.DisplayName,
+			Cluster: clientcmdapiv1.Cluster{
+				Server:                   fmt.Sprintf("https://%s", host),
+				CertificateAuthorityData: []byte(bootstrapInfra.Spec.CaCert),
+			},
+		})
+
+		contexts = append(contexts, clientcmdapiv1.NamedContext{
+			Name: ba.Metadata.DisplayName,
+			Context: clientcmdapiv1.Context{
+				Cluster:   ba.Metadata.DisplayName,
+				AuthInfo:  name,
+				Namespace: namespace,
+			},
+		})
 	}
 
 	config := &clientcmdapiv1.Config{
@@ -673,3 +695,23 @@ func getUserConfig(ctx context.Context, opts commonv3.QueryOptions, username, na
 
 	return config, nil
 }
+
+// getRelayNetworkUserHost returns the external host of the user template
+// which connects users to the agents of agentTemplate
+func getRelayNetworkUserHost(ctx context.Context, bs service.BootstrapService, agentTemplate string) (string, error) {
+	batl, err := bs.SelectBootstrapAgentTemplates(ctx,
+		query.WithSelector(fmt.Sprintf("%s=%s", service.ConnectorAgentTemplateLabel, agentTemplate)),
+		query.WithGlobalScope(),
+	)
+	if err != nil {
+		return "", err
+	}
+	for _, bat := range batl.Items {
+		for _, host := range bat.Spec.Hosts {
+			if host.Type == sentry.BootstrapTemplateHostType_HostTypeExternal {
+				return host.Host, nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -112,7 +112,7 @@ func registerHTTP(ctx context.Context, config *Config) error {
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 
 	if bootstrapCA := os.Getenv("BOOTSTRAP_CA_CERT"); strings.TrimSpace(bootstrapCA) != "" {
-		_log.Infow("using rootCA for bootstrap")
+		_log.WithContext(ctx).Infow("using rootCA for bootstrap")
 		rootCAs := x509.NewCertPool()
 		decodedCA, err := base64.StdEncoding.DecodeString(bootstrapCA)
 		if err != nil {
@@ -125,7 +125,7 @@ func registerHTTP(ctx context.Context, config *Config) error {
 		}
 		transport.TLSClientConfig.RootCAs = rootCAs
 	} else if insecure := os.Getenv("ALLOW_INSECURE_BOOTSTRAP"); strings.TrimSpace(insecure) == "true" {
-		_log.Infow("using InsecureSkipVerify for bootstrap")
+		_log.WithContext(ctx).Infow("using InsecureSkipVerify for bootstrap")
 		transport.TLSClientConfig.InsecureSkipVerify = true
 	}
 
@@ -177,16 +177,17 @@ func prepareAccountPermissionResponse(aps models.AccountPermission) sentry.Accou
 		json.Unmarshal(aps.Urls, &urls)
 	}
 	return sentry.AccountPermission{
-		AccountID:      aps.AccountId.String(),
-		ProjectID:      aps.ProjectId.String(),
-		OrganizationID: aps.OrganizationId.String(),
-		PartnerID:      aps.PartnerId.String(),
-		RoleName:       aps.RoleName,
-		IsGlobal:       aps.IsGlobal,
-		Scope:          aps.Scope,
-		PermissionName: aps.PermissionName,
-		BaseURL:        aps.BaseUrl,
-		Urls:           urls,
+		AccountID:       aps.AccountId.String(),
+		ProjectID:       aps.ProjectId.String(),
+		OrganizationID:  aps.OrganizationId.String(),
+		PartnerID:       aps.PartnerId.String(),
+		RoleName:        aps.RoleName,
+		IsGlobal:        aps.IsGlobal,
+		Scope:           aps.Scope,
+		PermissionName:  aps.PermissionName,
+		BaseURL:         aps.BaseUrl,
+		Urls:            urls,
+		ClusterSelector: aps.ClusterSelector,
 	}
 }
 
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/alert"
+	"github.com/paralus/paralus/pkg/audit/forward"
+	"github.com/paralus/paralus/pkg/query"
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	auditv1 "github.com/paralus/paralus/proto/types/audit"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	alertRuleKind     = "AlertRule"
+	alertRuleListKind = "AlertRuleList"
+
+	defaultAlertRuleTimezone = "UTC"
+	// recent events of the organization a rule is tested against
+	alertRuleTestEvents = 1000
+	// matches returned by a test against the recent events
+	alertRuleTestMatches = 100
+)
+
+// AlertRuleService is the interface for audit alert rule operations
+type AlertRuleService interface {
+	// create alert rule
+	Create(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error)
+	// get alert rule
+	Get(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error)
+	// update alert rule
+	Update(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error)
+	// delete alert rule
+	Delete(ctx context.Context, rule *auditv1.AlertRule) error
+	// list alert rules of organization
+	List(ctx context.Context, opts ...query.Option) (*auditv1.AlertRuleList, error)
+	// test alert rule against a sample event or the recent events
+	Test(ctx context.Context, req *v1.AlertRuleTestRequest) (*v1.AlertRuleTestResponse, error)
+	// Sync loads the enabled alert rules into the alert engine
+	Sync(ctx context.Context) error
+}
+
+// alertRuleService implements AlertRuleService
+type alertRuleService struct {
+	db     *bun.DB
+	engine *alert.Engine
+	al     *zap.Logger
+	// databaseAudits is true when the audits are stored in the database,
+	// rules can be tested against the recent events only then
+	databaseAudits bool
+}
+
+// NewAlertRuleService return new alert rule service
+func NewAlertRuleService(db *bun.DB, engine *alert.Engine, al *zap.Logger, databaseAudits bool) AlertRuleService {
+	return &alertRuleService{db: db, engine: engine, al: al, databaseAudits: databaseAudits}
+}
+
+// validateAlertRuleSpec compiles the expression of the rule and checks
+// its notifier and timezone
+func (s *alertRuleService) validateAlertRuleSpec(spec *auditv1.AlertRuleSpec) (*alert.Expr, *time.Location, error) {
+	expr, err := alert.Compile(spec.GetExpression())
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid alert rule expression: %v", err)
+	}
+	if !s.engine.HasNotifier(spec.GetNotifier()) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid alert rule, notifier %q is not configured, available notifiers: %v",
+			spec.GetNotifier(), s.engine.Notifiers())
+	}
+	loc, err := alertRuleLocation(spec.GetTimezone())
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid alert rule timezone: %v", err)
+	}
+	return expr, loc, nil
+}
+
+func alertRuleLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		timezone = defaultAlertRuleTimezone
+	}
+	return time.LoadLocation(timezone)
+}
+
+func (s *alertRuleService) Create(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	if rule.GetMetadata().GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid alert rule, name is missing")
+	}
+	if _, _, err := s.validateAlertRuleSpec(rule.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	partnerID, orgID, err := s.getPartnerOrganization(ctx, rule.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":            rule.Metadata.Name,
+		"organization_id": orgID,
+		"trash":           false,
+	}, &models.AuditAlertRule{})
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "alert rule %s already exists", rule.Metadata.Name)
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	ar := &models.AuditAlertRule{
+		ID:             uuid.New(),
+		Name:           rule.Metadata.Name,
+		Description:    rule.Metadata.Description,
+		OrganizationId: orgID,
+		PartnerId:      partnerID,
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+	}
+	setAlertRuleSpec(ar, rule.Spec)
+
+	_, err = dao.Create(ctx, s.db, ar)
+	if err != nil {
+		return nil, err
+	}
+	s.syncAfterChange(ctx)
+
+	CreateAlertRuleAuditEvent(ctx, s.al, AuditActionCreate, ar.Name, ar.ID)
+
+	return prepareAlertRuleResponse(ar, rule.Metadata), nil
+}
+
+func (s *alertRuleService) Get(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	ar, err := s.getAlertRule(ctx, rule.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return prepareAlertRuleResponse(ar, rule.Metadata), nil
+}
+
+func (s *alertRuleService) Update(ctx context.Context, rule *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	if _, _, err := s.validateAlertRuleSpec(rule.GetSpec()); err != nil {
+		return nil, err
+	}
+	ar, err := s.getAlertRule(ctx, rule.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	ar.Description = rule.Metadata.Description
+	ar.ModifiedAt = time.Now()
+	setAlertRuleSpec(ar, rule.Spec)
+
+	_, err = dao.Update(ctx, s.db, ar.ID, ar)
+	if err != nil {
+		return nil, err
+	}
+	s.syncAfterChange(ctx)
+
+	CreateAlertRuleAuditEvent(ctx, s.al, AuditActionUpdate, ar.Name, ar.ID)
+
+	return prepareAlertRuleResponse(ar, rule.Metadata), nil
+}
+
+func (s *alertRuleService) Delete(ctx context.Context, rule *auditv1.AlertRule) error {
+	ar, err := s.getAlertRule(ctx, rule.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	err = dao.Delete(ctx, s.db, ar.ID, ar)
+	if err != nil {
+		return err
+	}
+	s.syncAfterChange(ctx)
+
+	CreateAlertRuleAuditEvent(ctx, s.al, AuditActionDelete, ar.Name, ar.ID)
+	return nil
+}
+
+func (s *alertRuleService) List(ctx context.Context, opts ...query.Option) (*auditv1.AlertRuleList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	meta := &commonv3.Metadata{
+		Partner:      queryOptions.Partner,
+		Organization: queryOptions.Organization,
+	}
+	_, orgID, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	ars, err := dao.ListAuditAlertRules(ctx, s.db, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &auditv1.AlertRuleList{
+		ApiVersion: constants.ApiVersion,
+		Kind:       alertRuleListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: int64(len(ars)),
+		},
+	}
+	for i := range ars {
+		list.Items = append(list.Items, prepareAlertRuleResponse(&ars[i], meta))
+	}
+	return list, nil
+}
+
+// Test evaluates the rule of the request against its sample event, or
+// against the recent events of the organization when there is none. The
+// saved rule of the name of the request is tested when it has no spec.
+func (s *alertRuleService) Test(ctx context.Context, req *v1.AlertRuleTestRequest) (*v1.AlertRuleTestResponse, error) {
+	_, orgID, err := s.getPartnerOrganization(ctx, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := req.GetSpec()
+	if spec.GetExpression() == "" {
+		if req.GetMetadata().GetName() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "either the spec or the name of an alert rule is required")
+		}
+		ar, err := s.getAlertRule(ctx, req.GetMetadata())
+		if err != nil {
+			return nil, err
+		}
+		spec = &auditv1.AlertRuleSpec{Expression: ar.Expression, Timezone: ar.Timezone}
+	}
+	expr, err := alert.Compile(spec.GetExpression())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid alert rule expression: %v", err)
+	}
+	loc, err := alertRuleLocation(spec.GetTimezone())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid alert rule timezone: %v", err)
+	}
+
+	if req.GetEvent() != nil {
+		source := req.GetSource()
+		if source == "" {
+			source = audit.SYSTEM
+		}
+		switch source {
+		case audit.SYSTEM, audit.KUBECTL_API, audit.KUBECTL_CMD:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event source %q", source)
+		}
+		data, err := protojson.Marshal(req.GetEvent())
+		if err != nil {
+			return nil, err
+		}
+		ev, err := forward.NewEvent(source, data)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event: %v", err)
+		}
+		return &v1.AlertRuleTestResponse{
+			Matched: expr.Match(ev, loc),
+			Fields:  alert.Fields(ev, loc),
+		}, nil
+	}
+
+	if !s.databaseAudits {
+		return nil, status.Errorf(codes.FailedPrecondition, "alert rules are tested against recent events only with database audit storage, test with a sample event instead")
+	}
+	logs, err := dao.GetRecentAuditLogs(ctx, s.db, orgID.String(), alertRuleTestEvents)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.AlertRuleTestResponse{Tested: int32(len(logs))}
+	for _, l := range logs {
+		ev, err := forward.NewEvent(l.Tag, l.Data)
+		if err != nil {
+			continue
+		}
+		if !expr.Match(ev, loc) {
+			continue
+		}
+		resp.Matched = true
+		if len(resp.Matches) >= alertRuleTestMatches {
+			continue
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal(l.Data, &data); err != nil {
+			continue
+		}
+		event, err := structpb.NewStruct(data)
+		if err != nil {
+			continue
+		}
+		resp.Matches = append(resp.Matches, &v1.AlertRuleTestMatch{
+			Source: l.Tag,
+			Time:   timestamppb.New(l.Time),
+			Event:  event,
+		})
+	}
+	return resp, nil
+}
+
+// Sync loads the enabled alert rules of all the organizations into the
+// alert engine. Rules which do not compile anymore are skipped.
+func (s *alertRuleService) Sync(ctx context.Context) error {
+	ars, err := dao.ListEnabledAuditAlertRules(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	rules := make([]*alert.Rule, 0, len(ars))
+	for _, ar := range ars {
+		expr, err := alert.Compile(ar.Expression)
+		if err != nil {
+			_log.WithContext(ctx).Warnw("skipping invalid alert rule", "rule", ar.Name, "error", err)
+			continue
+		}
+		loc, err := alertRuleLocation(ar.Timezone)
+		if err != nil {
+			_log.WithContext(ctx).Warnw("skipping alert rule with invalid timezone", "rule", ar.Name, "error", err)
+			continue
+		}
+		rules = append(rules, &alert.Rule{
+			ID:           ar.ID.String(),
+			Name:         ar.Name,
+			Organization: ar.OrganizationId.String(),
+			Notifier:     ar.Notifier,
+			Expr:         expr,
+			Location:     loc,
+		})
+	}
+	s.engine.SetRules(rules)
+	return nil
+}
+
+// syncAfterChange applies a change of the rules right away, the periodic
+// sync catches up when it fails
+func (s *alertRuleService) syncAfterChange(ctx context.Context) {
+	if err := s.Sync(ctx); err != nil {
+		_log.WithContext(ctx).Warnw("unable to sync alert rules", "error", err)
+	}
+}
+
+func (s *alertRuleService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerID, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner %s", meta.GetPartner())
+	}
+	orgID, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization %s", meta.GetOrganization())
+	}
+	return partnerID, orgID, nil
+}
+
+func (s *alertRuleService) getAlertRule(ctx context.Context, meta *commonv3.Metadata) (*models.AuditAlertRule, error) {
+	_, orgID, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	var ar models.AuditAlertRule
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":            meta.GetName(),
+		"organization_id": orgID,
+		"trash":           false,
+	}, &ar)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "alert rule %s not found", meta.GetName())
+		}
+		return nil, err
+	}
+	return &ar, nil
+}
+
+func setAlertRuleSpec(ar *models.AuditAlertRule, spec *auditv1.AlertRuleSpec) {
+	ar.Expression = spec.GetExpression()
+	ar.Notifier = spec.GetNotifier()
+	ar.Timezone = spec.GetTimezone()
+	if ar.Timezone == "" {
+		ar.Timezone = defaultAlertRuleTimezone
+	}
+	ar.Disabled = spec.GetDisabled()
+}
+
+func prepareAlertRuleResponse(ar *models.AuditAlertRule, meta *commonv3.Metadata) *auditv1.AlertRule {
+	return &auditv1.AlertRule{
+		ApiVersion: constants.ApiVersion,
+		Kind:       alertRuleKind,
+		Metadata: &commonv3.Metadata{
+			Id:           ar.ID.String(),
+			Name:         ar.Name,
+			Description:  ar.Description,
+			Partner:      meta.GetPartner(),
+			Organization: meta.GetOrganization(),
+			CreatedAt:    timestamppb.New(ar.CreatedAt),
+			ModifiedAt:   timestamppb.New(ar.ModifiedAt),
+		},
+		Spec: &auditv1.AlertRuleSpec{
+			Expression: ar.Expression,
+			Notifier:   ar.Notifier,
+			Timezone:   ar.Timezone,
+			Disabled:   ar.Disabled,
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/alert"
+	"github.com/paralus/paralus/pkg/audit/forward"
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	auditv1 "github.com/paralus/paralus/proto/types/audit"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func alertEngine() *alert.Engine {
+	return alert.NewEngine(map[string]*forward.Forwarder{
+		"oncall": forward.New("test.oncall", &nopDestination{}, forward.Filter{}, forward.Options{}),
+	})
+}
+
+type nopDestination struct{}
+
+func (nopDestination) Deliver(ctx context.Context, ev *forward.Event) error { return nil }
+func (nopDestination) Close() error                                         { return nil }
+
+func TestCreateAlertRuleValidation(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	s := NewAlertRuleService(db, alertEngine(), getLogger(), true)
+	for _, tc := range []struct {
+		name string
+		spec *auditv1.AlertRuleSpec
+	}{
+		{"expression", &auditv1.AlertRuleSpec{Expression: `user == "x"`, Notifier: "oncall"}},
+		{"notifier", &auditv1.AlertRuleSpec{Expression: `kind == "secrets"`, Notifier: "pager"}},
+		{"timezone", &auditv1.AlertRuleSpec{Expression: `kind == "secrets"`, Notifier: "oncall", Timezone: "Mars/Olympus"}},
+	} {
+		_, err := s.Create(context.Background(), &auditv1.AlertRule{
+			Metadata: &commonv3.Metadata{Name: "rule", Partner: "partner", Organization: "org"},
+			Spec:     tc.spec,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected invalid argument, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestTestAlertRuleSampleEvent(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT "partner"."id" FROM "authsrv_partner"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT "organization"."id" FROM "authsrv_organization"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+
+	event, err := structpb.NewStruct(map[string]interface{}{
+		"ts": "2022-01-01T22:30:00Z", "un": "dev@paralus.local", "m": "GET", "k": "secrets", "ns": "prod-web",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewAlertRuleService(db, alertEngine(), getLogger(), false)
+	resp, err := s.Test(context.Background(), &v1.AlertRuleTestRequest{
+		Metadata: &commonv3.Metadata{Partner: "partner", Organization: "org"},
+		Spec:     &auditv1.AlertRuleSpec{Expression: `kind == "secrets" and hour >= 18`},
+		Source:   audit.KUBECTL_API,
+		Event:    event,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Matched || resp.Fields["actor"] != "dev@paralus.local" || resp.Fields["hour"] != "22" {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %s", err)
+	}
+}
+
+func TestTestAlertRuleRecentEvents(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ouuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "partner"."id" FROM "authsrv_partner"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT "organization"."id" FROM "authsrv_organization"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ouuid))
+	mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(tag IN \('system', 'kubectl_api', 'kubectl_cmd'\)\) ` +
+		`AND \(CASE WHEN tag = 'kubectl_api' THEN data->>'o' ELSE data->>'organization' END = '` + ouuid + `'\) ` +
+		`ORDER BY "time" DESC, "id" DESC LIMIT 1000`).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id"}).
+			AddRow(audit.KUBECTL_API, "2022-01-01T22:31:00Z", []byte(`{"ts":"2022-01-01T22:31:00Z","m":"GET","url":"/api/v1/pods"}`), 2).
+			AddRow(audit.KUBECTL_API, "2022-01-01T22:30:00Z", []byte(`{"ts":"2022-01-01T22:30:00Z","m":"POST","url":"/api/v1/namespaces/web/pods/web-0/exec"}`), 1))
+
+	s := NewAlertRuleService(db, alertEngine(), getLogger(), true)
+	resp, err := s.Test(context.Background(), &v1.AlertRuleTestRequest{
+		Metadata: &commonv3.Metadata{Partner: "partner", Organization: "org"},
+		Spec:     &auditv1.AlertRuleSpec{Expression: `url matches "*/exec"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Matched || resp.Tested != 2 || len(resp.Matches) != 1 ||
+		resp.Matches[0].Event.Fields["url"].GetStringValue() != "/api/v1/namespaces/web/pods/web-0/exec" {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// audit chain issue kinds
+const (
+	AuditChainGap                 = "GAP"
+	AuditChainBrokenLink          = "BROKEN_LINK"
+	AuditChainHashMismatch        = "HASH_MISMATCH"
+	AuditChainCheckpointMismatch  = "CHECKPOINT_MISMATCH"
+	AuditChainCheckpointSignature = "CHECKPOINT_SIGNATURE"
+
+	// events loaded at once while walking the chain
+	auditChainPageSize = 1000
+)
+
+// AuditSignerFunc returns the key signing audit chain checkpoints
+type AuditSignerFunc func(ctx context.Context) (crypto.Signer, error)
+
+// AuditSignerFromPEM returns the dedicated signing key, PEM encoded and
+// optionally encrypted with the password of pf
+func AuditSignerFromPEM(key []byte, pf cryptoutil.PasswordFunc) AuditSignerFunc {
+	return func(ctx context.Context) (crypto.Signer, error) {
+		return decodeAuditSigner(key, pf)
+	}
+}
+
+// AuditSignerFromInfra returns the ca key of the bootstrap infra as the
+// signing key
+func AuditSignerFromInfra(bs BootstrapService, infra string, pf cryptoutil.PasswordFunc) AuditSignerFunc {
+	return func(ctx context.Context) (crypto.Signer, error) {
+		bi, err := bs.GetBootstrapInfra(ctx, infra)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get bootstrap infra %s: %w", infra, err)
+		}
+		return decodeAuditSigner([]byte(bi.GetSpec().GetCaKey()), pf)
+	}
+}
+
+func decodeAuditSigner(key []byte, pf cryptoutil.PasswordFunc) (crypto.Signer, error) {
+	pk, err := cryptoutil.DecodePrivateKey(key, pf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode audit signing key: %w", err)
+	}
+	signer, ok := pk.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("audit signing key %T cannot sign", pk)
+	}
+	return signer, nil
+}
+
+// AuditChainService checkpoints and verifies the hash chains of the audit
+// events written by the database sink
+type AuditChainService interface {
+	// Checkpoint signs the heads of the chains which moved since their
+	// last checkpoint
+	Checkpoint(ctx context.Context) error
+	// Verify walks the chain of the organization of the caller over the
+	// time range of req and reports gaps and tampered events. Relay
+	// audits are not chained, they are reported as unchained tags.
+	Verify(ctx context.Context, req *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error)
+}
+
+type auditChainService struct {
+	db     *bun.DB
+	signer AuditSignerFunc
+}
+
+// NewAuditChainService returns new audit chain service
+func NewAuditChainService(db *bun.DB, signer AuditSignerFunc) AuditChainService {
+	return &auditChainService{db: db, signer: signer}
+}
+
+func (s *auditChainService) Checkpoint(ctx context.Context) error {
+	heads, err := dao.GetAuditChainHeads(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	if len(heads) == 0 {
+		return nil
+	}
+	signer, err := s.signer(ctx)
+	if err != nil {
+		return err
+	}
+	keyID, err := audit.CheckpointKeyID(signer.Public())
+	if err != nil {
+		return err
+	}
+
+	for _, head := range heads {
+		last, err := dao.GetLastAuditCheckpoint(ctx, s.db, head.Organization)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil && last.Seq == head.Seq {
+			continue
+		}
+		sig, err := audit.SignCheckpoint(signer, head.Organization, head.Seq, head.Hash)
+		if err != nil {
+			return err
+		}
+		cp := &models.AuditCheckpoint{
+			Organization: head.Organization,
+			Seq:          head.Seq,
+			Hash:         head.Hash,
+			KeyID:        keyID,
+			Signature:    sig,
+		}
+		if _, err := s.db.NewInsert().Model(cp).Exec(ctx); err != nil {
+			return err
+		}
+		_log.WithContext(ctx).Infow("signed audit checkpoint", "organization", head.Organization, "seq", head.Seq)
+	}
+	return nil
+}
+
+func (s *auditChainService) Verify(ctx context.Context, req *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, errors.New("failed to get session data")
+	}
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from has to be before to")
+	}
+	return s.verify(ctx, sd.GetOrganization(), from, to)
+}
+
+// unchainedAuditTags are the tags of the events stored without a hash
+// chain, the relay audits are shipped by fluent-bit straight to the
+// database
+var unchainedAuditTags = []string{audit.KUBECTL_API, audit.KUBECTL_CMD}
+
+// chainVerifier accumulates the result of walking a chain
+type chainVerifier struct {
+	res  *v1.VerifyAuditLogResponse
+	prev *models.AuditLog
+	// events up to purged were removed by retention
+	purged int64
+	// hashes of the events at the checkpoints
+	hashes map[int64]string
+}
+
+func (v *chainVerifier) issue(kind string, seq int64, format string, args ...interface{}) {
+	v.res.Issues = append(v.res.Issues, &v1.AuditChainIssue{
+		Kind:    kind,
+		Seq:     seq,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// gap reports the events missing before seq
+func (v *chainVerifier) gap(first, seq int64) {
+	if first == seq-1 {
+		v.issue(AuditChainGap, first, "event %d is missing", first)
+	} else {
+		v.issue(AuditChainGap, first, "events %d to %d are missing", first, seq-1)
+	}
+}
+
+// walk checks the link and the hash of e, the events have to be walked
+// in chain order
+func (v *chainVerifier) walk(e *models.AuditLog, first int64) {
+	switch {
+	case v.prev == nil && e.Seq != first:
+		v.gap(first, e.Seq)
+	case v.prev != nil && e.Seq != v.prev.Seq+1:
+		v.gap(v.prev.Seq+1, e.Seq)
+	case v.prev != nil && e.PrevHash != v.prev.Hash:
+		v.issue(AuditChainBrokenLink, e.Seq, "event %d does not link to event %d", e.Seq, v.prev.Seq)
+	case e.Seq == 1 && e.PrevHash != "":
+		v.issue(AuditChainBrokenLink, e.Seq, "first event links to a previous event")
+	}
+
+	hash, err := audit.ChainHash(e.PrevHash, e.Seq, e.Tag, e.Time, e.Data)
+	if err != nil {
+		v.issue(AuditChainHashMismatch, e.Seq, "event %d is not valid json: %s", e.Seq, err)
+	} else if hash != e.Hash {
+		v.issue(AuditChainHashMismatch, e.Seq, "event %d was modified", e.Seq)
+	}
+	if _, ok := v.hashes[e.Seq]; ok {
+		v.hashes[e.Seq] = e.Hash
+	}
+	v.prev = e
+}
+
+func (s *auditChainService) verify(ctx context.Context, org string, from, to time.Time) (*v1.VerifyAuditLogResponse, error) {
+	head, err := dao.GetAuditChainHead(ctx, s.db, org)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	hasHead := err == nil
+
+	first, last, err := dao.GetAuditChainSeqRange(ctx, s.db, org, from, to)
+	if err != nil {
+		return nil, err
+	}
+	cps, err := dao.GetAuditCheckpoints(ctx, s.db, org, first, last, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &chainVerifier{
+		res:    &v1.VerifyAuditLogResponse{FirstSeq: first, LastSeq: last, UnchainedTags: unchainedAuditTags},
+		hashes: map[int64]string{},
+		purged: head.PurgedSeq,
+	}
+	for _, cp := range cps {
+		v.hashes[cp.Seq] = ""
+	}
+
+	if last > 0 {
+		// the event before the range anchors the link of the first one,
+		// unless it was purged
+		start := first
+		if start > 1 && start-1 > v.purged {
+			start--
+		}
+		for next := start; next <= last; {
+			page, err := dao.GetAuditChain(ctx, s.db, org, next, last, auditChainPageSize)
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				break
+			}
+			for i := range page {
+				v.walk(&page[i], start)
+				if page[i].Seq >= first {
+					v.res.Events++
+				}
+			}
+			next = page[len(page)-1].Seq + 1
+		}
+
+		// events deleted at the end of the range are only noticed by
+		// the head having moved past them
+		if hasHead && head.Seq > last {
+			after, err := dao.GetAuditChain(ctx, s.db, org, last+1, last+1, 1)
+			if err != nil {
+				return nil, err
+			}
+			if len(after) == 0 {
+				v.gap(last+1, last+2)
+			}
+		}
+	}
+
+	if err := s.verifyCheckpoints(ctx, v, org, cps); err != nil {
+		return nil, err
+	}
+	v.res.Verified = len(v.res.Issues) == 0
+	return v.res, nil
+}
+
+// verifyCheckpoints checks the signatures of cps and that the chain still
+// has the signed hashes
+func (s *auditChainService) verifyCheckpoints(ctx context.Context, v *chainVerifier, org string, cps []models.AuditCheckpoint) error {
+	if len(cps) == 0 {
+		return nil
+	}
+	signer, err := s.signer(ctx)
+	if err != nil {
+		return err
+	}
+	pub := signer.Public()
+	keyID, err := audit.CheckpointKeyID(pub)
+	if err != nil {
+		return err
+	}
+
+	for _, cp := range cps {
+		v.res.Checkpoints++
+		if cp.KeyID != keyID {
+			v.issue(AuditChainCheckpointSignature, cp.Seq, "checkpoint %d is signed by unknown key %s", cp.Seq, cp.KeyID)
+		} else if err := audit.VerifyCheckpoint(pub, org, cp.Seq, cp.Hash, cp.Signature); err != nil {
+			v.issue(AuditChainCheckpointSignature, cp.Seq, "checkpoint %d: %s", cp.Seq, err)
+		}
+
+		hash := v.hashes[cp.Seq]
+		if hash == "" {
+			// outside of the walked events
+			e, err := dao.GetAuditChain(ctx, s.db, org, cp.Seq, cp.Seq, 1)
+			if err != nil {
+				return err
+			}
+			if len(e) == 0 && cp.Seq <= v.purged {
+				continue
+			}
+			if len(e) == 0 {
+				v.issue(AuditChainCheckpointMismatch, cp.Seq, "event %d of checkpoint is missing", cp.Seq)
+				continue
+			}
+			hash = e[0].Hash
+		}
+		if hash != cp.Hash {
+			v.issue(AuditChainCheckpointMismatch, cp.Seq, "event %d does not match its checkpoint", cp.Seq)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+// buildAuditChain returns n events of org chained like the database sink
+// does
+func buildAuditChain(t *testing.T, org string, n int) []models.AuditLog {
+	var logs []models.AuditLog
+	prev := ""
+	ts := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	for seq := int64(1); seq <= int64(n); seq++ {
+		data := []byte(fmt.Sprintf(`{"organization":%q,"n":%d}`, org, seq))
+		hash, err := audit.ChainHash(prev, seq, audit.SYSTEM, ts, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		logs = append(logs, models.AuditLog{
+			Tag: audit.SYSTEM, Time: ts, Data: data, ID: seq,
+			Organization: org, Seq: seq, PrevHash: prev, Hash: hash,
+		})
+		prev = hash
+		ts = ts.Add(time.Second)
+	}
+	return logs
+}
+
+func auditChainRows(logs []models.AuditLog) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"tag", "time", "data", "id", "organization", "seq", "prev_hash", "hash"})
+	for _, l := range logs {
+		rows.AddRow(l.Tag, l.Time, []byte(l.Data), l.ID, l.Organization, l.Seq, l.PrevHash, l.Hash)
+	}
+	return rows
+}
+
+func checkpointRow(t *testing.T, signer crypto.Signer, org string, seq int64, hash string) *sqlmock.Rows {
+	sig, err := audit.SignCheckpoint(signer, org, seq, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyID, _ := audit.CheckpointKeyID(signer.Public())
+	return sqlmock.NewRows([]string{"id", "organization", "seq", "hash", "key_id", "signature"}).
+		AddRow(1, org, seq, hash, keyID, sig)
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := func(ctx context.Context) (crypto.Signer, error) { return key, nil }
+	org := "org-1"
+
+	t.Run("intact", func(t *testing.T) {
+		db, mock := getDB(t)
+		defer db.Close()
+		logs := buildAuditChain(t, org, 5)
+
+		mock.ExpectQuery(`SELECT .* FROM "audit_chain_heads" AS "ach" WHERE \(organization = 'org-1'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"organization", "seq", "hash"}).AddRow(org, 5, logs[4].Hash))
+		mock.ExpectQuery(`SELECT min\(seq\) AS min, max\(seq\) AS max FROM "audit_logs" WHERE \(organization = 'org-1'\) AND \(seq IS NOT NULL\) AND \(time >= '2022-01-02 03:04:06\+00:00'\)$`).
+			WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(2, 5))
+		mock.ExpectQuery(`SELECT .* FROM "audit_checkpoints" AS "acp" WHERE \(organization = 'org-1'\) AND \(\(seq BETWEEN 2 AND 5\) OR \(created_at >= .*\)\) ORDER BY "seq", "id"`).
+			WillReturnRows(checkpointRow(t, key, org, 4, logs[3].Hash))
+		mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(organization = 'org-1'\) AND \(seq BETWEEN 1 AND 5\) ORDER BY "seq" LIMIT 1000`).
+			WillReturnRows(auditChainRows(logs))
+
+		s := &auditChainService{db: db, signer: signer}
+		res, err := s.verify(context.Background(), org, logs[1].Time, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Verified || len(res.Issues) != 0 {
+			t.Errorf("expected intact chain to verify, got %v", res.Issues)
+		}
+		if res.Events != 4 || res.FirstSeq != 2 || res.LastSeq != 5 || res.Checkpoints != 1 {
+			t.Errorf("unexpected result %v", res)
+		}
+		if strings.Join(res.UnchainedTags, ",") != "kubectl_api,kubectl_cmd" {
+			t.Errorf("expected relay audits to be reported as unchained, got %v", res.UnchainedTags)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		db, mock := getDB(t)
+		defer db.Close()
+		logs := buildAuditChain(t, org, 5)
+		// event 3 is edited, event 4 and the newest event 6 are deleted
+		logs[2].Data = []byte(`{"organization":"org-1","n":33}`)
+		walked := append(append([]models.AuditLog{}, logs[:3]...), logs[4])
+
+		mock.ExpectQuery(`SELECT .* FROM "audit_chain_heads"`).
+			WillReturnRows(sqlmock.NewRows([]string{"organization", "seq", "hash"}).AddRow(org, 6, "h6"))
+		mock.ExpectQuery(`SELECT min\(seq\) AS min, max\(seq\) AS max FROM "audit_logs"`).
+			WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(1, 5))
+		// the chain was rewritten after event 5 was checkpointed
+		mock.ExpectQuery(`SELECT .* FROM "audit_checkpoints"`).
+			WillReturnRows(checkpointRow(t, key, org, 5, "rewritten"))
+		mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(organization = 'org-1'\) AND \(seq BETWEEN 1 AND 5\)`).
+			WillReturnRows(auditChainRows(walked))
+		mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(organization = 'org-1'\) AND \(seq BETWEEN 6 AND 6\)`).
+			WillReturnRows(auditChainRows(nil))
+
+		s := &auditChainService{db: db, signer: signer}
+		res, err := s.verify(context.Background(), org, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Verified {
+			t.Error("expected tampered chain to fail verification")
+		}
+		expected := []struct {
+			kind string
+			seq  int64
+		}{
+			{AuditChainHashMismatch, 3},
+			{AuditChainGap, 4},
+			{AuditChainGap, 6},
+			{AuditChainCheckpointMismatch, 5},
+		}
+		if len(res.Issues) != len(expected) {
+			t.Fatalf("expected %d issues, got %v", len(expected), res.Issues)
+		}
+		for i, e := range expected {
+			if res.Issues[i].Kind != e.kind || res.Issues[i].Seq != e.seq {
+				t.Errorf("issue %d: expected %s at %d, got %v", i, e.kind, e.seq, res.Issues[i])
+			}
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("purged", func(t *testing.T) {
+		db, mock := getDB(t)
+		defer db.Close()
+		logs := buildAuditChain(t, org, 5)
+
+		// events 1 to 2 and the checkpoint at 2 are past retention
+		mock.ExpectQuery(`SELECT .* FROM "audit_chain_heads"`).
+			WillReturnRows(sqlmock.NewRows([]string{"organization", "seq", "hash", "purged_seq"}).AddRow(org, 5, logs[4].Hash, 2))
+		mock.ExpectQuery(`SELECT min\(seq\) AS min, max\(seq\) AS max FROM "audit_logs"`).
+			WillReturnRows(sqlmock.NewRows([]string{"min", "max"}).AddRow(3, 5))
+		mock.ExpectQuery(`SELECT .* FROM "audit_checkpoints"`).
+			WillReturnRows(checkpointRow(t, key, org, 2, logs[1].Hash))
+		mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(organization = 'org-1'\) AND \(seq BETWEEN 3 AND 5\)`).
+			WillReturnRows(auditChainRows(logs[2:]))
+		mock.ExpectQuery(`SELECT .* FROM "audit_logs" AS "auditlog" WHERE \(organization = 'org-1'\) AND \(seq BETWEEN 2 AND 2\)`).
+			WillReturnRows(auditChainRows(nil))
+
+		s := &auditChainService{db: db, signer: signer}
+		res, err := s.verify(context.Background(), org, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Verified || res.Events != 3 || res.Checkpoints != 1 {
+			t.Errorf("expected purged chain to verify, got %v", res)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -23,8 +23,8 @@ const (
 	ProjectAuditLogPermission   = "project.auditLog.read"
 )
 
-func NewAuditLogElasticSearchService(url string, auditPattern string, logPrefix string, db *bun.DB) (AuditLogService, error) {
-	auditQuery, err := NewElasticSearchQuery(url, auditPattern, logPrefix)
+func NewAuditLogElasticSearchService(url string, auditPattern string, logPrefix string, version string, db *bun.DB) (AuditLogService, error) {
+	auditQuery, err := NewElasticSearchQuery(url, auditPattern, logPrefix, version)
 	if err != nil {
 		return nil, err
 	}
@@ -38,14 +38,15 @@ func NewAuditLogDatabaseService(db *bun.DB, tag string) (AuditLogService, error)
 type RelayAuditService interface {
 	GetRelayAudit(ctx context.Context, req *v1.RelayAuditRequest) (res *v1.RelayAuditResponse, err error)
 	GetRelayAuditByProjects(ctx context.Context, req *v1.RelayAuditRequest) (res *v1.RelayAuditResponse, err error)
+	GetRelaySessions(ctx context.Context, req *v1.RelaySessionRequest) (*v1.RelaySessionResponse, error)
 }
 
 func NewRelayAuditDatabaseService(db *bun.DB, tag string) (RelayAuditService, error) {
 	return &relayAuditDatabaseService{db: db, tag: tag}, nil
 }
 
-func NewRelayAuditElasticSearchService(url string, auditPattern string, logPrefix string, db *bun.DB) (RelayAuditService, error) {
-	relayQuery, err := NewElasticSearchQuery(url, auditPattern, logPrefix)
+func NewRelayAuditElasticSearchService(url string, auditPattern string, logPrefix string, version string, db *bun.DB) (RelayAuditService, error) {
+	relayQuery, err := NewElasticSearchQuery(url, auditPattern, logPrefix, version)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +60,7 @@ func ValidateUserAuditReadRequest(ctx context.Context, projects []string, db *bu
 	if !ok {
 		return errors.New("failed to get session data")
 	}
-	_log.Infow("fetching auditlogs", "account", sd)
+	_log.WithContext(ctx).Infow("fetching auditlogs", "account", sd)
 
 	// let's check if user has organization scoped roles associated
 	isOrgAdmin, err := dao.IsOrgAdmin(ctx, db, uuid.MustParse(sd.Account), uuid.MustParse(sd.Partner))
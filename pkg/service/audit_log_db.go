@@ -6,6 +6,8 @@ import (
 
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit/auditql"
+	"github.com/paralus/paralus/pkg/query"
 	v1 "github.com/paralus/paralus/proto/rpc/audit"
 	auditv1 "github.com/paralus/paralus/proto/types/audit"
 	"github.com/uptrace/bun"
@@ -54,8 +56,10 @@ func buildDataSource(logs []models.AuditLog) (ds []*auditv1.DataSource) {
 }
 
 func (a *auditLogDatabaseService) GetAuditLogByProjects(ctx context.Context, req *v1.GetAuditLogSearchRequest) (res *v1.GetAuditLogSearchResponse, err error) {
-	err = validateQueryString(req.GetFilter().QueryString)
-	if err != nil {
+	if _, err := compileAuditQuery(req.GetFilter().QueryString, auditql.ForTag(a.tag)); err != nil {
+		return nil, err
+	}
+	if _, err := validateAuditPage(req.GetFilter()); err != nil {
 		return nil, err
 	}
 
@@ -66,7 +70,7 @@ func (a *auditLogDatabaseService) GetAuditLogByProjects(ctx context.Context, req
 		}
 	}
 
-	auditLogs, err := dao.GetAuditLogs(ctx, a.db, a.tag, req.Filter)
+	auditLogs, next, err := dao.GetAuditLogs(ctx, a.db, a.tag, req.Filter)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +118,8 @@ func (a *auditLogDatabaseService) GetAuditLogByProjects(ctx context.Context, req
 
 	result, _ := structpb.NewStruct(resMap)
 	res = &v1.GetAuditLogSearchResponse{
-		Result: result,
+		Result:        result,
+		NextPageToken: query.EncodeAuditCursor(next),
 	}
 	return res, nil
 }
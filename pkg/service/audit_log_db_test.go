@@ -9,11 +9,15 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/audit"
 	"github.com/paralus/paralus/pkg/common"
 	eventv1 "github.com/paralus/paralus/proto/rpc/audit"
 	auditv1 "github.com/paralus/paralus/proto/types/audit"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const project = "projectone"
@@ -74,19 +78,19 @@ func testGetAuditLogForLastHour(tag string) func(t *testing.T) {
 		}
 
 		uuid := uuid.New().String()
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 			WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now())`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data", "auditlog"."id", "auditlog"."organization", "auditlog"."seq", "auditlog"."prev_hash", "auditlog"."hash" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now())`)).
 			WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data"}).AddRow("system", time.Now(), auditrecord))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "cluster"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "username"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "type"))
 
 		sd := commonv3.SessionData{
@@ -149,19 +153,19 @@ func testGetAuditLogForDay(tag string) func(t *testing.T) {
 		}
 
 		uuid := uuid.New().String()
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 			WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now())`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data", "auditlog"."id", "auditlog"."organization", "auditlog"."seq", "auditlog"."prev_hash", "auditlog"."hash" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now())`)).
 			WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data"}).AddRow(tag, time.Now(), auditrecord).AddRow(tag, time.Now(), auditrecordtwo))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "project"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "username"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "type"))
 
 		sd := commonv3.SessionData{
@@ -224,19 +228,19 @@ func testGetKubectlCommands(tag string) func(t *testing.T) {
 		}
 
 		uuid := uuid.New().String()
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 			WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now())`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data", "auditlog"."id", "auditlog"."organization", "auditlog"."seq", "auditlog"."prev_hash", "auditlog"."hash" FROM "audit_logs" AS "auditlog" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now())`)).
 			WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data"}).AddRow(tag, time.Now(), auditrecord).AddRow(tag, time.Now(), auditrecordtwo))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'project' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'project'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "project"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->'actor'->'account'->>'username' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->'actor'->'account'->>'username'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "username"))
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' = '` + project + `') AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count, data->>'type' as key FROM "audit_logs" WHERE (tag = '` + tag + `') AND (data->>'project' IN ('` + project + `')) AND (time between now() - interval '` + timefrom + `' and now()) GROUP BY data->>'type'`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, "type"))
 
 		sd := commonv3.SessionData{
@@ -273,3 +277,146 @@ func testGetKubectlCommands(tag string) func(t *testing.T) {
 		}
 	}
 }
+
+func TestGetAuditLogPages(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	as, err := NewAuditLogDatabaseService(db, audit.SYSTEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2022, 11, 21, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	newest := from.Add(2 * time.Hour)
+	req := &eventv1.GetAuditLogSearchRequest{
+		Filter: &eventv1.AuditLogQueryFilter{
+			Projects: []string{"one", "two"},
+			Clusters: []string{"c1", "c2"},
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			PageSize: 1,
+		},
+	}
+
+	uuid := uuid.New().String()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data", "auditlog"."id", "auditlog"."organization", "auditlog"."seq", "auditlog"."prev_hash", "auditlog"."hash" FROM "audit_logs" AS "auditlog" WHERE (tag = 'system') AND (data->>'project' IN ('one', 'two')) AND (data->'detail'->'meta'->>'cluster_name' IN ('c1', 'c2')) AND (time >= '2022-11-21 00:00:00+00:00') AND (time < '2022-11-22 00:00:00+00:00') ORDER BY "time" DESC, "id" DESC LIMIT 2`)).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id"}).
+			AddRow(audit.SYSTEM, newest, "{}", 7).
+			AddRow(audit.SYSTEM, from.Add(time.Hour), "{}", 6))
+	for range []string{"project", "username", "type"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}))
+	}
+
+	sd := commonv3.SessionData{Account: uuid, Organization: uuid, Partner: uuid, Username: "user"}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &sd)
+	res, err := as.GetAuditLogByProjects(ctx, req)
+	if err != nil {
+		t.Fatal("could not get audit logs:", err)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+
+	req.Filter.PageToken = res.NextPageToken
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
+	mock.ExpectQuery(regexp.QuoteMeta(`AND ((time, id) < ('2022-11-21 02:00:00+00:00', 7)) ORDER BY "time" DESC, "id" DESC LIMIT 2`)).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id"}).
+			AddRow(audit.SYSTEM, from.Add(time.Hour), "{}", 6))
+	for range []string{"project", "username", "type"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(1) as count`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}))
+	}
+	res, err = as.GetAuditLogByProjects(ctx, req)
+	if err != nil {
+		t.Fatal("could not get audit logs:", err)
+	}
+	if res.NextPageToken != "" {
+		t.Errorf("expected last page, got token %q", res.NextPageToken)
+	}
+}
+
+func TestGetAuditLogInvalidPage(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	as, err := NewAuditLogDatabaseService(db, audit.SYSTEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	for _, filter := range []*eventv1.AuditLogQueryFilter{
+		{From: timestamppb.New(now), To: timestamppb.New(now.Add(-time.Hour))},
+		{PageToken: "not-a-token"},
+	} {
+		_, err := as.GetAuditLogByProjects(context.Background(), &eventv1.GetAuditLogSearchRequest{Filter: filter})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected invalid argument for %v, got %v", filter, err)
+		}
+	}
+}
+
+func TestGetAuditLogQuery(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	as, err := NewAuditLogDatabaseService(db, audit.SYSTEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &eventv1.GetAuditLogSearchRequest{
+		Filter: &eventv1.AuditLogQueryFilter{
+			QueryString: `user:bob AND NOT cluster:prod*`,
+		},
+	}
+	predicate := `(COALESCE(data #>> '{actor,account,username}' = 'bob', FALSE) AND NOT (COALESCE(data #>> '{detail,meta,cluster_name}' LIKE 'prod%', FALSE)))`
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "audit_logs" AS "auditlog" WHERE (tag = 'system') AND (` + predicate + `) ORDER BY`)).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id"}))
+	for _, field := range []string{"project", "username", "type"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE (tag = 'system') AND (` + predicate + `) GROUP BY`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "key"}).AddRow(1, field))
+	}
+	if _, err := as.GetAuditLogByProjects(context.Background(), req); err != nil {
+		t.Fatal("could not get audit logs:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	for _, q := range []string{`user:bob and type:x`, `method:GET`, `user:"bob`} {
+		req.Filter.QueryString = q
+		_, err := as.GetAuditLogByProjects(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected invalid argument for %q, got %v", q, err)
+		}
+	}
+}
+
+func TestBuildDataSourceV2(t *testing.T) {
+	logs := []models.AuditLog{{
+		Tag:  audit.SYSTEM,
+		Time: time.Now(),
+		Data: []byte(`{"version":"2.0","type":"project.update.success","request_id":"req-1",` +
+			`"actor":{"type":"USER","account":{"username":"bob"},"partner_id":"partner-id","organization_id":"org-id"},` +
+			`"resource":{"kind":"Project","id":"id-1","name":"p1"},` +
+			`"diff":[{"path":"metadata.description","before":"\"old\"","after":"\"new\""}]}`),
+	}}
+	ds := buildDataSource(logs)
+	if len(ds) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(ds))
+	}
+	data := ds[0].XSource.Json
+	if data.RequestId != "req-1" || data.Actor.PartnerId != "partner-id" || data.Actor.OrganizationId != "org-id" {
+		t.Errorf("unexpected event %v", data)
+	}
+	if r := data.Resource; r.GetKind() != "Project" || r.GetId() != "id-1" || r.GetName() != "p1" {
+		t.Errorf("unexpected resource %v", r)
+	}
+	if len(data.Diff) != 1 || data.Diff[0].Path != "metadata.description" || data.Diff[0].After != `"new"` {
+		t.Errorf("unexpected diff %v", data.Diff)
+	}
+}
@@ -6,9 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/paralus/paralus/pkg/audit/auditql"
+	"github.com/paralus/paralus/pkg/query"
 	v1 "github.com/paralus/paralus/proto/rpc/audit"
 	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -29,14 +34,85 @@ func (a *auditLogElasticSearchService) GetAuditLog(ctx context.Context, req *v1.
 	return a.GetAuditLogByProjects(ctx, req)
 }
 
-func validateQueryString(queryString string) error {
-	if strings.Contains(queryString, "*") {
-		return fmt.Errorf("'*' is not supported in search query")
+// compileAuditQuery compiles the queryString filter against the fields of
+// schema, the query is nil when there is no filter
+func compileAuditQuery(queryString string, schema *auditql.Schema) (*auditql.Query, error) {
+	q, err := auditql.Compile(queryString, schema)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+	return q, nil
+}
+
+// validateAuditPage checks the absolute time range and page token of
+// filters
+func validateAuditPage(filters query.QueryFilters) (*query.AuditCursor, error) {
+	if err := query.ValidateAuditTimeRange(filters); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	after, err := query.DecodeAuditCursor(filters.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return after, nil
+}
+
+// esSort orders hits newest first on field, the unique field tiebreaker
+// breaks ties of equal timestamps so that search_after pages are stable.
+// The document order is not stable across shards and refreshes, it is
+// only used without a tiebreaker.
+func esSort(field, tiebreaker string) []map[string]interface{} {
+	if tiebreaker == "" {
+		return []map[string]interface{}{
+			{field: map[string]interface{}{"order": "desc"}},
+			{"_doc": map[string]interface{}{"order": "desc"}},
+		}
+	}
+	return []map[string]interface{}{
+		{field: map[string]interface{}{"order": "desc"}},
+		// events written before they had ids are sorted last
+		{tiebreaker: map[string]interface{}{"order": "desc", "unmapped_type": "keyword", "missing": "_last"}},
+	}
+}
+
+// esTimeRange returns the range filter on field for the relative timefrom
+// and absolute from and to of filters, nil without any bound
+func esTimeRange(filters query.QueryFilters, field string) map[string]interface{} {
+	rng := map[string]interface{}{}
+	if filters.GetTimefrom() != "" {
+		rng["gte"] = filters.GetTimefrom()
+		rng["lt"] = "now"
+	}
+	if filters.GetFrom() != nil {
+		rng["gte"] = filters.GetFrom().AsTime().Format(time.RFC3339Nano)
+	}
+	if filters.GetTo() != nil {
+		rng["lt"] = filters.GetTo().AsTime().Format(time.RFC3339Nano)
+	}
+	if len(rng) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"range": map[string]interface{}{
+			field: rng,
+		},
 	}
-	if len(queryString) > 0 && len(queryString) < 3 {
-		return fmt.Errorf("search string has to be atleast 3 characters")
+}
+
+// esNextPageToken returns the page token continuing after the last hit of
+// a full page of search result r
+func esNextPageToken(r map[string]interface{}, size int) string {
+	hits, _ := r["hits"].(map[string]interface{})
+	items, _ := hits["hits"].([]interface{})
+	if size == 0 || len(items) < size {
+		return ""
+	}
+	last, _ := items[len(items)-1].(map[string]interface{})
+	sort, _ := last["sort"].([]interface{})
+	if len(sort) == 0 {
+		return ""
 	}
-	return nil
+	return query.EncodeAuditCursor(&query.AuditCursor{SortValues: sort})
 }
 
 func getProjectFromUrlScope(urlScope string) (string, error) {
@@ -49,7 +125,7 @@ func getProjectFromUrlScope(urlScope string) (string, error) {
 }
 
 func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context, req *v1.GetAuditLogSearchRequest) (res *v1.GetAuditLogSearchResponse, err error) {
-	err = validateQueryString(req.GetFilter().QueryString)
+	auditQuery, err := compileAuditQuery(req.GetFilter().QueryString, auditql.Events)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +135,13 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 			return nil, err
 		}
 	}
+	after, err := validateAuditPage(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	size := query.AuditPageSize(req.GetFilter())
+	clusters := query.AuditClusters(req.GetFilter())
+	timeRange := esTimeRange(req.GetFilter(), "json.timestamp")
 	res = &v1.GetAuditLogSearchResponse{
 		Result: &structpb.Struct{},
 	}
@@ -66,7 +149,9 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 	var r map[string]interface{}
 	query := map[string]interface{}{
 		"_source": []string{"json"},
-		"size":    500,
+		"size":    size,
+		// 7.x and later count only up to 10000 hits by default
+		"track_total_hits": true,
 		"query": map[string]interface{}{
 			"bool": map[string]interface{}{
 				"must": []map[string]interface{}{
@@ -78,11 +163,7 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 				},
 			},
 		},
-		"sort": map[string]interface{}{
-			"json.timestamp": map[string]interface{}{
-				"order": "desc",
-			},
-		},
+		"sort": esSort("json.timestamp", "json.id"),
 		"aggs": map[string]interface{}{
 			"group_by_username": map[string]interface{}{
 				"terms": map[string]interface{}{
@@ -130,17 +211,13 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 	//Results not required in case of dashboard - only aggregations required
 	if req.GetFilter().DashboardData {
 		query["size"] = 0
+		size = 0
+	} else if after != nil && len(after.SortValues) > 0 {
+		query["search_after"] = after.SortValues
 	}
-	//	Add timefrom filter
-	if req.GetFilter().Timefrom != "" {
-		b["filter"] = map[string]interface{}{
-			"range": map[string]interface{}{
-				"json.timestamp": map[string]interface{}{
-					"gte": req.GetFilter().Timefrom,
-					"lt":  "now",
-				},
-			},
-		}
+	//	Add time range filter
+	if timeRange != nil {
+		b["filter"] = timeRange
 	}
 	// Add type
 	if req.GetFilter().Type != "" {
@@ -169,6 +246,15 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 		}
 		m = append(m, t)
 	}
+	// Clusters
+	if len(clusters) > 0 {
+		t := map[string]interface{}{
+			"terms": map[string]interface{}{
+				"json.detail.meta.cluster_name": clusters,
+			},
+		}
+		m = append(m, t)
+	}
 	// Project
 	if len(req.GetFilter().Projects) > 0 {
 		t := map[string]interface{}{
@@ -179,20 +265,15 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 		m = append(m, t)
 	}
 	// query string
-	if req.GetFilter().QueryString != "" {
-		q := map[string]interface{}{
-			"query_string": map[string]interface{}{
-				"query": req.GetFilter().QueryString,
-			},
-		}
-		m = append(m, q)
+	if auditQuery != nil {
+		m = append(m, auditQuery.ES("json."))
 	}
 	b["must"] = m
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
-		_log.Errorw("Error encoding query:", " err", err)
+		_log.WithContext(ctx).Errorw("Error encoding query:", " err", err)
 		return res, err
 	}
-	_log.Debug("Executing Query: ", q)
+	_log.WithContext(ctx).Debug("Executing Query: ", q)
 	r, err = a.auditQuery.Handle(buf)
 	if err != nil {
 		return res, err
@@ -208,6 +289,6 @@ func (a *auditLogElasticSearchService) GetAuditLogByProjects(ctx context.Context
 	if err != nil {
 		return res, err
 	}
-	res = &v1.GetAuditLogSearchResponse{Result: raw}
+	res = &v1.GetAuditLogSearchResponse{Result: raw, NextPageToken: esNextPageToken(r, size)}
 	return res, nil
 }
@@ -66,26 +66,25 @@ type md struct {
 				Terms struct {
 					JSONProject []string `json:"json.project"`
 				} `json:"terms,omitempty"`
-				QueryString struct {
-					Query string `json:"query"`
-				} `json:"query_string,omitempty"`
 			} `json:"must"`
 		} `json:"bool"`
 	} `json:"query"`
 	Size int `json:"size"`
-	Sort struct {
-		JSONTimestamp struct {
-			Order string `json:"order"`
-		} `json:"json.timestamp"`
+	Sort []map[string]struct {
+		Order string `json:"order"`
 	} `json:"sort"`
 }
 
 type mockElasticSearchQuery struct {
 	msg []bytes.Buffer
+	res map[string]interface{}
 }
 
 func (m *mockElasticSearchQuery) Handle(msg bytes.Buffer) (map[string]interface{}, error) {
 	m.msg = append(m.msg, msg)
+	if m.res != nil {
+		return m.res, nil
+	}
 	return map[string]interface{}{}, nil
 }
 
@@ -98,7 +97,7 @@ func TestGetAuditLogByProjectsSimple(t *testing.T) {
 	al := &auditLogElasticSearchService{auditQuery: esq, db: db}
 	req := v1.GetAuditLogSearchRequest{
 		Filter: &v1.AuditLogQueryFilter{
-			QueryString:   "query-string",
+			QueryString:   "detail.message:\"query string\"",
 			Projects:      []string{"project-one", "project-two"},
 			Timefrom:      "now-1h",
 			Type:          "fake-type",
@@ -108,7 +107,7 @@ func TestGetAuditLogByProjectsSimple(t *testing.T) {
 		},
 	}
 	uuid := uuid.New().String()
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
 	sd := v3.SessionData{
@@ -131,7 +130,7 @@ func TestGetAuditLogByProjectsSimple(t *testing.T) {
 	if err != nil {
 		t.Fatal("unable to unmarshall es request")
 	}
-	expected := `{"_source":["json"],"aggs":{"group_by_project":{"aggs":{"group_by_type":{"terms":{"field":"json.type","size":1000}},"group_by_username":{"terms":{"field":"json.actor.account.username","size":1000}}},"terms":{"field":"json.project","size":1000}},"group_by_type":{"terms":{"field":"json.type"}},"group_by_username":{"terms":{"field":"json.actor.account.username"}}},"query":{"bool":{"filter":{"range":{"json.timestamp":{"gte":"now-1h","lt":"now"}}},"must":[{"term":{"json.category":"AUDIT"}},{"term":{"json.type":"fake-type"}},{"term":{"json.actor.account.username":"fake-user"}},{"term":{"json.client.type":"fake-client"}},{"terms":{"json.project":["project-one","project-two"]}},{"query_string":{"query":"query-string"}}]}},"size":0,"sort":{"json.timestamp":{"order":"desc"}}}`
+	expected := `{"_source":["json"],"aggs":{"group_by_project":{"aggs":{"group_by_type":{"terms":{"field":"json.type","size":1000}},"group_by_username":{"terms":{"field":"json.actor.account.username","size":1000}}},"terms":{"field":"json.project","size":1000}},"group_by_type":{"terms":{"field":"json.type"}},"group_by_username":{"terms":{"field":"json.actor.account.username"}}},"query":{"bool":{"filter":{"range":{"json.timestamp":{"gte":"now-1h","lt":"now"}}},"must":[{"term":{"json.category":"AUDIT"}},{"term":{"json.type":"fake-type"}},{"term":{"json.actor.account.username":"fake-user"}},{"term":{"json.client.type":"fake-client"}},{"terms":{"json.project":["project-one","project-two"]}},{"term":{"json.detail.message":"query string"}}]}},"size":0,"sort":[{"json.timestamp":{"order":"desc"}},{"json.id":{"missing":"_last","order":"desc","unmapped_type":"keyword"}}],"track_total_hits":true}`
 	if strings.TrimSpace(esq.msg[0].String()) != expected {
 		t.Errorf("incorrect es query; expected '%v', got '%v'", expected, strings.TrimSpace(esq.msg[0].String()))
 	}
@@ -146,11 +145,11 @@ func TestGetAuditLogByProjectsNoProject(t *testing.T) {
 	req := v1.GetAuditLogSearchRequest{
 		Metadata: &v3.Metadata{UrlScope: "url/project"},
 		Filter: &v1.AuditLogQueryFilter{
-			QueryString: "query-string",
+			QueryString: "detail.message:\"query string\"",
 		},
 	}
 	uuid := uuid.New().String()
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
 	sd := v3.SessionData{
@@ -173,7 +172,7 @@ func TestGetAuditLogByProjectsNoProject(t *testing.T) {
 		t.Fatal("unable to unmarshall es request")
 	}
 
-	expected := `{"_source":["json"],"aggs":{"group_by_type":{"terms":{"field":"json.type"}},"group_by_username":{"terms":{"field":"json.actor.account.username"}}},"query":{"bool":{"must":[{"term":{"json.category":"AUDIT"}},{"terms":{"json.project":["project"]}},{"query_string":{"query":"query-string"}}]}},"size":500,"sort":{"json.timestamp":{"order":"desc"}}}`
+	expected := `{"_source":["json"],"aggs":{"group_by_type":{"terms":{"field":"json.type"}},"group_by_username":{"terms":{"field":"json.actor.account.username"}}},"query":{"bool":{"must":[{"term":{"json.category":"AUDIT"}},{"terms":{"json.project":["project"]}},{"term":{"json.detail.message":"query string"}}]}},"size":500,"sort":[{"json.timestamp":{"order":"desc"}},{"json.id":{"missing":"_last","order":"desc","unmapped_type":"keyword"}}],"track_total_hits":true}`
 	if strings.TrimSpace(esq.msg[0].String()) != expected {
 		t.Errorf("incorrect es query; expected '%v', got '%v'", expected, strings.TrimSpace(esq.msg[0].String()))
 	}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+const (
+	// events removed at once
+	auditRetentionBatchSize = 5000
+	// days partitions of audit_logs are created ahead
+	auditPartitionsAhead = 7
+	// advisory lock held by the replica running retention
+	auditRetentionLock = 7283265
+)
+
+// auditRetentionTags are the tags of the audit logs with a retention
+var auditRetentionTags = []string{audit.SYSTEM, audit.KUBECTL_CMD, audit.KUBECTL_API}
+
+// AuditRetentionOptions configure the retention of the audit logs stored
+// in the database
+type AuditRetentionOptions struct {
+	// Days the events of a tag are kept unless the organization of the
+	// event overrides it, zero keeps them forever
+	Days map[string]int
+	// ArchiveDir receives the expired events as gzip compressed NDJSON
+	// before they are removed, they are not archived when empty
+	ArchiveDir string
+}
+
+// ParseAuditRetention parses the default retention days of the tags,
+// e.g. system=365,kubectl_cmd=90,kubectl_api=30
+func ParseAuditRetention(s string) (map[string]int, error) {
+	days := make(map[string]int)
+	for _, kv := range strings.Split(s, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		tag := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || !isAuditRetentionTag(tag) {
+			return nil, fmt.Errorf("invalid audit retention %q, expected <tag>=<days> with tag one of %s",
+				kv, strings.Join(auditRetentionTags, ", "))
+		}
+		d, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid audit retention days %q of %s", parts[1], tag)
+		}
+		days[tag] = d
+	}
+	return days, nil
+}
+
+func isAuditRetentionTag(tag string) bool {
+	for _, t := range auditRetentionTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuditRetention checks the audit retention settings of an
+// organization
+func validateAuditRetention(r *systemv3.AuditRetention) error {
+	if r.GetSystemDays() < 0 || r.GetKubectlCmdDays() < 0 || r.GetKubectlApiDays() < 0 {
+		return fmt.Errorf("audit retention days cannot be negative")
+	}
+	return nil
+}
+
+// AuditRetentionService maintains the daily partitions of audit_logs and
+// removes the events past their retention
+type AuditRetentionService interface {
+	// Run creates the upcoming partitions, then archives and removes the
+	// expired events. Expired partitions are dropped as a whole.
+	Run(ctx context.Context) error
+}
+
+type auditRetentionService struct {
+	db   *bun.DB
+	al   *zap.Logger
+	opts AuditRetentionOptions
+	now  func() time.Time
+}
+
+// NewAuditRetentionService returns new audit retention service
+func NewAuditRetentionService(db *bun.DB, al *zap.Logger, opts AuditRetentionOptions) AuditRetentionService {
+	return &auditRetentionService{db: db, al: al, opts: opts, now: time.Now}
+}
+
+// auditRetentionPolicy holds the days the events of a tag are kept
+type auditRetentionPolicy struct {
+	tag  string
+	days int
+	// days of the organizations overriding the default
+	orgs map[string]int
+}
+
+func (s *auditRetentionService) Run(ctx context.Context) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// replicas take turns, the others skip this run
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(?)", auditRetentionLock).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(?)", auditRetentionLock)
+
+	now := s.now().UTC()
+	for i := 0; i <= auditPartitionsAhead; i++ {
+		day := now.AddDate(0, 0, i)
+		if err := s.createPartition(ctx, day); err != nil {
+			_log.WithContext(ctx).Errorw("unable to create audit log partition", "partition", dao.AuditLogPartition(day), "error", err)
+		}
+	}
+
+	policies, err := s.policies(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.dropPartitions(ctx, now, policies); err != nil {
+		return err
+	}
+	for _, p := range policies {
+		var overrides []string
+		for org := range p.orgs {
+			overrides = append(overrides, org)
+		}
+		sort.Strings(overrides)
+		for _, org := range overrides {
+			if err := s.purge(ctx, p.tag, org, []string{org}, false, now.AddDate(0, 0, -p.orgs[org])); err != nil {
+				return err
+			}
+		}
+		if p.days > 0 {
+			if err := s.purge(ctx, p.tag, "", overrides, true, now.AddDate(0, 0, -p.days)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// policies returns the retention of the tags with the overrides of the
+// organizations
+func (s *auditRetentionService) policies(ctx context.Context) ([]auditRetentionPolicy, error) {
+	var orgs []models.Organization
+	err := s.db.NewSelect().Model(&orgs).
+		Column("id", "settings").
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []auditRetentionPolicy
+	for _, tag := range auditRetentionTags {
+		policies = append(policies, auditRetentionPolicy{tag: tag, days: s.opts.Days[tag], orgs: map[string]int{}})
+	}
+	for _, org := range orgs {
+		var settings systemv3.OrganizationSettings
+		if org.Settings != nil {
+			json.Unmarshal(org.Settings, &settings)
+		}
+		r := settings.GetAuditRetention()
+		for i, days := range []int32{r.GetSystemDays(), r.GetKubectlCmdDays(), r.GetKubectlApiDays()} {
+			if days > 0 {
+				policies[i].orgs[org.ID.String()] = int(days)
+			}
+		}
+	}
+	return policies, nil
+}
+
+// createPartition creates the partition of audit_logs of day. When the
+// default partition already holds events of the day the partition can
+// only be created by moving them out of the default partition.
+func (s *auditRetentionService) createPartition(ctx context.Context, day time.Time) error {
+	err := dao.CreateAuditLogPartition(ctx, s.db, day)
+	if err == nil {
+		return nil
+	}
+	_log.WithContext(ctx).Infow("moving audit logs of the default partition", "partition", dao.AuditLogPartition(day), "error", err)
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return dao.CreateAuditLogPartitionFromDefault(ctx, tx, day)
+	})
+}
+
+// dropPartitions drops the partitions past the longest retention, a
+// partition holds the events of all the tags and organizations
+func (s *auditRetentionService) dropPartitions(ctx context.Context, now time.Time, policies []auditRetentionPolicy) error {
+	longest := 0
+	for _, p := range policies {
+		if p.days <= 0 {
+			// kept forever
+			return nil
+		}
+		if p.days > longest {
+			longest = p.days
+		}
+		for _, days := range p.orgs {
+			if days > longest {
+				longest = days
+			}
+		}
+	}
+
+	days, err := dao.GetAuditLogPartitions(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	cutoff := now.AddDate(0, 0, -longest)
+	for _, day := range days {
+		if day.AddDate(0, 0, 1).After(cutoff) {
+			break
+		}
+		if err := s.dropPartition(ctx, day); err != nil {
+			s.createEvent("audit.retention.partition.drop.failure", "",
+				fmt.Sprintf("Unable to drop audit log partition %s", dao.AuditLogPartition(day)),
+				map[string]string{"partition": dao.AuditLogPartition(day), "error": err.Error()})
+			return err
+		}
+	}
+	return nil
+}
+
+// dropPartition archives the events of the partition of day and drops it
+func (s *auditRetentionService) dropPartition(ctx context.Context, day time.Time) error {
+	name := dao.AuditLogPartition(day)
+	var arc *audit.Archive
+	if s.opts.ArchiveDir != "" {
+		var err error
+		if arc, err = audit.CreateArchive(s.opts.ArchiveDir, name); err != nil {
+			return err
+		}
+	}
+
+	purged := make(map[string]int64)
+	var n int
+	for last := int64(0); ; {
+		logs, err := dao.GetAuditLogPartitionLogs(ctx, s.db, day, last, auditRetentionBatchSize)
+		if err == nil && arc != nil {
+			for i := range logs {
+				if err = arc.Write(&logs[i]); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			if arc != nil {
+				arc.Abort()
+			}
+			return err
+		}
+		for _, l := range logs {
+			if l.Seq > purged[l.Organization] {
+				purged[l.Organization] = l.Seq
+			}
+			last = l.ID
+		}
+		n += len(logs)
+		if len(logs) < auditRetentionBatchSize {
+			break
+		}
+	}
+	if arc != nil {
+		if err := arc.Close(); err != nil {
+			return err
+		}
+	}
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := setAuditChainsPurged(ctx, tx, purged); err != nil {
+			return err
+		}
+		return dao.DropAuditLogPartition(ctx, tx, day)
+	})
+	if err != nil {
+		return err
+	}
+
+	meta := map[string]string{"partition": name, "events": strconv.Itoa(n)}
+	if arc != nil {
+		meta["archive"] = arc.Path()
+	}
+	_log.WithContext(ctx).Infow("dropped audit log partition", "partition", name, "events", n)
+	s.createEvent("audit.retention.partition.drop.success", "",
+		fmt.Sprintf("Audit log partition %s dropped with %d events", name, n), meta)
+	return nil
+}
+
+// purge archives and removes the events of tag older than before, of org
+// or, with exclude, of the organizations without an override
+func (s *auditRetentionService) purge(ctx context.Context, tag, org string, orgs []string, exclude bool, before time.Time) error {
+	label := org
+	if label == "" {
+		label = "default"
+	}
+	var (
+		total    int
+		archives []string
+	)
+	for {
+		var n int
+		err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			logs, err := dao.DeleteAuditLogs(ctx, tx, tag, orgs, exclude, before, auditRetentionBatchSize)
+			if err != nil || len(logs) == 0 {
+				return err
+			}
+			purged := make(map[string]int64)
+			for _, l := range logs {
+				if l.Seq > purged[l.Organization] {
+					purged[l.Organization] = l.Seq
+				}
+			}
+			if err := setAuditChainsPurged(ctx, tx, purged); err != nil {
+				return err
+			}
+
+			// the events are only removed once they are archived
+			if s.opts.ArchiveDir != "" {
+				name := fmt.Sprintf("audit_logs_%s_%s_%s", tag, label, s.now().UTC().Format("20060102T150405.000000000"))
+				arc, err := audit.CreateArchive(s.opts.ArchiveDir, name)
+				if err != nil {
+					return err
+				}
+				for i := range logs {
+					if err := arc.Write(&logs[i]); err != nil {
+						arc.Abort()
+						return err
+					}
+				}
+				if err := arc.Close(); err != nil {
+					return err
+				}
+				archives = append(archives, arc.Path())
+			}
+			n = len(logs)
+			return nil
+		})
+		if err != nil {
+			s.createEvent("audit.retention.purge.failure", org,
+				fmt.Sprintf("Unable to purge %s audit logs before %s", tag, before.Format(time.RFC3339)),
+				map[string]string{"tag": tag, "before": before.Format(time.RFC3339), "error": err.Error()})
+			return err
+		}
+		total += n
+		if n < auditRetentionBatchSize {
+			break
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	meta := map[string]string{
+		"tag":    tag,
+		"before": before.Format(time.RFC3339),
+		"events": strconv.Itoa(total),
+	}
+	if len(archives) > 0 {
+		meta["archive"] = strings.Join(archives, ",")
+	}
+	_log.WithContext(ctx).Infow("purged audit logs", "tag", tag, "organization", label, "events", total)
+	s.createEvent("audit.retention.purge.success", org,
+		fmt.Sprintf("%d %s audit logs before %s purged", total, tag, before.Format(time.RFC3339)), meta)
+	return nil
+}
+
+// setAuditChainsPurged moves the purge marks of the chains
+func setAuditChainsPurged(ctx context.Context, db bun.IDB, purged map[string]int64) error {
+	for org, seq := range purged {
+		if seq == 0 {
+			continue
+		}
+		if err := dao.SetAuditChainPurged(ctx, db, org, seq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createEvent audits a retention action, org is empty for the actions
+// spanning organizations
+func (s *auditRetentionService) createEvent(eventType, org, message string, meta map[string]string) {
+	event := &audit.Event{
+		Type:   eventType,
+		Portal: "OPS",
+		Actor: &audit.EventActor{
+			Type:    "SYSTEM",
+			Account: audit.EventActorAccount{Username: "paralus"},
+		},
+		Client: &audit.EventClient{
+			Type:      "SYSTEM",
+			IP:        "-",
+			UserAgent: "-",
+			Host:      "-",
+		},
+		Detail: &audit.EventDetail{
+			Message: message,
+			Meta:    meta,
+		},
+	}
+	audit.CreateEvent(s.al, event,
+		audit.WithVersion(audit.VersionV1),
+		audit.WithCategory(audit.AuditCategory),
+		audit.WithOrigin(audit.OriginCore),
+		audit.WithOrganization(org),
+	)
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/audit"
+)
+
+func TestParseAuditRetention(t *testing.T) {
+	days, err := ParseAuditRetention("system=365, kubectl_cmd=90,kubectl_api=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if days[audit.SYSTEM] != 365 || days[audit.KUBECTL_CMD] != 90 || days[audit.KUBECTL_API] != 0 {
+		t.Errorf("unexpected retention %v", days)
+	}
+	for _, invalid := range []string{"system", "system=-1", "other=10", "system=1d"} {
+		if _, err := ParseAuditRetention(invalid); err == nil {
+			t.Errorf("expected %q to be rejected", invalid)
+		}
+	}
+}
+
+func readArchive(t *testing.T, path string) []audit.ArchiveRecord {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []audit.ArchiveRecord
+	sc := bufio.NewScanner(gz)
+	for sc.Scan() {
+		var r audit.ArchiveRecord
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestAuditRetentionCreatePartitionFromDefault(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	s := &auditRetentionService{db: db, al: getLogger()}
+
+	// the default partition holds events of the day
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "audit_logs_p20220310" PARTITION OF audit_logs`).
+		WillReturnError(errors.New("updated partition constraint for default partition would be violated by some row"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`ALTER TABLE audit_logs DETACH PARTITION "audit_logs_default"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE TABLE "audit_logs_p20220310" PARTITION OF audit_logs FOR VALUES FROM \('2022-03-10'\) TO \('2022-03-11'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "audit_logs_p20220310" \(tag, time, data, id, organization, seq, prev_hash, hash\) SELECT tag, time, data, id, organization, seq, prev_hash, hash FROM "audit_logs_default" WHERE time >= '2022-03-10 00:00:00\+00:00' AND time < '2022-03-11 00:00:00\+00:00'`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM "audit_logs_default" WHERE time >= '2022-03-10 00:00:00\+00:00' AND time < '2022-03-11 00:00:00\+00:00'`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`ALTER TABLE audit_logs ATTACH PARTITION "audit_logs_default" DEFAULT`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	if err := s.createPartition(context.Background(), time.Date(2022, 3, 10, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuditRetentionRun(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+	dir := t.TempDir()
+	org := uuid.New().String()
+
+	s := &auditRetentionService{
+		db: db,
+		al: getLogger(),
+		opts: AuditRetentionOptions{
+			Days:       map[string]int{audit.SYSTEM: 30, audit.KUBECTL_CMD: 30, audit.KUBECTL_API: 60},
+			ArchiveDir: dir,
+		},
+		now: func() time.Time { return time.Date(2022, 3, 10, 12, 0, 0, 0, time.UTC) },
+	}
+
+	mock.ExpectQuery(`SELECT pg_try_advisory_lock\(7283265\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "audit_logs_p20220310" PARTITION OF audit_logs FOR VALUES FROM \('2022-03-10'\) TO \('2022-03-11'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 1; i <= auditPartitionsAhead; i++ {
+		mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "audit_logs_p202203` + time.Date(2022, 3, 10+i, 0, 0, 0, 0, time.UTC).Format("02") + `"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	// the organization keeps kubectl api audits for a week
+	mock.ExpectQuery(`SELECT "organization"."id", "organization"."settings" FROM "authsrv_organization" AS "organization" WHERE \(trash = FALSE\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "settings"}).
+			AddRow(org, []byte(`{"auditRetention":{"kubectl_api_days":7}}`)))
+
+	// partitions older than the longest retention of 60 days are dropped
+	mock.ExpectQuery(`SELECT c.relname FROM pg_inherits AS i JOIN pg_class AS c ON c.oid = i.inhrelid WHERE \(i.inhparent = 'audit_logs'::regclass\) AND \(c.relname LIKE 'audit_logs_p%'\) ORDER BY c.relname`).
+		WillReturnRows(sqlmock.NewRows([]string{"relname"}).AddRow("audit_logs_p20220101").AddRow("audit_logs_p20220301"))
+	mock.ExpectQuery(`SELECT .* FROM "audit_logs_p20220101" AS auditlog WHERE \(id > 0\) ORDER BY "id" LIMIT 5000`).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id", "organization", "seq", "prev_hash", "hash"}).
+			AddRow(audit.SYSTEM, time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC), []byte(`{"n":1}`), 10, org, 4, "h3", "h4").
+			AddRow(audit.KUBECTL_API, time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC), []byte(`{"n":2}`), 11, nil, nil, nil, nil))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "audit_chain_heads" AS "ach" SET purged_seq = greatest\(purged_seq, 4\) WHERE \(organization = '` + org + `'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DROP TABLE IF EXISTS "audit_logs_p20220101"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// expired events of the partitions which are kept are deleted
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM "audit_logs" AS "auditlog" WHERE \(tag = 'system'\) AND \(time < '2022-02-08 12:00:00\+00:00'\) AND \(\(time, id\) IN \(SELECT "time", "id" FROM "audit_logs" WHERE \(tag = 'system'\) AND \(time < '2022-02-08 12:00:00\+00:00'\) ORDER BY "time", "id" LIMIT 5000\)\) RETURNING \*`).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "time", "data", "id", "organization", "seq", "prev_hash", "hash"}).
+			AddRow(audit.SYSTEM, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC), []byte(`{"n":3}`), 20, org, 9, "h8", "h9"))
+	mock.ExpectExec(`UPDATE "audit_chain_heads" AS "ach" SET purged_seq = greatest\(purged_seq, 9\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM "audit_logs" AS "auditlog" WHERE \(tag = 'kubectl_cmd'\) AND \(time < '.+'\) AND \(\(time, id\) IN \(SELECT "time", "id" FROM "audit_logs" WHERE \(tag = 'kubectl_cmd'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM "audit_logs" AS "auditlog" WHERE \(tag = 'kubectl_api'\) AND \(time < '2022-03-03 12:00:00\+00:00'\) AND \(\(time, id\) IN \(SELECT "time", "id" FROM "audit_logs" WHERE \(tag = 'kubectl_api'\) AND \(time < '2022-03-03 12:00:00\+00:00'\) AND \(coalesce\(data->>'o', ''\) IN \('` + org + `'\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM "audit_logs" AS "auditlog" WHERE \(tag = 'kubectl_api'\) AND \(time < '2022-01-09 12:00:00\+00:00'\) AND \(\(time, id\) IN \(SELECT "time", "id" FROM "audit_logs" WHERE \(tag = 'kubectl_api'\) AND \(time < '2022-01-09 12:00:00\+00:00'\) AND \(coalesce\(data->>'o', ''\) NOT IN \('` + org + `'\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+	mock.ExpectExec(`SELECT pg_advisory_unlock\(7283265\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := s.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	records := readArchive(t, filepath.Join(dir, "audit_logs_p20220101.ndjson.gz"))
+	if len(records) != 2 || records[0].Hash != "h4" || string(records[1].Data) != `{"n":2}` {
+		t.Errorf("unexpected partition archive %v", records)
+	}
+	archives, _ := filepath.Glob(filepath.Join(dir, "audit_logs_system_default_*.ndjson.gz"))
+	if len(archives) != 1 {
+		t.Fatalf("expected purged events to be archived, got %v", archives)
+	}
+	if records := readArchive(t, archives[0]); len(records) != 1 || records[0].Seq != 9 {
+		t.Errorf("unexpected purge archive %v", records)
+	}
+}
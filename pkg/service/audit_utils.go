@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -25,7 +26,7 @@ const (
 func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, id uuid.UUID, rolesBefore, rolesAfter, groupsBefore, groupsAfter []uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -35,18 +36,18 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 			"username": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("user.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("user.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 
 	cr, _, dr := utils.DiffU(rolesBefore, rolesAfter)
 	ncr, err := dao.GetNamesByIds(ctx, db, cr, &models.Role{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	ndr, err := dao.GetNamesByIds(ctx, db, dr, &models.Role{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	for _, r := range ncr {
 		detail := &audit.EventDetail{
@@ -57,8 +58,8 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 			},
 		}
 		// user.role.created is user.project.created in paralus
-		if err := audit.CreateV1Event(al, sd, detail, "user.role.created", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "user.role.created", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -70,19 +71,19 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 				"role_name": r,
 			},
 		}
-		if err := audit.CreateV1Event(al, sd, detail, "user.role.deleted", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "user.role.deleted", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
 	cg, _, dg := utils.DiffU(groupsBefore, rolesAfter)
 	ncg, err := dao.GetNamesByIds(ctx, db, cg, &models.Group{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	ndg, err := dao.GetNamesByIds(ctx, db, dg, &models.Group{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	for _, g := range ncg {
 		detail := &audit.EventDetail{
@@ -93,8 +94,8 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 			},
 		}
 		// user.role.created is user.project.created in paralus
-		if err := audit.CreateV1Event(al, sd, detail, "user.group.created", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "user.group.created", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -106,8 +107,8 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 				"group_name": g,
 			},
 		}
-		if err := audit.CreateV1Event(al, sd, detail, "user.group.deleted", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "user.group.deleted", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 }
@@ -115,7 +116,7 @@ func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, actio
 func CreateUserLoginAuditEvent(ctx context.Context, al *zap.Logger, action string, name string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -125,8 +126,8 @@ func CreateUserLoginAuditEvent(ctx context.Context, al *zap.Logger, action strin
 			"user": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("user.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("user.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 
 }
@@ -134,7 +135,7 @@ func CreateUserLoginAuditEvent(ctx context.Context, al *zap.Logger, action strin
 func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, id uuid.UUID, usersBefore, usersAfter, rolesBefore, rolesAfter []uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -144,19 +145,19 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 			"group_name": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("group.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("group.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 
 	cu, _, du := utils.DiffU(usersBefore, usersAfter)
 
 	cun, err := dao.GetUserNamesByIds(ctx, db, cu, &models.KratosIdentities{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	dun, err := dao.GetUserNamesByIds(ctx, db, du, &models.KratosIdentities{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 
 	for _, u := range cun {
@@ -167,8 +168,8 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 				"username":   u,
 			},
 		}
-		if err := audit.CreateV1Event(al, sd, detail, "group.user.created", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "group.user.created", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -180,19 +181,19 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 				"username":   u,
 			},
 		}
-		if err := audit.CreateV1Event(al, sd, detail, "group.user.deleted", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "group.user.deleted", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
 	cr, _, dr := utils.DiffU(rolesBefore, rolesAfter)
 	ncr, err := dao.GetNamesByIds(ctx, db, cr, &models.Role{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	ndr, err := dao.GetNamesByIds(ctx, db, dr, &models.Role{})
 	if err != nil {
-		_log.Warn("unable to create audit event", err)
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 	for _, r := range ncr {
 		detail := &audit.EventDetail{
@@ -203,8 +204,8 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 			},
 		}
 		// group.role.created is group.project.created in paralus
-		if err := audit.CreateV1Event(al, sd, detail, "group.role.created", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "group.role.created", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -216,8 +217,8 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 				"role_name":  r,
 			},
 		}
-		if err := audit.CreateV1Event(al, sd, detail, "group.role.deleted", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "group.role.deleted", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -226,7 +227,7 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 func CreateRoleAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, permissions []string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -237,15 +238,15 @@ func CreateRoleAuditEvent(ctx context.Context, al *zap.Logger, action string, na
 			"permissions": strings.Join(permissions, ","), // TODO: Should we split it into individual ones?
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("role.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("role.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreateProjectAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -255,15 +256,15 @@ func CreateProjectAuditEvent(ctx context.Context, al *zap.Logger, action string,
 			"project_name": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("project.%s.success", action), name); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("project.%s.success", action), name); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreatePartnerAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -273,15 +274,15 @@ func CreatePartnerAuditEvent(ctx context.Context, al *zap.Logger, action string,
 			"partner_name": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("partner.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("partner.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, settingsBefore, settingsAfter *systemv3.OrganizationSettings) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -291,8 +292,8 @@ func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action st
 			"organization_name": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("organization.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("organization.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 
 	if settingsBefore == nil && settingsAfter == nil {
@@ -315,8 +316,41 @@ func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action st
 			}
 		}
 
-		if err := audit.CreateV1Event(al, sd, detail, "organization.idle.timeout.settings.updated", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "organization.idle.timeout.settings.updated", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
+		}
+	}
+
+	if !bavail || settingsBefore.RequireClusterApproval != settingsAfter.RequireClusterApproval {
+		detail := &audit.EventDetail{
+			Message: fmt.Sprintf("Cluster approval settings updated for organization %s", name),
+			Meta: map[string]string{
+				"organization_name":        name,
+				"require_cluster_approval": strconv.FormatBool(settingsAfter.GetRequireClusterApproval()),
+			},
+		}
+
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "organization.cluster.approval.settings.updated", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
+		}
+	}
+
+	if rb, ra := settingsBefore.GetAuditRetention(), settingsAfter.GetAuditRetention(); !bavail ||
+		rb.GetSystemDays() != ra.GetSystemDays() ||
+		rb.GetKubectlCmdDays() != ra.GetKubectlCmdDays() ||
+		rb.GetKubectlApiDays() != ra.GetKubectlApiDays() {
+		detail := &audit.EventDetail{
+			Message: fmt.Sprintf("Audit retention settings updated for organization %s", name),
+			Meta: map[string]string{
+				"organization_name": name,
+				"system_days":       strconv.Itoa(int(ra.GetSystemDays())),
+				"kubectl_cmd_days":  strconv.Itoa(int(ra.GetKubectlCmdDays())),
+				"kubectl_api_days":  strconv.Itoa(int(ra.GetKubectlApiDays())),
+			},
+		}
+
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "organization.audit.retention.settings.updated", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 
@@ -347,8 +381,8 @@ func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action st
 			}
 		}
 
-		if err := audit.CreateV1Event(al, sd, detail, "organization.lockout.settings.updated", ""); err != nil {
-			_log.Warn("unable to create audit event", err)
+		if err := audit.CreateV1Event(ctx, al, sd, detail, "organization.lockout.settings.updated", ""); err != nil {
+			_log.WithContext(ctx).Warn("unable to create audit event", err)
 		}
 	}
 }
@@ -356,7 +390,7 @@ func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action st
 func CreateIdpAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -367,15 +401,15 @@ func CreateIdpAuditEvent(ctx context.Context, al *zap.Logger, action string, nam
 		},
 	}
 	// idp.create.success is idp.config.created in paralus
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("idp.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("idp.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreateOidcAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -386,15 +420,15 @@ func CreateOidcAuditEvent(ctx context.Context, al *zap.Logger, action string, na
 		},
 	}
 
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("oidc.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("oidc.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreateApiKeyAuditEvent(ctx context.Context, al *zap.Logger, action string, id string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -404,15 +438,15 @@ func CreateApiKeyAuditEvent(ctx context.Context, al *zap.Logger, action string,
 			"apikey": id,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("apikey.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("apikey.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func DownloadCliConfigAuditEvent(ctx context.Context, al *zap.Logger, action string, user string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -422,15 +456,15 @@ func DownloadCliConfigAuditEvent(ctx context.Context, al *zap.Logger, action str
 			"username": user,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("cliconfig.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("cliconfig.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func DownloadKubeconfigAuditEvent(ctx context.Context, al *zap.Logger, user string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -440,15 +474,15 @@ func DownloadKubeconfigAuditEvent(ctx context.Context, al *zap.Logger, user stri
 			"user": user,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, "user.kubeconfig.download", ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, "user.kubeconfig.download", ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func RevokeKubeconfigAuditEvent(ctx context.Context, al *zap.Logger, user string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -458,15 +492,87 @@ func RevokeKubeconfigAuditEvent(ctx context.Context, al *zap.Logger, user string
 			"user": user,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, "user.kubeconfig.revoke", ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, "user.kubeconfig.revoke", ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
 func CreateClusterAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, project string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Cluster %s %sd", name, action),
+		Meta: map[string]string{
+			"cluster_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("cluster.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterActionAuditEvent(ctx context.Context, al *zap.Logger, action string, msg string, name string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Cluster %s %s", name, msg),
+		Meta: map[string]string{
+			"cluster_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("cluster.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterGroupAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Cluster group %s %sd", name, action),
+		Meta: map[string]string{
+			"cluster_group_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("clustergroup.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterGroupActionAuditEvent(ctx context.Context, al *zap.Logger, action string, msg string, name string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Cluster group %s %s", name, msg),
+		Meta: map[string]string{
+			"cluster_group_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("clustergroup.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterApprovalAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, reason string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -474,10 +580,63 @@ func CreateClusterAuditEvent(ctx context.Context, al *zap.Logger, action string,
 		Message: fmt.Sprintf("Cluster %s %sd", name, action),
 		Meta: map[string]string{
 			"cluster_name": name,
+			"reason":       reason,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("cluster.%s.success", action), project); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("cluster.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterShareAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, sharedProject string, access string, namespaces []string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	msg := fmt.Sprintf("Cluster %s shared with project %s", name, sharedProject)
+	if action == "unshare" {
+		msg = fmt.Sprintf("Cluster %s unshared from project %s", name, sharedProject)
+	}
+	detail := &audit.EventDetail{
+		Message: msg,
+		Meta: map[string]string{
+			"cluster_name":   name,
+			"shared_project": sharedProject,
+		},
+	}
+	if access != "" {
+		detail.Meta["access"] = access
+	}
+	if len(namespaces) > 0 {
+		detail.Meta["namespaces"] = strings.Join(namespaces, ",")
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("cluster.%s.success", action), project); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateRelayNetworkAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
+	CreateRelayNetworkActionAuditEvent(ctx, al, action, action+"d", name, id)
+}
+
+func CreateRelayNetworkActionAuditEvent(ctx context.Context, al *zap.Logger, action string, msg string, name string, id uuid.UUID) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Relay network %s %s", name, msg),
+		Meta: map[string]string{
+			"relay_network_name": name,
+			"relay_network_id":   id.String(),
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("relaynetwork.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
 
@@ -485,7 +644,7 @@ func CreateClusterAuditEvent(ctx context.Context, al *zap.Logger, action string,
 func CreateLocationAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
-		_log.Warn("unable to create audit event: could not fetch info from context")
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
 		return
 	}
 
@@ -495,7 +654,51 @@ func CreateLocationAuditEvent(ctx context.Context, al *zap.Logger, action string
 			"location_name": name,
 		},
 	}
-	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("location.%s.success", action), ""); err != nil {
-		_log.Warn("unable to create audit event", err)
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("location.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateConfigAuditEvent(ctx context.Context, al *zap.Logger, org string, changes []*systemv3.ConfigChange) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.Action]++
+	}
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Config applied to organization %s: %d created, %d updated, %d unchanged",
+			org, counts[ConfigActionCreate], counts[ConfigActionUpdate], counts[ConfigActionUnchanged]),
+		Meta: map[string]string{
+			"organization": org,
+			"created":      fmt.Sprint(counts[ConfigActionCreate]),
+			"updated":      fmt.Sprint(counts[ConfigActionUpdate]),
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, "config.apply.success", ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
+	}
+}
+
+func CreateAlertRuleAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.WithContext(ctx).Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Alert rule %s %sd", name, action),
+		Meta: map[string]string{
+			"alert_rule_name": name,
+			"alert_rule_id":   id.String(),
+		},
+	}
+	if err := audit.CreateV1Event(ctx, al, sd, detail, fmt.Sprintf("alertrule.%s.success", action), ""); err != nil {
+		_log.WithContext(ctx).Warn("unable to create audit event", err)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/casbin/casbin/v2"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/tracing"
 	authzpbv1 "github.com/paralus/paralus/proto/types/authz"
 	"github.com/uptrace/bun"
 	"google.golang.org/grpc/codes"
@@ -225,6 +226,9 @@ func (s *authzService) fromRolePermissionMappingList(ctx context.Context, r *aut
 }
 
 func (s *authzService) Enforce(ctx context.Context, req *authzpbv1.EnforceRequest) (*authzpbv1.BoolReply, error) {
+	_, span := tracing.Start(ctx, "casbin.Enforce")
+	defer span.End()
+
 	var param interface{}
 	params := make([]interface{}, 0, len(req.Params))
 	for index := range req.Params {
@@ -234,6 +238,7 @@ func (s *authzService) Enforce(ctx context.Context, req *authzpbv1.EnforceReques
 
 	res, err := s.enforcer.Enforce(params...)
 	if err != nil {
+		span.RecordError(err)
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
+	"github.com/rs/xid"
 	"github.com/uptrace/bun"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -32,6 +33,7 @@ type BootstrapService interface {
 	GetBootstrapAgentTemplateForToken(ctx context.Context, token string) (*sentry.BootstrapAgentTemplate, error)
 	GetBootstrapAgentTemplateForHost(ctx context.Context, host string) (*sentry.BootstrapAgentTemplate, error)
 	SelectBootstrapAgentTemplates(ctx context.Context, opts ...query.Option) (*sentry.BootstrapAgentTemplateList, error)
+	DeleteBootstrapAgentTemplate(ctx context.Context, name string) error
 	// bootstrap agent methods
 	CreateBootstrapAgent(ctx context.Context, agent *sentry.BootstrapAgent) error
 	GetBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error)
@@ -43,16 +45,43 @@ type BootstrapService interface {
 	RegisterBootstrapAgent(ctx context.Context, token, ip, fingerprint string) error
 	DeleteBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) error
 	PatchBootstrapAgent(ctx context.Context, ba *sentry.BootstrapAgent, templateRef string, opts ...query.Option) error
+	ResetBootstrapAgent(ctx context.Context, templateRef string, rotateToken bool, opts ...query.Option) (*sentry.BootstrapAgent, error)
+	// TokenExpiresAt returns when a cluster bootstrap token issued now
+	// expires, zero when tokens do not expire
+	TokenExpiresAt() time.Time
 }
 
 // bootstrapService implements BootstrapService
 type bootstrapService struct {
-	db *bun.DB
+	db       *bun.DB
+	tokenTTL time.Duration
+}
+
+// BootstrapOption is the option for bootstrap service
+type BootstrapOption func(s *bootstrapService)
+
+// WithBootstrapTokenTTL sets how long a cluster bootstrap token can be
+// used for the first registration, tokens do not expire when it is zero
+func WithBootstrapTokenTTL(ttl time.Duration) BootstrapOption {
+	return func(s *bootstrapService) {
+		s.tokenTTL = ttl
+	}
 }
 
 // NewBootstrapService return new bootstrap service
-func NewBootstrapService(db *bun.DB) BootstrapService {
-	return &bootstrapService{db}
+func NewBootstrapService(db *bun.DB, opts ...BootstrapOption) BootstrapService {
+	s := &bootstrapService{db: db}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *bootstrapService) TokenExpiresAt() time.Time {
+	if s.tokenTTL <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(s.tokenTTL)
 }
 
 func (s *bootstrapService) PatchBootstrapInfra(ctx context.Context, infra *sentry.BootstrapInfra) error {
@@ -88,7 +117,7 @@ func (s *bootstrapService) PatchBootstrapAgentTemplate(ctx context.Context, temp
 		CreatedAt:              time.Now(),
 	}
 
-	return dao.CreateOrUpdateBootstrapAgentTemplate(ctx, s.db, &templ)
+	return dao.CreateOrUpdateBootstrapAgentTemplate(ctx, dao.GetDB(ctx, s.db), &templ)
 }
 
 func (s *bootstrapService) GetBootstrapAgentTemplate(ctx context.Context, agentType string) (*sentry.BootstrapAgentTemplate, error) {
@@ -130,10 +159,22 @@ func (s *bootstrapService) SelectBootstrapAgentTemplates(ctx context.Context, op
 	return ret, nil
 }
 
+func (s *bootstrapService) DeleteBootstrapAgentTemplate(ctx context.Context, name string) error {
+	tx, err := dao.BeginTx(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	if err := dao.DeleteBootstrapAgentTemplate(ctx, tx, name); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
 func (s *bootstrapService) CreateBootstrapAgent(ctx context.Context, agent *sentry.BootstrapAgent) error {
 	ba := convertToAgentModel(agent)
 	ba.CreatedAt = time.Now()
-	return dao.CreateBootstrapAgent(ctx, s.db, ba)
+	return dao.CreateBootstrapAgent(ctx, dao.GetDB(ctx, s.db), ba)
 }
 
 func convertToAgentModel(agent *sentry.BootstrapAgent) *models.BootstrapAgent {
@@ -155,6 +196,9 @@ func convertToAgentModel(agent *sentry.BootstrapAgent) *models.BootstrapAgent {
 	if projId, err := uuid.Parse(agent.Metadata.Project); err == nil {
 		agentMdl.ProjectId = projId
 	}
+	if agent.Status.GetTokenExpiresAt() != nil {
+		agentMdl.TokenExpiresAt = agent.Status.TokenExpiresAt.AsTime()
+	}
 	return agentMdl
 }
 
@@ -202,6 +246,9 @@ func prepareAgentResponse(agent *models.BootstrapAgent) *sentry.BootstrapAgent {
 			Fingerprint:   agent.Fingerprint,
 		},
 	}
+	if !agent.TokenExpiresAt.IsZero() {
+		ba.Status.TokenExpiresAt = timestamppb.New(agent.TokenExpiresAt)
+	}
 	return ba
 }
 
@@ -308,7 +355,7 @@ func (s *bootstrapService) SelectBootstrapAgents(ctx context.Context, templateRe
 		opt(queryOptions)
 	}
 
-	agl, count, err := dao.SelectBootstrapAgents(ctx, s.db, templateRef, queryOptions)
+	agl, count, err := dao.SelectBootstrapAgents(ctx, dao.GetDB(ctx, s.db), templateRef, queryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -337,7 +384,7 @@ func (s *bootstrapService) DeleteBootstrapAgent(ctx context.Context, templateRef
 		opt(queryOptions)
 	}
 
-	err := dao.DeleteBootstrapAgent(ctx, s.db, templateRef, queryOptions)
+	err := dao.DeleteBootstrapAgent(ctx, dao.GetDB(ctx, s.db), templateRef, queryOptions)
 	return err
 }
 
@@ -378,6 +425,37 @@ func (s *bootstrapService) PatchBootstrapAgent(ctx context.Context, ba *sentry.B
 	return err
 }
 
+// ResetBootstrapAgent allows the token of the agent to be registered
+// again by a new agent, optionally replacing the token. The token
+// expires after the token TTL of the service.
+func (s *bootstrapService) ResetBootstrapAgent(ctx context.Context, templateRef string, rotateToken bool, opts ...query.Option) (*sentry.BootstrapAgent, error) {
+	queryOptions := &commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(queryOptions)
+	}
+
+	var ret *models.BootstrapAgent
+	err := s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		bdb, err := dao.GetBootstrapAgent(ctx, tx, templateRef, queryOptions)
+		if err != nil {
+			return err
+		}
+		var token string
+		if rotateToken {
+			token = xid.New().String()
+		}
+		if err := dao.ResetBootstrapAgent(ctx, tx, bdb.ID, token, s.TokenExpiresAt()); err != nil {
+			return err
+		}
+		ret, err = dao.GetBootstrapAgent(ctx, tx, templateRef, queryOptions)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prepareAgentResponse(ret), nil
+}
+
 func (s *bootstrapService) GetBootstrapAgentForToken(ctx context.Context, token string) (*sentry.BootstrapAgent, error) {
 	ba, err := dao.GetBootstrapAgentForToken(ctx, s.db, token)
 	if err != nil {
@@ -426,7 +504,7 @@ func (s *bootstrapService) GetRelayAgent(ctx context.Context, ClusterScope strin
 		query.WithPartnerID(queryOptions.Partner),
 	)
 	if err != nil {
-		_log.Infow("failed to get default bootstrap agent list", "cluster", ClusterScope, "error", err)
+		_log.WithContext(ctx).Infow("failed to get default bootstrap agent list", "cluster", ClusterScope, "error", err)
 		return nil, err
 	}
 
@@ -435,7 +513,7 @@ func (s *bootstrapService) GetRelayAgent(ctx context.Context, ClusterScope strin
 		found := false
 		// match labels
 		for _, b := range bal.Items {
-			_log.Infow("match", "ClusterScope", ClusterScope, "DisplayName", b.Metadata.DisplayName)
+			_log.WithContext(ctx).Infow("match", "ClusterScope", ClusterScope, "DisplayName", b.Metadata.DisplayName)
 			if "cluster/"+b.Metadata.DisplayName == ClusterScope {
 				found = true
 				ba = *b
@@ -446,9 +524,9 @@ func (s *bootstrapService) GetRelayAgent(ctx context.Context, ClusterScope strin
 			// found bootstrap relay agent for cluster as per the association
 			return &ba, nil
 		} else {
-			_log.Infow("did not find relay bootstrap agent for", "cluster", ClusterScope, "template", queryOptions.Name)
+			_log.WithContext(ctx).Infow("did not find relay bootstrap agent for", "cluster", ClusterScope, "template", queryOptions.Name)
 		}
 	}
-	_log.Infow("did not find relay bootstrap agent for", "cluster", ClusterScope, "template", queryOptions.Name)
+	_log.WithContext(ctx).Infow("did not find relay bootstrap agent for", "cluster", ClusterScope, "template", queryOptions.Name)
 	return nil, fmt.Errorf("failed to get relay agent")
 }
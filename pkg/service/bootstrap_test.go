@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+)
+
+func bootstrapAgentRows(state, fingerprint string, expiresAt time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "template_ref", "token", "token_state", "fingerprint", "token_expires_at"}).
+		AddRow(uuid.New().String(), "agent", "paralus-core-relay-agent", "token", state, fingerprint, expiresAt)
+}
+
+func bootstrapTemplateRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"name", "auto_approve", "ignore_multiple_register"}).
+		AddRow("paralus-core-relay-agent", true, true)
+}
+
+func TestRegisterBootstrapAgent(t *testing.T) {
+	tt := []struct {
+		name        string
+		state       string
+		pinned      string
+		fingerprint string
+		expiresAt   time.Time
+		err         error
+	}{
+		{"first registration", sentry.BootstrapAgentState_NotRegistered.String(), "", "fp", time.Now().Add(time.Hour), nil},
+		{"no expiry", sentry.BootstrapAgentState_NotRegistered.String(), "", "fp", time.Time{}, nil},
+		{"expired", sentry.BootstrapAgentState_NotRegistered.String(), "", "fp", time.Now().Add(-time.Hour), dao.ErrBootstrapTokenExpired},
+		{"same agent", sentry.BootstrapAgentState_Approved.String(), "fp", "fp", time.Now().Add(-time.Hour), nil},
+		{"other agent", sentry.BootstrapAgentState_Approved.String(), "fp", "other", time.Now().Add(time.Hour), dao.ErrBootstrapFingerprintMismatch},
+		{"not pinned", sentry.BootstrapAgentState_Approved.String(), "", "", time.Time{}, dao.ErrBootstrapFingerprintMismatch},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT (.+) FROM "sentry_bootstrap_agent"`).
+				WillReturnRows(bootstrapAgentRows(tc.state, tc.pinned, tc.expiresAt))
+			mock.ExpectQuery(`SELECT (.+) FROM "sentry_bootstrap_agent_template"`).
+				WillReturnRows(bootstrapTemplateRows())
+			if tc.err == nil {
+				mock.ExpectExec(`UPDATE "sentry_bootstrap_agent"`).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			bs := NewBootstrapService(db)
+			err := bs.RegisterBootstrapAgent(context.Background(), "token", "10.0.0.1", tc.fingerprint)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestResetBootstrapAgent(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT (.+) FROM "sentry_bootstrap_agent"`).
+		WillReturnRows(bootstrapAgentRows(sentry.BootstrapAgentState_Approved.String(), "fp", time.Time{}))
+	mock.ExpectExec(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_state = 'NotRegistered', fingerprint = '', ip_address = '', token_expires_at = '.+', modified_at = '.+', token = '.+' WHERE`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT (.+) FROM "sentry_bootstrap_agent"`).
+		WillReturnRows(bootstrapAgentRows(sentry.BootstrapAgentState_NotRegistered.String(), "", expiresAt))
+	mock.ExpectCommit()
+
+	bs := NewBootstrapService(db, WithBootstrapTokenTTL(time.Hour))
+	ba, err := bs.ResetBootstrapAgent(context.Background(), "paralus-core-relay-agent", true,
+		query.WithMeta(&commonv3.Metadata{Name: "agent"}))
+	if err != nil {
+		t.Fatal("could not reset bootstrap agent:", err)
+	}
+	if ba.Status.Fingerprint != "" || ba.Status.TokenExpiresAt == nil {
+		t.Errorf("unexpected status %v", ba.Status)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
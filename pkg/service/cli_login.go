@@ -0,0 +1,242 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"html/template"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// CliLoginVerificationPath is the page a user approves a cli login
+	// on, relative to the rest endpoint
+	CliLoginVerificationPath = "/auth/v3/cli/login/verify"
+	cliLoginExpiry           = 10 * time.Minute
+	cliLoginInterval         = 5 * time.Second
+	// user codes avoid vowels and look alike characters, RFC 8628 6.1
+	cliLoginUserCodeChars = "BCDFGHJKLMNPQRSTVWXZ"
+	cliLoginUserCodeLen   = 8
+)
+
+var (
+	// ErrCliLoginPending is returned for a login not yet approved
+	ErrCliLoginPending = status.Error(codes.FailedPrecondition, "authorization_pending")
+	// ErrCliLoginExpired is returned for an unknown, expired or already
+	// claimed login
+	ErrCliLoginExpired = status.Error(codes.NotFound, "expired_token")
+)
+
+// CliLoginService logs in paralusctl through the browser. The cli starts a
+// login, the user approves its user code on the verification page using
+// the dashboard session, and the cli polls with its device code for the
+// cli config of the user.
+type CliLoginService interface {
+	Start(ctx context.Context) (*userrpcv3.CliLoginResponse, error)
+	VerificationPage(ctx context.Context, userCode string) ([]byte, error)
+	Approve(ctx context.Context, userCode string) error
+	Config(ctx context.Context, deviceCode string) (*common.CliConfigDownloadData, error)
+}
+
+type cliLoginService struct {
+	db *bun.DB
+	us UserService
+}
+
+// NewCliLoginService return new cli login service
+func NewCliLoginService(db *bun.DB, us UserService) CliLoginService {
+	return &cliLoginService{db: db, us: us}
+}
+
+func hashDeviceCode(deviceCode string) string {
+	sum := sha256.Sum256([]byte(deviceCode))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateUserCode() (string, error) {
+	max := big.NewInt(int64(len(cliLoginUserCodeChars)))
+	var b strings.Builder
+	for i := 0; i < cliLoginUserCodeLen; i++ {
+		if i == cliLoginUserCodeLen/2 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(cliLoginUserCodeChars[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// normalizeUserCode accepts user codes typed in lower case or without
+// the dash
+func normalizeUserCode(userCode string) string {
+	s := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(userCode))
+	if len(s) != cliLoginUserCodeLen {
+		return s
+	}
+	return s[:cliLoginUserCodeLen/2] + "-" + s[cliLoginUserCodeLen/2:]
+}
+
+func (s *cliLoginService) Start(ctx context.Context) (*userrpcv3.CliLoginResponse, error) {
+	if err := dao.DeleteExpiredCliLogins(ctx, s.db, time.Now()); err != nil {
+		_log.WithContext(ctx).Warnw("unable to delete expired cli logins", "error", err)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	deviceCode := base64.RawURLEncoding.EncodeToString(b)
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, err
+	}
+
+	cl := &models.CliLogin{
+		DeviceCodeHash: hashDeviceCode(deviceCode),
+		UserCode:       userCode,
+		ExpiresAt:      time.Now().Add(cliLoginExpiry),
+		CreatedAt:      time.Now(),
+	}
+	if _, err := dao.Create(ctx, s.db, cl); err != nil {
+		return nil, err
+	}
+
+	return &userrpcv3.CliLoginResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationUri:         CliLoginVerificationPath,
+		VerificationUriComplete: CliLoginVerificationPath + "?userCode=" + userCode,
+		ExpiresIn:               int32(cliLoginExpiry.Seconds()),
+		Interval:                int32(cliLoginInterval.Seconds()),
+	}, nil
+}
+
+var cliLoginPage = template.Must(template.New("cli-login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Paralus CLI login</title>
+</head>
+<body>
+<h1>Paralus CLI login</h1>
+<p>Approve the login of paralusctl only if it shows the code below.</p>
+<form id="approve">
+<input id="userCode" name="userCode" value="{{.}}" placeholder="XXXX-XXXX" autocomplete="off" required>
+<button type="submit">Approve</button>
+</form>
+<p id="result"></p>
+<script>
+document.getElementById("approve").addEventListener("submit", function (e) {
+  e.preventDefault();
+  var result = document.getElementById("result");
+  fetch("/auth/v3/cli/login/approve", {
+    method: "POST",
+    credentials: "same-origin",
+    headers: {"Content-Type": "application/json"},
+    body: JSON.stringify({userCode: document.getElementById("userCode").value})
+  }).then(function (res) {
+    if (res.ok) {
+      result.textContent = "Login approved, you can close this page and return to the terminal.";
+    } else if (res.status === 401) {
+      result.textContent = "Log in to the dashboard first, then reload this page.";
+    } else {
+      result.textContent = "Unable to approve the login, the code is invalid or expired.";
+    }
+  });
+});
+</script>
+</body>
+</html>
+`))
+
+func (s *cliLoginService) VerificationPage(ctx context.Context, userCode string) ([]byte, error) {
+	if userCode != "" {
+		userCode = normalizeUserCode(userCode)
+	}
+	var b bytes.Buffer
+	if err := cliLoginPage.Execute(&b, userCode); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (s *cliLoginService) Approve(ctx context.Context, userCode string) error {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unable to retrieve session data")
+	}
+	accountID, err := uuid.Parse(sd.Account)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unable to retrieve session data")
+	}
+
+	cl, err := dao.GetCliLoginByUserCode(ctx, s.db, normalizeUserCode(userCode))
+	if err == sql.ErrNoRows {
+		return ErrCliLoginExpired
+	}
+	if err != nil {
+		return err
+	}
+
+	cl.AccountId = uuid.NullUUID{UUID: accountID, Valid: true}
+	cl.Username = sd.Username
+	if oid, err := uuid.Parse(sd.Organization); err == nil {
+		cl.OrganizationId = uuid.NullUUID{UUID: oid, Valid: true}
+	}
+	if pid, err := uuid.Parse(sd.Partner); err == nil {
+		cl.PartnerId = uuid.NullUUID{UUID: pid, Valid: true}
+	}
+	cl.ApprovedAt = bun.NullTime{Time: time.Now()}
+	err = dao.ApproveCliLogin(ctx, s.db, cl)
+	if err == sql.ErrNoRows {
+		return ErrCliLoginExpired
+	}
+	return err
+}
+
+func (s *cliLoginService) Config(ctx context.Context, deviceCode string) (*common.CliConfigDownloadData, error) {
+	hash := hashDeviceCode(deviceCode)
+	cl, err := dao.ClaimCliLogin(ctx, s.db, hash)
+	if err == sql.ErrNoRows {
+		if _, err := dao.GetCliLoginByDeviceCodeHash(ctx, s.db, hash); err == nil {
+			return nil, ErrCliLoginPending
+		}
+		return nil, ErrCliLoginExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the cli config is issued on behalf of the approving user, audit
+	// events record them
+	sd := &commonv3.SessionData{
+		Account:      cl.AccountId.UUID.String(),
+		Username:     cl.Username,
+		Organization: cl.OrganizationId.UUID.String(),
+		Partner:      cl.PartnerId.UUID.String(),
+	}
+	ctx = context.WithValue(ctx, common.SessionDataKey, sd)
+	return s.us.RetrieveCliConfig(ctx, &userrpcv3.ApiKeyRequest{
+		Username: cl.Username,
+		Id:       cl.AccountId.UUID.String(),
+	})
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/common"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func TestNormalizeUserCode(t *testing.T) {
+	tt := []struct {
+		code     string
+		expected string
+	}{
+		{"BCDF-GHJK", "BCDF-GHJK"},
+		{"bcdfghjk", "BCDF-GHJK"},
+		{" bcdf ghjk", "BCDF-GHJK"},
+		{"bcd", "BCD"},
+	}
+	for _, tc := range tt {
+		if got := normalizeUserCode(tc.code); got != tc.expected {
+			t.Errorf("%q: expected %s, got %s", tc.code, tc.expected, got)
+		}
+	}
+	code, err := generateUserCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalizeUserCode(code) != code {
+		t.Errorf("generated code %s is not normalized", code)
+	}
+}
+
+func TestCliLoginStart(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cls := NewCliLoginService(db, nil)
+	mock.ExpectExec(`DELETE FROM "authsrv_cli_login" AS "cl" WHERE \(expires_at < `).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO "authsrv_cli_login"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+
+	l, err := cls.Start(context.Background())
+	if err != nil {
+		t.Fatal("unable to start login:", err)
+	}
+	if l.DeviceCode == "" || l.UserCode == "" {
+		t.Errorf("expected device and user codes, got %v", l)
+	}
+	if l.VerificationUriComplete != CliLoginVerificationPath+"?userCode="+l.UserCode {
+		t.Errorf("unexpected verification uri %s", l.VerificationUriComplete)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliLoginApprove(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cls := NewCliLoginService(db, nil)
+	if err := cls.Approve(context.Background(), "BCDF-GHJK"); err == nil {
+		t.Error("expected approval without session to fail")
+	}
+
+	auuid, ouuid, puuid := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	sd := &v3.SessionData{Account: auuid, Username: "user@paralus.local", Organization: ouuid, Partner: puuid}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, sd)
+
+	cuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "cl"."id", .* FROM "authsrv_cli_login" AS "cl" WHERE \(user_code = 'BCDF-GHJK'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_code"}).AddRow(cuuid, "BCDF-GHJK"))
+	mock.ExpectExec(`UPDATE "authsrv_cli_login" AS "cl" SET "account_id" = '` + auuid + `', "username" = 'user@paralus.local', .* WHERE \(approved_at IS NULL\) AND .* AND \("cl"."id" = '` + cuuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := cls.Approve(ctx, "bcdfghjk"); err != nil {
+		t.Fatal("unable to approve login:", err)
+	}
+
+	// a login is approved once
+	mock.ExpectQuery(`SELECT "cl"."id", .* FROM "authsrv_cli_login" AS "cl" WHERE \(user_code = 'BCDF-GHJK'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_code"}).AddRow(cuuid, "BCDF-GHJK"))
+	mock.ExpectExec(`UPDATE "authsrv_cli_login"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := cls.Approve(ctx, "BCDF-GHJK"); err != ErrCliLoginExpired {
+		t.Errorf("expected %v, got %v", ErrCliLoginExpired, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliLoginConfigPending(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cls := NewCliLoginService(db, nil)
+	hash := hashDeviceCode("device-code")
+
+	// not yet approved
+	mock.ExpectQuery(`DELETE FROM "authsrv_cli_login" AS "cl" WHERE \(device_code_hash = '` + hash + `'\) AND \(approved_at IS NOT NULL\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT "cl"."id", .* FROM "authsrv_cli_login" AS "cl" WHERE \(device_code_hash = '` + hash + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	if _, err := cls.Config(context.Background(), "device-code"); err != ErrCliLoginPending {
+		t.Errorf("expected %v, got %v", ErrCliLoginPending, err)
+	}
+
+	// expired or already claimed
+	mock.ExpectQuery(`DELETE FROM "authsrv_cli_login"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT "cl"."id", .* FROM "authsrv_cli_login"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	if _, err := cls.Config(context.Background(), "device-code"); err != ErrCliLoginExpired {
+		t.Errorf("expected %v, got %v", ErrCliLoginExpired, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
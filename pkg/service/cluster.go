@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -23,9 +24,12 @@ import (
 	"github.com/paralus/paralus/pkg/patch"
 	"github.com/paralus/paralus/pkg/query"
 	sentryutil "github.com/paralus/paralus/pkg/sentry/util"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	"github.com/pkg/errors"
 	"github.com/rs/xid"
 	"github.com/spf13/viper"
@@ -34,7 +38,9 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var _log = log.GetLogger()
@@ -45,6 +51,16 @@ const (
 	clusterNotifyChan = "cluster:notify"
 )
 
+var (
+	// ErrClusterApprovalPending is returned for clusters waiting for approval
+	ErrClusterApprovalPending = errors.New("cluster is pending approval")
+	// ErrClusterRejected is returned for clusters which are rejected
+	ErrClusterRejected = errors.New("cluster is rejected")
+	// ErrClusterSessionsRevoked is returned for kubeconfigs issued before
+	// the sessions of the cluster were revoked
+	ErrClusterSessionsRevoked = errors.New("kubeconfig revoked for cluster")
+)
+
 type ClusterService interface {
 	// create Cluster
 	Create(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error)
@@ -74,6 +90,39 @@ type ClusterService interface {
 	GetRelaysConfigForCluster(ctx context.Context, cluster *infrav3.Cluster) ([]common.Relay, error)
 	// Update projects for bootstrap agents for cluster
 	UpdateProjectsForBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error
+	// Reset registration of the bootstrap agents of cluster
+	ResetClusterRegistration(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error)
+	// Rotate bootstrap token of cluster
+	RotateClusterToken(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error)
+	// Approve cluster pending approval
+	ApproveCluster(ctx context.Context, cluster *infrav3.Cluster, reason string) (*infrav3.Cluster, error)
+	// Reject cluster pending approval
+	RejectCluster(ctx context.Context, cluster *infrav3.Cluster, reason string) (*infrav3.Cluster, error)
+	// Revoke kubeconfigs issued so far for cluster
+	RevokeClusterSessions(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error)
+	// Check if user kubeconfig issued at certIssueSeconds can be served for cluster
+	CheckClusterAccess(ctx context.Context, clusterID string, certIssueSeconds int64) error
+	// Share cluster with another project
+	ShareCluster(ctx context.Context, cluster *infrav3.Cluster, project string, access infrav3.ClusterShareAccess, namespaces []string) (*infrav3.Cluster, error)
+	// Stop sharing cluster with another project
+	UnshareCluster(ctx context.Context, cluster *infrav3.Cluster, project string) (*infrav3.Cluster, error)
+	// Get projects the cluster is shared with along with their access, owner project included
+	GetClusterShares(ctx context.Context, clusterID string) ([]*infrav3.ProjectCluster, error)
+	// Delete bootstrap agents of cluster
+	DeleteBootstrapAgentsForCluster(ctx context.Context, cluster *infrav3.Cluster) error
+	// Check if agent of cluster should uninstall itself
+	IsClusterDecommissioning(ctx context.Context, clusterID string) (bool, error)
+	// Get usernames of the kubectl users and namespaces of cluster, their
+	// service accounts and role bindings are removed on uninstall
+	GetClusterKubectlUsers(ctx context.Context, clusterID string) ([]string, []string, error)
+	// Record the result of the agent uninstalling itself
+	AckClusterDecommission(ctx context.Context, clusterID string, success bool, reason string) error
+	// Get relay endpoints and proxy settings the agent of cluster checks connectivity with
+	GetClusterPreflight(ctx context.Context, clusterID string) (*sentryrpc.GetPreflightResponse, error)
+	// Record connectivity preflight results reported by the agent of cluster
+	ReportClusterPreflight(ctx context.Context, clusterID string, results []*sentryrpc.PreflightResult) error
+	// Get current labels of cluster, used to resolve label scoped role bindings
+	GetClusterLabels(ctx context.Context, clusterID string) (map[string]string, error)
 	//Add event handlers
 	AddEventHandler(evh event.Handler)
 }
@@ -84,12 +133,13 @@ type clusterService struct {
 	downloadData    common.DownloadData
 	clusterHandlers []event.Handler
 	bs              BootstrapService
+	azc             AuthzService
 	al              *zap.Logger
 }
 
 // NewClusterService return new cluster service
-func NewClusterService(db *bun.DB, data *common.DownloadData, bs BootstrapService, al *zap.Logger) ClusterService {
-	return &clusterService{db: db, downloadData: *data, bs: bs, al: al}
+func NewClusterService(db *bun.DB, data *common.DownloadData, bs BootstrapService, azc AuthzService, al *zap.Logger) ClusterService {
+	return &clusterService{db: db, downloadData: *data, bs: bs, azc: azc, al: al}
 }
 
 func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
@@ -144,7 +194,7 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 	clusterPresent, err := dao.GetByNamePartnerOrg(ctx, s.db, cluster.Metadata.Name, uuid.NullUUID{UUID: proj.PartnerId, Valid: true},
 		uuid.NullUUID{UUID: proj.OrganizationId, Valid: true}, &models.Cluster{})
 	if err != nil && err.Error() == "sql: no rows in result set" {
-		_log.Infof("Skipping as first time cluster create ")
+		_log.WithContext(ctx).Infof("Skipping as first time cluster create ")
 	} else if clusterPresent != nil {
 		errormsg = "cluster name is already taken. please try another name"
 		return &infrav3.Cluster{}, fmt.Errorf(errormsg)
@@ -177,6 +227,22 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 		}
 		return cluster, err
 	}
+	if err := clstrutil.ValidateBootstrapOverrides(cluster.Spec.BootstrapOverrides); err != nil {
+		cluster.Status = &commonv3.Status{
+			ConditionType:   "Create",
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			Reason:          err.Error(),
+		}
+		return cluster, err
+	}
+	if err := clstrutil.ValidateProxyConfig(cluster.Spec.ProxyConfig); err != nil {
+		cluster.Status = &commonv3.Status{
+			ConditionType:   "Create",
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			Reason:          err.Error(),
+		}
+		return cluster, err
+	}
 	clusterLabels := clstrutil.ExtractV2ClusterLabels(cluster.Metadata.Labels, nil, cluster.Metadata.Name, cluster.Spec.ClusterType, metro.Name)
 
 	if clusterLabels == nil {
@@ -210,6 +276,10 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 		pcfgsByts, _ := json.Marshal(cluster.Spec.ProxyConfig)
 		edb.ProxyConfig = json.RawMessage(pcfgsByts)
 	}
+	if cluster.Spec.BootstrapOverrides != nil {
+		ovrByts, _ := json.Marshal(cluster.Spec.BootstrapOverrides)
+		edb.BootstrapOverrides = json.RawMessage(ovrByts)
+	}
 
 	cluster.Spec.ClusterData = &infrav3.ClusterData{
 		ClusterStatus: &infrav3.ClusterStatus{
@@ -243,8 +313,11 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 	pcList := make([]models.ProjectCluster, 0)
 	if edb.ProjectId != uuid.Nil {
 		pc = &models.ProjectCluster{
-			ProjectID: edb.ProjectId,
-			ClusterID: edb.ID,
+			ProjectID:  edb.ProjectId,
+			ClusterID:  edb.ID,
+			Access:     infrav3.ClusterShareAccess_FULL_ACCESS.String(),
+			Namespaces: json.RawMessage("[]"),
+			CreatedAt:  time.Now(),
 		}
 		err = cdao.CreateProjectCluster(ctx, tx, pc)
 		if err != nil {
@@ -253,17 +326,17 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 		}
 		pcList = append(pcList, *pc)
 	}
-	_log.Infow("Created the cluster: ", "Cluster", edb)
+	_log.WithContext(ctx).Infow("Created the cluster: ", "Cluster", edb)
 
 	clusterResp := s.prepareClusterResponse(ctx, cluster, edb, metro, pcList, true)
 
 	if clusterGeneration == constants.Cluster_V2 && edb.PartnerId != uuid.Nil && edb.OrganizationId != uuid.Nil {
 		operatorSpecStr, err := clstrutil.GetClusterOperatorYaml(ctx, &s.downloadData, clusterResp)
 		if err != nil {
-			_log.Errorw("Error downloading v2 cluster operator yaml", "Error", err)
+			_log.WithContext(ctx).Errorw("Error downloading v2 cluster operator yaml", "Error", err)
 			return &infrav3.Cluster{}, err
 		}
-		_log.Infow("Creating cluster operator yaml", "clusterid", edb.ID)
+		_log.WithContext(ctx).Infow("Creating cluster operator yaml", "clusterid", edb.ID)
 		operatorSpecEncoded := base64.StdEncoding.EncodeToString([]byte(operatorSpecStr))
 		bootstrapData := models.ClusterOperatorBootstrap{
 			ClusterId:   edb.ID,
@@ -285,7 +358,7 @@ func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		_log.Warn("unable to commit changes", err)
+		_log.WithContext(ctx).Warn("unable to commit changes", err)
 	}
 
 	ev := event.Resource{
@@ -345,7 +418,7 @@ func (s *clusterService) Select(ctx context.Context, cluster *infrav3.Cluster, i
 	if c.MetroId != uuid.Nil {
 		entity, err := dao.GetByID(ctx, s.db, c.MetroId, &models.Metro{})
 		if err != nil {
-			_log.Errorf("failed to fetch metro details", err)
+			_log.WithContext(ctx).Errorf("failed to fetch metro details", err)
 		}
 		metro = entity.(*models.Metro)
 	}
@@ -409,19 +482,19 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 	var part models.Partner
 	_, err := dao.GetNameById(ctx, s.db, c.PartnerId, &part)
 	if err != nil {
-		_log.Infow("unable to fetch partner information, ", err.Error())
+		_log.WithContext(ctx).Infow("unable to fetch partner information, ", err.Error())
 	}
 
 	var org models.Organization
 	_, err = dao.GetNameById(ctx, s.db, c.OrganizationId, &org)
 	if err != nil {
-		_log.Infow("unable to fetch organization information, ", err.Error())
+		_log.WithContext(ctx).Infow("unable to fetch organization information, ", err.Error())
 	}
 
 	var proj models.Project
 	_, err = dao.GetNameById(ctx, s.db, c.ProjectId, &proj)
 	if err != nil {
-		_log.Infow("unable to fetch project information, ", err.Error())
+		_log.WithContext(ctx).Infow("unable to fetch project information, ", err.Error())
 	}
 
 	var lbls map[string]string
@@ -449,18 +522,20 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 	if c.ProxyConfig != nil {
 		json.Unmarshal(c.ProxyConfig, &proxy)
 	}
+	var overrides *infrav3.BootstrapOverrides
+	if c.BootstrapOverrides != nil {
+		overrides = &infrav3.BootstrapOverrides{}
+		json.Unmarshal(c.BootstrapOverrides, overrides)
+	}
 	var params infrav3.ProvisionParams
 	if c.Extra != nil {
 		json.Unmarshal(c.Extra, &params)
 	}
 	var pcs []*infrav3.ProjectCluster
 	if len(projects) > 0 {
-		pcs = make([]*infrav3.ProjectCluster, len(projects)-1)
+		pcs = make([]*infrav3.ProjectCluster, 0, len(projects))
 		for _, pc := range projects {
-			pcs = append(pcs, &infrav3.ProjectCluster{
-				ProjectID: pc.ProjectID.String(),
-				ClusterID: pc.ClusterID.String(),
-			})
+			pcs = append(pcs, prepareProjectClusterResponse(pc))
 		}
 	}
 	var conditions []*infrav3.ClusterCondition
@@ -468,10 +543,11 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 		json.Unmarshal(c.Conditions, &conditions)
 	}
 	clstr.Spec = &infrav3.ClusterSpec{
-		ClusterType:      c.ClusterType,
-		OverrideSelector: c.OverrideSelector,
-		ProxyConfig:      &proxy,
-		Params:           &params,
+		ClusterType:        c.ClusterType,
+		OverrideSelector:   c.OverrideSelector,
+		ProxyConfig:        &proxy,
+		BootstrapOverrides: overrides,
+		Params:             &params,
 		ClusterData: &infrav3.ClusterData{
 			ClusterBlueprint: c.BlueprintRef,
 			Projects:         pcs,
@@ -494,6 +570,12 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 }
 
 func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
+	return s.update(ctx, cluster, false)
+}
+
+// update updates the cluster, approval condition of the cluster is
+// only changed when setApproval is true
+func (s *clusterService) update(ctx context.Context, cluster *infrav3.Cluster, setApproval bool) (*infrav3.Cluster, error) {
 
 	projectName := cluster.Metadata.Project
 	var errormsg string
@@ -538,6 +620,23 @@ func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (
 		return &infrav3.Cluster{}, fmt.Errorf("invalid cluster data, cluster generation is invalid")
 	}
 
+	if err := clstrutil.ValidateBootstrapOverrides(cluster.Spec.BootstrapOverrides); err != nil {
+		cluster.Status = &commonv3.Status{
+			ConditionType:   "Update",
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			Reason:          err.Error(),
+		}
+		return cluster, err
+	}
+	if err := clstrutil.ValidateProxyConfig(cluster.Spec.ProxyConfig); err != nil {
+		cluster.Status = &commonv3.Status{
+			ConditionType:   "Update",
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			Reason:          err.Error(),
+		}
+		return cluster, err
+	}
+
 	if len(cluster.Metadata.Labels) == 0 {
 		cluster.Metadata.Labels = make(map[string]string)
 	}
@@ -586,6 +685,10 @@ func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (
 		pcfgsByts, _ := json.Marshal(cluster.Spec.ProxyConfig)
 		cdb.ProxyConfig = json.RawMessage(pcfgsByts)
 	}
+	if cluster.Spec.BootstrapOverrides != nil {
+		ovrByts, _ := json.Marshal(cluster.Spec.BootstrapOverrides)
+		cdb.BootstrapOverrides = json.RawMessage(ovrByts)
+	}
 
 	if cluster.Spec.Params != nil {
 		prmsByts, _ := json.Marshal(cluster.Spec.Params)
@@ -598,7 +701,15 @@ func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (
 			cdb.PublishedBlueprint = cluster.Spec.ClusterData.ClusterStatus.PublishedBlueprint
 
 			if len(cluster.Spec.ClusterData.ClusterStatus.Conditions) > 0 {
-				cndBytes, _ := json.Marshal(cluster.Spec.ClusterData.ClusterStatus.Conditions)
+				conditions := cluster.Spec.ClusterData.ClusterStatus.Conditions
+				if !setApproval {
+					var stored []*infrav3.ClusterCondition
+					if cdb.Conditions != nil {
+						json.Unmarshal(cdb.Conditions, &stored)
+					}
+					conditions = setApprovalCondition(conditions, approvalCondition(stored))
+				}
+				cndBytes, _ := json.Marshal(conditions)
 				cdb.Conditions = json.RawMessage(cndBytes)
 			}
 		}
@@ -659,9 +770,9 @@ func (s *clusterService) Delete(ctx context.Context, cluster *infrav3.Cluster) e
 	clusterId := cluster.Metadata.Id
 	projectId := cluster.Metadata.Project
 
-	_log.Infow("deleting cluster", "name", cluster.Metadata.Name)
+	_log.WithContext(ctx).Infow("deleting cluster", "name", cluster.Metadata.Name)
 
-	_log.Debugw("setting cluster condition to pending delete", "name", cluster.Metadata.Name, "conditions", cluster.Spec.ClusterData.ClusterStatus.Conditions)
+	_log.WithContext(ctx).Debugw("setting cluster condition to pending delete", "name", cluster.Metadata.Name, "conditions", cluster.Spec.ClusterData.ClusterStatus.Conditions)
 	clstrutil.SetClusterCondition(cluster, clstrutil.NewClusterDelete(constants.Pending, "delete request submitted"))
 
 	err = s.UpdateClusterConditionStatus(ctx, cluster)
@@ -786,32 +897,44 @@ func (s *clusterService) UpdateClusterConditionStatus(ctx context.Context, curre
 		return err
 	}
 
-	_log.Debugw("existing cluster conditions", "name", existing.Spec.ClusterData.ClusterStatus.Conditions)
-	_log.Debugw("current cluster conditions", "name", current.Spec.ClusterData.ClusterStatus.Conditions)
+	_log.WithContext(ctx).Debugw("existing cluster conditions", "name", existing.Spec.ClusterData.ClusterStatus.Conditions)
+	_log.WithContext(ctx).Debugw("current cluster conditions", "name", current.Spec.ClusterData.ClusterStatus.Conditions)
+
+	// approval is only changed by approve and reject of the cluster
+	approval := approvalCondition(existing.Spec.ClusterData.ClusterStatus.Conditions)
 
 	err = patch.ClusterStatus(existing.Spec.ClusterData.ClusterStatus, current.Spec.ClusterData.ClusterStatus)
 	if err != nil {
-		_log.Debugw("failed to update cluster status, ", err.Error())
+		_log.WithContext(ctx).Debugw("failed to update cluster status, ", err.Error())
 		return err
 	}
 
-	_log.Debugw("updated cluster conditions", "name", existing.Spec.ClusterData.ClusterStatus.Conditions)
-	_log.Debugw("updated cluster object", "name", existing.Metadata.Name)
+	if clstrutil.IsClusterRegisterd(current) && (approval == nil || approval.Status == constants.NotSet) {
+		approval, err = s.registrationApproval(ctx, existing.Metadata.Organization)
+		if err != nil {
+			return err
+		}
+		_log.WithContext(ctx).Infow("cluster registered", "name", existing.Metadata.Name, "approval", approval.Status)
+	}
+	existing.Spec.ClusterData.ClusterStatus.Conditions = setApprovalCondition(existing.Spec.ClusterData.ClusterStatus.Conditions, approval)
+
+	_log.WithContext(ctx).Debugw("updated cluster conditions", "name", existing.Spec.ClusterData.ClusterStatus.Conditions)
+	_log.WithContext(ctx).Debugw("updated cluster object", "name", existing.Metadata.Name)
 
 	//update the cluster
-	_, err = s.Update(ctx, existing)
+	_, err = s.update(ctx, existing, true)
 	if err != nil {
 		return err
 	}
 
-	_log.Debugw("updated cluster in db", "name", existing.Metadata.Name)
+	_log.WithContext(ctx).Debugw("updated cluster in db", "name", existing.Metadata.Name)
 
 	if clstrutil.IsClusterDeleted(existing) {
 		err = s.deleteCluster(ctx, existing.Metadata.Id, existing.Metadata.Project)
 		if err != nil {
 			return err
 		}
-		_log.Debugw("deleted cluster in db", "name", existing.Metadata.Name)
+		_log.WithContext(ctx).Debugw("deleted cluster in db", "name", existing.Metadata.Name)
 	}
 
 	// set current to patched existing
@@ -848,7 +971,7 @@ listenerLoop:
 			var meta commonv3.Metadata
 			err := json.Unmarshal([]byte(n.Payload), &meta)
 			if err != nil {
-				_log.Infow("unable to unmarshal cluster notification", "error", err)
+				_log.WithContext(ctx).Infow("unable to unmarshal cluster notification", "error", err)
 				continue
 
 			}
@@ -919,21 +1042,120 @@ func (s *clusterService) UpdateStatus(ctx context.Context, current *infrav3.Clus
 	return nil
 }
 
-// CreateForCluster creates bootstrap agent for cluster
-func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error {
-	var relays []common.Relay
+func (s *clusterService) ResetClusterRegistration(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
+	return s.resetBootstrapAgents(ctx, cluster, false)
+}
 
+func (s *clusterService) RotateClusterToken(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
+	return s.resetBootstrapAgents(ctx, cluster, true)
+}
+
+// resetBootstrapAgents unpins the bootstrap agents of the cluster so
+// that the cluster can be bootstrapped again, with a new token when
+// rotateToken is set
+func (s *clusterService) resetBootstrapAgents(ctx context.Context, cluster *infrav3.Cluster, rotateToken bool) (*infrav3.Cluster, error) {
+	c, err := s.Select(ctx, cluster, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.bs.SelectBootstrapAgentTemplates(ctx, query.WithOptions(&commonv3.QueryOptions{
+		GlobalScope: true,
+		Selector:    "paralus.dev/defaultRelay=true",
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get bootstrap agent template")
+	}
+	for _, bat := range resp.Items {
+		_, err := s.bs.ResetBootstrapAgent(ctx, bat.Metadata.Name, rotateToken, query.WithMeta(&commonv3.Metadata{
+			Name: c.Metadata.Id,
+		}))
+		if err == sql.ErrNoRows {
+			// agent is yet to be created by the reconciler
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to reset bootstrap agent")
+		}
+	}
+
+	action, msg := "registration.reset", "registration reset"
+	if rotateToken {
+		// the relays annotation carries the tokens of the agents
+		if err := s.CreateBootstrapAgentForCluster(ctx, c); err != nil {
+			return nil, err
+		}
+		if err := s.UpdateClusterAnnotations(ctx, c); err != nil {
+			return nil, err
+		}
+		action, msg = "token.rotate", "bootstrap token rotated"
+	}
+	CreateClusterActionAuditEvent(ctx, s.al, action, msg, c.Metadata.Name, c.Metadata.Project)
+
+	return c, nil
+}
+
+// relayAgentTemplates returns the default relay templates along with the
+// agent templates of the relay networks the cluster is assigned to
+func (s *clusterService) relayAgentTemplates(ctx context.Context, cluster *infrav3.Cluster) ([]*sentry.BootstrapAgentTemplate, error) {
 	resp, err := s.bs.SelectBootstrapAgentTemplates(ctx, query.WithOptions(&commonv3.QueryOptions{
 		GlobalScope: true,
 		Selector:    "paralus.dev/defaultRelay=true",
 	}))
 	if err != nil {
-		err = errors.Wrap(err, "unable to get bootstrap agent template")
+		return nil, errors.Wrap(err, "unable to get bootstrap agent template")
+	}
+	templates := resp.Items
+
+	orgID, err := uuid.Parse(cluster.Metadata.Organization)
+	if err != nil {
+		return templates, nil
+	}
+	rns, err := dao.ListRelayNetworks(ctx, s.db, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get relay networks")
+	}
+	var projectIDs []string
+	for _, p := range cluster.GetSpec().GetClusterData().GetProjects() {
+		projectIDs = append(projectIDs, p.ProjectID)
+	}
+	if len(projectIDs) == 0 && len(rns) > 0 {
+		// projects are not always loaded with the cluster
+		if id, err := uuid.Parse(cluster.Metadata.Id); err == nil {
+			pcs, err := cdao.GetProjectsForCluster(ctx, s.db, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, pc := range pcs {
+				projectIDs = append(projectIDs, pc.ProjectID.String())
+			}
+		}
+	}
+	for i := range rns {
+		if !relayNetworkHasCluster(&rns[i], cluster.Metadata.Id, projectIDs) {
+			continue
+		}
+		_, _, name := RelayNetworkTemplateNames(rns[i].ID.String())
+		bat, err := s.bs.GetBootstrapAgentTemplate(ctx, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get bootstrap agent template of relay network %s", rns[i].Name)
+		}
+		templates = append(templates, bat)
+	}
+	return templates, nil
+}
+
+// CreateForCluster creates bootstrap agent for cluster
+func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error {
+	var relays []common.Relay
+
+	templates, err := s.relayAgentTemplates(ctx, cluster)
+	if err != nil {
 		return err
 	}
 
 	// create bootstrap agent
-	for _, bat := range resp.Items {
+	for _, bat := range templates {
 		found := true
 		agent, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
 			Name: cluster.Metadata.Id,
@@ -966,6 +1188,11 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 					Token:       xid.New().String(),
 				},
 			}
+			if expiresAt := s.bs.TokenExpiresAt(); !expiresAt.IsZero() {
+				agent.Status = &sentry.BootStrapAgentStatus{
+					TokenExpiresAt: timestamppb.New(expiresAt),
+				}
+			}
 
 			for _, project := range cluster.Spec.ClusterData.Projects {
 				agent.Metadata.Labels[fmt.Sprintf("project/%s", project.ProjectID)] = ""
@@ -973,7 +1200,7 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 
 			err = s.bs.CreateBootstrapAgent(ctx, agent)
 			if err != nil {
-				_log.Infow("unable to create bootstrap agent", "error", err, "agent", *agent)
+				_log.WithContext(ctx).Infow("unable to create bootstrap agent", "error", err, "agent", *agent)
 				err = errors.Wrap(err, "unable to create bootstrap agent")
 				return err
 			}
@@ -1011,16 +1238,12 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 func (s *clusterService) GetRelaysConfigForCluster(ctx context.Context, cluster *infrav3.Cluster) ([]common.Relay, error) {
 	var relays []common.Relay
 
-	resp, err := s.bs.SelectBootstrapAgentTemplates(ctx, query.WithOptions(&commonv3.QueryOptions{
-		GlobalScope: true,
-		Selector:    "paralus.dev/defaultRelay=true",
-	}))
+	templates, err := s.relayAgentTemplates(ctx, cluster)
 	if err != nil {
-		err = errors.Wrap(err, "unable to get bootstrap agent template")
 		return nil, err
 	}
 
-	for _, bat := range resp.Items {
+	for _, bat := range templates {
 		agent, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
 			Id:           cluster.Metadata.Id,
 			Name:         cluster.Metadata.Name,
@@ -1028,6 +1251,10 @@ func (s *clusterService) GetRelaysConfigForCluster(ctx context.Context, cluster
 			Organization: cluster.Metadata.Organization,
 			Project:      cluster.Metadata.Project,
 		}))
+		if err == sql.ErrNoRows && bat.Metadata.Labels[RelayNetworkLabel] != "" {
+			// agent of the relay network is yet to be created
+			continue
+		}
 		if err != nil {
 			err = errors.Wrap(err, "unable to get bootstrap agent")
 			return nil, err
@@ -1111,13 +1338,13 @@ func getRelayBootstrapAddr() string {
 func (s *clusterService) notifyCluster(ctx context.Context, c *infrav3.Cluster) {
 	b, err := json.Marshal(c.Metadata)
 	if err != nil {
-		_log.Infow("unable to marshal cluster meta", "error", err)
+		_log.WithContext(ctx).Infow("unable to marshal cluster meta", "error", err)
 		return
 	}
 
 	err = cdao.Notify(s.db, clusterNotifyChan, string(b))
 	if err != nil {
-		_log.Infow("unable to send cluster notification", "error", err)
+		_log.WithContext(ctx).Infow("unable to send cluster notification", "error", err)
 		return
 	}
 }
@@ -1125,3 +1352,610 @@ func (s *clusterService) notifyCluster(ctx context.Context, c *infrav3.Cluster)
 func (s *clusterService) AddEventHandler(evh event.Handler) {
 	s.clusterHandlers = append(s.clusterHandlers, evh)
 }
+
+// registrationApproval returns the approval condition of a newly registered
+// cluster as per the settings of its organization
+func (s *clusterService) registrationApproval(ctx context.Context, organizationID string) (*infrav3.ClusterCondition, error) {
+	id, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	var org models.Organization
+	_, err = dao.GetByID(ctx, s.db, id, &org)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get organization of cluster")
+	}
+	var settings systemv3.OrganizationSettings
+	if org.Settings != nil {
+		json.Unmarshal(org.Settings, &settings)
+	}
+	if settings.RequireClusterApproval {
+		return clstrutil.NewClusterApprove(constants.Pending, "awaiting approval"), nil
+	}
+	return clstrutil.NewClusterApprove(constants.Success, "approval not required"), nil
+}
+
+func (s *clusterService) ApproveCluster(ctx context.Context, cluster *infrav3.Cluster, reason string) (*infrav3.Cluster, error) {
+	return s.setClusterApproval(ctx, cluster, true, reason)
+}
+
+func (s *clusterService) RejectCluster(ctx context.Context, cluster *infrav3.Cluster, reason string) (*infrav3.Cluster, error) {
+	return s.setClusterApproval(ctx, cluster, false, reason)
+}
+
+func (s *clusterService) setClusterApproval(ctx context.Context, cluster *infrav3.Cluster, approve bool, reason string) (*infrav3.Cluster, error) {
+	c, err := s.Select(ctx, cluster, true)
+	if err != nil {
+		return nil, err
+	}
+
+	action, status := "approve", constants.Success
+	if !approve {
+		action, status = "reject", constants.Failed
+	}
+	switch {
+	case clstrutil.IsClusterApprovePending(c):
+	case approve && clstrutil.IsClusterRejected(c):
+	case !approve && clstrutil.IsClusterApproved(c) && clstrutil.IsClusterRegisterd(c):
+	default:
+		return nil, fmt.Errorf("cluster %s cannot be %sd in its current state", c.Metadata.Name, action)
+	}
+
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, errors.New("failed to get session data")
+	}
+	msg := fmt.Sprintf("%sd by %s", action, sd.Username)
+	if reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+
+	conditions := c.Spec.ClusterData.ClusterStatus.Conditions
+	c.Spec.ClusterData.ClusterStatus.Conditions = setApprovalCondition(conditions, clstrutil.NewClusterApprove(status, msg))
+	if _, err := s.update(ctx, c, true); err != nil {
+		return nil, err
+	}
+
+	projectName := cluster.Metadata.Project
+	if id, err := uuid.Parse(projectName); err == nil {
+		projectName, err = dao.GetProjectName(ctx, s.db, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	CreateClusterApprovalAuditEvent(ctx, s.al, action, c.Metadata.Name, reason, projectName)
+
+	return c, nil
+}
+
+func (s *clusterService) RevokeClusterSessions(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
+	c, err := s.Select(ctx, cluster, true)
+	if err != nil {
+		return nil, err
+	}
+	err = cdao.RevokeClusterSessions(ctx, s.db, []uuid.UUID{uuid.MustParse(c.Metadata.Id)}, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to revoke sessions")
+	}
+	CreateClusterActionAuditEvent(ctx, s.al, "sessions.revoke", "sessions revoked", c.Metadata.Name, cluster.Metadata.Project)
+	return c, nil
+}
+
+func (s *clusterService) CheckClusterAccess(ctx context.Context, clusterID string, certIssueSeconds int64) error {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return err
+	}
+	var conditions []*infrav3.ClusterCondition
+	if c.Conditions != nil {
+		json.Unmarshal(c.Conditions, &conditions)
+	}
+	if approval := approvalCondition(conditions); approval != nil {
+		switch approval.Status {
+		case constants.Pending:
+			return ErrClusterApprovalPending
+		case constants.Failed:
+			return ErrClusterRejected
+		}
+	}
+	if !c.SessionsRevokedAt.IsZero() && c.SessionsRevokedAt.Unix() >= certIssueSeconds {
+		return ErrClusterSessionsRevoked
+	}
+	return nil
+}
+
+func (s *clusterService) GetClusterLabels(ctx context.Context, clusterID string) (map[string]string, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return nil, err
+	}
+	lbls := make(map[string]string)
+	if c.Labels != nil {
+		json.Unmarshal(c.Labels, &lbls)
+	}
+	return lbls, nil
+}
+
+// approvalCondition returns the approval condition from cluster conditions
+func approvalCondition(conditions []*infrav3.ClusterCondition) *infrav3.ClusterCondition {
+	for _, c := range conditions {
+		if c.Type == infrav3.ClusterConditionType_ClusterApprove {
+			return c
+		}
+	}
+	return nil
+}
+
+// setApprovalCondition returns conditions with the approval condition
+// replaced by approval, approval condition is dropped if approval is nil
+func setApprovalCondition(conditions []*infrav3.ClusterCondition, approval *infrav3.ClusterCondition) []*infrav3.ClusterCondition {
+	ret := make([]*infrav3.ClusterCondition, 0, len(conditions)+1)
+	found := false
+	for _, c := range conditions {
+		if c.Type != infrav3.ClusterConditionType_ClusterApprove {
+			ret = append(ret, c)
+			continue
+		}
+		if approval != nil {
+			ret = append(ret, approval)
+			found = true
+		}
+	}
+	if !found && approval != nil {
+		ret = append(ret, approval)
+	}
+	return ret
+}
+
+func prepareProjectClusterResponse(pc models.ProjectCluster) *infrav3.ProjectCluster {
+	var namespaces []string
+	if pc.Namespaces != nil {
+		json.Unmarshal(pc.Namespaces, &namespaces)
+	}
+	return &infrav3.ProjectCluster{
+		ProjectID:  pc.ProjectID.String(),
+		ClusterID:  pc.ClusterID.String(),
+		Access:     infrav3.ClusterShareAccess(infrav3.ClusterShareAccess_value[pc.Access]),
+		Namespaces: namespaces,
+	}
+}
+
+// ShareCluster shares the cluster owned by the project in scope with
+// another project. Sharing with a project already shared with replaces
+// its access and namespaces.
+func (s *clusterService) ShareCluster(ctx context.Context, cluster *infrav3.Cluster, project string, access infrav3.ClusterShareAccess, namespaces []string) (*infrav3.Cluster, error) {
+	c, err := s.selectOwnedCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := s.shareProjectID(ctx, c, project, "share")
+	if err != nil {
+		return nil, err
+	}
+	if access == infrav3.ClusterShareAccess_ClusterShareAccessNotSet {
+		access = infrav3.ClusterShareAccess_FULL_ACCESS
+	}
+	namespaces, err = validateShareNamespaces(namespaces)
+	if err != nil {
+		return nil, err
+	}
+	nsBytes, _ := json.Marshal(namespaces)
+
+	pc := &models.ProjectCluster{
+		ProjectID:  projectID,
+		ClusterID:  uuid.MustParse(c.Metadata.Id),
+		Access:     access.String(),
+		Namespaces: json.RawMessage(nsBytes),
+		CreatedAt:  time.Now(),
+	}
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if err := cdao.DeleteProjectCluster(ctx, tx, pc.ClusterID, pc.ProjectID); err != nil {
+			return err
+		}
+		return cdao.CreateProjectCluster(ctx, tx, pc)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to share cluster")
+	}
+
+	CreateClusterShareAuditEvent(ctx, s.al, "share", c.Metadata.Name, project, access.String(), namespaces, c.Metadata.Project)
+	return s.Select(ctx, cluster, true)
+}
+
+// UnshareCluster stops sharing the cluster owned by the project in scope
+// with another project
+func (s *clusterService) UnshareCluster(ctx context.Context, cluster *infrav3.Cluster, project string) (*infrav3.Cluster, error) {
+	c, err := s.selectOwnedCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := s.shareProjectID(ctx, c, project, "unshare")
+	if err != nil {
+		return nil, err
+	}
+	shared := false
+	for _, pc := range c.Spec.ClusterData.Projects {
+		if pc.ProjectID == projectID.String() {
+			shared = true
+			break
+		}
+	}
+	if !shared {
+		return nil, fmt.Errorf("cluster %s is not shared with project %s", c.Metadata.Name, project)
+	}
+
+	err = cdao.DeleteProjectCluster(ctx, s.db, uuid.MustParse(c.Metadata.Id), projectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unshare cluster")
+	}
+
+	CreateClusterShareAuditEvent(ctx, s.al, "unshare", c.Metadata.Name, project, "", nil, c.Metadata.Project)
+	return s.Select(ctx, cluster, true)
+}
+
+// selectOwnedCluster returns the cluster of the request, which has to be
+// owned by the project in scope. Projects the cluster is shared with
+// cannot share it further.
+func (s *clusterService) selectOwnedCluster(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
+	projectID, err := dao.GetProjectId(ctx, s.db, cluster.GetMetadata().GetProject())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "project %s not found", cluster.GetMetadata().GetProject())
+	}
+	c, err := s.Select(ctx, cluster, true)
+	if err != nil {
+		return nil, err
+	}
+	isAllowed, err := cdao.ValidateClusterAccess(ctx, s.db, commonv3.QueryOptions{
+		ID:      c.Metadata.Id,
+		Project: projectID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !isAllowed {
+		return nil, status.Errorf(codes.PermissionDenied, "cluster %s is not owned by project %s", c.Metadata.Name, cluster.Metadata.Project)
+	}
+	return c, nil
+}
+
+// shareProjectID returns the id of the project a cluster is shared with.
+// The owner project cannot be the target of a share, the target has to be
+// in the organization of the cluster and the caller has to be allowed to
+// perform action in it as well.
+func (s *clusterService) shareProjectID(ctx context.Context, c *infrav3.Cluster, project, action string) (uuid.UUID, error) {
+	if project == "" {
+		return uuid.Nil, fmt.Errorf("project to share cluster %s with is missing", c.Metadata.Name)
+	}
+	if project == c.Metadata.Project {
+		return uuid.Nil, fmt.Errorf("cluster %s is owned by project %s", c.Metadata.Name, project)
+	}
+	owner, err := dao.GetProjectOrganization(ctx, s.db, c.Metadata.Project)
+	if err != nil {
+		return uuid.Nil, errors.Wrapf(err, "unable to get project %s", c.Metadata.Project)
+	}
+	target, err := dao.GetProjectOrganization(ctx, s.db, project)
+	if err != nil || target.OrganizationId != owner.OrganizationId {
+		return uuid.Nil, status.Errorf(codes.NotFound, "project %s not found", project)
+	}
+	if err := s.authorizeShareProject(ctx, c, project, action); err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(target.ProjectId)
+}
+
+// authorizeShareProject checks that the caller may share or unshare the
+// cluster in the target project, the same way the request was authorized
+// in the owner project
+func (s *clusterService) authorizeShareProject(ctx context.Context, c *infrav3.Cluster, project, action string) error {
+	if IsInternalRequest(ctx) {
+		return nil
+	}
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "unable to retrieve session data")
+	}
+	res, err := s.azc.Enforce(ctx, &authzv1.EnforceRequest{
+		Params: []string{"u:" + sd.Username, "*", project, c.Metadata.Organization,
+			fmt.Sprintf("/infra/v3/project/%s/cluster/%s/%s", project, c.Metadata.Name, action), "POST"},
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Res {
+		return status.Errorf(codes.PermissionDenied, "not authorized to %s clusters with project %s", action, project)
+	}
+	return nil
+}
+
+// validateShareNamespaces validates the namespaces a share is restricted
+// to and returns them sorted without duplicates
+func validateShareNamespaces(namespaces []string) ([]string, error) {
+	seen := make(map[string]bool)
+	ret := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid namespace %q: %s", ns, strings.Join(errs, ", "))
+		}
+		if !seen[ns] {
+			seen[ns] = true
+			ret = append(ret, ns)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (s *clusterService) GetClusterShares(ctx context.Context, clusterID string) ([]*infrav3.ProjectCluster, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := cdao.GetProjectsForCluster(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]*infrav3.ProjectCluster, 0, len(projects))
+	for _, pc := range projects {
+		shares = append(shares, prepareProjectClusterResponse(pc))
+	}
+	return shares, nil
+}
+
+// DeleteBootstrapAgentsForCluster deletes the bootstrap agents of the cluster
+func (s *clusterService) DeleteBootstrapAgentsForCluster(ctx context.Context, cluster *infrav3.Cluster) error {
+	templates, err := s.relayAgentTemplates(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	for _, bat := range templates {
+
+		agent := &sentry.BootstrapAgent{
+			Metadata: &commonv3.Metadata{
+				Id:           cluster.Metadata.Id,
+				Name:         cluster.Metadata.Name,
+				Partner:      cluster.Metadata.Partner,
+				Organization: cluster.Metadata.Organization,
+				Project:      cluster.Metadata.Project,
+			},
+			Spec: &sentry.BootstrapAgentSpec{
+				TemplateRef: fmt.Sprintf("template/%s", bat.Metadata.Name),
+			},
+		}
+
+		templateRef, err := sentryutil.GetTemplateScope(agent.Spec.TemplateRef)
+		if err != nil {
+			return err
+		}
+
+		err = s.bs.DeleteBootstrapAgent(ctx, templateRef, query.WithMeta(agent.Metadata))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decommissioningCluster returns the cluster with its ids in metadata if
+// its agent was asked to uninstall
+func (s *clusterService) decommissioningCluster(ctx context.Context, clusterID string) (*infrav3.Cluster, bool, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, false, err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return nil, false, err
+	}
+	var conditions []*infrav3.ClusterCondition
+	if c.Conditions != nil {
+		json.Unmarshal(c.Conditions, &conditions)
+	}
+	cluster := &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{
+			Id:           c.ID.String(),
+			Name:         c.Name,
+			Project:      c.ProjectId.String(),
+			Organization: c.OrganizationId.String(),
+			Partner:      c.PartnerId.String(),
+		},
+		Spec: &infrav3.ClusterSpec{
+			ClusterData: &infrav3.ClusterData{
+				ClusterStatus: &infrav3.ClusterStatus{Conditions: conditions},
+			},
+		},
+	}
+	return cluster, clstrutil.IsClusterDeleteInProgress(cluster), nil
+}
+
+func (s *clusterService) IsClusterDecommissioning(ctx context.Context, clusterID string) (bool, error) {
+	_, ok, err := s.decommissioningCluster(ctx, clusterID)
+	return ok, err
+}
+
+func (s *clusterService) GetClusterKubectlUsers(ctx context.Context, clusterID string) ([]string, []string, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := dao.GetOrganizationUsernames(ctx, s.db, c.PartnerId, c.OrganizationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	cnss, err := cdao.ListLiveClusterNamespaces(ctx, s.db, []uuid.UUID{c.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+	namespaces := make([]string, 0, len(cnss))
+	for _, cns := range cnss {
+		namespaces = append(namespaces, cns.Name)
+	}
+	return users, namespaces, nil
+}
+
+// AckClusterDecommission purges the cluster once its agent uninstalled
+// itself. Failed uninstalls are retried by deleting the relay agent
+// through the relay.
+func (s *clusterService) AckClusterDecommission(ctx context.Context, clusterID string, success bool, reason string) error {
+	cluster, ok, err := s.decommissioningCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("cluster %s is not being deleted", clusterID)
+	}
+
+	condition := clstrutil.NewClusterDelete(constants.Retry, fmt.Sprintf("agent uninstall failed: %s", reason))
+	if success {
+		err = s.DeleteBootstrapAgentsForCluster(ctx, cluster)
+		if err != nil {
+			return errors.Wrap(err, "unable to delete bootstrap agents")
+		}
+		condition = clstrutil.NewClusterDelete(constants.Success, "agent uninstalled")
+	}
+	_log.WithContext(ctx).Infow("cluster agent uninstall acknowledged", "cluster", cluster.Metadata.Name, "success", success, "reason", reason)
+
+	return s.UpdateStatus(ctx, &infrav3.Cluster{
+		Metadata: cluster.Metadata,
+		Spec: &infrav3.ClusterSpec{
+			ClusterData: &infrav3.ClusterData{
+				ClusterStatus: &infrav3.ClusterStatus{
+					Conditions: []*infrav3.ClusterCondition{condition},
+				},
+			},
+		},
+	}, query.WithMeta(cluster.Metadata))
+}
+
+// preflightCluster returns the cluster with its ids in metadata and its
+// proxy config
+func (s *clusterService) preflightCluster(ctx context.Context, clusterID string) (*infrav3.Cluster, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return nil, err
+	}
+	var proxy infrav3.ProxyConfig
+	if c.ProxyConfig != nil {
+		json.Unmarshal(c.ProxyConfig, &proxy)
+	}
+	return &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{
+			Id:           c.ID.String(),
+			Name:         c.Name,
+			Project:      c.ProjectId.String(),
+			Organization: c.OrganizationId.String(),
+			Partner:      c.PartnerId.String(),
+		},
+		Spec: &infrav3.ClusterSpec{ProxyConfig: &proxy},
+	}, nil
+}
+
+// GetClusterPreflight returns the relay endpoints the agent of cluster
+// dials, wildcard hosts are resolved to the cluster id the way the agent
+// does when connecting.
+func (s *clusterService) GetClusterPreflight(ctx context.Context, clusterID string) (*sentryrpc.GetPreflightResponse, error) {
+	cluster, err := s.preflightCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	relays, err := s.GetRelaysConfigForCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &sentryrpc.GetPreflightResponse{}
+	seen := make(map[string]bool)
+	for _, relay := range relays {
+		for _, ep := range []string{relay.Addr, relay.Endpoint} {
+			ep = strings.Replace(ep, "*", cluster.Metadata.Id, 1)
+			if ep == "" || seen[ep] {
+				continue
+			}
+			seen[ep] = true
+			resp.Endpoints = append(resp.Endpoints, ep)
+		}
+	}
+	resp.Proxy = preflightProxy(cluster.Spec.ProxyConfig)
+	return resp, nil
+}
+
+// preflightProxy returns the proxy the agent checks the endpoints through,
+// the credentials are never returned, the agent has them from its
+// bootstrap manifests
+func preflightProxy(pc *infrav3.ProxyConfig) *infrav3.ProxyConfig {
+	if !pc.GetEnabled() {
+		return nil
+	}
+	proxy := proto.Clone(pc).(*infrav3.ProxyConfig)
+	proxy.ProxyAuth = ""
+	return proxy
+}
+
+// ReportClusterPreflight records the connectivity preflight results of
+// the agent as the connectivity preflight condition of cluster
+func (s *clusterService) ReportClusterPreflight(ctx context.Context, clusterID string, results []*sentryrpc.PreflightResult) error {
+	if len(results) == 0 {
+		return status.Error(codes.InvalidArgument, "no preflight results reported")
+	}
+	cluster, err := s.preflightCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	condition := preflightCondition(results, cluster.Spec.ProxyConfig.Enabled)
+	_log.WithContext(ctx).Infow("cluster connectivity preflight reported", "cluster", cluster.Metadata.Name, "status", condition.Status, "reason", condition.Reason)
+
+	return s.UpdateStatus(ctx, &infrav3.Cluster{
+		Metadata: cluster.Metadata,
+		Spec: &infrav3.ClusterSpec{
+			ClusterData: &infrav3.ClusterData{
+				ClusterStatus: &infrav3.ClusterStatus{
+					Conditions: []*infrav3.ClusterCondition{condition},
+				},
+			},
+		},
+	}, query.WithMeta(cluster.Metadata))
+}
+
+// preflightCondition summarizes preflight results, failed when any of the
+// endpoints is unreachable
+func preflightCondition(results []*sentryrpc.PreflightResult, proxy bool) *infrav3.ClusterCondition {
+	via := "directly"
+	if proxy {
+		via = "through proxy"
+	}
+	var failed []string
+	for _, r := range results {
+		if !r.Reachable {
+			failed = append(failed, fmt.Sprintf("%s: %s", r.Endpoint, r.Reason))
+		}
+	}
+	if len(failed) > 0 {
+		return clstrutil.NewClusterConnectivityPreflight(constants.Failed,
+			fmt.Sprintf("%d of %d relay endpoints unreachable %s: %s", len(failed), len(results), via, strings.Join(failed, "; ")))
+	}
+	return clstrutil.NewClusterConnectivityPreflight(constants.Success,
+		fmt.Sprintf("%d relay endpoints reachable %s", len(results), via))
+}
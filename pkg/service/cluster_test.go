@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/query"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
@@ -33,7 +38,7 @@ func TestCreateCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -77,7 +82,7 @@ func TestUpdateCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -113,7 +118,7 @@ func TestSelectCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -149,7 +154,7 @@ func TestGetCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -184,7 +189,7 @@ func TestListCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	puuid := uuid.New().String()
 	ouuid := uuid.New().String()
@@ -215,7 +220,7 @@ func TestListCluster(t *testing.T) {
 func TestListClusterNoProject(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
-	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
 
 	pruuid := uuid.New().String()
 
@@ -235,3 +240,250 @@ func TestListClusterNoProject(t *testing.T) {
 		t.Errorf("expect error: %s, got error: %s", expect.Error(), err.Error())
 	}
 }
+
+func TestCheckClusterAccess(t *testing.T) {
+	issuedAt := time.Now().Add(-time.Hour)
+	tt := []struct {
+		name      string
+		status    commonv3.ParalusConditionStatus
+		revokedAt time.Time
+		err       error
+	}{
+		{"not set", commonv3.ParalusConditionStatus_NotSet, time.Time{}, nil},
+		{"approved", commonv3.ParalusConditionStatus_Success, time.Time{}, nil},
+		{"pending", commonv3.ParalusConditionStatus_Pending, time.Time{}, ErrClusterApprovalPending},
+		{"rejected", commonv3.ParalusConditionStatus_Failed, time.Time{}, ErrClusterRejected},
+		{"revoked", commonv3.ParalusConditionStatus_Success, time.Now(), ErrClusterSessionsRevoked},
+		{"issued after revoke", commonv3.ParalusConditionStatus_Success, issuedAt.Add(-time.Minute), nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			conditions, _ := json.Marshal([]*infrav3.ClusterCondition{
+				{Type: infrav3.ClusterConditionType_ClusterRegister, Status: commonv3.ParalusConditionStatus_Success},
+				{Type: infrav3.ClusterConditionType_ClusterApprove, Status: tc.status},
+			})
+			cuuid := uuid.New().String()
+			mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."organization_id"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "conditions", "sessions_revoked_at"}).
+					AddRow(cuuid, "cluster", conditions, tc.revokedAt))
+
+			cs := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
+			err := cs.CheckClusterAccess(context.Background(), cuuid, issuedAt.Unix())
+			if err != tc.err {
+				t.Errorf("expected error %v, got %v", tc.err, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSetApprovalCondition(t *testing.T) {
+	register := &infrav3.ClusterCondition{Type: infrav3.ClusterConditionType_ClusterRegister}
+	approve := &infrav3.ClusterCondition{Type: infrav3.ClusterConditionType_ClusterApprove, Status: commonv3.ParalusConditionStatus_Pending}
+	approved := &infrav3.ClusterCondition{Type: infrav3.ClusterConditionType_ClusterApprove, Status: commonv3.ParalusConditionStatus_Success}
+
+	conditions := setApprovalCondition([]*infrav3.ClusterCondition{approve, register}, approved)
+	if len(conditions) != 2 || conditions[0] != approved || approvalCondition(conditions) != approved {
+		t.Errorf("approval condition not replaced: %v", conditions)
+	}
+	conditions = setApprovalCondition([]*infrav3.ClusterCondition{register}, approved)
+	if len(conditions) != 2 || conditions[1] != approved {
+		t.Errorf("approval condition not added: %v", conditions)
+	}
+	conditions = setApprovalCondition([]*infrav3.ClusterCondition{approve, register}, nil)
+	if len(conditions) != 1 || approvalCondition(conditions) != nil {
+		t.Errorf("approval condition not dropped: %v", conditions)
+	}
+}
+
+func TestValidateShareNamespaces(t *testing.T) {
+	namespaces, err := validateShareNamespaces([]string{"web", "api", "web"})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if len(namespaces) != 2 || namespaces[0] != "api" || namespaces[1] != "web" {
+		t.Errorf("expected [api web], got %v", namespaces)
+	}
+	if _, err := validateShareNamespaces([]string{"Not_A_Namespace"}); err == nil {
+		t.Error("expected invalid namespace to be rejected")
+	}
+}
+
+type denyAuthzClient struct {
+	mockAuthzClient
+	params []string
+}
+
+func (c *denyAuthzClient) Enforce(ctx context.Context, in *authzv1.EnforceRequest) (*authzv1.BoolReply, error) {
+	c.params = in.Params
+	return &authzv1.BoolReply{Res: false}, nil
+}
+
+func TestAuthorizeShareProject(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	azc := &denyAuthzClient{}
+	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), azc, getLogger())
+	c := &infrav3.Cluster{Metadata: &commonv3.Metadata{Name: "c1", Project: "owner", Organization: "org1"}}
+
+	err := ps.(*clusterService).authorizeShareProject(context.Background(), c, "target", "share")
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated without session, got %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{Username: "user@example.com"})
+	err = ps.(*clusterService).authorizeShareProject(ctx, c, "target", "share")
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+	expected := "u:user@example.com,*,target,org1,/infra/v3/project/target/cluster/c1/share,POST"
+	if strings.Join(azc.params, ",") != expected {
+		t.Errorf("expected enforce params %s, got %v", expected, azc.params)
+	}
+
+	internal := context.WithValue(context.Background(), common.SessionInternalKey, true)
+	if err := ps.(*clusterService).authorizeShareProject(internal, c, "target", "share"); err != nil {
+		t.Errorf("expected internal requests to be allowed, got %v", err)
+	}
+}
+
+func TestGetClusterShares(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
+
+	cuuid := uuid.New().String()
+	owner := uuid.New().String()
+	shared := uuid.New().String()
+
+	mock.ExpectQuery(`SELECT "projectcluster"."project_id", "projectcluster"."cluster_id"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id", "access", "namespaces"}).
+		AddRow(owner, cuuid, "FULL_ACCESS", []byte("[]")).
+		AddRow(shared, cuuid, "READ_ONLY", []byte(`["web"]`)))
+
+	shares, err := ps.GetClusterShares(context.Background(), cuuid)
+	if err != nil {
+		t.Fatal("could not get cluster shares:", err)
+	}
+	if len(shares) != 2 {
+		t.Fatalf("expected 2 shares, got %d", len(shares))
+	}
+	if shares[0].Access != infrav3.ClusterShareAccess_FULL_ACCESS || len(shares[0].Namespaces) != 0 {
+		t.Errorf("unexpected owner share %v", shares[0])
+	}
+	if shares[1].ProjectID != shared || shares[1].Access != infrav3.ClusterShareAccess_READ_ONLY {
+		t.Errorf("unexpected share %v", shares[1])
+	}
+	if len(shares[1].Namespaces) != 1 || shares[1].Namespaces[0] != "web" {
+		t.Errorf("expected share restricted to web, got %v", shares[1].Namespaces)
+	}
+}
+
+func TestIsClusterDecommissioning(t *testing.T) {
+	tt := []struct {
+		name   string
+		status commonv3.ParalusConditionStatus
+		want   bool
+	}{
+		{"not deleted", commonv3.ParalusConditionStatus_NotSet, false},
+		{"delete pending", commonv3.ParalusConditionStatus_Pending, false},
+		{"waiting for agent", commonv3.ParalusConditionStatus_InProgress, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			conditions, _ := json.Marshal([]*infrav3.ClusterCondition{
+				{Type: infrav3.ClusterConditionType_ClusterDelete, Status: tc.status},
+			})
+			cuuid := uuid.New().String()
+			mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."organization_id"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "conditions"}).
+					AddRow(cuuid, "cluster", conditions))
+
+			cs := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
+			got, err := cs.IsClusterDecommissioning(context.Background(), cuuid)
+			if err != nil {
+				t.Fatal("could not check cluster decommission:", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAckClusterDecommissionNotDeleting(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	conditions, _ := json.Marshal([]*infrav3.ClusterCondition{
+		{Type: infrav3.ClusterConditionType_ClusterDelete, Status: commonv3.ParalusConditionStatus_NotSet},
+	})
+	cuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."organization_id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "conditions"}).
+			AddRow(cuuid, "cluster", conditions))
+
+	cs := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
+	if err := cs.AckClusterDecommission(context.Background(), cuuid, true, ""); err == nil {
+		t.Error("expected acknowledgement of cluster not being deleted to fail")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPreflightCondition(t *testing.T) {
+	results := []*sentryrpc.PreflightResult{
+		{Endpoint: "sentry.paralus.local:443", Reachable: true},
+		{Endpoint: "c1.core-connector.relay.paralus.local:443", Reason: "proxy returned 403"},
+	}
+	c := preflightCondition(results, true)
+	if c.Type != infrav3.ClusterConditionType_ClusterConnectivityPreflight || c.Status != commonv3.ParalusConditionStatus_Failed {
+		t.Errorf("expected failed preflight condition, got %v", c)
+	}
+	if !strings.Contains(c.Reason, "1 of 2") || !strings.Contains(c.Reason, "through proxy") || !strings.Contains(c.Reason, "proxy returned 403") {
+		t.Errorf("unexpected reason %q", c.Reason)
+	}
+	c = preflightCondition(results[:1], false)
+	if c.Status != commonv3.ParalusConditionStatus_Success || !strings.Contains(c.Reason, "directly") {
+		t.Errorf("expected successful preflight condition, got %v", c)
+	}
+}
+
+func TestPreflightProxy(t *testing.T) {
+	if p := preflightProxy(&infrav3.ProxyConfig{HttpsProxy: "http://proxy:3128"}); p != nil {
+		t.Errorf("expected no proxy when disabled, got %v", p)
+	}
+	pc := &infrav3.ProxyConfig{Enabled: true, HttpsProxy: "http://proxy:3128", ProxyAuth: "user:secret"}
+	p := preflightProxy(pc)
+	if p.HttpsProxy != "http://proxy:3128" {
+		t.Errorf("unexpected proxy %v", p)
+	}
+	if p.ProxyAuth != "" {
+		t.Error("expected proxy credentials to be removed")
+	}
+	if pc.ProxyAuth != "user:secret" {
+		t.Error("expected cluster proxy config to be unchanged")
+	}
+}
+
+func TestReportClusterPreflightNoResults(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	cs := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), &mockAuthzClient{}, getLogger())
+	err := cs.ReportClusterPreflight(context.Background(), uuid.NewString(), nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/pkg/errors"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ClusterGroupService is the interface for cluster group operations
+type ClusterGroupService interface {
+	// create cluster group
+	Create(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error)
+	// get cluster group
+	Get(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error)
+	// update cluster group
+	Update(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error)
+	// delete cluster group
+	Delete(ctx context.Context, group *infrav3.ClusterGroup) error
+	// list cluster groups of project
+	List(ctx context.Context, opts ...query.Option) (*infrav3.ClusterGroupList, error)
+	// rotate bootstrap token of the clusters in group
+	RotateToken(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error)
+	// revoke kubeconfigs issued for the clusters in group
+	RevokeSessions(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error)
+	// get combined policy of the groups the cluster is a member of
+	GetClusterGroupPolicy(ctx context.Context, clusterID string) (*ClusterGroupPolicy, error)
+}
+
+// ClusterGroupPolicy is the combined policy of the cluster groups
+// a cluster is a member of
+type ClusterGroupPolicy struct {
+	// names of the groups
+	Groups []string
+	// projects the cluster is shared with, merged across the groups
+	Shares            []*infrav3.ProjectCluster
+	DisableWebKubectl bool
+	DisableCLIKubectl bool
+}
+
+// clusterGroupService implements ClusterGroupService
+type clusterGroupService struct {
+	db *bun.DB
+	cs ClusterService
+	al *zap.Logger
+}
+
+// NewClusterGroupService return new cluster group service
+func NewClusterGroupService(db *bun.DB, cs ClusterService, al *zap.Logger) ClusterGroupService {
+	return &clusterGroupService{db: db, cs: cs, al: al}
+}
+
+// ValidateClusterGroupSelector checks if selector is a valid non empty
+// label selector
+func ValidateClusterGroupSelector(selector string) error {
+	if strings.TrimSpace(selector) == "" {
+		return fmt.Errorf("invalid cluster group, selector is missing")
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return fmt.Errorf("invalid cluster group selector %q: %s", selector, err)
+	}
+	return nil
+}
+
+func (s *clusterGroupService) Create(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error) {
+	if group.GetMetadata().GetName() == "" {
+		return nil, fmt.Errorf("invalid cluster group, name is missing")
+	}
+	if err := ValidateClusterGroupSelector(group.GetSpec().GetSelector()); err != nil {
+		return nil, err
+	}
+
+	po, err := dao.GetProjectOrganization(ctx, s.db, group.Metadata.Project)
+	if err != nil {
+		return nil, err
+	}
+	projectID := uuid.MustParse(po.ProjectId)
+
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":       group.Metadata.Name,
+		"project_id": projectID,
+		"trash":      false,
+	}, &models.ClusterGroup{})
+	if err == nil {
+		return nil, fmt.Errorf("cluster group %s already exists", group.Metadata.Name)
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	cg := &models.ClusterGroup{
+		Name:           group.Metadata.Name,
+		Description:    group.Metadata.Description,
+		OrganizationId: uuid.MustParse(po.OrganizationId),
+		PartnerId:      uuid.MustParse(po.PartnerId),
+		ProjectId:      projectID,
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+	}
+	if err := s.setSpec(ctx, cg, group.Spec); err != nil {
+		return nil, err
+	}
+
+	_, err = dao.Create(ctx, s.db, cg)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateClusterGroupAuditEvent(ctx, s.al, AuditActionCreate, cg.Name, group.Metadata.Project)
+
+	return s.prepareClusterGroupResponse(ctx, cg, group.Metadata.Project)
+}
+
+func (s *clusterGroupService) Get(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error) {
+	cg, err := s.getClusterGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	return s.prepareClusterGroupResponse(ctx, cg, group.Metadata.Project)
+}
+
+func (s *clusterGroupService) Update(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error) {
+	if err := ValidateClusterGroupSelector(group.GetSpec().GetSelector()); err != nil {
+		return nil, err
+	}
+	cg, err := s.getClusterGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	cg.Description = group.Metadata.Description
+	cg.ModifiedAt = time.Now()
+	if err := s.setSpec(ctx, cg, group.Spec); err != nil {
+		return nil, err
+	}
+
+	_, err = dao.Update(ctx, s.db, cg.ID, cg)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateClusterGroupAuditEvent(ctx, s.al, AuditActionUpdate, cg.Name, group.Metadata.Project)
+
+	return s.prepareClusterGroupResponse(ctx, cg, group.Metadata.Project)
+}
+
+func (s *clusterGroupService) Delete(ctx context.Context, group *infrav3.ClusterGroup) error {
+	cg, err := s.getClusterGroup(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	err = dao.Delete(ctx, s.db, cg.ID, cg)
+	if err != nil {
+		return err
+	}
+
+	CreateClusterGroupAuditEvent(ctx, s.al, AuditActionDelete, cg.Name, group.Metadata.Project)
+	return nil
+}
+
+func (s *clusterGroupService) List(ctx context.Context, opts ...query.Option) (*infrav3.ClusterGroupList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	projectID, err := dao.GetProjectId(ctx, s.db, queryOptions.Project)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "no cluster groups found")
+		}
+		return nil, err
+	}
+
+	cgs, err := cdao.ListClusterGroupsForProject(ctx, s.db, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &infrav3.ClusterGroupList{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.ClusterGroupListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: int64(len(cgs)),
+		},
+	}
+	for i := range cgs {
+		group, err := s.prepareClusterGroupResponse(ctx, &cgs[i], queryOptions.Project)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, group)
+	}
+	return list, nil
+}
+
+func (s *clusterGroupService) RotateToken(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error) {
+	cg, err := s.getClusterGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	members, err := cdao.ListClusterGroupMembers(ctx, s.db, cg)
+	if err != nil {
+		return nil, err
+	}
+
+	// rotate as many as possible, a failure of one cluster should not
+	// leave the rest of the group with the old token
+	var failed []string
+	for _, c := range members {
+		_, err := s.cs.RotateClusterToken(ctx, &infrav3.Cluster{
+			Metadata: &commonv3.Metadata{
+				Name:    c.Name,
+				Project: group.Metadata.Project,
+			},
+		})
+		if err != nil {
+			_log.WithContext(ctx).Errorw("unable to rotate token of cluster", "group", cg.Name, "cluster", c.Name, "error", err)
+			failed = append(failed, c.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("unable to rotate token of clusters %s", strings.Join(failed, ", "))
+	}
+
+	CreateClusterGroupActionAuditEvent(ctx, s.al, "token.rotate", "bootstrap tokens rotated", cg.Name, group.Metadata.Project)
+
+	return s.prepareClusterGroupResponse(ctx, cg, group.Metadata.Project)
+}
+
+func (s *clusterGroupService) RevokeSessions(ctx context.Context, group *infrav3.ClusterGroup) (*infrav3.ClusterGroup, error) {
+	cg, err := s.getClusterGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	members, err := cdao.ListClusterGroupMembers(ctx, s.db, cg)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, c := range members {
+		ids = append(ids, c.ID)
+	}
+	err = cdao.RevokeClusterSessions(ctx, s.db, ids, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to revoke sessions")
+	}
+
+	CreateClusterGroupActionAuditEvent(ctx, s.al, "sessions.revoke", "sessions revoked", cg.Name, group.Metadata.Project)
+
+	return s.prepareClusterGroupResponse(ctx, cg, group.Metadata.Project)
+}
+
+func (s *clusterGroupService) GetClusterGroupPolicy(ctx context.Context, clusterID string) (*ClusterGroupPolicy, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	var c models.Cluster
+	_, err = dao.GetByID(ctx, s.db, id, &c)
+	if err != nil {
+		return nil, err
+	}
+	var lbls map[string]string
+	if c.Labels != nil {
+		json.Unmarshal(c.Labels, &lbls)
+	}
+
+	// membership is evaluated against the current labels of the cluster
+	cgs, err := cdao.ListClusterGroupsForProject(ctx, s.db, c.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &ClusterGroupPolicy{}
+	shared := make(map[string]*infrav3.ProjectCluster)
+	for _, cg := range cgs {
+		sel, err := labels.Parse(cg.Selector)
+		if err != nil {
+			_log.WithContext(ctx).Infow("ignoring cluster group with invalid selector", "group", cg.Name, "error", err)
+			continue
+		}
+		if !sel.Matches(labels.Set(lbls)) {
+			continue
+		}
+		policy.Groups = append(policy.Groups, cg.Name)
+
+		var ks infrav3.ClusterGroupKubectlSettings
+		if cg.KubectlSettings != nil {
+			json.Unmarshal(cg.KubectlSettings, &ks)
+		}
+		policy.DisableWebKubectl = policy.DisableWebKubectl || ks.DisableWebKubectl
+		policy.DisableCLIKubectl = policy.DisableCLIKubectl || ks.DisableCLIKubectl
+
+		var shares []models.ClusterGroupShare
+		if cg.Shares != nil {
+			json.Unmarshal(cg.Shares, &shares)
+		}
+		for _, gs := range shares {
+			share := &infrav3.ProjectCluster{
+				ProjectID:  gs.ProjectId,
+				ClusterID:  clusterID,
+				Access:     infrav3.ClusterShareAccess(infrav3.ClusterShareAccess_value[gs.Access]),
+				Namespaces: gs.Namespaces,
+			}
+			existing, ok := shared[gs.ProjectId]
+			if !ok {
+				shared[gs.ProjectId] = share
+				policy.Shares = append(policy.Shares, share)
+				continue
+			}
+			mergeClusterGroupShare(existing, share)
+		}
+	}
+	return policy, nil
+}
+
+// mergeClusterGroupShare merges the share of another group for the same
+// project into share, the most permissive access and the union of the
+// namespaces apply, no namespaces means all of them
+func mergeClusterGroupShare(share, other *infrav3.ProjectCluster) {
+	if other.Access == infrav3.ClusterShareAccess_FULL_ACCESS {
+		share.Access = infrav3.ClusterShareAccess_FULL_ACCESS
+	}
+	if len(share.Namespaces) == 0 {
+		return
+	}
+	if len(other.Namespaces) == 0 {
+		share.Namespaces = nil
+		return
+	}
+	namespaces, _ := validateShareNamespaces(append(append([]string{}, share.Namespaces...), other.Namespaces...))
+	share.Namespaces = namespaces
+}
+
+func (s *clusterGroupService) getClusterGroup(ctx context.Context, group *infrav3.ClusterGroup) (*models.ClusterGroup, error) {
+	projectID, err := dao.GetProjectId(ctx, s.db, group.GetMetadata().GetProject())
+	if err != nil {
+		return nil, err
+	}
+	var cg models.ClusterGroup
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":       group.Metadata.Name,
+		"project_id": projectID,
+		"trash":      false,
+	}, &cg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "cluster group %s not found", group.Metadata.Name)
+		}
+		return nil, err
+	}
+	return &cg, nil
+}
+
+// setSpec sets the editable fields of the cluster group from spec, shared
+// projects have to be in the same organization as the group
+func (s *clusterGroupService) setSpec(ctx context.Context, cg *models.ClusterGroup, spec *infrav3.ClusterGroupSpec) error {
+	cg.Selector = spec.GetSelector()
+
+	ks, _ := json.Marshal(spec.GetKubectlSettings())
+	cg.KubectlSettings = json.RawMessage(ks)
+
+	shares := make([]models.ClusterGroupShare, 0, len(spec.GetShares()))
+	for _, share := range spec.GetShares() {
+		po, err := dao.GetProjectOrganization(ctx, s.db, share.GetProject())
+		if err != nil {
+			return fmt.Errorf("invalid cluster group, unable to find project %s", share.GetProject())
+		}
+		if po.OrganizationId != cg.OrganizationId.String() {
+			return fmt.Errorf("invalid cluster group, project %s is not in the organization", share.GetProject())
+		}
+		if po.ProjectId == cg.ProjectId.String() {
+			// clusters are always accessible in their own project
+			continue
+		}
+		access := share.GetAccess()
+		if access == infrav3.ClusterShareAccess_ClusterShareAccessNotSet {
+			access = infrav3.ClusterShareAccess_FULL_ACCESS
+		}
+		namespaces, err := validateShareNamespaces(share.GetNamespaces())
+		if err != nil {
+			return fmt.Errorf("invalid cluster group, %s", err)
+		}
+		shares = append(shares, models.ClusterGroupShare{
+			ProjectId:  po.ProjectId,
+			Access:     access.String(),
+			Namespaces: namespaces,
+		})
+	}
+	sb, _ := json.Marshal(shares)
+	cg.Shares = json.RawMessage(sb)
+	return nil
+}
+
+func (s *clusterGroupService) prepareClusterGroupResponse(ctx context.Context, cg *models.ClusterGroup, project string) (*infrav3.ClusterGroup, error) {
+	group := &infrav3.ClusterGroup{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.ClusterGroupKind,
+		Metadata: &commonv3.Metadata{
+			Id:          cg.ID.String(),
+			Name:        cg.Name,
+			Description: cg.Description,
+			Project:     project,
+			CreatedAt:   timestamppb.New(cg.CreatedAt),
+			ModifiedAt:  timestamppb.New(cg.ModifiedAt),
+		},
+		Spec: &infrav3.ClusterGroupSpec{
+			Selector:        cg.Selector,
+			KubectlSettings: &infrav3.ClusterGroupKubectlSettings{},
+		},
+	}
+	if cg.KubectlSettings != nil {
+		json.Unmarshal(cg.KubectlSettings, group.Spec.KubectlSettings)
+	}
+
+	var shares []models.ClusterGroupShare
+	if cg.Shares != nil {
+		json.Unmarshal(cg.Shares, &shares)
+	}
+	for _, share := range shares {
+		id, err := uuid.Parse(share.ProjectId)
+		if err != nil {
+			continue
+		}
+		name, err := dao.GetProjectName(ctx, s.db, id)
+		if err != nil {
+			// project might have been deleted since
+			_log.WithContext(ctx).Infow("unable to get shared project of cluster group", "group", cg.Name, "project", share.ProjectId, "error", err)
+			continue
+		}
+		group.Spec.Shares = append(group.Spec.Shares, &infrav3.ClusterGroupShare{
+			Project:    name,
+			Access:     infrav3.ClusterShareAccess(infrav3.ClusterShareAccess_value[share.Access]),
+			Namespaces: share.Namespaces,
+		})
+	}
+
+	members, err := cdao.ListClusterGroupMembers(ctx, s.db, cg)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range members {
+		group.Spec.Clusters = append(group.Spec.Clusters, c.Name)
+	}
+	return group, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+)
+
+func TestValidateClusterGroupSelector(t *testing.T) {
+	tt := []struct {
+		selector string
+		valid    bool
+	}{
+		{"", false},
+		{"env=prod", true},
+		{"env in (prod,staging),tier!=edge", true},
+		{"env in (prod", false},
+		{"env in prod", false},
+	}
+	for _, tc := range tt {
+		err := ValidateClusterGroupSelector(tc.selector)
+		if (err == nil) != tc.valid {
+			t.Errorf("selector %q: expected valid %v, got %v", tc.selector, tc.valid, err)
+		}
+	}
+}
+
+func TestGetClusterGroupPolicy(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	shared1 := uuid.New().String()
+	shared2 := uuid.New().String()
+
+	lbls, _ := json.Marshal(map[string]string{"env": "prod", "region": "eu"})
+	mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."organization_id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "labels", "project_id"}).
+			AddRow(cuuid, "cluster", lbls, puuid))
+
+	shares1, _ := json.Marshal([]models.ClusterGroupShare{
+		{ProjectId: shared1, Access: "READ_ONLY", Namespaces: []string{"web"}},
+		{ProjectId: shared2, Access: "READ_ONLY", Namespaces: []string{"web"}},
+	})
+	shares2, _ := json.Marshal([]models.ClusterGroupShare{
+		{ProjectId: shared1, Access: "FULL_ACCESS", Namespaces: []string{"api"}},
+		{ProjectId: shared2, Access: "READ_ONLY"},
+	})
+	mock.ExpectQuery(`SELECT "clustergroup"."id", "clustergroup"."name"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "selector", "kubectl_settings", "shares"}).
+			AddRow(uuid.New().String(), "eu", "region=eu", []byte(`{"disableWebKubectl":true}`), shares1).
+			AddRow(uuid.New().String(), "prod", "env in (prod)", []byte(`{"disableCLIKubectl":true}`), shares2).
+			AddRow(uuid.New().String(), "staging", "env=staging", []byte(`{"disableWebKubectl":true}`), nil).
+			AddRow(uuid.New().String(), "broken", "env in (prod", nil, nil))
+
+	cgs := NewClusterGroupService(db, nil, getLogger())
+	policy, err := cgs.GetClusterGroupPolicy(context.Background(), cuuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policy.Groups) != 2 || policy.Groups[0] != "eu" || policy.Groups[1] != "prod" {
+		t.Errorf("unexpected groups %v", policy.Groups)
+	}
+	if !policy.DisableWebKubectl || !policy.DisableCLIKubectl {
+		t.Errorf("kubectl settings not merged: %+v", policy)
+	}
+	if len(policy.Shares) != 2 {
+		t.Fatalf("unexpected shares %v", policy.Shares)
+	}
+	s1, s2 := policy.Shares[0], policy.Shares[1]
+	if s1.ProjectID != shared1 || s1.ClusterID != cuuid || s1.Access != infrav3.ClusterShareAccess_FULL_ACCESS ||
+		len(s1.Namespaces) != 2 || s1.Namespaces[0] != "api" || s1.Namespaces[1] != "web" {
+		t.Errorf("unexpected share %v", s1)
+	}
+	if s2.ProjectID != shared2 || s2.Access != infrav3.ClusterShareAccess_READ_ONLY || len(s2.Namespaces) != 0 {
+		t.Errorf("unexpected share %v", s2)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
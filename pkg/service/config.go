@@ -0,0 +1,949 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/diff"
+	"github.com/paralus/paralus/pkg/query"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/segmentio/encoding/json"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configKind = "Config"
+
+	oidcProviderKind   = "OIDCProvider"
+	kubectlSettingKind = "KubectlSetting"
+
+	// kubectlSettingName is the name of the single kubectl setting of an
+	// organization
+	kubectlSettingName = "default"
+	// kubectlSettingValiditySeconds is the validity used when a kubectl
+	// setting does not set one, same as when there is no setting
+	kubectlSettingValiditySeconds = 28800
+)
+
+// Actions reported for resources of a config bundle
+const (
+	ConfigActionCreate    = "create"
+	ConfigActionUpdate    = "update"
+	ConfigActionUnchanged = "unchanged"
+)
+
+const redactedSecret = "******"
+
+// ConfigService is the interface for declarative configuration of an
+// organization
+type ConfigService interface {
+	// apply multi document bundle of resources
+	Apply(ctx context.Context, config *systemv3.Config) (*systemv3.Config, error)
+	// export configuration of organization as multi document bundle
+	Export(ctx context.Context, config *systemv3.Config) (*systemv3.Config, error)
+}
+
+// configObject is a resource which can be part of a config bundle
+type configObject interface {
+	proto.Message
+	GetMetadata() *commonv3.Metadata
+}
+
+// configHandler maps a kind of config bundle to the service managing it
+type configHandler struct {
+	kind   string
+	newObj func() configObject
+	list   func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error)
+	create func(ctx context.Context, obj configObject) error
+	update func(ctx context.Context, obj configObject) error
+	// validate checks object of a bundle beyond its type; nil means
+	// every object is valid
+	validate func(obj configObject) error
+	// managed reports whether object can be managed through config;
+	// nil means every object is managed
+	managed func(obj configObject) bool
+	// strip clears fields which are either output only or managed
+	// through another kind
+	strip func(obj configObject)
+	// preserve copies fields cleared by strip from current to desired
+	// so that an update does not reset them
+	preserve func(desired, current configObject)
+	// redact hides secrets of current and desired before they are
+	// rendered
+	redact func(desired, current configObject)
+	// revert undoes the changes of an applied step which are not stored
+	// in the database, and so not rolled back with the transaction of
+	// the apply
+	revert func(ctx context.Context, step configStep) error
+}
+
+type configService struct {
+	db       *bun.DB
+	handlers []*configHandler
+	azc      AuthzService
+	al       *zap.Logger
+}
+
+// NewConfigService returns new config service. Resources of a bundle
+// are applied in the order Role, Project, Group, User, OIDCProvider,
+// KubectlSetting so that references are always resolvable.
+func NewConfigService(db *bun.DB, rs RoleService, ps ProjectService, gs GroupService, us UserService, oidcs OIDCProviderService, kss KubeconfigSettingService, azc AuthzService, al *zap.Logger) ConfigService {
+	return &configService{
+		db: db,
+		handlers: []*configHandler{
+			roleConfigHandler(rs, azc),
+			projectConfigHandler(ps),
+			groupConfigHandler(gs),
+			userConfigHandler(us),
+			oidcProviderConfigHandler(oidcs),
+			kubectlSettingConfigHandler(db, kss),
+		},
+		azc: azc,
+		al:  al,
+	}
+}
+
+func roleConfigHandler(rs RoleService, azc AuthzService) *configHandler {
+	return &configHandler{
+		kind:   roleKind,
+		newObj: func() configObject { return &rolev3.Role{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			rl, err := rs.List(ctx, &rolev3.Role{Metadata: meta})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]configObject, 0, len(rl.GetItems()))
+			for _, r := range rl.GetItems() {
+				objs = append(objs, r)
+			}
+			return objs, nil
+		},
+		create: func(ctx context.Context, obj configObject) error {
+			_, err := rs.Create(ctx, obj.(*rolev3.Role))
+			return err
+		},
+		update: func(ctx context.Context, obj configObject) error {
+			_, err := rs.Update(ctx, obj.(*rolev3.Role))
+			return err
+		},
+		managed: func(obj configObject) bool {
+			return !obj.(*rolev3.Role).GetSpec().GetBuiltin()
+		},
+		// permissions of roles are mapped in authz
+		revert: func(ctx context.Context, step configStep) error {
+			name := step.desired.GetMetadata().GetName()
+			if _, err := azc.DeleteRolePermissionMappings(ctx, &authzv1.FilteredRolePermissionMapping{Role: name}); err != nil {
+				return err
+			}
+			if step.current == nil {
+				return nil
+			}
+			perms := step.current.(*rolev3.Role).GetSpec().GetRolepermissions()
+			if len(perms) == 0 {
+				return nil
+			}
+			_, err := azc.CreateRolePermissionMappings(ctx, &authzv1.RolePermissionMappingList{
+				RolePermissionMappingList: []*authzv1.RolePermissionMapping{{Role: name, Permission: perms}},
+			})
+			return err
+		},
+		strip: func(obj configObject) {
+			if spec := obj.(*rolev3.Role).GetSpec(); spec != nil {
+				spec.Builtin = false
+				sort.Strings(spec.Rolepermissions)
+			}
+		},
+	}
+}
+
+func projectConfigHandler(ps ProjectService) *configHandler {
+	return &configHandler{
+		kind:   projectKind,
+		newObj: func() configObject { return &systemv3.Project{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			pl, err := ps.List(ctx, &systemv3.Project{Metadata: meta})
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]configObject, 0, len(pl.GetItems()))
+			for _, p := range pl.GetItems() {
+				objs = append(objs, p)
+			}
+			return objs, nil
+		},
+		create: func(ctx context.Context, obj configObject) error {
+			_, err := ps.Create(ctx, obj.(*systemv3.Project))
+			return err
+		},
+		update: func(ctx context.Context, obj configObject) error {
+			_, err := ps.Update(ctx, obj.(*systemv3.Project))
+			return err
+		},
+		// role associations of a project are managed through users and
+		// groups
+		strip: func(obj configObject) {
+			if spec := obj.(*systemv3.Project).GetSpec(); spec != nil {
+				spec.ProjectNamespaceRoles = nil
+				spec.UserRoles = nil
+			}
+		},
+		preserve: func(desired, current configObject) {
+			d, c := desired.(*systemv3.Project), current.(*systemv3.Project)
+			if d.Spec == nil {
+				d.Spec = &systemv3.ProjectSpec{}
+			}
+			d.Spec.ProjectNamespaceRoles = c.GetSpec().GetProjectNamespaceRoles()
+			d.Spec.UserRoles = c.GetSpec().GetUserRoles()
+		},
+	}
+}
+
+func groupConfigHandler(gs GroupService) *configHandler {
+	return &configHandler{
+		kind:   groupKind,
+		newObj: func() configObject { return &userv3.Group{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			gl, err := gs.List(ctx, query.WithMeta(meta))
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]configObject, 0, len(gl.GetItems()))
+			for _, g := range gl.GetItems() {
+				objs = append(objs, g)
+			}
+			return objs, nil
+		},
+		create: func(ctx context.Context, obj configObject) error {
+			_, err := gs.Create(ctx, obj.(*userv3.Group))
+			return err
+		},
+		update: func(ctx context.Context, obj configObject) error {
+			_, err := gs.Update(ctx, obj.(*userv3.Group))
+			return err
+		},
+		// group membership is managed through users
+		strip: func(obj configObject) {
+			if spec := obj.(*userv3.Group).GetSpec(); spec != nil {
+				spec.Users = nil
+			}
+		},
+		preserve: func(desired, current configObject) {
+			d, c := desired.(*userv3.Group), current.(*userv3.Group)
+			if d.Spec == nil {
+				d.Spec = &userv3.GroupSpec{}
+			}
+			d.Spec.Users = c.GetSpec().GetUsers()
+		},
+	}
+}
+
+func userConfigHandler(us UserService) *configHandler {
+	return &configHandler{
+		kind:   userKind,
+		newObj: func() configObject { return &userv3.User{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			ul, err := us.List(ctx, query.WithMeta(meta))
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]configObject, 0, len(ul.GetItems()))
+			for _, u := range ul.GetItems() {
+				objs = append(objs, u)
+			}
+			return objs, nil
+		},
+		create: func(ctx context.Context, obj configObject) error {
+			_, err := us.Create(ctx, obj.(*userv3.User))
+			return err
+		},
+		update: func(ctx context.Context, obj configObject) error {
+			_, err := us.Update(ctx, obj.(*userv3.User))
+			return err
+		},
+		// roles inherited from groups and groups synced from identity
+		// providers are not part of the user configuration
+		strip: func(obj configObject) {
+			spec := obj.(*userv3.User).GetSpec()
+			if spec == nil {
+				return
+			}
+			var roles []*userv3.ProjectNamespaceRole
+			for _, r := range spec.ProjectNamespaceRoles {
+				if r.Group == nil {
+					roles = append(roles, r)
+				}
+			}
+			spec.ProjectNamespaceRoles = roles
+			spec.IdpGroups = nil
+			spec.Password = ""
+			spec.LastLogin = ""
+			spec.RecoveryUrl = nil
+			spec.ForceReset = false
+			spec.EmailVerified = false
+			spec.PhoneVerified = false
+		},
+		// users are identities of the identity provider
+		revert: func(ctx context.Context, step configStep) error {
+			if step.current == nil {
+				_, err := us.Delete(ctx, step.desired.(*userv3.User))
+				return err
+			}
+			previous := proto.Clone(step.current).(*userv3.User)
+			previous.Metadata.Organization = step.desired.GetMetadata().GetOrganization()
+			previous.Metadata.Partner = step.desired.GetMetadata().GetPartner()
+			if spec := previous.GetSpec(); spec != nil {
+				var roles []*userv3.ProjectNamespaceRole
+				for _, r := range spec.ProjectNamespaceRoles {
+					if r.Group == nil {
+						roles = append(roles, r)
+					}
+				}
+				spec.ProjectNamespaceRoles = roles
+			}
+			_, err := us.Update(ctx, previous)
+			return err
+		},
+	}
+}
+
+func oidcProviderConfigHandler(oidcs OIDCProviderService) *configHandler {
+	return &configHandler{
+		kind:   oidcProviderKind,
+		newObj: func() configObject { return &systemv3.OIDCProvider{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			pl, err := oidcs.List(ctx, meta)
+			if err != nil {
+				return nil, err
+			}
+			objs := make([]configObject, 0, len(pl.GetItems()))
+			for _, p := range pl.GetItems() {
+				objs = append(objs, p)
+			}
+			return objs, nil
+		},
+		create: func(ctx context.Context, obj configObject) error {
+			_, err := oidcs.Create(ctx, obj.(*systemv3.OIDCProvider))
+			return err
+		},
+		update: func(ctx context.Context, obj configObject) error {
+			_, err := oidcs.Update(ctx, obj.(*systemv3.OIDCProvider))
+			return err
+		},
+		strip: func(obj configObject) {
+			if spec := obj.(*systemv3.OIDCProvider).GetSpec(); spec != nil {
+				spec.CallbackUrl = ""
+			}
+		},
+		// client secret is not exported, keep the current one when
+		// desired state does not set it
+		preserve: func(desired, current configObject) {
+			d, c := desired.(*systemv3.OIDCProvider), current.(*systemv3.OIDCProvider)
+			if d.Spec != nil && d.Spec.ClientSecret == "" {
+				d.Spec.ClientSecret = c.GetSpec().GetClientSecret()
+			}
+		},
+		redact: func(desired, current configObject) {
+			d, c := desired.(*systemv3.OIDCProvider), current.(*systemv3.OIDCProvider)
+			changed := d.GetSpec().GetClientSecret() != c.GetSpec().GetClientSecret()
+			if c.GetSpec().GetClientSecret() != "" {
+				c.Spec.ClientSecret = redactedSecret
+			}
+			if d.GetSpec().GetClientSecret() != "" {
+				d.Spec.ClientSecret = redactedSecret
+				if changed {
+					d.Spec.ClientSecret += " (changed)"
+				}
+			}
+		},
+	}
+}
+
+func kubectlSettingConfigHandler(db *bun.DB, kss KubeconfigSettingService) *configHandler {
+	put := func(ctx context.Context, obj configObject) error {
+		meta := obj.GetMetadata()
+		partnerID, err := dao.GetPartnerId(ctx, dao.GetDB(ctx, db), meta.GetPartner())
+		if err != nil {
+			return err
+		}
+		orgID, err := dao.GetOrganizationId(ctx, dao.GetDB(ctx, db), meta.GetOrganization())
+		if err != nil {
+			return err
+		}
+		spec := obj.(*systemv3.KubectlSetting).GetSpec()
+		ks := &sentry.KubeconfigSetting{
+			OrganizationID:              orgID.String(),
+			PartnerID:                   partnerID.String(),
+			ValiditySeconds:             spec.GetValiditySeconds(),
+			SaValiditySeconds:           spec.GetSaValiditySeconds(),
+			EnableSessionCheck:          spec.GetEnableSessionCheck(),
+			EnablePrivateRelay:          spec.GetEnablePrivateRelay(),
+			EnforceOrgAdminSecretAccess: spec.GetEnforceOrgAdminSecretAccess(),
+			DisableWebKubectl:           spec.GetDisableWebKubectl(),
+			DisableCLIKubectl:           spec.GetDisableCLIKubectl(),
+		}
+		if ks.ValiditySeconds == 0 {
+			ks.ValiditySeconds = kubectlSettingValiditySeconds
+		}
+		if ks.SaValiditySeconds == 0 {
+			ks.SaValiditySeconds = kubectlSettingValiditySeconds
+		}
+		return kss.Patch(ctx, ks)
+	}
+	return &configHandler{
+		kind:   kubectlSettingKind,
+		newObj: func() configObject { return &systemv3.KubectlSetting{} },
+		list: func(ctx context.Context, meta *commonv3.Metadata) ([]configObject, error) {
+			orgID, err := dao.GetOrganizationId(ctx, dao.GetDB(ctx, db), meta.GetOrganization())
+			if err != nil {
+				return nil, err
+			}
+			ks, err := kss.Get(ctx, orgID.String(), "", false)
+			if err == constants.ErrNotFound {
+				return nil, nil
+			} else if err != nil {
+				return nil, err
+			}
+			return []configObject{&systemv3.KubectlSetting{
+				Metadata: &commonv3.Metadata{Name: kubectlSettingName},
+				Spec: &systemv3.KubectlSettingSpec{
+					ValiditySeconds:             ks.ValiditySeconds,
+					SaValiditySeconds:           ks.SaValiditySeconds,
+					EnableSessionCheck:          ks.EnableSessionCheck,
+					EnablePrivateRelay:          ks.EnablePrivateRelay,
+					EnforceOrgAdminSecretAccess: ks.EnforceOrgAdminSecretAccess,
+					DisableWebKubectl:           ks.DisableWebKubectl,
+					DisableCLIKubectl:           ks.DisableCLIKubectl,
+				},
+			}}, nil
+		},
+		create: put,
+		update: put,
+		validate: func(obj configObject) error {
+			if name := obj.GetMetadata().GetName(); name != kubectlSettingName {
+				return fmt.Errorf("%s is named %q, got %q", kubectlSettingKind, kubectlSettingName, name)
+			}
+			return nil
+		},
+	}
+}
+
+func (s *configService) handler(kind string) (*configHandler, int) {
+	for i, h := range s.handlers {
+		if strings.EqualFold(h.kind, kind) {
+			return h, i
+		}
+	}
+	return nil, -1
+}
+
+func (s *configService) supportedKinds() []string {
+	kinds := make([]string, 0, len(s.handlers))
+	for _, h := range s.handlers {
+		kinds = append(kinds, h.kind)
+	}
+	return kinds
+}
+
+// configDocument is a single resource of a config bundle
+type configDocument struct {
+	handler *configHandler
+	order   int
+	obj     configObject
+}
+
+// normalizeConfigObject sets type meta and clears output only fields of
+// obj so that current and desired states can be compared
+func normalizeConfigObject(h *configHandler, obj configObject) {
+	m := obj.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByName("apiVersion"), protoreflect.ValueOfString(apiVersion))
+	m.Set(fields.ByName("kind"), protoreflect.ValueOfString(h.kind))
+	m.Clear(fields.ByName("status"))
+
+	if meta := obj.GetMetadata(); meta != nil {
+		meta.Id = ""
+		meta.UrlScope = ""
+		meta.CreatedAt = nil
+		meta.ModifiedAt = nil
+		meta.Organization = ""
+		meta.Partner = ""
+		// labels holding the ids of organization and partner are set by
+		// the services when listing
+		delete(meta.Labels, "organization")
+		delete(meta.Labels, "partner")
+		if len(meta.Labels) == 0 {
+			meta.Labels = nil
+		}
+	}
+	if h.strip != nil {
+		h.strip(obj)
+	}
+	// empty spec is same as no spec
+	if spec := fields.ByName("spec"); m.Has(spec) && proto.Size(m.Get(spec).Message().Interface()) == 0 {
+		m.Clear(spec)
+	}
+}
+
+func marshalConfigObject(obj configObject) (string, error) {
+	jb, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	yb, err := yaml.JSONToYAML(jb)
+	if err != nil {
+		return "", err
+	}
+	return string(yb), nil
+}
+
+type configTypeMeta struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+}
+
+// parseBundle parses multi document YAML bundle into resources sorted
+// in the order they have to be applied
+func (s *configService) parseBundle(bundle string) ([]configDocument, error) {
+	var docs []configDocument
+	seen := make(map[string]bool)
+
+	reader := kyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(bundle)))
+	for {
+		yb, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to read bundle: %w", err)
+		}
+		if len(bytes.TrimSpace(yb)) == 0 {
+			continue
+		}
+		jb, err := yaml.YAMLToJSON(yb)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse document %d: %w", len(docs)+1, err)
+		}
+		if string(jb) == "null" {
+			continue
+		}
+
+		var tm configTypeMeta
+		if err := json.Unmarshal(jb, &tm); err != nil {
+			return nil, fmt.Errorf("unable to parse document %d: %w", len(docs)+1, err)
+		}
+		if tm.APIVersion != "" && tm.APIVersion != apiVersion {
+			return nil, fmt.Errorf("document %d: unsupported apiVersion %q", len(docs)+1, tm.APIVersion)
+		}
+		h, order := s.handler(tm.Kind)
+		if h == nil {
+			return nil, fmt.Errorf("document %d: unsupported kind %q, supported kinds are %s",
+				len(docs)+1, tm.Kind, strings.Join(s.supportedKinds(), ", "))
+		}
+
+		obj := h.newObj()
+		if err := json.Unmarshal(jb, obj); err != nil {
+			return nil, fmt.Errorf("unable to parse %s document %d: %w", h.kind, len(docs)+1, err)
+		}
+		name := obj.GetMetadata().GetName()
+		if name == "" {
+			return nil, fmt.Errorf("document %d: %s without metadata.name", len(docs)+1, h.kind)
+		}
+		if h.validate != nil {
+			if err := h.validate(obj); err != nil {
+				return nil, fmt.Errorf("document %d: %w", len(docs)+1, err)
+			}
+		}
+		key := h.kind + "/" + name
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate %s %q in bundle", h.kind, name)
+		}
+		seen[key] = true
+
+		docs = append(docs, configDocument{handler: h, order: order, obj: obj})
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		return docs[i].order < docs[j].order
+	})
+	return docs, nil
+}
+
+// configStep is a change computed for a single resource of the bundle
+type configStep struct {
+	handler *configHandler
+	action  string
+	desired configObject
+	current configObject
+}
+
+func (s *configService) Apply(ctx context.Context, config *systemv3.Config) (*systemv3.Config, error) {
+	meta := config.GetMetadata()
+	if meta.GetOrganization() == "" || meta.GetPartner() == "" {
+		return config, fmt.Errorf("organization and partner are required")
+	}
+	docs, err := s.parseBundle(config.GetSpec().GetBundle())
+	if err != nil {
+		return config, err
+	}
+
+	scope := &commonv3.Metadata{Organization: meta.Organization, Partner: meta.Partner}
+	current := make(map[*configHandler]map[string]configObject)
+	var steps []configStep
+	var changes []*systemv3.ConfigChange
+	for _, doc := range docs {
+		h := doc.handler
+		if _, ok := current[h]; !ok {
+			objs, err := h.list(ctx, scope)
+			if err != nil {
+				return config, fmt.Errorf("unable to list %s: %w", h.kind, err)
+			}
+			byName := make(map[string]configObject, len(objs))
+			for _, obj := range objs {
+				byName[obj.GetMetadata().GetName()] = obj
+			}
+			current[h] = byName
+		}
+
+		name := doc.obj.GetMetadata().GetName()
+		step := configStep{handler: h, action: ConfigActionCreate, desired: doc.obj}
+		var before configObject
+		if cur, ok := current[h][name]; ok {
+			if h.managed != nil && !h.managed(cur) {
+				return config, fmt.Errorf("%s %q is builtin and cannot be changed", h.kind, name)
+			}
+			step.current = cur
+			if h.preserve != nil {
+				h.preserve(doc.obj, cur)
+			}
+			before = proto.Clone(cur).(configObject)
+			normalizeConfigObject(h, before)
+		}
+		after := proto.Clone(doc.obj).(configObject)
+		normalizeConfigObject(h, after)
+		if before != nil {
+			if proto.Equal(before, after) {
+				step.action = ConfigActionUnchanged
+			} else {
+				step.action = ConfigActionUpdate
+			}
+		}
+
+		var beforeYAML string
+		if before == nil {
+			before = h.newObj()
+		}
+		if h.redact != nil {
+			h.redact(after, before)
+		}
+		if step.current != nil {
+			if beforeYAML, err = marshalConfigObject(before); err != nil {
+				return config, err
+			}
+		}
+		afterYAML, err := marshalConfigObject(after)
+		if err != nil {
+			return config, err
+		}
+
+		steps = append(steps, step)
+		changes = append(changes, &systemv3.ConfigChange{
+			Kind:   h.kind,
+			Name:   name,
+			Action: step.action,
+			Diff:   diff.Lines(beforeYAML, afterYAML),
+		})
+	}
+
+	config.ApiVersion = apiVersion
+	config.Kind = configKind
+	config.Status = &systemv3.ConfigStatus{Changes: changes}
+	if config.GetSpec().GetDryRun() {
+		return config, nil
+	}
+
+	if err := s.applySteps(ctx, meta, steps); err != nil {
+		return config, err
+	}
+	config.Status.Applied = true
+	CreateConfigAuditEvent(ctx, s.al, meta.Organization, changes)
+	return config, nil
+}
+
+// applySteps applies steps in order in a single transaction, so that
+// either all or none of the changes are stored. Authz policies and
+// identities are stored outside of the database transaction, the changes
+// made to them by the applied steps are reverted when it is rolled back.
+func (s *configService) applySteps(ctx context.Context, meta *commonv3.Metadata, steps []configStep) error {
+	snapshot, err := s.snapshotAuthz(ctx, meta, steps)
+	if err != nil {
+		return fmt.Errorf("unable to read authz policies: %w", err)
+	}
+
+	var applied []configStep
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		ctx = dao.WithTx(ctx, tx)
+		for _, step := range steps {
+			if step.action == ConfigActionUnchanged {
+				continue
+			}
+			h := step.handler
+			obj := proto.Clone(step.desired).(configObject)
+			obj.GetMetadata().Organization = meta.Organization
+			obj.GetMetadata().Partner = meta.Partner
+			step.desired = obj
+
+			var err error
+			switch step.action {
+			case ConfigActionCreate:
+				err = h.create(ctx, obj)
+			case ConfigActionUpdate:
+				err = h.update(ctx, obj)
+			}
+			// a failed step may have changed authz or identities before
+			// failing
+			applied = append(applied, step)
+			if err != nil {
+				return fmt.Errorf("unable to %s %s %q: %w", step.action, h.kind, obj.GetMetadata().GetName(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.revert(ctx, applied, snapshot)
+	}
+	return err
+}
+
+// revert undoes the changes of applied steps which are not rolled back
+// with the transaction of the apply
+func (s *configService) revert(ctx context.Context, applied []configStep, snapshot *authzSnapshot) {
+	for i := len(applied) - 1; i >= 0; i-- {
+		step := applied[i]
+		if step.handler.revert == nil {
+			continue
+		}
+		if err := step.handler.revert(ctx, step); err != nil {
+			_log.WithContext(ctx).Errorw("unable to revert config change", "kind", step.handler.kind,
+				"name", step.desired.GetMetadata().GetName(), "error", err)
+		}
+	}
+	if err := s.restoreAuthz(ctx, snapshot); err != nil {
+		_log.WithContext(ctx).Errorw("unable to restore authz policies", "error", err)
+	}
+}
+
+// authzSnapshot holds the authz policies of an organization and the
+// group memberships of the users and groups of a bundle before it is
+// applied
+type authzSnapshot struct {
+	org        string
+	policies   []*authzv1.Policy
+	subjects   map[string]bool
+	userGroups []*authzv1.UserGroup
+}
+
+func (s *configService) snapshotAuthz(ctx context.Context, meta *commonv3.Metadata, steps []configStep) (*authzSnapshot, error) {
+	snapshot := &authzSnapshot{org: meta.GetOrganization(), subjects: make(map[string]bool)}
+	if s.azc == nil {
+		return snapshot, nil
+	}
+	for _, step := range steps {
+		switch step.handler.kind {
+		case userKind:
+			snapshot.subjects["u:"+step.desired.GetMetadata().GetName()] = true
+		case groupKind:
+			snapshot.subjects["g:"+step.desired.GetMetadata().GetName()] = true
+		}
+	}
+
+	policies, err := s.azc.ListPolicies(ctx, &authzv1.Policy{Org: snapshot.org})
+	if err != nil {
+		return nil, err
+	}
+	snapshot.policies = policies.GetPolicies()
+	userGroups, err := s.listUserGroups(ctx, snapshot.subjects)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.userGroups = userGroups
+	return snapshot, nil
+}
+
+// listUserGroups returns the group memberships of users or groups of
+// subjects
+func (s *configService) listUserGroups(ctx context.Context, subjects map[string]bool) ([]*authzv1.UserGroup, error) {
+	ugs, err := s.azc.ListUserGroups(ctx, &authzv1.UserGroup{})
+	if err != nil {
+		return nil, err
+	}
+	var res []*authzv1.UserGroup
+	for _, ug := range ugs.GetUserGroups() {
+		if subjects[ug.GetUser()] || subjects[ug.GetGrp()] {
+			res = append(res, ug)
+		}
+	}
+	return res, nil
+}
+
+// restoreAuthz restores the policies and memberships of snapshot. Extra
+// ones are deleted first as deleting a policy with empty fields deletes
+// every policy matching it.
+func (s *configService) restoreAuthz(ctx context.Context, snapshot *authzSnapshot) error {
+	if s.azc == nil {
+		return nil
+	}
+	policyKey := func(p *authzv1.Policy) string {
+		return strings.Join([]string{p.Sub, p.Ns, p.Proj, p.Org, p.Obj}, "|")
+	}
+	before := make(map[string]bool)
+	for _, p := range snapshot.policies {
+		before[policyKey(p)] = true
+	}
+	policies, err := s.azc.ListPolicies(ctx, &authzv1.Policy{Org: snapshot.org})
+	if err != nil {
+		return err
+	}
+	for _, p := range policies.GetPolicies() {
+		if !before[policyKey(p)] {
+			if _, err := s.azc.DeletePolicies(ctx, p); err != nil {
+				return err
+			}
+		}
+	}
+	if policies, err = s.azc.ListPolicies(ctx, &authzv1.Policy{Org: snapshot.org}); err != nil {
+		return err
+	}
+	current := make(map[string]bool)
+	for _, p := range policies.GetPolicies() {
+		current[policyKey(p)] = true
+	}
+	var missing []*authzv1.Policy
+	for _, p := range snapshot.policies {
+		if !current[policyKey(p)] {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		if _, err := s.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: missing}); err != nil {
+			return err
+		}
+	}
+
+	ugKey := func(ug *authzv1.UserGroup) string {
+		return ug.GetUser() + "|" + ug.GetGrp()
+	}
+	beforeUGs := make(map[string]bool)
+	for _, ug := range snapshot.userGroups {
+		beforeUGs[ugKey(ug)] = true
+	}
+	userGroups, err := s.listUserGroups(ctx, snapshot.subjects)
+	if err != nil {
+		return err
+	}
+	currentUGs := make(map[string]bool)
+	for _, ug := range userGroups {
+		currentUGs[ugKey(ug)] = true
+		if !beforeUGs[ugKey(ug)] {
+			if _, err := s.azc.DeleteUserGroups(ctx, ug); err != nil {
+				return err
+			}
+		}
+	}
+	var missingUGs []*authzv1.UserGroup
+	for _, ug := range snapshot.userGroups {
+		if !currentUGs[ugKey(ug)] {
+			missingUGs = append(missingUGs, ug)
+		}
+	}
+	if len(missingUGs) > 0 {
+		if _, err := s.azc.CreateUserGroups(ctx, &authzv1.UserGroups{UserGroups: missingUGs}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *configService) Export(ctx context.Context, config *systemv3.Config) (*systemv3.Config, error) {
+	meta := config.GetMetadata()
+	if meta.GetOrganization() == "" || meta.GetPartner() == "" {
+		return config, fmt.Errorf("organization and partner are required")
+	}
+
+	handlers := s.handlers
+	if kinds := config.GetSpec().GetKinds(); len(kinds) > 0 {
+		handlers = nil
+		for _, h := range s.handlers {
+			for _, k := range kinds {
+				if strings.EqualFold(h.kind, k) {
+					handlers = append(handlers, h)
+					break
+				}
+			}
+		}
+		if len(handlers) == 0 {
+			return config, fmt.Errorf("no supported kind in %s, supported kinds are %s",
+				strings.Join(kinds, ", "), strings.Join(s.supportedKinds(), ", "))
+		}
+	}
+
+	scope := &commonv3.Metadata{Organization: meta.Organization, Partner: meta.Partner}
+	var docs []string
+	for _, h := range handlers {
+		objs, err := h.list(ctx, scope)
+		if err != nil {
+			return config, fmt.Errorf("unable to list %s: %w", h.kind, err)
+		}
+		sort.Slice(objs, func(i, j int) bool {
+			return objs[i].GetMetadata().GetName() < objs[j].GetMetadata().GetName()
+		})
+		for _, obj := range objs {
+			if h.managed != nil && !h.managed(obj) {
+				continue
+			}
+			obj = proto.Clone(obj).(configObject)
+			normalizeConfigObject(h, obj)
+			redactConfigSecrets(obj)
+			y, err := marshalConfigObject(obj)
+			if err != nil {
+				return config, err
+			}
+			docs = append(docs, y)
+		}
+	}
+
+	config.ApiVersion = apiVersion
+	config.Kind = configKind
+	if config.Spec == nil {
+		config.Spec = &systemv3.ConfigSpec{}
+	}
+	config.Spec.Bundle = strings.Join(docs, "---\n")
+	return config, nil
+}
+
+// redactConfigSecrets clears secrets so that they are never exported;
+// exported bundle can be applied back as empty secrets keep the current
+// ones
+func redactConfigSecrets(obj configObject) {
+	if p, ok := obj.(*systemv3.OIDCProvider); ok && p.Spec != nil {
+		p.Spec.ClientSecret = ""
+	}
+}
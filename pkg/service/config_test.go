@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/proto"
+)
+
+func getConfigService(t *testing.T) (ConfigService, sqlmock.Sqlmock, *mockAuthzClient, func()) {
+	db, mock := getDB(t)
+	mazc := &mockAuthzClient{}
+	rs := NewRoleService(db, mazc, getLogger())
+	ps := NewProjectService(db, mazc, getLogger(), true)
+	cs := NewConfigService(db, rs, ps, nil, nil, nil, nil, mazc, getLogger())
+	return cs, mock, mazc, func() { db.Close() }
+}
+
+// addProjectListExpectation adds the queries listing the projects of an
+// organization, projects maps id to description
+func addProjectListExpectation(mock sqlmock.Sqlmock, projects map[string]string) {
+	ouuid := addFetchExpectation(mock, "organization")
+	puuid := addFetchExpectation(mock, "partner")
+	rows := sqlmock.NewRows([]string{"id", "name", "description"})
+	var ids []string
+	for name, description := range projects {
+		id := uuid.NewString()
+		rows.AddRow(id, name, description)
+		ids = append(ids, id)
+	}
+	mock.ExpectQuery(`SELECT "project"."id", "project"."name", .* FROM "authsrv_project" AS "project" WHERE \(partner_id = '` + puuid + `'\) AND \(organization_id = '` + ouuid + `'\) AND \(trash = false\)`).
+		WithArgs().WillReturnRows(rows)
+	for range ids {
+		mock.ExpectQuery(`FROM "authsrv_projectgrouprole"`).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+		mock.ExpectQuery(`FROM "authsrv_projectgroupnamespacerole"`).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+		mock.ExpectQuery(`FROM "authsrv_projectaccountresourcerole"`).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+		mock.ExpectQuery(`FROM "authsrv_projectaccountnamespacerole"`).WillReturnRows(sqlmock.NewRows([]string{"role"}))
+	}
+}
+
+// addProjectCreateExpectation adds the queries creating project name in
+// the transaction of an apply, err fails the insert
+func addProjectCreateExpectation(mock sqlmock.Sqlmock, name string, err error) {
+	addFetchExpectation(mock, "organization")
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = '` + name + `'`).
+		WillReturnError(fmt.Errorf("no data available"))
+	if err != nil {
+		mock.ExpectQuery(`INSERT INTO "authsrv_project"`).WillReturnError(err)
+		return
+	}
+	mock.ExpectQuery(`INSERT INTO "authsrv_project"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+}
+
+func TestConfigParseBundle(t *testing.T) {
+	cs, _, _, done := getConfigService(t)
+	defer done()
+	s := cs.(*configService)
+
+	docs, err := s.parseBundle(`
+apiVersion: system.k8smgmt.io/v3
+kind: Project
+metadata:
+  name: p1
+---
+kind: Project
+metadata:
+  name: p2
+  description: second
+`)
+	if err != nil {
+		t.Fatal("unable to parse bundle:", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(docs))
+	}
+	if docs[1].obj.GetMetadata().GetDescription() != "second" {
+		t.Errorf("incorrect description %q", docs[1].obj.GetMetadata().GetDescription())
+	}
+
+	for _, bundle := range []string{
+		"kind: Cluster\nmetadata:\n  name: c1\n",
+		"kind: Project\nmetadata:\n  description: no name\n",
+		"kind: Project\nmetadata:\n  name: p1\n---\nkind: Project\nmetadata:\n  name: p1\n",
+		"apiVersion: v1\nkind: Project\nmetadata:\n  name: p1\n",
+		"kind: KubectlSetting\nmetadata:\n  name: org\n",
+	} {
+		if _, err := s.parseBundle(bundle); err == nil {
+			t.Errorf("expected error for bundle %q", bundle)
+		}
+	}
+}
+
+func TestConfigApplyDryRun(t *testing.T) {
+	cs, mock, _, done := getConfigService(t)
+	defer done()
+
+	addProjectListExpectation(mock, map[string]string{"p1": "old", "p2": ""})
+
+	config := &systemv3.Config{
+		Metadata: &commonv3.Metadata{Organization: "org", Partner: "partner"},
+		Spec: &systemv3.ConfigSpec{
+			DryRun: true,
+			Bundle: `
+kind: Project
+metadata:
+  name: p1
+  description: new
+---
+kind: Project
+metadata:
+  name: p2
+---
+kind: Project
+metadata:
+  name: p3
+`,
+		},
+	}
+	resp, err := cs.Apply(context.Background(), config)
+	if err != nil {
+		t.Fatal("unable to apply config:", err)
+	}
+	actions := map[string]string{}
+	for _, c := range resp.GetStatus().GetChanges() {
+		actions[c.Name] = c.Action
+		if c.Action == ConfigActionUpdate && !strings.Contains(c.Diff, "+   description: new") {
+			t.Errorf("unexpected diff for %s:\n%s", c.Name, c.Diff)
+		}
+	}
+	expected := map[string]string{"p1": ConfigActionUpdate, "p2": ConfigActionUnchanged, "p3": ConfigActionCreate}
+	for name, action := range expected {
+		if actions[name] != action {
+			t.Errorf("expected %s for %s, got %s", action, name, actions[name])
+		}
+	}
+	if resp.GetStatus().GetApplied() {
+		t.Error("dry run should not apply changes")
+	}
+	// no transaction is started for a dry run
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigApply(t *testing.T) {
+	cs, mock, _, done := getConfigService(t)
+	defer done()
+
+	addProjectListExpectation(mock, map[string]string{})
+	mock.ExpectBegin()
+	addProjectCreateExpectation(mock, "p1", nil)
+	mock.ExpectCommit()
+
+	resp, err := cs.Apply(context.Background(), &systemv3.Config{
+		Metadata: &commonv3.Metadata{Organization: "org", Partner: "partner"},
+		Spec:     &systemv3.ConfigSpec{Bundle: "kind: Project\nmetadata:\n  name: p1\n"},
+	})
+	if err != nil {
+		t.Fatal("unable to apply config:", err)
+	}
+	if !resp.GetStatus().GetApplied() {
+		t.Error("expected config to be applied")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigApplyRollback(t *testing.T) {
+	cs, mock, mazc, done := getConfigService(t)
+	defer done()
+
+	// roles and projects of the organization
+	addOrgParterFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	addProjectListExpectation(mock, map[string]string{})
+
+	// the role is created in the transaction of the apply and its
+	// permissions are mapped in authz, creating the project fails
+	mock.ExpectBegin()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addUnavailableExpectation(mock, "resourcerole", puuid, ouuid, "r1")
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .*name = 'ops_star.all'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	addProjectCreateExpectation(mock, "p1", fmt.Errorf("unique constraint violation"))
+	mock.ExpectRollback()
+
+	resp, err := cs.Apply(context.Background(), &systemv3.Config{
+		Metadata: &commonv3.Metadata{Organization: "org", Partner: "partner"},
+		Spec: &systemv3.ConfigSpec{Bundle: `
+kind: Role
+metadata:
+  name: resourcerole-r1
+spec:
+  scope: project
+  rolepermissions:
+  - ops_star.all
+---
+kind: Project
+metadata:
+  name: p1
+`},
+	})
+	if err == nil {
+		t.Fatal("expected failure when creating p1")
+	}
+	if resp.GetStatus().GetApplied() {
+		t.Error("failed config should not be applied")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	// the authz mapping of the rolled back role is removed
+	performBasicAuthzChecks(t, *mazc, 0, 0, 0, 0, 1, 1)
+	if len(mazc.drpm) > 0 && mazc.drpm[0].Role != "resourcerole-r1" {
+		t.Errorf("expected mapping of resourcerole-r1 to be deleted, got %q", mazc.drpm[0].Role)
+	}
+}
+
+func TestConfigExport(t *testing.T) {
+	cs, mock, _, done := getConfigService(t)
+	defer done()
+
+	addProjectListExpectation(mock, map[string]string{"b": "", "a": ""})
+
+	resp, err := cs.Export(context.Background(), &systemv3.Config{
+		Metadata: &commonv3.Metadata{Organization: "org", Partner: "partner"},
+		Spec:     &systemv3.ConfigSpec{Kinds: []string{"Project"}},
+	})
+	if err != nil {
+		t.Fatal("unable to export config:", err)
+	}
+	expected := "apiVersion: system.k8smgmt.io/v3\nkind: Project\nmetadata:\n  name: a\n" +
+		"---\napiVersion: system.k8smgmt.io/v3\nkind: Project\nmetadata:\n  name: b\n"
+	if resp.GetSpec().GetBundle() != expected {
+		t.Errorf("unexpected bundle:\n%s", resp.GetSpec().GetBundle())
+	}
+
+	if _, err := cs.Export(context.Background(), &systemv3.Config{
+		Metadata: &commonv3.Metadata{Organization: "org", Partner: "partner"},
+		Spec:     &systemv3.ConfigSpec{Kinds: []string{"Cluster"}},
+	}); err == nil {
+		t.Error("expected error for unsupported kind")
+	}
+}
+
+// policyAuthzClient keeps policies and user groups in memory
+type policyAuthzClient struct {
+	mockAuthzClient
+	policies   []*authzv1.Policy
+	userGroups []*authzv1.UserGroup
+}
+
+func (c *policyAuthzClient) ListPolicies(ctx context.Context, in *authzv1.Policy) (*authzv1.Policies, error) {
+	return &authzv1.Policies{Policies: c.policies}, nil
+}
+
+func (c *policyAuthzClient) DeletePolicies(ctx context.Context, in *authzv1.Policy) (*authzv1.BoolReply, error) {
+	var kept []*authzv1.Policy
+	for _, p := range c.policies {
+		if !proto.Equal(p, in) {
+			kept = append(kept, p)
+		}
+	}
+	c.policies = kept
+	return &authzv1.BoolReply{Res: true}, nil
+}
+
+func (c *policyAuthzClient) CreatePolicies(ctx context.Context, in *authzv1.Policies) (*authzv1.BoolReply, error) {
+	c.policies = append(c.policies, in.Policies...)
+	return &authzv1.BoolReply{Res: true}, nil
+}
+
+func (c *policyAuthzClient) ListUserGroups(ctx context.Context, in *authzv1.UserGroup) (*authzv1.UserGroups, error) {
+	return &authzv1.UserGroups{UserGroups: c.userGroups}, nil
+}
+
+func (c *policyAuthzClient) DeleteUserGroups(ctx context.Context, in *authzv1.UserGroup) (*authzv1.BoolReply, error) {
+	var kept []*authzv1.UserGroup
+	for _, ug := range c.userGroups {
+		if !proto.Equal(ug, in) {
+			kept = append(kept, ug)
+		}
+	}
+	c.userGroups = kept
+	return &authzv1.BoolReply{Res: true}, nil
+}
+
+func (c *policyAuthzClient) CreateUserGroups(ctx context.Context, in *authzv1.UserGroups) (*authzv1.BoolReply, error) {
+	c.userGroups = append(c.userGroups, in.UserGroups...)
+	return &authzv1.BoolReply{Res: true}, nil
+}
+
+func TestConfigRestoreAuthz(t *testing.T) {
+	kept := &authzv1.Policy{Sub: "u:alice", Ns: "*", Proj: "p1", Org: "org", Obj: "PROJECT_ADMIN"}
+	removed := &authzv1.Policy{Sub: "u:bob", Ns: "*", Proj: "p1", Org: "org", Obj: "PROJECT_READ_ONLY"}
+	other := &authzv1.UserGroup{User: "u:carol", Grp: "g:admins"}
+	azc := &policyAuthzClient{
+		policies:   []*authzv1.Policy{kept, removed},
+		userGroups: []*authzv1.UserGroup{{User: "u:bob", Grp: "g:dev"}, other},
+	}
+	s := &configService{azc: azc}
+	steps := []configStep{{handler: &configHandler{kind: userKind}, desired: &userv3.User{Metadata: &commonv3.Metadata{Name: "bob"}}}}
+
+	snapshot, err := s.snapshotAuthz(context.Background(), &commonv3.Metadata{Organization: "org"}, steps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.userGroups) != 1 {
+		t.Errorf("expected only memberships of the bundle, got %v", snapshot.userGroups)
+	}
+
+	// changes made by a failed apply
+	added := &authzv1.Policy{Sub: "u:bob", Ns: "*", Proj: "p1", Org: "org", Obj: "PROJECT_ADMIN"}
+	azc.policies = []*authzv1.Policy{kept, added}
+	azc.userGroups = []*authzv1.UserGroup{{User: "u:bob", Grp: "g:ops"}, other}
+
+	if err := s.restoreAuthz(context.Background(), snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if len(azc.policies) != 2 || !proto.Equal(azc.policies[0], kept) || !proto.Equal(azc.policies[1], removed) {
+		t.Errorf("unexpected policies after restore %v", azc.policies)
+	}
+	if len(azc.userGroups) != 2 || !proto.Equal(azc.userGroups[0], other) || azc.userGroups[1].Grp != "g:dev" {
+		t.Errorf("unexpected user groups after restore %v", azc.userGroups)
+	}
+}
@@ -13,17 +13,17 @@ func DeleteRelayAgent(ctx context.Context, kubeConfig []byte, namespace string)
 
 	config, err := clientcmd.NewClientConfigFromBytes(kubeConfig)
 	if err != nil {
-		_log.Errorf("Unable to build kube configuration %s", err.Error())
+		_log.WithContext(ctx).Errorf("Unable to build kube configuration %s", err.Error())
 		return false
 	}
 	clientConfig, err := config.ClientConfig()
 	if err != nil {
-		_log.Errorf("Unable to get client config %s", err.Error())
+		_log.WithContext(ctx).Errorf("Unable to get client config %s", err.Error())
 		return false
 	}
 	clientSet, err := kubernetes.NewForConfig(clientConfig)
 	if err != nil {
-		_log.Errorf("Unable to clientset %s", err.Error())
+		_log.WithContext(ctx).Errorf("Unable to clientset %s", err.Error())
 		return false
 	}
 	status, err := processDeleteDeployment(ctx, clientSet, namespace)
@@ -37,12 +37,12 @@ func DeleteRelayAgent(ctx context.Context, kubeConfig []byte, namespace string)
 func processDeleteDeployment(ctx context.Context, clientset *kubernetes.Clientset, ns string) (bool, error) {
 	err := clientset.AppsV1().Deployments(ns).Delete(ctx, "relay-agent", v1.DeleteOptions{})
 	if err != nil {
-		_log.Errorf("Error while deleting deployment %s", err.Error())
+		_log.WithContext(ctx).Errorf("Error while deleting deployment %s", err.Error())
 		return false, err
 	}
 	err = clientset.CoreV1().ConfigMaps(ns).Delete(ctx, "relay-agent-config", v1.DeleteOptions{})
 	if err != nil {
-		_log.Errorf("Error while deleting ConfigMap %s", err.Error())
+		_log.WithContext(ctx).Errorf("Error while deleting ConfigMap %s", err.Error())
 		return false, err
 	}
 	return true, nil
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// search API flavours of the audit index cluster
+const (
+	ESVersionAuto       = "auto"
+	ESVersion6          = "6"
+	ESVersion7          = "7"
+	ESVersion8          = "8"
+	ESVersionOpenSearch = "opensearch"
+)
+
+// esAdapter hides the differences between the search APIs of the
+// supported Elasticsearch and OpenSearch versions. The queries are built
+// once for all versions.
+type esAdapter interface {
+	// Normalize gives search result r the shape of Elasticsearch 6: the
+	// mapping types are removed from the hits and hits.total is the
+	// number of matching hits, which is what the clients read.
+	Normalize(r map[string]interface{})
+}
+
+// es6Adapter talks to Elasticsearch 6.x, hits.total is a number there
+// and the hits carry the mapping types removed in 7.0
+type es6Adapter struct{}
+
+func (es6Adapter) Normalize(r map[string]interface{}) {
+	esDropTypes(r)
+}
+
+// es7Adapter talks to Elasticsearch 7.x and 8.x and to OpenSearch, which
+// forked from 7.10 and kept its search API. hits.total is an object with
+// the count in value there.
+type es7Adapter struct{}
+
+func (es7Adapter) Normalize(r map[string]interface{}) {
+	// 7.x still returns the _doc type
+	esDropTypes(r)
+	hits, _ := r["hits"].(map[string]interface{})
+	if total, ok := hits["total"].(map[string]interface{}); ok {
+		hits["total"] = total["value"]
+	}
+}
+
+// esDropTypes removes the mapping types of the hits of search result r
+func esDropTypes(r map[string]interface{}) {
+	hits, _ := r["hits"].(map[string]interface{})
+	items, _ := hits["hits"].([]interface{})
+	for _, item := range items {
+		if hit, ok := item.(map[string]interface{}); ok {
+			delete(hit, "_type")
+		}
+	}
+}
+
+// newESAdapter returns the adapter of version
+func newESAdapter(version string) (esAdapter, error) {
+	switch version {
+	case ESVersion6:
+		return es6Adapter{}, nil
+	case ESVersion7, ESVersion8, ESVersionOpenSearch:
+		return es7Adapter{}, nil
+	}
+	return nil, fmt.Errorf("unsupported elasticsearch version %q, should be one of %s, %s, %s, %s or %s",
+		version, ESVersionAuto, ESVersion6, ESVersion7, ESVersion8, ESVersionOpenSearch)
+}
+
+// esClusterInfo is the part of the response of the root endpoint
+// identifying the version of the cluster
+type esClusterInfo struct {
+	Version struct {
+		Number       string `json:"number"`
+		Distribution string `json:"distribution"`
+	} `json:"version"`
+}
+
+// detectESVersion returns the flavour of the search API of the cluster
+// from the response of its root endpoint
+func detectESVersion(root []byte) (string, error) {
+	var info esClusterInfo
+	if err := json.Unmarshal(root, &info); err != nil {
+		return "", fmt.Errorf("unable to parse cluster info: %w", err)
+	}
+	if info.Version.Distribution == "opensearch" {
+		return ESVersionOpenSearch, nil
+	}
+	major, err := strconv.Atoi(strings.SplitN(info.Version.Number, ".", 2)[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid elasticsearch version %q", info.Version.Number)
+	}
+	switch {
+	case major < 6:
+		return "", fmt.Errorf("elasticsearch %s is not supported", info.Version.Number)
+	case major == 6:
+		return ESVersion6, nil
+	case major == 7:
+		return ESVersion7, nil
+	}
+	return ESVersion8, nil
+}
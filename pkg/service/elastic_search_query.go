@@ -5,84 +5,140 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-
-	v6Client "github.com/elastic/go-elasticsearch"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
+// esRequestTimeout bounds the searches and the version detection
+const esRequestTimeout = 30 * time.Second
+
 type elasticSearchQuery struct {
 	url          string
 	indexPattern string
 	logPrefix    string
-	esClient     *v6Client.Client
+	client       *http.Client
+
+	mu sync.Mutex
+	// adapter of the version of the cluster, detected on the first search
+	// when the version is auto
+	adapter esAdapter
 }
 
 type ElasticSearchQuery interface {
 	Handle(bytes.Buffer) (map[string]interface{}, error)
 }
 
-func NewElasticSearchQuery(url string, indexPattern string, logPrefix string) (ElasticSearchQuery, error) {
-	cfg := v6Client.Config{
-		Addresses: []string{
-			url,
-		},
-	}
-	esClient, err := v6Client.NewClient(cfg)
-	if err != nil {
-		_log.Errorw("NewElasticSearchQuery: Not able to get New Elastic Search Client")
-		return nil, err
-	}
-	// res, err := esClient.Info()
-	// if err != nil {
-	// 	_log.Errorw("NewElasticSearchQuery: Error in getting ES client Info")
-	// 	return nil, err
-	// }
-	// _log.Infow(logPrefix+":Connected to elastic search ", "cluster", res, "index", indexPattern)
+// NewElasticSearchQuery returns query searching the indices of
+// indexPattern of the Elasticsearch or OpenSearch cluster at url. The
+// version is one of 6, 7, 8 or opensearch, with auto it is detected from
+// the cluster.
+func NewElasticSearchQuery(url string, indexPattern string, logPrefix string, version string) (ElasticSearchQuery, error) {
 	esQuery := &elasticSearchQuery{
-		url:          url,
+		url:          strings.TrimRight(url, "/"),
 		indexPattern: indexPattern,
 		logPrefix:    logPrefix,
-		esClient:     esClient,
+		client:       &http.Client{Timeout: esRequestTimeout},
+	}
+	if version != "" && version != ESVersionAuto {
+		adapter, err := newESAdapter(version)
+		if err != nil {
+			_log.Errorw("NewElasticSearchQuery: invalid elastic search version", "version", version)
+			return nil, err
+		}
+		esQuery.adapter = adapter
 	}
 	return esQuery, nil
 }
 
+// getAdapter returns the adapter of the cluster, detecting its version
+// until it succeeds
+func (q *elasticSearchQuery) getAdapter(ctx context.Context) (esAdapter, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.adapter != nil {
+		return q.adapter, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, q.url+"/", nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := q.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect elastic search version: %w", err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to detect elastic search version: %s received from ES", res.Status)
+	}
+	version, err := detectESVersion(body)
+	if err != nil {
+		return nil, err
+	}
+	adapter, err := newESAdapter(version)
+	if err != nil {
+		return nil, err
+	}
+	_log.WithContext(ctx).Infow(q.logPrefix+"detected elastic search version", "url", q.url, "version", version)
+	q.adapter = adapter
+	return adapter, nil
+}
+
 // Handle Fires the search query
 func (q *elasticSearchQuery) Handle(msg bytes.Buffer) (map[string]interface{}, error) {
 	_log.Debugw("Searching elastic search: ", "index", q.indexPattern, "url", q.url, "q", q)
-	res, err := q.esClient.Search(
-		q.esClient.Search.WithContext(context.Background()),
-		q.esClient.Search.WithIndex(q.indexPattern),
-		q.esClient.Search.WithBody(&msg),
-		q.esClient.Search.WithTrackTotalHits(true),
-	)
-
+	ctx := context.Background()
+	adapter, err := q.getAdapter(ctx)
 	if err != nil {
 		_log.Errorw("Error getting response:", "err", err)
 		return nil, err
 	}
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, q.url+"/"+q.indexPattern+"/_search", bytes.NewReader(msg.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := q.client.Do(req)
+	if err != nil {
+		_log.Errorw("Error getting response:", "err", err)
+		return nil, err
+	}
 	defer res.Body.Close()
 
-	if res.IsError() {
-		_log.Warnw(q.logPrefix+" Error in search request ", "request", msg.String(), "response", res.String())
-		var e map[string]interface{}
-		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
-			_log.Errorw("Error parsing the response body", "err", err, "request", msg.String(), "response", res.String())
-			return nil, err
-		} else {
-			if e["error"].(map[string]interface{})["type"] == "index_not_found_exception" {
-				_log.Warnw(q.logPrefix+"Skipping this query as its a new setup", "request", msg.String(), "response", res.String())
-				return nil, nil
-			} else {
-				_log.Errorw("Error received from ES", "request", msg.String(), "response", res.String())
-				return nil, errors.New(res.Status() + " received from ES")
-			}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1<<20))
+		_log.Warnw(q.logPrefix+" Error in search request ", "request", msg.String(), "response", string(body))
+		var e struct {
+			Error struct {
+				Type string `json:"type"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(body, &e); err != nil {
+			_log.Errorw("Error parsing the response body", "err", err, "request", msg.String(), "response", string(body))
+			return nil, errors.New(res.Status + " received from ES")
+		}
+		if e.Error.Type == "index_not_found_exception" {
+			_log.Warnw(q.logPrefix+"Skipping this query as its a new setup", "request", msg.String(), "response", string(body))
+			return nil, nil
 		}
+		_log.Errorw("Error received from ES", "request", msg.String(), "response", string(body))
+		return nil, errors.New(res.Status + " received from ES")
 	}
 	var r map[string]interface{}
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		_log.Errorw("Error parsing the response body", "err", err)
 		return nil, err
 	}
+	adapter.Normalize(r)
 	return r, nil
 }
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeES replays the recorded responses of testdata/elasticsearch/<dir>
+// and records the search requests
+type fakeES struct {
+	t   *testing.T
+	dir string
+
+	mu       sync.Mutex
+	roots    int
+	searches []string
+}
+
+func (f *fakeES) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/":
+		f.roots++
+		f.reply(w, http.StatusOK, filepath.Join(f.dir, "root.json"))
+	case r.Method == http.MethodPost && r.URL.Path == "/ralog-relay-*/_search":
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			f.t.Errorf("unexpected content type %q", ct)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		f.searches = append(f.searches, string(body))
+		f.reply(w, http.StatusOK, filepath.Join(f.dir, "search.json"))
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeES) reply(w http.ResponseWriter, code int, name string) {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "elasticsearch", name))
+	if err != nil {
+		f.t.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+func TestDetectESVersion(t *testing.T) {
+	for dir, version := range map[string]string{
+		"es6":         ESVersion6,
+		"es7":         ESVersion7,
+		"es8":         ESVersion8,
+		"opensearch2": ESVersionOpenSearch,
+	} {
+		b, err := ioutil.ReadFile(filepath.Join("testdata", "elasticsearch", dir, "root.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := detectESVersion(b)
+		if err != nil || got != version {
+			t.Errorf("%s: expected version %s, got %s %v", dir, version, got, err)
+		}
+	}
+	if _, err := detectESVersion([]byte(`{"version":{"number":"5.6.16"}}`)); err == nil {
+		t.Error("expected error for elasticsearch 5")
+	}
+	if _, err := newESAdapter("9"); err == nil {
+		t.Error("expected error for unknown version")
+	}
+}
+
+func TestElasticSearchVersions(t *testing.T) {
+	for _, dir := range []string{"es6", "es7", "es8", "opensearch2"} {
+		t.Run(dir, func(t *testing.T) {
+			fake := &fakeES{t: t, dir: dir}
+			srv := httptest.NewServer(fake)
+			defer srv.Close()
+
+			esq, err := NewElasticSearchQuery(srv.URL+"/", "ralog-relay-*", "test: ", ESVersionAuto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ra := &relayAuditElasticSearchService{relayQuery: esq}
+			req := &v1.RelayAuditRequest{Filter: &v1.RelayAuditQueryFilter{PageSize: 2, Timefrom: "now-1h"}}
+			res, err := ra.GetRelayAuditByProjects(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hits := res.Result.Fields["hits"].GetStructValue()
+			// hits.total is a number for all versions
+			if _, ok := hits.Fields["total"].GetKind().(*structpb.Value_NumberValue); !ok || hits.Fields["total"].GetNumberValue() != 3 {
+				t.Errorf("unexpected total hits %v", hits.Fields["total"])
+			}
+			items := hits.Fields["hits"].GetListValue().GetValues()
+			if len(items) != 2 {
+				t.Fatalf("expected 2 hits, got %d", len(items))
+			}
+			for _, item := range items {
+				if _, ok := item.GetStructValue().Fields["_type"]; ok {
+					t.Errorf("expected hits without _type, got %v", item)
+				}
+			}
+			if res.NextPageToken == "" {
+				t.Fatal("expected next page token")
+			}
+
+			req.Filter.PageToken = res.NextPageToken
+			if _, err := ra.GetRelayAuditByProjects(context.Background(), req); err != nil {
+				t.Fatal(err)
+			}
+			if fake.roots != 1 {
+				t.Errorf("expected the version to be detected once, got %d", fake.roots)
+			}
+			if len(fake.searches) != 2 {
+				t.Fatalf("expected 2 searches, got %d", len(fake.searches))
+			}
+			for _, s := range fake.searches {
+				if !strings.Contains(s, `"track_total_hits":true`) {
+					t.Errorf("expected search to track total hits, got %s", s)
+				}
+			}
+			if !strings.Contains(fake.searches[1], `"search_after":[1669024110500,9]`) {
+				t.Errorf("expected search_after of last hit, got %s", fake.searches[1])
+			}
+		})
+	}
+}
+
+func TestElasticSearchConfiguredVersion(t *testing.T) {
+	fake := &fakeES{t: t, dir: "es8"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			t.Error("unexpected version detection")
+		}
+		fake.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	esq, err := NewElasticSearchQuery(srv.URL, "ralog-relay-*", "test: ", ESVersion8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ra := &relayAuditElasticSearchService{relayQuery: esq}
+	if _, err := ra.GetRelayAuditByProjects(context.Background(), &v1.RelayAuditRequest{Filter: &v1.RelayAuditQueryFilter{}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewElasticSearchQuery(srv.URL, "ralog-relay-*", "test: ", "5"); err == nil {
+		t.Error("expected error for unsupported version")
+	}
+}
+
+func TestElasticSearchIndexNotFound(t *testing.T) {
+	fake := &fakeES{t: t, dir: "es7"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			fake.ServeHTTP(w, r)
+			return
+		}
+		fake.reply(w, http.StatusNotFound, "index_not_found.json")
+	}))
+	defer srv.Close()
+
+	esq, err := NewElasticSearchQuery(srv.URL, "ralog-relay-*", "test: ", ESVersionAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ra := &relayAuditElasticSearchService{relayQuery: esq}
+	res, err := ra.GetRelayAuditByProjects(context.Background(), &v1.RelayAuditRequest{Filter: &v1.RelayAuditQueryFilter{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result != nil {
+		t.Errorf("expected empty result, got %v", res.Result)
+	}
+}
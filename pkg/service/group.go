@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"regexp"
 	"strings"
@@ -125,6 +124,10 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 
 		project := pnr.GetProject()
 		org := group.GetMetadata().GetOrganization()
+		selector := pnr.GetClusterSelector()
+		if err := validateRoleClusterSelector(selector, scope); err != nil {
+			return &userv3.Group{}, nil, err
+		}
 
 		switch scope {
 		case "system":
@@ -171,19 +174,20 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 			if project == "" {
 				return &userv3.Group{}, nil, fmt.Errorf("no project name provided for role '%v'", roleName)
 			}
-			projectId, err := dao.GetProjectId(ctx, s.db, project)
+			projectId, err := dao.GetProjectId(ctx, dao.GetDB(ctx, s.db), project)
 			if err != nil {
 				return &userv3.Group{}, nil, fmt.Errorf("unable to find project '%v'", project)
 			}
 
 			pgr := models.ProjectGroupRole{
-				Trash:          false,
-				RoleId:         roleId,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				GroupId:        ids.Id,
-				ProjectId:      projectId,
-				Active:         true,
+				Trash:           false,
+				RoleId:          roleId,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				GroupId:         ids.Id,
+				ProjectId:       projectId,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			pgrs = append(pgrs, pgr)
 
@@ -201,7 +205,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 			if project == "" {
 				return &userv3.Group{}, nil, fmt.Errorf("no project name provided for role '%v'", roleName)
 			}
-			projectId, err := dao.GetProjectId(ctx, s.db, project)
+			projectId, err := dao.GetProjectId(ctx, dao.GetDB(ctx, s.db), project)
 			if err != nil {
 				return &userv3.Group{}, nil, fmt.Errorf("unable to find project '%v'", project)
 			}
@@ -216,16 +220,17 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 			}
 
 			pgnrObj := models.ProjectGroupNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				GroupId:        ids.Id,
-				ProjectId:      projectId,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				RoleId:          roleId,
+				GroupId:         ids.Id,
+				ProjectId:       projectId,
+				Namespace:       namespace,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			pgnr = append(pgnr, pgnrObj)
 
@@ -351,11 +356,11 @@ func (s *groupService) getPartnerOrganization(ctx context.Context, db bun.IDB, g
 }
 
 func (s *groupService) Create(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, group)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), group)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
-	g, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, group.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+	g, _ := dao.GetIdByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), group.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
 	if g != nil {
 		return nil, fmt.Errorf("group '%v' already exists", group.GetMetadata().GetName())
 	}
@@ -371,7 +376,7 @@ func (s *groupService) Create(ctx context.Context, group *userv3.Group) (*userv3
 		Type:           group.GetSpec().GetType(),
 	}
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := dao.BeginTx(ctx, s.db)
 	if err != nil {
 		return &userv3.Group{}, err
 	}
@@ -400,10 +405,11 @@ func (s *groupService) Create(ctx context.Context, group *userv3.Group) (*userv3
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
-		CreateGroupAuditEvent(ctx, s.al, s.db, AuditActionCreate, group.GetMetadata().GetName(), grp.ID, []uuid.UUID{}, usersAfter, []uuid.UUID{}, rolesAfter)
+		CreateGroupAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionCreate, group.GetMetadata().GetName(), grp.ID, []uuid.UUID{}, usersAfter, []uuid.UUID{}, rolesAfter)
+		group.Status = namespaceBindingStatus(ctx, dao.GetDB(ctx, s.db), namespaceBindings("", group.GetSpec().GetProjectNamespaceRoles()))
 		return group, nil
 	}
 	return &userv3.Group{}, fmt.Errorf("unable to create group")
@@ -452,13 +458,13 @@ func (s *groupService) GetByID(ctx context.Context, group *userv3.Group) (*userv
 	if err != nil {
 		return &userv3.Group{}, err
 	}
-	entity, err := dao.GetByID(ctx, s.db, uid, &models.Group{})
+	entity, err := dao.GetByID(ctx, dao.GetDB(ctx, s.db), uid, &models.Group{})
 	if err != nil {
 		return &userv3.Group{}, err
 	}
 
 	if grp, ok := entity.(*models.Group); ok {
-		return s.toV3Group(ctx, s.db, group, grp)
+		return s.toV3Group(ctx, dao.GetDB(ctx, s.db), group, grp)
 	}
 	return group, nil
 
@@ -466,17 +472,17 @@ func (s *groupService) GetByID(ctx context.Context, group *userv3.Group) (*userv
 
 func (s *groupService) GetByName(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
 	name := group.GetMetadata().GetName()
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, group)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), group)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
 	if err != nil {
 		return &userv3.Group{}, err
 	}
 
 	if grp, ok := entity.(*models.Group); ok {
-		return s.toV3Group(ctx, s.db, group, grp)
+		return s.toV3Group(ctx, dao.GetDB(ctx, s.db), group, grp)
 	}
 	return group, nil
 
@@ -485,11 +491,11 @@ func (s *groupService) GetByName(ctx context.Context, group *userv3.Group) (*use
 func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
 	// TODO: inform when unchanged
 	name := group.GetMetadata().GetName()
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, group)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), group)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
 	if err != nil {
 		return &userv3.Group{}, fmt.Errorf("no group found with name '%v'", name)
 	}
@@ -501,7 +507,7 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 		grp.Type = group.Spec.Type
 		grp.ModifiedAt = time.Now()
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &userv3.Group{}, err
 		}
@@ -537,7 +543,7 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
 		// update spec and status
@@ -547,7 +553,8 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 			ProjectNamespaceRoles: group.Spec.ProjectNamespaceRoles,
 		}
 
-		CreateGroupAuditEvent(ctx, s.al, s.db, AuditActionUpdate, group.GetMetadata().GetName(), grp.ID, usersBefore, usersAfter, rolesBefore, rolesAfter)
+		CreateGroupAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionUpdate, group.GetMetadata().GetName(), grp.ID, usersBefore, usersAfter, rolesBefore, rolesAfter)
+		group.Status = namespaceBindingStatus(ctx, dao.GetDB(ctx, s.db), namespaceBindings("", group.GetSpec().GetProjectNamespaceRoles()))
 	}
 
 	return group, nil
@@ -555,17 +562,17 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 
 func (s *groupService) Delete(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
 	name := group.GetMetadata().GetName()
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, group)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), group)
 	if err != nil {
 		return &userv3.Group{}, fmt.Errorf("unable to get partner and org id")
 	}
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
 	if err != nil {
 		return &userv3.Group{}, err
 	}
 	if grp, ok := entity.(*models.Group); ok {
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &userv3.Group{}, err
 		}
@@ -589,10 +596,10 @@ func (s *groupService) Delete(ctx context.Context, group *userv3.Group) (*userv3
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
-		CreateGroupAuditEvent(ctx, s.al, s.db, AuditActionDelete, group.GetMetadata().GetName(), grp.ID, usersBefore, []uuid.UUID{}, rolesBefore, []uuid.UUID{})
+		CreateGroupAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionDelete, group.GetMetadata().GetName(), grp.ID, usersBefore, []uuid.UUID{}, rolesBefore, []uuid.UUID{})
 		return group, nil
 	}
 
@@ -614,16 +621,16 @@ func (s *groupService) List(ctx context.Context, opts ...query.Option) (*userv3.
 		opt(&queryOptions)
 	}
 
-	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	orgId, err := dao.GetOrganizationId(ctx, dao.GetDB(ctx, s.db), queryOptions.Organization)
 	if err != nil {
 		return groupList, err
 	}
-	partId, err := dao.GetPartnerId(ctx, s.db, queryOptions.Partner)
+	partId, err := dao.GetPartnerId(ctx, dao.GetDB(ctx, s.db), queryOptions.Partner)
 	if err != nil {
 		return groupList, err
 	}
 	var grps []models.Group
-	entities, err := dao.ListFiltered(ctx, s.db,
+	entities, err := dao.ListFiltered(ctx, dao.GetDB(ctx, s.db),
 		uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
 		uuid.NullUUID{Valid: false},
 		&grps,
@@ -642,7 +649,7 @@ func (s *groupService) List(ctx context.Context, opts ...query.Option) (*userv3.
 				Organization: queryOptions.Organization,
 				Partner:      queryOptions.Partner,
 			}}
-			entry, err = s.toV3Group(ctx, s.db, entry, &grp)
+			entry, err = s.toV3Group(ctx, dao.GetDB(ctx, s.db), entry, &grp)
 			if err != nil {
 				return groupList, err
 			}
@@ -87,17 +87,18 @@ func prepareGroupPermissionResponse(gps models.GroupPermission) sentry.GroupPerm
 		json.Unmarshal(gps.Urls, &urls)
 	}
 	return sentry.GroupPermission{
-		GroupID:        gps.GroupId.String(),
-		ProjectID:      gps.ProjecttId,
-		OrganizationID: gps.OrganizationId.String(),
-		PartnerID:      gps.PartnerId.String(),
-		GroupName:      gps.GroupName,
-		RoleName:       gps.RoleName,
-		IsGlobal:       gps.IsGlobal,
-		Scope:          gps.Scope,
-		PermissionName: gps.PermissionName,
-		BaseURL:        gps.BaseUrl,
-		Urls:           urls,
-		ProjectName:    gps.ProjectName,
+		GroupID:         gps.GroupId.String(),
+		ProjectID:       gps.ProjecttId,
+		OrganizationID:  gps.OrganizationId.String(),
+		PartnerID:       gps.PartnerId.String(),
+		GroupName:       gps.GroupName,
+		RoleName:        gps.RoleName,
+		IsGlobal:        gps.IsGlobal,
+		Scope:           gps.Scope,
+		PermissionName:  gps.PermissionName,
+		BaseURL:         gps.BaseUrl,
+		Urls:            urls,
+		ProjectName:     gps.ProjectName,
+		ClusterSelector: gps.ClusterSelector,
 	}
 }
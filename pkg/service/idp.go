@@ -29,7 +29,9 @@ type IdpService interface {
 	Create(context.Context, *systemv3.Idp) (*systemv3.Idp, error)
 	GetByID(context.Context, *systemv3.Idp) (*systemv3.Idp, error)
 	GetByName(context.Context, *systemv3.Idp) (*systemv3.Idp, error)
-	List(context.Context) (*systemv3.IdpList, error)
+	// List returns the idps of the organization of metadata, of all
+	// organizations when it has none
+	List(context.Context, *commonv3.Metadata) (*systemv3.IdpList, error)
 	Update(context.Context, *systemv3.Idp) (*systemv3.Idp, error)
 	Delete(context.Context, *systemv3.Idp) error
 }
@@ -391,12 +393,20 @@ func (s *idpService) Update(ctx context.Context, idp *systemv3.Idp) (*systemv3.I
 	return rv, nil
 }
 
-func (s *idpService) List(ctx context.Context) (*systemv3.IdpList, error) {
+func (s *idpService) List(ctx context.Context, meta *commonv3.Metadata) (*systemv3.IdpList, error) {
 	var (
 		entities []models.Idp
 		orgID    uuid.NullUUID
 		parID    uuid.NullUUID
 	)
+	if meta.GetOrganization() != "" {
+		partnerId, organizationId, err := s.getPartnerOrganization(ctx, &systemv3.Idp{Metadata: meta})
+		if err != nil {
+			return &systemv3.IdpList{}, err
+		}
+		parID = uuid.NullUUID{UUID: partnerId, Valid: true}
+		orgID = uuid.NullUUID{UUID: organizationId, Valid: true}
+	}
 	_, err := dao.List(ctx, s.db, parID, orgID, &entities)
 	if err != nil {
 		return &systemv3.IdpList{}, err
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func TestIdpListOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	is := NewIdpService(db, "https://paralus.local", getLogger())
+
+	// idps of org-b are left out by the query of org-a
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	iuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "idp"."id", .* FROM "authsrv_idp" AS "idp" WHERE \(partner_id = '` + puuid + `'\) AND \(organization_id = '` + ouuid + `'\) AND \(trash = false\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).
+		AddRow(iuuid, "idp-a", ouuid, puuid))
+
+	idps, err := is.List(context.Background(), &v3.Metadata{Partner: "partner", Organization: "org-a"})
+	if err != nil {
+		t.Fatal("could not list idps:", err)
+	}
+	if len(idps.Items) != 1 || idps.Items[0].Metadata.Organization != ouuid {
+		t.Errorf("expected only the idp of org-a, got %v", idps.Items)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
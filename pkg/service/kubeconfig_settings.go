@@ -34,14 +34,14 @@ func NewKubeconfigSettingService(db *bun.DB) KubeconfigSettingService {
 func (kss *kubeconfigSettingService) Get(ctx context.Context, orgID string, accountID string, isSSO bool) (*sentry.KubeconfigSetting, error) {
 	oid, err := uuid.Parse(orgID)
 	if err != nil {
-		_log.Info("organization identifier is empty")
+		_log.WithContext(ctx).Info("organization identifier is empty")
 	}
 	aid, err := uuid.Parse(accountID)
 	if err != nil {
-		_log.Info("account identifier is empty")
+		_log.WithContext(ctx).Info("account identifier is empty")
 	}
 
-	kr, err := dao.GetKubeconfigSetting(ctx, kss.db, oid, aid, isSSO)
+	kr, err := dao.GetKubeconfigSetting(ctx, dao.GetDB(ctx, kss.db), oid, aid, isSSO)
 	if err == sql.ErrNoRows {
 		return nil, constants.ErrNotFound
 	} else if err != nil {
@@ -68,16 +68,21 @@ func (kss *kubeconfigSettingService) Patch(ctx context.Context, ks *sentry.Kubec
 		return fmt.Errorf("invalid sa validity duration. should be between %.0f mins and %.0f hours", minTimeDisplay.Minutes(), maxTimeDisplay.Hours())
 	}
 
-	return kss.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
-		_, err := dao.GetKubeconfigSetting(ctx, tx, uuid.MustParse(ks.OrganizationID), accId, ks.IsSSOUser)
-		db := convertToKubeCfgSettingModel(ks)
-		if err != nil && err == sql.ErrNoRows {
-			db.CreatedAt = time.Now()
-			return dao.CreateKubeconfigSetting(ctx, tx, convertToKubeCfgSettingModel(ks))
-		}
-		db.ModifiedAt = time.Now()
-		return dao.UpdateKubeconfigSetting(ctx, tx, convertToKubeCfgSettingModel(ks))
-	})
+	tx, err := dao.BeginTx(ctx, kss.db)
+	if err != nil {
+		return err
+	}
+	_, err = dao.GetKubeconfigSetting(ctx, tx, uuid.MustParse(ks.OrganizationID), accId, ks.IsSSOUser)
+	if err != nil && err == sql.ErrNoRows {
+		err = dao.CreateKubeconfigSetting(ctx, tx, convertToKubeCfgSettingModel(ks))
+	} else {
+		err = dao.UpdateKubeconfigSetting(ctx, tx, convertToKubeCfgSettingModel(ks))
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
 }
 
 func prepareKubeCfgSettingResponse(ks *models.KubeconfigSetting) *sentry.KubeconfigSetting {
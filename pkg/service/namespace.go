@@ -2,19 +2,48 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/cluster/hash"
 	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/utils"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/scheduler"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
 	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // NamespaceService is the interface for namespace operations
 type NamespaceService interface {
 	// GetProjectNamespaces
 	GetProjectNamespaces(ctx context.Context, projectID uuid.UUID) ([]string, error)
-	GetAccountProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID) ([]string, error)
-	GetGroupProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID) ([]string, error)
+	// GetAccountProjectNamespaces and GetGroupProjectNamespaces skip the
+	// bindings whose cluster selector does not match clusterLabels
+	GetAccountProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error)
+	GetGroupProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error)
+	// ReportClusterNamespaces syncs the namespace inventory of the cluster
+	// with the complete list of namespaces reported by it
+	ReportClusterNamespaces(ctx context.Context, clusterID string, namespaces []*metav1.ObjectMeta) (*sentryrpc.ReportNamespacesResponse, error)
+	// ListClusterNamespaces returns the namespaces present on the clusters
+	// of the project, limited to the cluster when name is set in options
+	ListClusterNamespaces(ctx context.Context, opts ...query.Option) (*scheduler.ClusterNamespaceList, error)
 }
 
 // namespaceService implements NamespaceService
@@ -37,8 +66,8 @@ func (s *namespaceService) GetProjectNamespaces(ctx context.Context, projectID u
 	return utils.Unique(cns), nil
 }
 
-func (s *namespaceService) GetAccountProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID) ([]string, error) {
-	cns, err := dao.GetAccountProjectNamespaces(ctx, s.db, projectID, accountID)
+func (s *namespaceService) GetAccountProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error) {
+	cns, err := dao.GetAccountProjectNamespaces(ctx, s.db, projectID, accountID, clusterLabels)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +75,332 @@ func (s *namespaceService) GetAccountProjectNamespaces(ctx context.Context, proj
 	return utils.Unique(cns), nil
 }
 
-func (s *namespaceService) GetGroupProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID) ([]string, error) {
-	cns, err := dao.GetGroupProjectNamespaces(ctx, s.db, projectID, accountID)
+func (s *namespaceService) GetGroupProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID, clusterLabels map[string]string) ([]string, error) {
+	cns, err := dao.GetGroupProjectNamespaces(ctx, s.db, projectID, accountID, clusterLabels)
 	if err != nil {
 		return nil, err
 	}
 
 	return utils.Unique(cns), nil
 }
+
+func (s *namespaceService) ReportClusterNamespaces(ctx context.Context, clusterID string, namespaces []*metav1.ObjectMeta) (*sentryrpc.ReportNamespacesResponse, error) {
+	cid, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cluster id %q", clusterID)
+	}
+	if _, err := dao.GetByID(ctx, s.db, cid, &models.Cluster{}); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "cluster %s not found", clusterID)
+		}
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	existing, err := cdao.GetClusterNamespaces(ctx, tx, cid)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]models.ClusterNamespace, len(existing))
+	for _, ns := range existing {
+		known[ns.Name] = ns
+	}
+
+	resp := &sentryrpc.ReportNamespacesResponse{}
+	now := time.Now()
+	reported := make(map[string]bool, len(namespaces))
+	for _, meta := range namespaces {
+		if meta == nil || meta.Name == "" || reported[meta.Name] {
+			continue
+		}
+		reported[meta.Name] = true
+
+		h := hash.GetNamespaceHashFrom(meta.Labels, meta.Annotations)
+		ens, ok := known[meta.Name]
+		if ok && ens.DeletedAt.IsZero() && ens.Hash == h {
+			continue
+		}
+
+		cns, err := prepareClusterNamespace(cid, meta, h, now)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !ok:
+			err = cdao.CreateClusterNamespace(ctx, tx, cns)
+			resp.Added = append(resp.Added, meta.Name)
+		case !ens.DeletedAt.IsZero():
+			// namespace was created again after being deleted
+			err = cdao.UpdateClusterNamespace(ctx, tx, cns)
+			resp.Added = append(resp.Added, meta.Name)
+		default:
+			err = cdao.UpdateClusterNamespace(ctx, tx, cns)
+			resp.Updated = append(resp.Updated, meta.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ens := range existing {
+		if reported[ens.Name] || !ens.DeletedAt.IsZero() {
+			continue
+		}
+		conditions, _ := json.Marshal([]*scheduler.ClusterNamespaceCondition{
+			clstrutil.NewNamespaceDeleted(constants.Success, "namespace removed from cluster"),
+		})
+		ens.Conditions = conditions
+		ens.ModifiedAt = now
+		ens.DeletedAt = now
+		if err := cdao.UpdateClusterNamespace(ctx, tx, &ens); err != nil {
+			return nil, err
+		}
+		resp.Deleted = append(resp.Deleted, ens.Name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Added)+len(resp.Updated)+len(resp.Deleted) > 0 {
+		_log.WithContext(ctx).Infow("cluster namespace inventory changed", "cluster", clusterID, "added", resp.Added, "updated", resp.Updated, "deleted", resp.Deleted)
+	}
+	return resp, nil
+}
+
+func (s *namespaceService) ListClusterNamespaces(ctx context.Context, opts ...query.Option) (*scheduler.ClusterNamespaceList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	selector, err := labels.Parse(queryOptions.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector %q: %s", queryOptions.Selector, err)
+	}
+
+	partnerID, orgID, err := getPartnerOrganization(ctx, s.db, queryOptions.Partner, queryOptions.Organization)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization %s", queryOptions.Organization)
+	}
+	var proj models.Project
+	_, err = dao.GetByNamePartnerOrg(ctx, s.db, queryOptions.Project,
+		uuid.NullUUID{UUID: partnerID, Valid: true}, uuid.NullUUID{UUID: orgID, Valid: true}, &proj)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "project %s not found", queryOptions.Project)
+		}
+		return nil, err
+	}
+
+	clusters, err := cdao.ListProjectClusters(ctx, s.db, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+	clusterNames := make(map[uuid.UUID]string)
+	var clusterIDs []uuid.UUID
+	for _, c := range clusters {
+		if queryOptions.Name != "" && c.Name != queryOptions.Name {
+			continue
+		}
+		clusterNames[c.ID] = c.Name
+		clusterIDs = append(clusterIDs, c.ID)
+	}
+	if queryOptions.Name != "" && len(clusterIDs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "cluster %s not found", queryOptions.Name)
+	}
+
+	cnss, err := cdao.ListLiveClusterNamespaces(ctx, s.db, clusterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*scheduler.ClusterNamespace
+	for _, cns := range cnss {
+		ns := prepareClusterNamespaceResponse(cns, proj.Name, clusterNames[cns.ClusterId])
+		if !selector.Matches(labels.Set(ns.Metadata.Labels)) {
+			continue
+		}
+		items = append(items, ns)
+	}
+
+	return &scheduler.ClusterNamespaceList{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.NamespaceListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		},
+		Items: items,
+	}, nil
+}
+
+// system namespaces are the ones of kubernetes and paralus itself
+func clusterNamespaceType(name string) scheduler.ClusterNamespaceType {
+	if strings.HasPrefix(name, "kube-") || name == "paralus-system" {
+		return scheduler.ClusterNamespaceType_ClusterNamespaceSystem
+	}
+	return scheduler.ClusterNamespaceType_ClusterNamespaceCustom
+}
+
+func prepareClusterNamespace(clusterID uuid.UUID, meta *metav1.ObjectMeta, h string, now time.Time) (*models.ClusterNamespace, error) {
+	nsType := clusterNamespaceType(meta.Name)
+	namespace, err := json.Marshal(&scheduler.NamespaceTemplate{
+		ObjectMeta: &metav1.ObjectMeta{
+			Name:              meta.Name,
+			Labels:            meta.Labels,
+			Annotations:       meta.Annotations,
+			CreationTimestamp: meta.CreationTimestamp,
+		},
+		Type: nsType.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	conditions, err := json.Marshal([]*scheduler.ClusterNamespaceCondition{
+		clstrutil.NewNamespaceReady(constants.Success, "reported by cluster"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.ClusterNamespace{
+		ClusterId:  clusterID,
+		Name:       meta.Name,
+		Hash:       h,
+		Type:       nsType.String(),
+		Namespace:  namespace,
+		Conditions: conditions,
+		Status:     json.RawMessage("{}"),
+		CreatedAt:  now,
+		ModifiedAt: now,
+	}, nil
+}
+
+func prepareClusterNamespaceResponse(cns models.ClusterNamespace, projectName, clusterName string) *scheduler.ClusterNamespace {
+	var template scheduler.NamespaceTemplate
+	if cns.Namespace != nil {
+		json.Unmarshal(cns.Namespace, &template)
+	}
+	var conditions []*scheduler.ClusterNamespaceCondition
+	if cns.Conditions != nil {
+		json.Unmarshal(cns.Conditions, &conditions)
+	}
+	return &scheduler.ClusterNamespace{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.NamespaceKind,
+		Metadata: &commonv3.Metadata{
+			Name:        cns.Name,
+			Project:     projectName,
+			Labels:      template.GetObjectMeta().GetLabels(),
+			Annotations: template.GetObjectMeta().GetAnnotations(),
+			CreatedAt:   timestamppb.New(cns.CreatedAt),
+			ModifiedAt:  timestamppb.New(cns.ModifiedAt),
+		},
+		Spec: &scheduler.ClusterNamespaceSpec{
+			Type:        scheduler.ClusterNamespaceType(scheduler.ClusterNamespaceType_value[cns.Type]),
+			Namespace:   &template,
+			ClusterID:   cns.ClusterId.String(),
+			ClusterName: clusterName,
+		},
+		Status: &scheduler.ClusterNamespaceStatus{
+			Conditions: conditions,
+		},
+	}
+}
+
+type namespaceBinding struct {
+	project   string
+	namespace string
+}
+
+// namespaceBindings returns the project namespace pairs of the namespace
+// scoped roles, project defaults to the given one when not set
+func namespaceBindings(project string, pnrs []*userv3.ProjectNamespaceRole) []namespaceBinding {
+	var bindings []namespaceBinding
+	for _, pnr := range pnrs {
+		if pnr.GetNamespace() == "" {
+			continue
+		}
+		p := pnr.GetProject()
+		if p == "" {
+			p = project
+		}
+		bindings = append(bindings, namespaceBinding{project: p, namespace: pnr.GetNamespace()})
+	}
+	return bindings
+}
+
+// namespaceBindingStatus returns the status for a resource with role
+// bindings, with a warning for namespaces which are not present on any
+// cluster of their project. Lookup failures do not fail the request.
+func namespaceBindingStatus(ctx context.Context, db bun.IDB, bindings []namespaceBinding) *commonv3.Status {
+	st := &commonv3.Status{
+		ConditionStatus: commonv3.ConditionStatus_StatusOK,
+		LastUpdated:     timestamppb.Now(),
+	}
+
+	missing := make(map[string][]string)
+	var projects []string
+	for _, b := range bindings {
+		if b.project == "" {
+			continue
+		}
+		if _, ok := missing[b.project]; !ok {
+			missing[b.project] = []string{}
+			projects = append(projects, b.project)
+		}
+		missing[b.project] = append(missing[b.project], b.namespace)
+	}
+
+	var warnings []string
+	for _, project := range projects {
+		present, err := projectClusterNamespaces(ctx, db, project)
+		if err != nil {
+			_log.WithContext(ctx).Warnw("unable to validate namespaces of role bindings", "project", project, "error", err)
+			continue
+		}
+		var unknown []string
+		for _, ns := range utils.Unique(missing[project]) {
+			if !present[ns] {
+				unknown = append(unknown, ns)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			warnings = append(warnings, fmt.Sprintf("namespaces %s not found on any cluster of project %s", strings.Join(unknown, ", "), project))
+		}
+	}
+	if len(warnings) > 0 {
+		st.ConditionType = "NamespaceValidation"
+		st.Reason = strings.Join(warnings, "; ")
+	}
+	return st
+}
+
+func projectClusterNamespaces(ctx context.Context, db bun.IDB, project string) (map[string]bool, error) {
+	projectID, err := dao.GetProjectId(ctx, db, project)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := cdao.ListProjectClusters(ctx, db, projectID)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	for _, c := range clusters {
+		ids = append(ids, c.ID)
+	}
+	cnss, err := cdao.ListLiveClusterNamespaces(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	present := make(map[string]bool, len(cnss))
+	for _, cns := range cnss {
+		present[cns.Name] = true
+	}
+	return present, nil
+}
@@ -2,10 +2,17 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/cluster/hash"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGetProjectNamespaces(t *testing.T) {
@@ -13,9 +20,9 @@ func TestGetProjectNamespaces(t *testing.T) {
 	defer db.Close()
 
 	puuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."cluster_selector", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
-	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."cluster_selector", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace2"))
 
 	ns := NewNamespaceService(db)
@@ -40,11 +47,11 @@ func TestGetAccountProjectNamespaces(t *testing.T) {
 
 	puuid := uuid.New()
 	uuuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(account_id = '` + uuuid.String() + `'\)`).
-		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
+	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."cluster_selector", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(account_id = '` + uuuid.String() + `'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace", "cluster_selector"}).AddRow("namespace1", "env=dev").AddRow("namespace2", "env=prod"))
 
 	ns := NewNamespaceService(db)
-	nl, err := ns.GetAccountProjectNamespaces(context.Background(), puuid, uuuid)
+	nl, err := ns.GetAccountProjectNamespaces(context.Background(), puuid, uuuid, map[string]string{"env": "dev"})
 	if err != nil {
 		t.Fatal("unable to get namespaces", err)
 	}
@@ -62,11 +69,11 @@ func TestGetGroupProjectNamespaces(t *testing.T) {
 
 	puuid := uuid.New()
 	uuuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id WHERE \(project_id = '+` + puuid.String() + `+'\) AND \(authsrv_groupaccount.account_id = '` + uuuid.String() + `'\) AND \(projectgroupnamespacerole.trash = FALSE\) AND \(authsrv_groupaccount.trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."cluster_selector", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id WHERE \(project_id = '+` + puuid.String() + `+'\) AND \(authsrv_groupaccount.account_id = '` + uuuid.String() + `'\) AND \(projectgroupnamespacerole.trash = FALSE\) AND \(authsrv_groupaccount.trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
 
 	ns := NewNamespaceService(db)
-	nl, err := ns.GetGroupProjectNamespaces(context.Background(), puuid, uuuid)
+	nl, err := ns.GetGroupProjectNamespaces(context.Background(), puuid, uuuid, nil)
 	if err != nil {
 		t.Fatal("unable to get namespaces", err)
 	}
@@ -77,3 +84,116 @@ func TestGetGroupProjectNamespaces(t *testing.T) {
 		t.Errorf("incorrect namespace name; expected '%v', got '%v'", "namespace1", nl[0])
 	}
 }
+
+func TestReportClusterNamespaces(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cuuid := uuid.New()
+	unchanged := map[string]string{"env": "prod"}
+	deletedAt := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."organization_id"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(cuuid.String()))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT (.+) FROM "cluster_namespaces" AS "cns" WHERE \(cluster_id = '` + cuuid.String() + `'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "name", "hash", "deleted_at"}).
+		AddRow(cuuid.String(), "default", hash.GetNamespaceHashFrom(unchanged, nil), nil).
+		AddRow(cuuid.String(), "apps", "stale", nil).
+		AddRow(cuuid.String(), "removed", "stale", nil).
+		AddRow(cuuid.String(), "recreated", "stale", deletedAt))
+	mock.ExpectExec(`UPDATE "cluster_namespaces" AS "cns" SET (.+) WHERE \(cluster_id = '` + cuuid.String() + `'\) AND \(name = 'apps'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "cluster_namespaces" AS "cns" SET (.+)"deleted_at" = NULL WHERE (.+) AND \(name = 'recreated'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`INSERT INTO "cluster_namespaces" (.+)'kube-system'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(nil))
+	mock.ExpectExec(`UPDATE "cluster_namespaces" AS "cns" SET (.+) WHERE (.+) AND \(name = 'removed'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ns := NewNamespaceService(db)
+	resp, err := ns.ReportClusterNamespaces(context.Background(), cuuid.String(), []*metav1.ObjectMeta{
+		{Name: "default", Labels: unchanged},
+		{Name: "apps", Labels: map[string]string{"team": "a"}},
+		{Name: "recreated"},
+		{Name: "kube-system"},
+	})
+	if err != nil {
+		t.Fatal("unable to report namespaces", err)
+	}
+	if strings.Join(resp.Added, ",") != "recreated,kube-system" {
+		t.Errorf("incorrect added namespaces; got '%v'", resp.Added)
+	}
+	if strings.Join(resp.Updated, ",") != "apps" {
+		t.Errorf("incorrect updated namespaces; got '%v'", resp.Updated)
+	}
+	if strings.Join(resp.Deleted, ",") != "removed" {
+		t.Errorf("incorrect deleted namespaces; got '%v'", resp.Deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListClusterNamespacesScoped(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	puuid := uuid.New()
+	cuuid := uuid.New()
+	pid, oid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT (.+) FROM "authsrv_project" AS "project" WHERE \(organization_id = '` + oid + `'\) AND \(partner_id = '` + pid + `'\) AND \(name = 'project1'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(puuid.String(), "project1"))
+	mock.ExpectQuery(`SELECT (.+) FROM "cluster_clusters" AS "cluster" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid.String(), "cluster1"))
+	mock.ExpectQuery(`SELECT (.+) FROM "cluster_namespaces" AS "cns" WHERE \(cluster_id IN \('` + cuuid.String() + `'\)\) AND \(deleted_at IS NULL\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "name", "namespace"}).
+		AddRow(cuuid.String(), "ns1", []byte(`{"objectMeta":{"name":"ns1"}}`)))
+
+	ns := NewNamespaceService(db)
+	list, err := ns.ListClusterNamespaces(context.Background(), query.WithOptions(&commonv3.QueryOptions{
+		Partner:      "partner1",
+		Organization: "org1",
+		Project:      "project1",
+	}))
+	if err != nil {
+		t.Fatal("unable to list cluster namespaces", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Spec.ClusterName != "cluster1" {
+		t.Errorf("incorrect namespaces; got '%v'", list.Items)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNamespaceBindingStatus(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	puuid := uuid.New()
+	cuuid := uuid.New()
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE \(name = 'project1'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(puuid.String()))
+	mock.ExpectQuery(`SELECT (.+) FROM "cluster_clusters" AS "cluster" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid.String(), "cluster1"))
+	mock.ExpectQuery(`SELECT (.+) FROM "cluster_namespaces" AS "cns" WHERE \(cluster_id IN \('` + cuuid.String() + `'\)\) AND \(deleted_at IS NULL\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "name"}).AddRow(cuuid.String(), "ns1"))
+
+	project := "project1"
+	ns1, ns2, ns3 := "ns1", "ns2", "ns3"
+	st := namespaceBindingStatus(context.Background(), db, namespaceBindings(project, []*userv3.ProjectNamespaceRole{
+		{Namespace: &ns1},
+		{Project: &project, Namespace: &ns3},
+		{Project: &project, Namespace: &ns2},
+		{Project: &project},
+	}))
+	if st.ConditionStatus != commonv3.ConditionStatus_StatusOK {
+		t.Errorf("incorrect condition status; expected '%v', got '%v'", commonv3.ConditionStatus_StatusOK, st.ConditionStatus)
+	}
+	expected := "namespaces ns2, ns3 not found on any cluster of project project1"
+	if st.Reason != expected {
+		t.Errorf("incorrect warning; expected '%v', got '%v'", expected, st.Reason)
+	}
+}
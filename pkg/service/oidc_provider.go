@@ -25,7 +25,9 @@ type OIDCProviderService interface {
 	Create(context.Context, *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error)
 	GetByID(context.Context, *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error)
 	GetByName(context.Context, *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error)
-	List(context.Context) (*systemv3.OIDCProviderList, error)
+	// List returns the providers of the organization of metadata, of all
+	// organizations when it has none
+	List(context.Context, *commonv3.Metadata) (*systemv3.OIDCProviderList, error)
 	Update(context.Context, *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error)
 	Delete(context.Context, *systemv3.OIDCProvider) error
 }
@@ -69,11 +71,11 @@ func validateURL(rawURL string) bool {
 func (s *oidcProvider) getPartnerOrganization(ctx context.Context, provider *systemv3.OIDCProvider) (uuid.UUID, uuid.UUID, error) {
 	partner := provider.GetMetadata().GetPartner()
 	org := provider.GetMetadata().GetOrganization()
-	partnerId, err := dao.GetPartnerId(ctx, s.db, partner)
+	partnerId, err := dao.GetPartnerId(ctx, dao.GetDB(ctx, s.db), partner)
 	if err != nil {
 		return uuid.Nil, uuid.Nil, err
 	}
-	organizationId, err := dao.GetOrganizationId(ctx, s.db, org)
+	organizationId, err := dao.GetOrganizationId(ctx, dao.GetDB(ctx, s.db), org)
 	if err != nil {
 		return partnerId, uuid.Nil, err
 	}
@@ -100,7 +102,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 	}
 	p, _ := dao.GetIdByNamePartnerOrg(
 		ctx,
-		s.db,
+		dao.GetDB(ctx, s.db),
 		name,
 		uuid.NullUUID{UUID: partnerId, Valid: true},
 		uuid.NullUUID{UUID: organizationId, Valid: true},
@@ -110,7 +112,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 		return nil, fmt.Errorf("provider %q already exists", name)
 	}
 
-	p, _ = dao.GetM(ctx, s.db, map[string]interface{}{
+	p, _ = dao.GetM(ctx, dao.GetDB(ctx, s.db), map[string]interface{}{
 		"issuer_url":      issUrl,
 		"partner_id":      partnerId,
 		"organization_id": organizationId,
@@ -156,7 +158,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
 		Predefined:      provider.Spec.GetPredefined(),
 	}
-	_, err = dao.Create(ctx, s.db, entity)
+	_, err = dao.Create(ctx, dao.GetDB(ctx, s.db), entity)
 	if err != nil {
 		return &systemv3.OIDCProvider{}, err
 	}
@@ -196,7 +198,7 @@ func (s *oidcProvider) GetByID(ctx context.Context, provider *systemv3.OIDCProvi
 	}
 
 	entity := &models.OIDCProvider{}
-	_, err = dao.GetByID(ctx, s.db, id, entity)
+	_, err = dao.GetByID(ctx, dao.GetDB(ctx, s.db), id, entity)
 	// TODO: Return proper error for Id not exist
 	if err != nil {
 		return &systemv3.OIDCProvider{}, err
@@ -236,7 +238,7 @@ func (s *oidcProvider) GetByName(ctx context.Context, provider *systemv3.OIDCPro
 	}
 
 	entity := &models.OIDCProvider{}
-	_, err := dao.GetByName(ctx, s.db, name, entity)
+	_, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), name, entity)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -276,13 +278,21 @@ func (s *oidcProvider) GetByName(ctx context.Context, provider *systemv3.OIDCPro
 	return rv, nil
 }
 
-func (s *oidcProvider) List(ctx context.Context) (*systemv3.OIDCProviderList, error) {
+func (s *oidcProvider) List(ctx context.Context, meta *commonv3.Metadata) (*systemv3.OIDCProviderList, error) {
 	var (
 		entities []models.OIDCProvider
 		orgID    uuid.NullUUID
 		parID    uuid.NullUUID
 	)
-	_, err := dao.List(ctx, s.db, parID, orgID, &entities)
+	if meta.GetOrganization() != "" {
+		partnerId, organizationId, err := s.getPartnerOrganization(ctx, &systemv3.OIDCProvider{Metadata: meta})
+		if err != nil {
+			return &systemv3.OIDCProviderList{}, err
+		}
+		parID = uuid.NullUUID{UUID: partnerId, Valid: true}
+		orgID = uuid.NullUUID{UUID: organizationId, Valid: true}
+	}
+	_, err := dao.List(ctx, dao.GetDB(ctx, s.db), parID, orgID, &entities)
 	if err != nil {
 		return &systemv3.OIDCProviderList{}, nil
 	}
@@ -343,7 +353,7 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 	}
 
 	existingP := &models.OIDCProvider{}
-	_, err = dao.GetByName(ctx, s.db, name, existingP)
+	_, err = dao.GetByName(ctx, dao.GetDB(ctx, s.db), name, existingP)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return &systemv3.OIDCProvider{}, status.Errorf(codes.InvalidArgument, "oidc provider %q not exist", name)
@@ -387,9 +397,9 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
 		Predefined:      provider.Spec.GetPredefined(),
 	}
-	_, err = dao.Update(ctx, s.db, existingP.Id, entity)
+	_, err = dao.Update(ctx, dao.GetDB(ctx, s.db), existingP.Id, entity)
 	if err != nil {
-		_log.Errorf("Unable to create oidc provider: %s", err)
+		_log.WithContext(ctx).Errorf("Unable to create oidc provider: %s", err)
 		// TODO: catch already existing issuer url and return exact error
 		return &systemv3.OIDCProvider{}, fmt.Errorf("unable to create oidc provider")
 	}
@@ -428,12 +438,12 @@ func (s *oidcProvider) Delete(ctx context.Context, provider *systemv3.OIDCProvid
 	if len(name) == 0 {
 		return status.Error(codes.InvalidArgument, "EMPTY NAME")
 	}
-	_, err := dao.GetByName(ctx, s.db, name, entity)
+	_, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), name, entity)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "OIDC PROVIDER %q NOT EXIST", name)
 	}
 
-	err = dao.Delete(ctx, s.db, entity.Id, &models.OIDCProvider{})
+	err = dao.Delete(ctx, dao.GetDB(ctx, s.db), entity.Id, &models.OIDCProvider{})
 	if err != nil {
 		return err
 	}
@@ -287,7 +287,7 @@ func TestOidcProviderList(t *testing.T) {
 		AddRow(pruuid1, "provider_name-"+pruuid1, issuerUrl1).
 		AddRow(pruuid2, "provider_name-"+pruuid2, issuerUrl2))
 
-	providerList, err := ops.List(context.Background())
+	providerList, err := ops.List(context.Background(), nil)
 
 	if err != nil {
 		t.Fatal("could not list oidc provider:", err, pruuid)
@@ -302,3 +302,28 @@ func TestOidcProviderList(t *testing.T) {
 		t.Errorf("incorrect IssuerUrl returned when listing")
 	}
 }
+
+func TestOidcProviderListOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ops := NewOIDCProviderService(db, "", getLogger())
+
+	// providers of org-b are left out by the query of org-a
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	pruuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "oidcprovider"."id", .* FROM "authsrv_oidc_provider" AS "oidcprovider" WHERE \(partner_id = '` + puuid + `'\) AND \(organization_id = '` + ouuid + `'\) AND \(trash = false\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id"}).
+		AddRow(pruuid, "provider-a", ouuid))
+
+	providerList, err := ops.List(context.Background(), &v3.Metadata{Partner: "partner", Organization: "org-a"})
+	if err != nil {
+		t.Fatal("could not list oidc providers:", err)
+	}
+	if len(providerList.Items) != 1 || providerList.Items[0].Metadata.Name != "provider-a" {
+		t.Errorf("expected only the provider of org-a, got %v", providerList.Items)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -200,6 +200,9 @@ func (s *organizationService) Update(ctx context.Context, organization *systemv3
 
 	if org, ok := entity.(*models.Organization); ok {
 		settingsAfter := organization.GetSpec().GetSettings()
+		if err := validateAuditRetention(settingsAfter.GetAuditRetention()); err != nil {
+			return &systemv3.Organization{}, err
+		}
 		settingsBefore := systemv3.OrganizationSettings{}
 		_ = json.Unmarshal(org.Settings, &settingsBefore) // ignore any unmarshelling issues
 
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -68,12 +67,12 @@ func (s *projectService) Create(ctx context.Context, project *systemv3.Project)
 	}
 
 	var org models.Organization
-	_, err := dao.GetByName(ctx, s.db, project.Metadata.Organization, &org)
+	_, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), project.Metadata.Organization, &org)
 	if err != nil {
 		return nil, err
 	}
 
-	p, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, project.GetMetadata().GetName(), uuid.NullUUID{}, uuid.NullUUID{}, &models.Project{})
+	p, _ := dao.GetIdByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), project.GetMetadata().GetName(), uuid.NullUUID{}, uuid.NullUUID{}, &models.Project{})
 	if p != nil {
 		return nil, fmt.Errorf("project '%v' already exists", project.GetMetadata().GetName())
 	}
@@ -90,7 +89,7 @@ func (s *projectService) Create(ctx context.Context, project *systemv3.Project)
 		Default:        project.GetSpec().GetDefault(),
 	}
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := dao.BeginTx(ctx, s.db)
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
@@ -126,7 +125,7 @@ func (s *projectService) Create(ctx context.Context, project *systemv3.Project)
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		_log.Warn("unable to commit changes", err)
+		_log.WithContext(ctx).Warn("unable to commit changes", err)
 	}
 
 	return project, nil
@@ -147,7 +146,7 @@ func (s *projectService) GetByID(ctx context.Context, id string) (*systemv3.Proj
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
-	entity, err := dao.GetByID(ctx, s.db, uid, &models.Project{})
+	entity, err := dao.GetByID(ctx, dao.GetDB(ctx, s.db), uid, &models.Project{})
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
@@ -182,7 +181,7 @@ func (s *projectService) GetByName(ctx context.Context, name string) (*systemv3.
 		},
 	}
 
-	entity, err := dao.GetByName(ctx, s.db, name, &models.Project{})
+	entity, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), name, &models.Project{})
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
@@ -190,23 +189,23 @@ func (s *projectService) GetByName(ctx context.Context, name string) (*systemv3.
 	if proj, ok := entity.(*models.Project); ok {
 
 		var org models.Organization
-		_, err := dao.GetByID(ctx, s.db, proj.OrganizationId, &org)
+		_, err := dao.GetByID(ctx, dao.GetDB(ctx, s.db), proj.OrganizationId, &org)
 		if err != nil {
 			return nil, err
 		}
 
 		var partner models.Partner
-		_, err = dao.GetByID(ctx, s.db, proj.PartnerId, &partner)
+		_, err = dao.GetByID(ctx, dao.GetDB(ctx, s.db), proj.PartnerId, &partner)
 		if err != nil {
 			return nil, err
 		}
 
-		pnr, err := dao.GetProjectGroupRoles(ctx, s.db, proj.ID)
+		pnr, err := dao.GetProjectGroupRoles(ctx, dao.GetDB(ctx, s.db), proj.ID)
 		if err != nil {
 			return nil, err
 		}
 
-		ur, err := dao.GetProjectUserRoles(ctx, s.db, proj.ID)
+		ur, err := dao.GetProjectUserRoles(ctx, dao.GetDB(ctx, s.db), proj.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -233,14 +232,14 @@ func (s *projectService) GetByName(ctx context.Context, name string) (*systemv3.
 
 func (s *projectService) Update(ctx context.Context, project *systemv3.Project) (*systemv3.Project, error) {
 
-	entity, err := dao.GetByName(ctx, s.db, project.Metadata.Name, &models.Project{})
+	entity, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), project.Metadata.Name, &models.Project{})
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
 
 	if proj, ok := entity.(*models.Project); ok {
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &systemv3.Project{}, err
 		}
@@ -298,28 +297,36 @@ func (s *projectService) Update(ctx context.Context, project *systemv3.Project)
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
 		CreateProjectAuditEvent(ctx, s.al, AuditActionUpdate, project.GetMetadata().GetName(), proj.ID)
+
+		bindings := namespaceBindings(proj.Name, pnr)
+		for _, r := range ur {
+			if r.GetNamespace() != "" {
+				bindings = append(bindings, namespaceBinding{project: proj.Name, namespace: r.GetNamespace()})
+			}
+		}
+		project.Status = namespaceBindingStatus(ctx, dao.GetDB(ctx, s.db), bindings)
 	}
 
 	return project, nil
 }
 
 func (s *projectService) Delete(ctx context.Context, project *systemv3.Project) (*systemv3.Project, error) {
-	entity, err := dao.GetByName(ctx, s.db, project.Metadata.Name, &models.Project{})
+	entity, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), project.Metadata.Name, &models.Project{})
 	if err != nil {
 		return &systemv3.Project{}, err
 	}
 	if proj, ok := entity.(*models.Project); ok {
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &systemv3.Project{}, err
 		}
 
-		clusters, err := cdao.ListClusters(ctx, s.db, commonv3.QueryOptions{
+		clusters, err := cdao.ListClusters(ctx, dao.GetDB(ctx, s.db), commonv3.QueryOptions{
 			Project:      proj.ID.String(),
 			Organization: proj.OrganizationId.String(),
 			Partner:      proj.PartnerId.String(),
@@ -357,7 +364,7 @@ func (s *projectService) Delete(ctx context.Context, project *systemv3.Project)
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 			return &systemv3.Project{}, err
 		}
 
@@ -388,32 +395,32 @@ func (s *projectService) List(ctx context.Context, project *systemv3.Project) (*
 	}
 	if len(project.Metadata.Organization) > 0 {
 		var org models.Organization
-		_, err := dao.GetByName(ctx, s.db, project.Metadata.Organization, &org)
+		_, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), project.Metadata.Organization, &org)
 		if err != nil {
 			return &systemv3.ProjectList{}, err
 		}
 		var part models.Partner
-		_, err = dao.GetByName(ctx, s.db, project.Metadata.Partner, &part)
+		_, err = dao.GetByName(ctx, dao.GetDB(ctx, s.db), project.Metadata.Partner, &part)
 		if err != nil {
 			return &systemv3.ProjectList{}, err
 		}
 
 		var projs []models.Project
 		if !s.dev {
-			entity, err := dao.GetUserByEmail(ctx, s.db, username, &models.KratosIdentities{})
+			entity, err := dao.GetUserByEmail(ctx, dao.GetDB(ctx, s.db), username, &models.KratosIdentities{})
 			if err != nil {
 				return &systemv3.ProjectList{}, err
 			}
 
 			if usr, ok := entity.(*models.KratosIdentities); ok {
-				projs, err = dao.GetFileteredProjects(ctx, s.db, usr.ID, part.ID, org.ID)
+				projs, err = dao.GetFileteredProjects(ctx, dao.GetDB(ctx, s.db), usr.ID, part.ID, org.ID)
 				if err != nil {
 					return &systemv3.ProjectList{}, err
 				}
 			}
 
 		} else {
-			_, err = dao.List(ctx, s.db, uuid.NullUUID{UUID: part.ID, Valid: true}, uuid.NullUUID{UUID: org.ID, Valid: true}, &projs)
+			_, err = dao.List(ctx, dao.GetDB(ctx, s.db), uuid.NullUUID{UUID: part.ID, Valid: true}, uuid.NullUUID{UUID: org.ID, Valid: true}, &projs)
 			if err != nil {
 				return &systemv3.ProjectList{}, err
 			}
@@ -424,11 +431,11 @@ func (s *projectService) List(ctx context.Context, project *systemv3.Project) (*
 			labels["organization"] = proj.OrganizationId.String()
 			labels["partner"] = proj.PartnerId.String()
 
-			pnr, err := dao.GetProjectGroupRoles(ctx, s.db, proj.ID)
+			pnr, err := dao.GetProjectGroupRoles(ctx, dao.GetDB(ctx, s.db), proj.ID)
 			if err != nil {
 				return nil, err
 			}
-			ur, err := dao.GetProjectUserRoles(ctx, s.db, proj.ID)
+			ur, err := dao.GetProjectUserRoles(ctx, dao.GetDB(ctx, s.db), proj.ID)
 			if err != nil {
 				return nil, err
 			}
@@ -488,7 +495,7 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 		}
 
 		grp := pnr.Group
-		entity, err = dao.GetIdByName(ctx, s.db, *grp, &models.Group{})
+		entity, err = dao.GetIdByName(ctx, dao.GetDB(ctx, s.db), *grp, &models.Group{})
 		if err != nil {
 			return &systemv3.Project{}, fmt.Errorf("unable to find group '%v'", grp)
 		}
@@ -502,6 +509,10 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 		}
 
 		org := project.Metadata.Organization
+		selector := pnr.GetClusterSelector()
+		if err := validateRoleClusterSelector(selector, scope); err != nil {
+			return &systemv3.Project{}, err
+		}
 		switch scope {
 		case "project":
 			if org == "" {
@@ -509,13 +520,14 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 			}
 
 			pgr := models.ProjectGroupRole{
-				Trash:          false,
-				RoleId:         roleId,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				GroupId:        grpId,
-				ProjectId:      ids.Id,
-				Active:         true,
+				Trash:           false,
+				RoleId:          roleId,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				GroupId:         grpId,
+				ProjectId:       ids.Id,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			pgrs = append(pgrs, pgr)
 
@@ -533,16 +545,17 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 
 			namespace := pnr.GetNamespace()
 			pgnrObj := models.ProjectGroupNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				GroupId:        grpId,
-				ProjectId:      ids.Id,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				RoleId:          roleId,
+				GroupId:         grpId,
+				ProjectId:       ids.Id,
+				Namespace:       namespace,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			pgnr = append(pgnr, pgnrObj)
 
@@ -641,18 +654,22 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 
 		if acc, ok := entity.(*models.KratosIdentities); ok {
 			if role, ok := rentity.(*models.Role); ok {
+				if err := validateRoleClusterSelector(ur.GetClusterSelector(), role.Scope); err != nil {
+					return &systemv3.Project{}, err
+				}
 				switch role.Scope {
 				case "project":
 					parr := models.ProjectAccountResourcerole{
-						CreatedAt:      time.Now(),
-						ModifiedAt:     time.Now(),
-						Trash:          false,
-						AccountId:      acc.ID,
-						ProjectId:      projectId,
-						RoleId:         role.ID,
-						OrganizationId: role.OrganizationId,
-						PartnerId:      role.PartnerId,
-						Active:         true,
+						CreatedAt:       time.Now(),
+						ModifiedAt:      time.Now(),
+						Trash:           false,
+						AccountId:       acc.ID,
+						ProjectId:       projectId,
+						RoleId:          role.ID,
+						OrganizationId:  role.OrganizationId,
+						PartnerId:       role.PartnerId,
+						ClusterSelector: ur.GetClusterSelector(),
+						Active:          true,
 					}
 					parrs = append(parrs, parr)
 					ugs = append(ugs, &authzv1.Policy{
@@ -664,16 +681,17 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 					})
 				case "namespace":
 					panrObj := models.ProjectAccountNamespaceRole{
-						CreatedAt:      time.Now(),
-						ModifiedAt:     time.Now(),
-						Trash:          false,
-						AccountId:      acc.ID,
-						PartnerId:      role.PartnerId,
-						OrganizationId: role.OrganizationId,
-						RoleId:         role.ID,
-						ProjectId:      projectId,
-						Namespace:      ur.GetNamespace(),
-						Active:         true,
+						CreatedAt:       time.Now(),
+						ModifiedAt:      time.Now(),
+						Trash:           false,
+						AccountId:       acc.ID,
+						PartnerId:       role.PartnerId,
+						OrganizationId:  role.OrganizationId,
+						RoleId:          role.ID,
+						ProjectId:       projectId,
+						Namespace:       ur.GetNamespace(),
+						ClusterSelector: ur.GetClusterSelector(),
+						Active:          true,
 					}
 					panrs = append(panrs, panrObj)
 
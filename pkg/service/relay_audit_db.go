@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 
 	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/audit/auditql"
+	"github.com/paralus/paralus/pkg/query"
 	v1 "github.com/paralus/paralus/proto/rpc/audit"
 	auditv1 "github.com/paralus/paralus/proto/types/audit"
 	"github.com/uptrace/bun"
@@ -30,10 +32,12 @@ func (ra *relayAuditDatabaseService) GetRelayAudit(ctx context.Context, req *v1.
 }
 
 func (ra *relayAuditDatabaseService) GetRelayAuditByProjects(ctx context.Context, req *v1.RelayAuditRequest) (res *v1.RelayAuditResponse, err error) {
-	err = validateQueryString(req.GetFilter().QueryString)
-	if err != nil {
+	if _, err := compileAuditQuery(req.GetFilter().QueryString, auditql.ForTag(ra.tag)); err != nil {
 		return &v1.RelayAuditResponse{}, err
 	}
+	if _, err := validateAuditPage(req.GetFilter()); err != nil {
+		return nil, err
+	}
 
 	//validate user authz with incoming request
 	if len(req.GetFilter().GetProjects()) > 0 {
@@ -42,7 +46,7 @@ func (ra *relayAuditDatabaseService) GetRelayAuditByProjects(ctx context.Context
 		}
 	}
 
-	auditLogs, err := dao.GetAuditLogs(ctx, ra.db, ra.tag, req.Filter)
+	auditLogs, next, err := dao.GetAuditLogs(ctx, ra.db, ra.tag, req.Filter)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +115,8 @@ func (ra *relayAuditDatabaseService) GetRelayAuditByProjects(ctx context.Context
 
 	result, _ := structpb.NewStruct(resMap)
 	res = &v1.RelayAuditResponse{
-		Result: result,
+		Result:        result,
+		NextPageToken: query.EncodeAuditCursor(next),
 	}
 	return res, nil
 }
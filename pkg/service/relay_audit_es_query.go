@@ -5,9 +5,13 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/paralus/paralus/pkg/audit/auditql"
 	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/query"
 	v1 "github.com/paralus/paralus/proto/rpc/audit"
 	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -29,7 +33,11 @@ func (ra *relayAuditElasticSearchService) GetRelayAudit(ctx context.Context, req
 }
 
 func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Context, req *v1.RelayAuditRequest) (res *v1.RelayAuditResponse, err error) {
-	err = validateQueryString(req.GetFilter().QueryString)
+	schema := auditql.Events
+	if req.AuditType == common.RelayAPIAuditType {
+		schema = auditql.RelayAPI
+	}
+	auditQuery, err := compileAuditQuery(req.GetFilter().QueryString, schema)
 	if err != nil {
 		return &v1.RelayAuditResponse{}, err
 	}
@@ -40,23 +48,30 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 		}
 	}
 
+	after, err := validateAuditPage(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	size := query.AuditPageSize(req.GetFilter())
+	clusters := query.AuditClusters(req.GetFilter())
+	timeRange := esTimeRange(req.GetFilter(), "json.ts")
+
 	var buf bytes.Buffer
 	var r map[string]interface{}
 	res = &v1.RelayAuditResponse{}
 	//Handle defaults value
 	query := map[string]interface{}{
 		"_source": []string{"json"},
-		"size":    500,
+		"size":    size,
+		// 7.x and later count only up to 10000 hits by default
+		"track_total_hits": true,
 		"query": map[string]interface{}{
 			"bool": map[string]interface{}{
 				"must": []map[string]interface{}{},
 			},
 		},
-		"sort": map[string]interface{}{
-			"json.ts": map[string]interface{}{
-				"order": "desc",
-			},
-		},
+		// the relay does not write a unique field with its audits
+		"sort": esSort("json.ts", ""),
 		"aggs": map[string]interface{}{
 			"group_by_username": map[string]interface{}{
 				"terms": map[string]interface{}{
@@ -126,18 +141,14 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 	//Results not required in case of dashboard - only aggregations required
 	if req.GetFilter().DashboardData {
 		query["size"] = 0
+		size = 0
+	} else if after != nil && len(after.SortValues) > 0 {
+		query["search_after"] = after.SortValues
 	}
 
-	//	Add timefrom filter
-	if req.Filter.Timefrom != "" {
-		b["filter"] = map[string]interface{}{
-			"range": map[string]interface{}{
-				"json.ts": map[string]interface{}{
-					"gte": req.Filter.Timefrom,
-					"lt":  "now",
-				},
-			},
-		}
+	//	Add time range filter
+	if timeRange != nil {
+		b["filter"] = timeRange
 	}
 	// User
 	if req.Filter.User != "" {
@@ -148,11 +159,11 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 		}
 		m = append(m, t)
 	}
-	// Cluster
-	if req.Filter.Cluster != "" {
+	// Clusters
+	if len(clusters) > 0 {
 		t := map[string]interface{}{
-			"term": map[string]interface{}{
-				"json.cn": req.Filter.Cluster,
+			"terms": map[string]interface{}{
+				"json.cn": clusters,
 			},
 		}
 		m = append(m, t)
@@ -214,20 +225,15 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 		}
 	}
 	// query string
-	if req.GetFilter().QueryString != "" {
-		q := map[string]interface{}{
-			"query_string": map[string]interface{}{
-				"query": req.GetFilter().QueryString,
-			},
-		}
-		m = append(m, q)
+	if auditQuery != nil {
+		m = append(m, auditQuery.ES("json."))
 	}
 	b["must"] = m
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
-		_log.Errorw("Error encoding query:", " err", err)
+		_log.WithContext(ctx).Errorw("Error encoding query:", " err", err)
 		return res, err
 	}
-	_log.Debug("Executing Query: ", q)
+	_log.WithContext(ctx).Debug("Executing Query: ", q)
 	r, err = ra.relayQuery.Handle(buf)
 	if err != nil {
 		return res, err
@@ -239,7 +245,13 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 	if err != nil {
 		return res, err
 	}
-	res = &v1.RelayAuditResponse{Result: raw}
+	res = &v1.RelayAuditResponse{Result: raw, NextPageToken: esNextPageToken(r, size)}
 
 	return res, nil
 }
+
+// GetRelaySessions is not supported, the relay api calls and kubectl
+// commands of sessions are in separate indices
+func (ra *relayAuditElasticSearchService) GetRelaySessions(ctx context.Context, req *v1.RelaySessionRequest) (*v1.RelaySessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "kubectl sessions require the database audit log storage")
+}
@@ -6,12 +6,14 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/pkg/common"
 	v1 "github.com/paralus/paralus/proto/rpc/audit"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type rmd struct {
@@ -75,17 +77,12 @@ type rmd struct {
 				Terms struct {
 					JSONProject []string `json:"json.project"`
 				} `json:"terms,omitempty"`
-				QueryString struct {
-					Query string `json:"query"`
-				} `json:"query_string,omitempty"`
 			} `json:"must"`
 		} `json:"bool"`
 	} `json:"query"`
 	Size int `json:"size"`
-	Sort struct {
-		JSONTs struct {
-			Order string `json:"order"`
-		} `json:"json.ts"`
+	Sort []map[string]struct {
+		Order string `json:"order"`
 	} `json:"sort"`
 }
 
@@ -97,7 +94,7 @@ func TestGetRelayAuditLogByProjectsSimple(t *testing.T) {
 	al := &relayAuditElasticSearchService{relayQuery: esq, db: db}
 	req := v1.RelayAuditRequest{
 		Filter: &v1.RelayAuditQueryFilter{
-			QueryString:   "query-string",
+			QueryString:   "user:query*",
 			Projects:      []string{"project-one", "project-two"},
 			Timefrom:      "now-1h",
 			Type:          "test-type",
@@ -111,7 +108,7 @@ func TestGetRelayAuditLogByProjectsSimple(t *testing.T) {
 		},
 	}
 	uuid := uuid.New().String()
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
 	sd := v3.SessionData{
@@ -133,7 +130,7 @@ func TestGetRelayAuditLogByProjectsSimple(t *testing.T) {
 	if err != nil {
 		t.Fatal("unable to unmarshall es request")
 	}
-	expected := `{"_source":["json"],"aggs":{"group_by_cluster":{"aggs":{"group_by_namespace":{"terms":{"field":"json.ns","size":1000}},"group_by_username":{"terms":{"field":"json.un","size":1000}}},"terms":{"field":"json.cn","size":1000}},"group_by_kind":{"terms":{"field":"json.k"}},"group_by_method":{"terms":{"field":"json.m"}},"group_by_namespace":{"terms":{"field":"json.ns"}},"group_by_username":{"terms":{"field":"json.un"}}},"query":{"bool":{"filter":{"range":{"json.ts":{"gte":"now-1h","lt":"now"}}},"must":[{"term":{"json.un":"test-user"}},{"term":{"json.cn":"test-cluster"}},{"term":{"json.ns":"test-namespace"}},{"term":{"json.k":"test-kind"}},{"term":{"json.m":"test-method"}},{"terms":{"json.project":["project-one","project-two"]}},{"query_string":{"query":"query-string"}}]}},"size":0,"sort":{"json.ts":{"order":"desc"}}}`
+	expected := `{"_source":["json"],"aggs":{"group_by_cluster":{"aggs":{"group_by_namespace":{"terms":{"field":"json.ns","size":1000}},"group_by_username":{"terms":{"field":"json.un","size":1000}}},"terms":{"field":"json.cn","size":1000}},"group_by_kind":{"terms":{"field":"json.k"}},"group_by_method":{"terms":{"field":"json.m"}},"group_by_namespace":{"terms":{"field":"json.ns"}},"group_by_username":{"terms":{"field":"json.un"}}},"query":{"bool":{"filter":{"range":{"json.ts":{"gte":"now-1h","lt":"now"}}},"must":[{"term":{"json.un":"test-user"}},{"terms":{"json.cn":["test-cluster"]}},{"term":{"json.ns":"test-namespace"}},{"term":{"json.k":"test-kind"}},{"term":{"json.m":"test-method"}},{"terms":{"json.project":["project-one","project-two"]}},{"prefix":{"json.actor.account.username":"query"}}]}},"size":0,"sort":[{"json.ts":{"order":"desc"}},{"_doc":{"order":"desc"}}],"track_total_hits":true}`
 	if strings.TrimSpace(esq.msg[0].String()) != expected {
 		t.Errorf("incorrect es query; expected '%v', got '%v'", expected, strings.TrimSpace(esq.msg[0].String()))
 	}
@@ -148,11 +145,11 @@ func TestGetRelayAuditLogByProjectsNoProject(t *testing.T) {
 	req := v1.RelayAuditRequest{
 		Metadata: &v3.Metadata{UrlScope: "url/project"},
 		Filter: &v1.RelayAuditQueryFilter{
-			QueryString: "query-string",
+			QueryString: "user:query*",
 		},
 	}
 	uuid := uuid.New().String()
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "sap"."account_id", "sap"."project_id", "sap"."group_id", "sap"."role_id", "sap"."role_name", "sap"."organization_id", "sap"."partner_id", "sap"."is_global", "sap"."scope", "sap"."permission_name", "sap"."base_url", "sap"."urls", "sap"."cluster_selector" FROM "sentry_account_permission" AS "sap" WHERE (account_id = '` + uuid + `') AND (partner_id = '` + uuid + `') AND (lower(role_name) = 'admin') AND (lower(scope) = 'organization')`)).
 		WillReturnRows(sqlmock.NewRows([]string{"account_id", "role_name", "scope"}).AddRow(uuid, "admin", "organization"))
 
 	sd := v3.SessionData{
@@ -175,8 +172,54 @@ func TestGetRelayAuditLogByProjectsNoProject(t *testing.T) {
 		t.Fatal("unable to unmarshall es request")
 	}
 
-	expected := `{"_source":["json"],"aggs":{"group_by_cluster":{"terms":{"field":"json.cn"}},"group_by_kind":{"terms":{"field":"json.k"}},"group_by_method":{"terms":{"field":"json.m"}},"group_by_namespace":{"terms":{"field":"json.ns"}},"group_by_username":{"terms":{"field":"json.un"}}},"query":{"bool":{"must":[{"terms":{"json.project":["project"]}},{"query_string":{"query":"query-string"}}]}},"size":500,"sort":{"json.ts":{"order":"desc"}}}`
+	expected := `{"_source":["json"],"aggs":{"group_by_cluster":{"terms":{"field":"json.cn"}},"group_by_kind":{"terms":{"field":"json.k"}},"group_by_method":{"terms":{"field":"json.m"}},"group_by_namespace":{"terms":{"field":"json.ns"}},"group_by_username":{"terms":{"field":"json.un"}}},"query":{"bool":{"must":[{"terms":{"json.project":["project"]}},{"prefix":{"json.actor.account.username":"query"}}]}},"size":500,"sort":[{"json.ts":{"order":"desc"}},{"_doc":{"order":"desc"}}],"track_total_hits":true}`
 	if strings.TrimSpace(esq.msg[0].String()) != expected {
 		t.Errorf("incorrect es query; expected '%v', got '%v'", expected, strings.TrimSpace(esq.msg[0].String()))
 	}
 }
+
+func TestGetRelayAuditLogPages(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	esq := &mockElasticSearchQuery{
+		res: map[string]interface{}{
+			"hits": map[string]interface{}{
+				"hits": []interface{}{
+					map[string]interface{}{"sort": []interface{}{float64(1669024110597), float64(12)}},
+					map[string]interface{}{"sort": []interface{}{float64(1669024110500), float64(9)}},
+				},
+			},
+		},
+	}
+	al := &relayAuditElasticSearchService{relayQuery: esq, db: db}
+	from := time.Date(2022, 11, 21, 0, 0, 0, 0, time.UTC)
+	req := v1.RelayAuditRequest{
+		Filter: &v1.RelayAuditQueryFilter{
+			Clusters: []string{"c1", "c2"},
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(from.Add(time.Hour)),
+			PageSize: 2,
+		},
+	}
+	res, err := al.GetRelayAuditByProjects(context.Background(), &req)
+	if err != nil {
+		t.Fatal("unable to get audit logs", err)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+	expected := `{"_source":["json"],"aggs":{"group_by_cluster":{"terms":{"field":"json.cn"}},"group_by_kind":{"terms":{"field":"json.k"}},"group_by_method":{"terms":{"field":"json.m"}},"group_by_namespace":{"terms":{"field":"json.ns"}},"group_by_username":{"terms":{"field":"json.un"}}},"query":{"bool":{"filter":{"range":{"json.ts":{"gte":"2022-11-21T00:00:00Z","lt":"2022-11-21T01:00:00Z"}}},"must":[{"terms":{"json.cn":["c1","c2"]}}]}},"size":2,"sort":[{"json.ts":{"order":"desc"}},{"_doc":{"order":"desc"}}],"track_total_hits":true}`
+	if strings.TrimSpace(esq.msg[0].String()) != expected {
+		t.Errorf("incorrect es query; expected '%v', got '%v'", expected, strings.TrimSpace(esq.msg[0].String()))
+	}
+
+	req.Filter.PageToken = res.NextPageToken
+	_, err = al.GetRelayAuditByProjects(context.Background(), &req)
+	if err != nil {
+		t.Fatal("unable to get audit logs", err)
+	}
+	if !strings.Contains(esq.msg[1].String(), `"search_after":[1669024110500,9]`) {
+		t.Errorf("expected search_after of last hit, got '%v'", esq.msg[1].String())
+	}
+}
@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/audit/auditql"
+	"github.com/paralus/paralus/pkg/query"
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultRelaySessionGap is the idle time after which the next event
+	// of a user starts a new session
+	defaultRelaySessionGap = 15 * time.Minute
+	// defaultRelaySessionRange is the time range of the sessions when
+	// the request has none
+	defaultRelaySessionRange = "now-24h"
+	// maxRelaySessionEvents caps the events grouped by a request
+	maxRelaySessionEvents = 10000
+)
+
+// sources of session events
+const (
+	relaySessionSourceAPI     = "api"
+	relaySessionSourceCommand = "command"
+)
+
+// kubectl verbs which change resources
+var kubectlWriteVerbs = map[string]bool{
+	"annotate": true, "apply": true, "autoscale": true, "cordon": true,
+	"create": true, "delete": true, "drain": true, "edit": true,
+	"expose": true, "label": true, "patch": true, "replace": true,
+	"rollout": true, "run": true, "scale": true, "set": true,
+	"taint": true, "uncordon": true,
+}
+
+// relaySessionRecord holds the fields of relay api audits and kubectl
+// commands used by sessions
+type relaySessionRecord struct {
+	// kubectl commands
+	Project string `json:"project"`
+	Actor   *struct {
+		Account struct {
+			Username string `json:"username"`
+		} `json:"account"`
+	} `json:"actor"`
+	Client *struct {
+		IP string `json:"ip"`
+	} `json:"client"`
+	Detail *struct {
+		Message string            `json:"message"`
+		Meta    map[string]string `json:"meta"`
+	} `json:"detail"`
+
+	// relay api audits
+	Un         string `json:"un"`
+	Cn         string `json:"cn"`
+	Pr         string `json:"pr"`
+	Ra         string `json:"ra"`
+	St         string `json:"st"`
+	Method     string `json:"m"`
+	Kind       string `json:"k"`
+	Name       string `json:"n"`
+	Ns         string `json:"ns"`
+	URL        string `json:"url"`
+	StatusCode int32  `json:"sc"`
+}
+
+// relaySession is a session being built
+type relaySession struct {
+	session    *v1.RelaySession
+	last       time.Time
+	projects   map[string]bool
+	clientIPs  map[string]bool
+	namespaces map[string]bool
+	resources  map[string]bool
+}
+
+func (ra *relayAuditDatabaseService) GetRelaySessions(ctx context.Context, req *v1.RelaySessionRequest) (*v1.RelaySessionResponse, error) {
+	filter := req.GetFilter()
+	if filter == nil {
+		filter = &v1.RelayAuditQueryFilter{}
+	}
+	if scope := req.GetMetadata().GetUrlScope(); scope != "" {
+		project, err := getProjectFromUrlScope(scope)
+		if err != nil {
+			return nil, err
+		}
+		filter.Projects = []string{project}
+	}
+	gap, err := relaySessionGap(req.GetIdleGap())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := query.ValidateAuditTimeRange(filter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// sessions hold both streams, the query applies to those knowing its
+	// fields
+	if _, err := compileAuditQuery(filter.GetQueryString(), auditql.RelayAPI); err != nil {
+		if _, cmdErr := compileAuditQuery(filter.GetQueryString(), auditql.Events); cmdErr != nil {
+			return nil, err
+		}
+	}
+	if filter.GetTimefrom() == "" && filter.GetFrom() == nil {
+		filter.Timefrom = defaultRelaySessionRange
+	}
+
+	//validate user authz with incoming request
+	if len(filter.GetProjects()) > 0 {
+		if err := ValidateUserAuditReadRequest(ctx, filter.GetProjects(), ra.db, true); err != nil {
+			return nil, err
+		}
+	}
+
+	logs, err := dao.GetRelaySessionLogs(ctx, ra.db, filter, maxRelaySessionEvents+1)
+	if err != nil {
+		return nil, err
+	}
+	truncated := len(logs) > maxRelaySessionEvents
+	if truncated {
+		logs = logs[:maxRelaySessionEvents]
+	}
+	return &v1.RelaySessionResponse{
+		Sessions:  buildRelaySessions(logs, gap),
+		Truncated: truncated,
+	}, nil
+}
+
+func relaySessionGap(gap string) (time.Duration, error) {
+	if gap == "" {
+		return defaultRelaySessionGap, nil
+	}
+	d, err := time.ParseDuration(gap)
+	if err != nil {
+		return 0, fmt.Errorf("invalid idle gap %q", gap)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("idle gap has to be positive")
+	}
+	return d, nil
+}
+
+// buildRelaySessions groups logs, newest first, into sessions. Relay api
+// calls belong to the session of their user, cluster and session type,
+// which is split after gap without events. The session type is the only
+// attribute of the kubeconfig certificate in relay audits, calls made
+// with different kubeconfigs of the same type are grouped together.
+// Kubectl commands carry no session type and join the latest session of
+// their user and cluster.
+func buildRelaySessions(logs []models.AuditLog, gap time.Duration) []*v1.RelaySession {
+	var sessions []*relaySession
+	open := make(map[string]*relaySession)
+	latest := make(map[string]*relaySession)
+
+	for i := len(logs) - 1; i >= 0; i-- {
+		l := logs[i]
+		var r relaySessionRecord
+		if err := json.Unmarshal(l.Data, &r); err != nil {
+			_log.Infow("skipping invalid relay audit", "id", l.ID, "error", err)
+			continue
+		}
+
+		var (
+			user, cluster, sessionType, project, clientIP string
+			ev                                            *v1.RelaySessionEvent
+		)
+		switch l.Tag {
+		case audit.KUBECTL_API:
+			user, cluster, sessionType, project, clientIP = r.Un, r.Cn, r.St, r.Pr, r.Ra
+			ev = relayAPIEvent(l.Time, &r)
+		case audit.KUBECTL_CMD:
+			if r.Actor != nil {
+				user = r.Actor.Account.Username
+			}
+			if r.Client != nil {
+				clientIP = r.Client.IP
+			}
+			var command string
+			if r.Detail != nil {
+				cluster = r.Detail.Meta["cluster_name"]
+				command = r.Detail.Message
+			}
+			project = r.Project
+			ev = relayCommandEvent(l.Time, command)
+		default:
+			continue
+		}
+
+		userKey := user + "\x00" + cluster
+		key := userKey + "\x00" + sessionType
+		s := open[key]
+		if l.Tag == audit.KUBECTL_CMD {
+			s = latest[userKey]
+		}
+		if s == nil || l.Time.Sub(s.last) > gap {
+			s = newRelaySession(user, cluster, sessionType, l.Time)
+			sessions = append(sessions, s)
+			if l.Tag == audit.KUBECTL_CMD {
+				key = userKey + "\x00"
+			}
+			open[key] = s
+		}
+		latest[userKey] = s
+		s.add(ev, project, clientIP)
+	}
+
+	res := make([]*v1.RelaySession, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, s.build())
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Start.AsTime().After(res[j].Start.AsTime())
+	})
+	return res
+}
+
+func newRelaySession(user, cluster, sessionType string, start time.Time) *relaySession {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%d", user, cluster, sessionType, start.UnixNano())))
+	return &relaySession{
+		session: &v1.RelaySession{
+			Id:          hex.EncodeToString(h[:8]),
+			User:        user,
+			Cluster:     cluster,
+			SessionType: sessionType,
+			Start:       timestamppb.New(start),
+			Summary:     &v1.RelaySessionSummary{},
+		},
+		last:       start,
+		projects:   make(map[string]bool),
+		clientIPs:  make(map[string]bool),
+		namespaces: make(map[string]bool),
+		resources:  make(map[string]bool),
+	}
+}
+
+func (s *relaySession) add(ev *v1.RelaySessionEvent, project, clientIP string) {
+	s.last = ev.Time.AsTime()
+	s.session.Events = append(s.session.Events, ev)
+	if project != "" {
+		s.projects[project] = true
+	}
+	if clientIP != "" {
+		s.clientIPs[clientIP] = true
+	}
+
+	sum := s.session.Summary
+	if ev.Source == relaySessionSourceCommand {
+		sum.Commands++
+	} else {
+		sum.ApiCalls++
+		if ev.StatusCode >= 400 {
+			sum.Failures++
+		}
+		if ev.Namespace != "" {
+			s.namespaces[ev.Namespace] = true
+		}
+		if ev.Kind != "" {
+			s.resources[relaySessionResource(ev)] = true
+		}
+	}
+	if ev.Write {
+		sum.Writes++
+	}
+	if ev.Exec {
+		sum.Execs++
+	}
+	if ev.SecretRead {
+		sum.SecretReads++
+	}
+}
+
+func (s *relaySession) build() *v1.RelaySession {
+	s.session.End = timestamppb.New(s.last)
+	s.session.Projects = sortedKeys(s.projects)
+	s.session.ClientIPs = sortedKeys(s.clientIPs)
+	s.session.Summary.Namespaces = sortedKeys(s.namespaces)
+	s.session.Summary.Resources = sortedKeys(s.resources)
+	return s.session
+}
+
+// relaySessionResource returns the resource of ev as kind/namespace/name
+func relaySessionResource(ev *v1.RelaySessionEvent) string {
+	parts := []string{strings.ToLower(ev.Kind)}
+	if ev.Namespace != "" {
+		parts = append(parts, ev.Namespace)
+	}
+	if ev.Name != "" {
+		parts = append(parts, ev.Name)
+	}
+	return strings.Join(parts, "/")
+}
+
+func relayAPIEvent(t time.Time, r *relaySessionRecord) *v1.RelaySessionEvent {
+	method := strings.ToUpper(r.Method)
+	path := strings.ToLower(r.URL)
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	kind := strings.ToLower(r.Kind)
+
+	ev := &v1.RelaySessionEvent{
+		Time:       timestamppb.New(t),
+		Source:     relaySessionSourceAPI,
+		Method:     method,
+		Kind:       r.Kind,
+		Namespace:  r.Ns,
+		Name:       r.Name,
+		Url:        r.URL,
+		StatusCode: r.StatusCode,
+	}
+	ev.Exec = strings.HasSuffix(path, "/exec") || strings.HasSuffix(path, "/attach")
+	switch method {
+	case "POST", "PUT", "PATCH", "DELETE":
+		// exec sessions are opened with a POST
+		ev.Write = !ev.Exec
+	case "GET":
+		ev.SecretRead = !ev.Exec && (kind == "secret" || kind == "secrets" || strings.Contains(path, "/secrets"))
+	}
+	return ev
+}
+
+func relayCommandEvent(t time.Time, command string) *v1.RelaySessionEvent {
+	ev := &v1.RelaySessionEvent{
+		Time:    timestamppb.New(t),
+		Source:  relaySessionSourceCommand,
+		Command: command,
+	}
+	verb, args := kubectlVerb(command)
+	switch {
+	case verb == "exec" || verb == "attach":
+		ev.Exec = true
+	case kubectlWriteVerbs[verb]:
+		ev.Write = true
+	case verb == "get" || verb == "describe":
+		ev.SecretRead = len(args) > 0 && strings.HasPrefix(strings.ToLower(args[0]), "secret")
+	}
+	return ev
+}
+
+// kubectlVerb returns the verb of a kubectl command line and the
+// arguments following it, the namespace flag is skipped
+func kubectlVerb(command string) (string, []string) {
+	fields := strings.Fields(command)
+	if len(fields) > 0 && strings.HasSuffix(fields[0], "kubectl") {
+		fields = fields[1:]
+	}
+	var args []string
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		switch {
+		case f == "-n" || f == "--namespace":
+			i++
+		case strings.HasPrefix(f, "-"):
+		default:
+			args = append(args, f)
+		}
+	}
+	if len(args) == 0 {
+		return "", nil
+	}
+	return strings.ToLower(args[0]), args[1:]
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
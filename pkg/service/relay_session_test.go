@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/audit"
+	eventv1 "github.com/paralus/paralus/proto/rpc/audit"
+)
+
+func relayAPIRecord(method, kind, ns, name, url string, sc int, st string) string {
+	return fmt.Sprintf(`{"un":"alice@paralus.local","cn":"prod","pr":"default","ra":"10.0.0.1","st":%q,"m":%q,"k":%q,"ns":%q,"n":%q,"url":%q,"sc":%d}`,
+		st, method, kind, ns, name, url, sc)
+}
+
+func relayCommandRecord(command string) string {
+	return fmt.Sprintf(`{"type":"kubectl.command.detail","project":"default","actor":{"account":{"username":"alice@paralus.local"}},"client":{"ip":"10.0.0.2"},"detail":{"message":%q,"meta":{"cluster_name":"prod"}}}`, command)
+}
+
+func TestGetRelaySessions(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ras, err := NewRelayAuditDatabaseService(db, audit.KUBECTL_API)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, 11, 22, 22, 0, 0, 0, time.UTC)
+	at := func(min int) time.Time { return start.Add(time.Duration(min) * time.Minute) }
+	rows := sqlmock.NewRows([]string{"tag", "time", "data", "id"}).
+		// second session on the next morning
+		AddRow(audit.KUBECTL_API, at(600), relayAPIRecord("GET", "pods", "web", "", "/api/v1/namespaces/web/pods", 200, "browser shell"), 9).
+		// a kubectl command of the first session
+		AddRow(audit.KUBECTL_CMD, at(21), relayCommandRecord("kubectl get secrets -n web"), 8).
+		AddRow(audit.KUBECTL_API, at(20), relayAPIRecord("GET", "secrets", "web", "", "/api/v1/namespaces/web/secrets", 200, "browser shell"), 7).
+		AddRow(audit.KUBECTL_API, at(12), relayAPIRecord("DELETE", "pods", "web", "web-1", "/api/v1/namespaces/web/pods/web-1", 403, "browser shell"), 6).
+		AddRow(audit.KUBECTL_API, at(5), relayAPIRecord("POST", "pods", "web", "web-0", "/api/v1/namespaces/web/pods/web-0/exec?command=sh", 101, "browser shell"), 5).
+		AddRow(audit.KUBECTL_CMD, at(4), relayCommandRecord("kubectl exec -it web-0 -- sh"), 4).
+		AddRow(audit.KUBECTL_API, at(0), relayAPIRecord("PATCH", "deployments", "web", "web", "/apis/apps/v1/namespaces/web/deployments/web", 200, "browser shell"), 3)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "auditlog"."tag", "auditlog"."time", "auditlog"."data", "auditlog"."id", "auditlog"."organization", "auditlog"."seq", "auditlog"."prev_hash", "auditlog"."hash" FROM "audit_logs" AS "auditlog" WHERE (((tag = 'kubectl_api') AND (data->>'un' = 'alice@paralus.local') AND (data->>'cn' IN ('prod')) AND (time between now() - interval '24h' and now())) OR ((tag = 'kubectl_cmd') AND (data->'actor'->'account'->>'username' = 'alice@paralus.local') AND (data->'detail'->'meta'->>'cluster_name' IN ('prod')) AND (time between now() - interval '24h' and now()))) ORDER BY "time" DESC, "id" DESC LIMIT 10001`)).
+		WillReturnRows(rows)
+
+	res, err := ras.GetRelaySessions(context.Background(), &eventv1.RelaySessionRequest{
+		Filter:  &eventv1.RelayAuditQueryFilter{User: "alice@paralus.local", Cluster: "prod"},
+		IdleGap: "30m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	if len(res.Sessions) != 2 || res.Truncated {
+		t.Fatalf("expected 2 sessions, got %d", len(res.Sessions))
+	}
+	if s := res.Sessions[0]; !s.Start.AsTime().Equal(at(600)) || len(s.Events) != 1 {
+		t.Errorf("expected the latest session first, got %v", s)
+	}
+
+	s := res.Sessions[1]
+	if s.User != "alice@paralus.local" || s.Cluster != "prod" || s.SessionType != "browser shell" {
+		t.Errorf("unexpected session %s/%s/%s", s.User, s.Cluster, s.SessionType)
+	}
+	if !s.Start.AsTime().Equal(at(0)) || !s.End.AsTime().Equal(at(21)) || len(s.Events) != 6 {
+		t.Errorf("unexpected session timeline %v - %v with %d events", s.Start.AsTime(), s.End.AsTime(), len(s.Events))
+	}
+	for i := 1; i < len(s.Events); i++ {
+		if s.Events[i].Time.AsTime().Before(s.Events[i-1].Time.AsTime()) {
+			t.Errorf("events out of order at %d", i)
+		}
+	}
+
+	sum := s.Summary
+	if sum.ApiCalls != 4 || sum.Commands != 2 || sum.Writes != 2 || sum.Execs != 2 || sum.SecretReads != 2 || sum.Failures != 1 {
+		t.Errorf("unexpected summary %v", sum)
+	}
+	if want := []string{"deployments/web/web", "pods/web/web-0", "pods/web/web-1", "secrets/web"}; !reflect.DeepEqual(sum.Resources, want) {
+		t.Errorf("expected resources %v, got %v", want, sum.Resources)
+	}
+	if want := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(s.ClientIPs, want) {
+		t.Errorf("expected client ips %v, got %v", want, s.ClientIPs)
+	}
+}
+
+func TestBuildRelaySessionsSessionType(t *testing.T) {
+	start := time.Date(2022, 11, 22, 22, 0, 0, 0, time.UTC)
+	logs := []models.AuditLog{
+		{Tag: audit.KUBECTL_API, Time: start.Add(2 * time.Minute), Data: []byte(relayAPIRecord("GET", "pods", "web", "", "/api/v1/namespaces/web/pods", 200, "ts"))},
+		{Tag: audit.KUBECTL_API, Time: start.Add(time.Minute), Data: []byte(relayAPIRecord("GET", "pods", "web", "", "/api/v1/namespaces/web/pods", 200, "ws"))},
+		{Tag: audit.KUBECTL_API, Time: start, Data: []byte(relayAPIRecord("GET", "pods", "web", "", "/api/v1/namespaces/web/pods", 200, "ts"))},
+	}
+	// relay audits do not tell kubeconfigs of a type apart, the terminal
+	// calls are one session
+	sessions := buildRelaySessions(logs, 30*time.Minute)
+	if len(sessions) != 2 {
+		t.Fatalf("expected a session per session type, got %d", len(sessions))
+	}
+	for _, s := range sessions {
+		if s.SessionType == "ts" && len(s.Events) != 2 {
+			t.Errorf("expected 2 terminal events, got %d", len(s.Events))
+		}
+	}
+}
+
+func TestGetRelaySessionsInvalidGap(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	ras, err := NewRelayAuditDatabaseService(db, audit.KUBECTL_API)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, gap := range []string{"15", "-1m"} {
+		if _, err := ras.GetRelaySessions(context.Background(), &eventv1.RelaySessionRequest{IdleGap: gap}); err == nil {
+			t.Errorf("expected idle gap %q to be rejected", gap)
+		}
+	}
+}
@@ -0,0 +1,589 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// RelayNetworkLabel is set on the bootstrap templates of a relay
+	// network to the id of the network
+	RelayNetworkLabel = "paralus.dev/relayNetwork"
+	// ConnectorAgentTemplateLabel is set on user templates to the name of
+	// the template of the cluster agents the relay connects users to
+	ConnectorAgentTemplateLabel = "paralus.dev/connectorAgentTemplate"
+
+	relayNetworkKind     = "RelayNetwork"
+	relayNetworkListKind = "RelayNetworkList"
+
+	// infra of the core relay, user certificates of relay networks are
+	// issued by the same ca as the core relay
+	coreRelayInfra     = "paralus-core-relay"
+	coreRelayUserInfra = "paralus-core-relay-user"
+	// infra of sentry peering, relays of relay networks register as
+	// peering clients with a token of their own network
+	sentryPeeringInfra = "paralus-sentry-peering"
+)
+
+// RelayNetworkService is the interface for relay network operations
+type RelayNetworkService interface {
+	// create relay network
+	Create(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	// get relay network
+	Get(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	// update relay network
+	Update(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	// delete relay network
+	Delete(ctx context.Context, network *sentry.RelayNetwork) error
+	// list relay networks of organization
+	List(ctx context.Context, opts ...query.Option) (*sentry.RelayNetworkList, error)
+}
+
+// relayNetworkService implements RelayNetworkService
+type relayNetworkService struct {
+	db *bun.DB
+	bs BootstrapService
+	al *zap.Logger
+}
+
+// NewRelayNetworkService return new relay network service
+func NewRelayNetworkService(db *bun.DB, bs BootstrapService, al *zap.Logger) RelayNetworkService {
+	return &relayNetworkService{db: db, bs: bs, al: al}
+}
+
+// RelayNetworkTemplateNames returns the names of the user, connector and
+// agent bootstrap templates of the relay network
+func RelayNetworkTemplateNames(id string) (user, connector, agent string) {
+	return fmt.Sprintf("rn-%s-user", id), fmt.Sprintf("rn-%s-server", id), fmt.Sprintf("rn-%s-agent", id)
+}
+
+// RelayNetworkPeerTemplateName returns the name of the bootstrap template
+// the relay of the relay network registers as sentry peering client with
+func RelayNetworkPeerTemplateName(id string) string {
+	return fmt.Sprintf("rn-%s-peer", id)
+}
+
+// validateRelayNetworkSpec checks the hosts of the relay network, the
+// user host has to address the clusters by their id
+func validateRelayNetworkSpec(spec *sentry.RelayNetworkSpec) error {
+	if spec.GetUserHost() == "" {
+		return fmt.Errorf("invalid relay network, user host is missing")
+	}
+	if !strings.HasPrefix(spec.GetUserHost(), "*.") {
+		return fmt.Errorf("invalid relay network, user host %s should be a wildcard host", spec.GetUserHost())
+	}
+	if spec.GetConnectorHost() == "" {
+		return fmt.Errorf("invalid relay network, connector host is missing")
+	}
+	return nil
+}
+
+// relayNetworkHasCluster checks if the cluster is routed through the
+// relay network, either directly or through one of its projects
+func relayNetworkHasCluster(rn *models.RelayNetwork, clusterID string, projectIDs []string) bool {
+	var clusters, projects []string
+	if rn.Clusters != nil {
+		json.Unmarshal(rn.Clusters, &clusters)
+	}
+	if rn.Projects != nil {
+		json.Unmarshal(rn.Projects, &projects)
+	}
+	for _, c := range clusters {
+		if c == clusterID {
+			return true
+		}
+	}
+	for _, p := range projects {
+		for _, pid := range projectIDs {
+			if p == pid {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *relayNetworkService) Create(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	if network.GetMetadata().GetName() == "" {
+		return nil, fmt.Errorf("invalid relay network, name is missing")
+	}
+	if err := validateRelayNetworkSpec(network.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	partnerID, orgID, err := s.getPartnerOrganization(ctx, network.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":            network.Metadata.Name,
+		"organization_id": orgID,
+		"trash":           false,
+	}, &models.RelayNetwork{})
+	if err == nil {
+		return nil, fmt.Errorf("relay network %s already exists", network.Metadata.Name)
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	rn := &models.RelayNetwork{
+		ID:             uuid.New(),
+		Name:           network.Metadata.Name,
+		Description:    network.Metadata.Description,
+		OrganizationId: orgID,
+		PartnerId:      partnerID,
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+	}
+	if err := s.setSpec(ctx, rn, network.Spec); err != nil {
+		return nil, err
+	}
+
+	// the relay network is stored along with its templates and agents
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		ctx = dao.WithTx(ctx, tx)
+		if _, err := dao.Create(ctx, tx, rn); err != nil {
+			return err
+		}
+		if err := s.patchTemplates(ctx, rn); err != nil {
+			return err
+		}
+		return s.syncAgents(ctx, rn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	CreateRelayNetworkAuditEvent(ctx, s.al, AuditActionCreate, rn.Name, rn.ID)
+
+	return s.prepareRelayNetworkResponse(ctx, rn, network.Metadata)
+}
+
+func (s *relayNetworkService) Get(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	rn, err := s.getRelayNetwork(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+	return s.prepareRelayNetworkResponse(ctx, rn, network.Metadata)
+}
+
+func (s *relayNetworkService) Update(ctx context.Context, network *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	if err := validateRelayNetworkSpec(network.GetSpec()); err != nil {
+		return nil, err
+	}
+	rn, err := s.getRelayNetwork(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	rn.Description = network.Metadata.Description
+	rn.ModifiedAt = time.Now()
+	if err := s.setSpec(ctx, rn, network.Spec); err != nil {
+		return nil, err
+	}
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		ctx = dao.WithTx(ctx, tx)
+		if _, err := dao.Update(ctx, tx, rn.ID, rn); err != nil {
+			return err
+		}
+		if err := s.patchTemplates(ctx, rn); err != nil {
+			return err
+		}
+		return s.syncAgents(ctx, rn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	CreateRelayNetworkAuditEvent(ctx, s.al, AuditActionUpdate, rn.Name, rn.ID)
+
+	return s.prepareRelayNetworkResponse(ctx, rn, network.Metadata)
+}
+
+func (s *relayNetworkService) Delete(ctx context.Context, network *sentry.RelayNetwork) error {
+	rn, err := s.getRelayNetwork(ctx, network)
+	if err != nil {
+		return err
+	}
+
+	// the agents of the clusters go along with the templates, the clusters
+	// stay reachable through the core relay
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		ctx = dao.WithTx(ctx, tx)
+		if err := dao.Delete(ctx, tx, rn.ID, rn); err != nil {
+			return err
+		}
+		user, connector, agent := RelayNetworkTemplateNames(rn.ID.String())
+		for _, name := range []string{agent, connector, user, RelayNetworkPeerTemplateName(rn.ID.String())} {
+			if err := s.bs.DeleteBootstrapAgentTemplate(ctx, name); err != nil {
+				return errors.Wrapf(err, "unable to delete bootstrap template %s", name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	CreateRelayNetworkAuditEvent(ctx, s.al, AuditActionDelete, rn.Name, rn.ID)
+	return nil
+}
+
+func (s *relayNetworkService) List(ctx context.Context, opts ...query.Option) (*sentry.RelayNetworkList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	meta := &commonv3.Metadata{
+		Partner:      queryOptions.Partner,
+		Organization: queryOptions.Organization,
+	}
+	_, orgID, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	rns, err := dao.ListRelayNetworks(ctx, s.db, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &sentry.RelayNetworkList{
+		ApiVersion: constants.ApiVersion,
+		Kind:       relayNetworkListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: int64(len(rns)),
+		},
+	}
+	for i := range rns {
+		network, err := s.prepareRelayNetworkResponse(ctx, &rns[i], meta)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, network)
+	}
+	return list, nil
+}
+
+func (s *relayNetworkService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerID, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner %s", meta.GetPartner())
+	}
+	orgID, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization %s", meta.GetOrganization())
+	}
+	return partnerID, orgID, nil
+}
+
+func (s *relayNetworkService) getRelayNetwork(ctx context.Context, network *sentry.RelayNetwork) (*models.RelayNetwork, error) {
+	_, orgID, err := s.getPartnerOrganization(ctx, network.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	var rn models.RelayNetwork
+	_, err = dao.GetM(ctx, s.db, map[string]interface{}{
+		"name":            network.Metadata.Name,
+		"organization_id": orgID,
+		"trash":           false,
+	}, &rn)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "relay network %s not found", network.Metadata.Name)
+		}
+		return nil, err
+	}
+	return &rn, nil
+}
+
+// setSpec sets the editable fields of the relay network from spec, the
+// assigned projects and clusters have to be in the same organization
+func (s *relayNetworkService) setSpec(ctx context.Context, rn *models.RelayNetwork, spec *sentry.RelayNetworkSpec) error {
+	rn.UserHost = spec.GetUserHost()
+	rn.ConnectorHost = spec.GetConnectorHost()
+
+	projects := make([]string, 0, len(spec.GetProjects()))
+	for _, name := range spec.GetProjects() {
+		po, err := dao.GetProjectOrganization(ctx, s.db, name)
+		if err != nil {
+			return fmt.Errorf("invalid relay network, unable to find project %s", name)
+		}
+		if po.OrganizationId != rn.OrganizationId.String() {
+			return fmt.Errorf("invalid relay network, project %s is not in the organization", name)
+		}
+		projects = append(projects, po.ProjectId)
+	}
+	pb, _ := json.Marshal(projects)
+	rn.Projects = json.RawMessage(pb)
+
+	clusters := make([]string, 0, len(spec.GetClusters()))
+	for _, name := range spec.GetClusters() {
+		var c models.Cluster
+		_, err := dao.GetM(ctx, s.db, map[string]interface{}{
+			"name":            name,
+			"organization_id": rn.OrganizationId,
+			"trash":           false,
+		}, &c)
+		if err != nil {
+			return fmt.Errorf("invalid relay network, unable to find cluster %s", name)
+		}
+		clusters = append(clusters, c.ID.String())
+	}
+	cb, _ := json.Marshal(clusters)
+	rn.Clusters = json.RawMessage(cb)
+	return nil
+}
+
+// patchTemplates creates or updates the bootstrap templates of the relay
+// network, tokens of existing templates are retained
+func (s *relayNetworkService) patchTemplates(ctx context.Context, rn *models.RelayNetwork) error {
+	user, connector, agent := RelayNetworkTemplateNames(rn.ID.String())
+	templates := []*sentry.BootstrapAgentTemplate{
+		{
+			Metadata: &commonv3.Metadata{
+				Name:        user,
+				DisplayName: fmt.Sprintf("%s Relay User", rn.Name),
+				Labels: map[string]string{
+					RelayNetworkLabel:           rn.ID.String(),
+					"paralus.dev/userTemplate":  "true",
+					ConnectorAgentTemplateLabel: agent,
+				},
+			},
+			Spec: &sentry.BootstrapAgentTemplateSpec{
+				InfraRef:               coreRelayUserInfra,
+				AutoRegister:           true,
+				AutoApprove:            true,
+				IgnoreMultipleRegister: true,
+				TemplateType:           sentry.BootstrapAgentTemplateType_Mixed,
+				Hosts: []*sentry.BootstrapTemplateHost{
+					{Host: rn.UserHost, Type: sentry.BootstrapTemplateHostType_HostTypeExternal},
+				},
+			},
+		},
+		{
+			Metadata: &commonv3.Metadata{
+				Name:        connector,
+				DisplayName: fmt.Sprintf("%s Relay Server", rn.Name),
+				Labels: map[string]string{
+					RelayNetworkLabel:               rn.ID.String(),
+					"paralus.dev/connectorTemplate": "true",
+				},
+			},
+			Spec: &sentry.BootstrapAgentTemplateSpec{
+				InfraRef:               coreRelayInfra,
+				AutoRegister:           true,
+				AutoApprove:            true,
+				IgnoreMultipleRegister: true,
+				TemplateType:           sentry.BootstrapAgentTemplateType_Server,
+				Hosts: []*sentry.BootstrapTemplateHost{
+					{Host: rn.ConnectorHost, Type: sentry.BootstrapTemplateHostType_HostTypeExternal},
+				},
+			},
+		},
+		{
+			Metadata: &commonv3.Metadata{
+				Name:        agent,
+				DisplayName: fmt.Sprintf("%s Relay Agent", rn.Name),
+				Labels: map[string]string{
+					RelayNetworkLabel: rn.ID.String(),
+				},
+			},
+			Spec: &sentry.BootstrapAgentTemplateSpec{
+				InfraRef:               coreRelayInfra,
+				AutoRegister:           false,
+				AutoApprove:            true,
+				IgnoreMultipleRegister: true,
+				TemplateType:           sentry.BootstrapAgentTemplateType_Client,
+				Hosts: []*sentry.BootstrapTemplateHost{
+					{Host: rn.ConnectorHost, Type: sentry.BootstrapTemplateHostType_HostTypeExternal},
+				},
+			},
+		},
+		{
+			Metadata: &commonv3.Metadata{
+				Name:        RelayNetworkPeerTemplateName(rn.ID.String()),
+				DisplayName: fmt.Sprintf("%s Relay Peering Client", rn.Name),
+				Labels: map[string]string{
+					RelayNetworkLabel: rn.ID.String(),
+				},
+			},
+			Spec: &sentry.BootstrapAgentTemplateSpec{
+				InfraRef:               sentryPeeringInfra,
+				AutoRegister:           true,
+				AutoApprove:            true,
+				IgnoreMultipleRegister: true,
+				TemplateType:           sentry.BootstrapAgentTemplateType_Client,
+			},
+		},
+	}
+
+	for _, t := range templates {
+		t.Spec.Token = xid.New().String()
+		if err := s.bs.PatchBootstrapAgentTemplate(ctx, t); err != nil {
+			return errors.Wrapf(err, "unable to patch bootstrap template %s", t.Metadata.Name)
+		}
+	}
+	return nil
+}
+
+// syncAgents creates bootstrap agents for the clusters routed through the
+// relay network and removes the agents of clusters no longer assigned
+func (s *relayNetworkService) syncAgents(ctx context.Context, rn *models.RelayNetwork) error {
+	_, _, agentTemplate := RelayNetworkTemplateNames(rn.ID.String())
+
+	var projects, clusters []string
+	json.Unmarshal(rn.Projects, &projects)
+	json.Unmarshal(rn.Clusters, &clusters)
+	members, err := dao.ListRelayNetworkClusters(ctx, dao.GetDB(ctx, s.db), rn.OrganizationId, toUUIDs(projects), toUUIDs(clusters))
+	if err != nil {
+		return err
+	}
+
+	bal, err := s.bs.SelectBootstrapAgents(ctx, agentTemplate)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for _, ba := range bal.Items {
+		existing[ba.Metadata.Name] = true
+	}
+
+	for _, c := range members {
+		id := c.ID.String()
+		if existing[id] {
+			delete(existing, id)
+			continue
+		}
+		agent := &sentry.BootstrapAgent{
+			Metadata: &commonv3.Metadata{
+				Name:        id,
+				DisplayName: c.Name,
+				Labels: map[string]string{
+					"paralus.dev/clusterName": c.Name,
+				},
+				Partner:      c.PartnerId.String(),
+				Organization: c.OrganizationId.String(),
+				Project:      c.ProjectId.String(),
+			},
+			Spec: &sentry.BootstrapAgentSpec{
+				TemplateRef: agentTemplate,
+				Token:       xid.New().String(),
+			},
+		}
+		pcs, err := cdao.GetProjectsForCluster(ctx, dao.GetDB(ctx, s.db), c.ID)
+		if err != nil {
+			return err
+		}
+		for _, pc := range pcs {
+			agent.Metadata.Labels[fmt.Sprintf("project/%s", pc.ProjectID)] = ""
+		}
+		if err := s.bs.CreateBootstrapAgent(ctx, agent); err != nil {
+			return errors.Wrapf(err, "unable to create bootstrap agent for cluster %s", c.Name)
+		}
+	}
+
+	for id := range existing {
+		err := s.bs.DeleteBootstrapAgent(ctx, agentTemplate, query.WithMeta(&commonv3.Metadata{Id: id}))
+		if err != nil {
+			return errors.Wrapf(err, "unable to delete bootstrap agent %s", id)
+		}
+	}
+	return nil
+}
+
+func toUUIDs(ids []string) []uuid.UUID {
+	ret := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if u, err := uuid.Parse(id); err == nil {
+			ret = append(ret, u)
+		}
+	}
+	return ret
+}
+
+func (s *relayNetworkService) prepareRelayNetworkResponse(ctx context.Context, rn *models.RelayNetwork, meta *commonv3.Metadata) (*sentry.RelayNetwork, error) {
+	user, connector, agent := RelayNetworkTemplateNames(rn.ID.String())
+	network := &sentry.RelayNetwork{
+		ApiVersion: constants.ApiVersion,
+		Kind:       relayNetworkKind,
+		Metadata: &commonv3.Metadata{
+			Id:           rn.ID.String(),
+			Name:         rn.Name,
+			Description:  rn.Description,
+			Partner:      meta.GetPartner(),
+			Organization: meta.GetOrganization(),
+			CreatedAt:    timestamppb.New(rn.CreatedAt),
+			ModifiedAt:   timestamppb.New(rn.ModifiedAt),
+		},
+		Spec: &sentry.RelayNetworkSpec{
+			UserHost:      rn.UserHost,
+			ConnectorHost: rn.ConnectorHost,
+		},
+		Status: &sentry.RelayNetworkStatus{
+			UserTemplate:      user,
+			ConnectorTemplate: connector,
+			AgentTemplate:     agent,
+		},
+	}
+
+	var projects []string
+	if rn.Projects != nil {
+		json.Unmarshal(rn.Projects, &projects)
+	}
+	for _, p := range projects {
+		id, err := uuid.Parse(p)
+		if err != nil {
+			continue
+		}
+		name, err := dao.GetProjectName(ctx, s.db, id)
+		if err != nil {
+			// project might have been deleted since
+			_log.WithContext(ctx).Infow("unable to get project of relay network", "network", rn.Name, "project", p, "error", err)
+			continue
+		}
+		network.Spec.Projects = append(network.Spec.Projects, name)
+	}
+
+	var clusters []string
+	if rn.Clusters != nil {
+		json.Unmarshal(rn.Clusters, &clusters)
+	}
+	for _, id := range clusters {
+		cid, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		var c models.Cluster
+		_, err = dao.GetByID(ctx, s.db, cid, &c)
+		if err != nil || c.Trash {
+			_log.WithContext(ctx).Infow("unable to get cluster of relay network", "network", rn.Name, "cluster", id, "error", err)
+			continue
+		}
+		network.Spec.Clusters = append(network.Spec.Clusters, c.Name)
+	}
+	return network, nil
+}
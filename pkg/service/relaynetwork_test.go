@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+)
+
+func TestValidateRelayNetworkSpec(t *testing.T) {
+	tt := []struct {
+		user      string
+		connector string
+		valid     bool
+	}{
+		{"", "connector.relay.example.com:443", false},
+		{"user.relay.example.com", "connector.relay.example.com:443", false},
+		{"*.user.relay.example.com", "", false},
+		{"*.user.relay.example.com", "connector.relay.example.com:443", true},
+	}
+	for _, tc := range tt {
+		err := validateRelayNetworkSpec(&sentry.RelayNetworkSpec{UserHost: tc.user, ConnectorHost: tc.connector})
+		if (err == nil) != tc.valid {
+			t.Errorf("hosts %q %q: expected valid %v, got %v", tc.user, tc.connector, tc.valid, err)
+		}
+	}
+}
+
+func TestRelayNetworkHasCluster(t *testing.T) {
+	cluster := uuid.New().String()
+	project := uuid.New().String()
+	other := uuid.New().String()
+
+	clusters, _ := json.Marshal([]string{cluster})
+	projects, _ := json.Marshal([]string{project})
+	tt := []struct {
+		name       string
+		rn         *models.RelayNetwork
+		projectIDs []string
+		assigned   bool
+	}{
+		{"empty", &models.RelayNetwork{}, []string{project}, false},
+		{"cluster", &models.RelayNetwork{Clusters: clusters}, nil, true},
+		{"project", &models.RelayNetwork{Projects: projects}, []string{other, project}, true},
+		{"other project", &models.RelayNetwork{Projects: projects}, []string{other}, false},
+	}
+	for _, tc := range tt {
+		if got := relayNetworkHasCluster(tc.rn, cluster, tc.projectIDs); got != tc.assigned {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.assigned, got)
+		}
+	}
+}
+
+func TestListRelayNetworks(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	rnuuid := uuid.New().String()
+
+	mock.ExpectQuery(`SELECT "partner"."id" FROM "authsrv_partner"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(puuid))
+	mock.ExpectQuery(`SELECT "organization"."id" FROM "authsrv_organization"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ouuid))
+	mock.ExpectQuery(`SELECT "rn"."id", "rn"."name", .* FROM "sentry_relay_network" AS "rn" WHERE \(organization_id = '` + ouuid + `'\) AND \(trash = FALSE\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_host", "connector_host", "projects", "clusters"}).
+			AddRow(rnuuid, "edge", "*.user.edge.example.com", "connector.edge.example.com:443", []byte(`[]`), []byte(`[]`)))
+
+	rns := NewRelayNetworkService(db, nil, getLogger())
+	list, err := rns.List(context.Background(), query.WithPartnerID("partner"), query.WithOrganizationID("org"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("expected 1 relay network, got %d", len(list.Items))
+	}
+	rn := list.Items[0]
+	if rn.Metadata.Name != "edge" || rn.Spec.UserHost != "*.user.edge.example.com" {
+		t.Errorf("unexpected relay network %v", rn)
+	}
+	if rn.Status.AgentTemplate != "rn-"+rnuuid+"-agent" {
+		t.Errorf("unexpected agent template %s", rn.Status.AgentTemplate)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeleteRelayNetworkRollback(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	rnuuid := uuid.New().String()
+
+	addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "rn"."id", "rn"."name", .* FROM "sentry_relay_network" AS "rn"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(rnuuid, "edge"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "sentry_relay_network" AS "rn" SET trash = TRUE WHERE \(id  = '` + rnuuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM "sentry_bootstrap_agent" AS "ba" WHERE \(template_ref = 'rn-` + rnuuid + `-agent'\)`).
+		WillReturnError(fmt.Errorf("connection reset"))
+	mock.ExpectRollback()
+
+	rns := NewRelayNetworkService(db, NewBootstrapService(db), getLogger())
+	err := rns.Delete(context.Background(), &sentry.RelayNetwork{
+		Metadata: &commonv3.Metadata{Name: "edge", Partner: "partner", Organization: "org"},
+	})
+	if err == nil {
+		t.Fatal("expected failure when deleting the agent template")
+	}
+	// the relay network is not deleted without its templates
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
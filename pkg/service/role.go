@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -17,6 +16,7 @@ import (
 	bun "github.com/uptrace/bun"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -124,11 +124,11 @@ func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.ID
 }
 
 func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), role)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
-	r, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, role.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+	r, _ := dao.GetIdByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), role.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
 	if r != nil {
 		return nil, fmt.Errorf("role '%v' already exists", role.GetMetadata().GetName())
 	}
@@ -173,7 +173,7 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		Scope:          strings.ToLower(scope),
 	}
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := dao.BeginTx(ctx, s.db)
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
@@ -195,7 +195,7 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
 		CreateRoleAuditEvent(ctx, s.al, AuditActionCreate, role.GetMetadata().GetName(), createdRole.ID, role.GetSpec().GetRolepermissions())
@@ -214,13 +214,13 @@ func (s *roleService) GetByID(ctx context.Context, role *rolev3.Role) (*rolev3.R
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
-	entity, err := dao.GetByID(ctx, s.db, uid, &models.Role{})
+	entity, err := dao.GetByID(ctx, dao.GetDB(ctx, s.db), uid, &models.Role{})
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
 
 	if rle, ok := entity.(*models.Role); ok {
-		role, err = s.toV3Role(ctx, s.db, role, rle)
+		role, err = s.toV3Role(ctx, dao.GetDB(ctx, s.db), role, rle)
 		if err != nil {
 			return &rolev3.Role{}, err
 		}
@@ -232,17 +232,17 @@ func (s *roleService) GetByID(ctx context.Context, role *rolev3.Role) (*rolev3.R
 
 func (s *roleService) GetByName(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
 	name := role.GetMetadata().GetName()
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), role)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
 
 	if rle, ok := entity.(*models.Role); ok {
-		role, err = s.toV3Role(ctx, s.db, role, rle)
+		role, err = s.toV3Role(ctx, dao.GetDB(ctx, s.db), role, rle)
 		if err != nil {
 			return &rolev3.Role{}, err
 		}
@@ -254,13 +254,13 @@ func (s *roleService) GetByName(ctx context.Context, role *rolev3.Role) (*rolev3
 }
 
 func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), role)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
 
 	name := role.GetMetadata().GetName()
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
 	if err != nil {
 		return role, fmt.Errorf("unable to find role '%v'", name)
 	}
@@ -290,7 +290,7 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		rle.IsGlobal = role.Spec.IsGlobal
 		rle.ModifiedAt = time.Now()
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &rolev3.Role{}, err
 		}
@@ -322,7 +322,7 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
 		CreateRoleAuditEvent(ctx, s.al, AuditActionUpdate, role.GetMetadata().GetName(), rle.ID, role.GetSpec().GetRolepermissions())
@@ -333,12 +333,12 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 
 func (s *roleService) Delete(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
 	name := role.GetMetadata().GetName()
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), role)
 	if err != nil {
 		return &rolev3.Role{}, fmt.Errorf("unable to get partner and org id; %v", err)
 	}
 
-	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+	entity, err := dao.GetByNamePartnerOrg(ctx, dao.GetDB(ctx, s.db), name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
@@ -348,7 +348,7 @@ func (s *roleService) Delete(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			return role, fmt.Errorf("builtin role '%v' cannot be deleted", name)
 		}
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &rolev3.Role{}, err
 		}
@@ -368,7 +368,7 @@ func (s *roleService) Delete(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
 		CreateRoleAuditEvent(ctx, s.al, AuditActionDelete, role.GetMetadata().GetName(), rle.ID, []string{})
@@ -421,23 +421,23 @@ func (s *roleService) List(ctx context.Context, role *rolev3.Role) (*rolev3.Role
 		},
 	}
 	if len(role.Metadata.Organization) > 0 {
-		orgId, err := dao.GetOrganizationId(ctx, s.db, role.Metadata.Organization)
+		orgId, err := dao.GetOrganizationId(ctx, dao.GetDB(ctx, s.db), role.Metadata.Organization)
 		if err != nil {
 			return roleList, err
 		}
-		partId, err := dao.GetPartnerId(ctx, s.db, role.Metadata.Partner)
+		partId, err := dao.GetPartnerId(ctx, dao.GetDB(ctx, s.db), role.Metadata.Partner)
 		if err != nil {
 			return roleList, err
 		}
 		var rles []models.Role
-		entities, err := dao.List(ctx, s.db, uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true}, &rles)
+		entities, err := dao.List(ctx, dao.GetDB(ctx, s.db), uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true}, &rles)
 		if err != nil {
 			return roleList, err
 		}
 		if rles, ok := entities.(*[]models.Role); ok {
 			for _, rle := range *rles {
 				entry := &rolev3.Role{Metadata: role.GetMetadata()}
-				entry, err = s.toV3Role(ctx, s.db, entry, &rle)
+				entry, err = s.toV3Role(ctx, dao.GetDB(ctx, s.db), entry, &rle)
 				if err != nil {
 					return roleList, err
 				}
@@ -456,3 +456,20 @@ func (s *roleService) List(ctx context.Context, role *rolev3.Role) (*rolev3.Role
 	}
 	return roleList, nil
 }
+
+// validateRoleClusterSelector checks the optional cluster selector of a
+// role binding, only project and namespace roles can be limited to clusters
+func validateRoleClusterSelector(selector, scope string) error {
+	if selector == "" {
+		return nil
+	}
+	switch strings.ToLower(scope) {
+	case "project", "namespace":
+	default:
+		return fmt.Errorf("cluster selector is not supported for %s scoped roles", strings.ToLower(scope))
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return fmt.Errorf("invalid cluster selector %q: %s", selector, err)
+	}
+	return nil
+}
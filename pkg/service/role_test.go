@@ -448,3 +448,24 @@ func TestRoleList(t *testing.T) {
 		t.Errorf("incorrect role names returned when listing")
 	}
 }
+
+func TestValidateRoleClusterSelector(t *testing.T) {
+	tt := []struct {
+		selector string
+		scope    string
+		valid    bool
+	}{
+		{"", "organization", true},
+		{"", "project", true},
+		{"env=prod", "project", true},
+		{"env=prod", "namespace", true},
+		{"env=prod", "organization", false},
+		{"env in (prod", "project", false},
+	}
+	for _, tc := range tt {
+		err := validateRoleClusterSelector(tc.selector, tc.scope)
+		if (err == nil) != tc.valid {
+			t.Errorf("selector %q scope %q: expected valid %v, got %v", tc.selector, tc.scope, tc.valid, err)
+		}
+	}
+}
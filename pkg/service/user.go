@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -155,6 +154,10 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 
 		project := pnr.GetProject()
 		org := user.GetMetadata().GetOrganization()
+		selector := pnr.GetClusterSelector()
+		if err := validateRoleClusterSelector(selector, scope); err != nil {
+			return &userv3.User{}, nil, err
+		}
 
 		switch scope {
 		case "system":
@@ -216,16 +219,17 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 			}
 
 			par := models.ProjectAccountResourcerole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				Default:        true,
-				RoleId:         roleId,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				AccountId:      ids.Id,
-				ProjectId:      projectId,
-				Active:         true,
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				Default:         true,
+				RoleId:          roleId,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				AccountId:       ids.Id,
+				ProjectId:       projectId,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			pars = append(pars, par)
 
@@ -250,16 +254,17 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 
 			namespace := pnr.GetNamespace()
 			panrObj := models.ProjectAccountNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				AccountId:      ids.Id,
-				ProjectId:      projectId,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				RoleId:          roleId,
+				AccountId:       ids.Id,
+				ProjectId:       projectId,
+				Namespace:       namespace,
+				ClusterSelector: selector,
+				Active:          true,
 			}
 			panr = append(panr, panrObj)
 
@@ -314,7 +319,7 @@ func (s *userService) createGroupAccountRelations(ctx context.Context, db bun.ID
 	// Add managed groups
 	for _, group := range utils.Unique(usr.GetSpec().GetGroups()) {
 		// FIXME: do combined lookup
-		entity, err := dao.GetByName(ctx, s.db, group, &models.Group{})
+		entity, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), group, &models.Group{})
 		if err != nil {
 			return &userv3.User{}, nil, fmt.Errorf("unable to find group '%v'", group)
 		}
@@ -338,7 +343,7 @@ func (s *userService) createGroupAccountRelations(ctx context.Context, db bun.ID
 
 	// Add idp groups
 	for _, group := range utils.Unique(usr.GetSpec().GetIdpGroups()) {
-		entity, err := dao.GetByName(ctx, s.db, group, &models.Group{})
+		entity, err := dao.GetByName(ctx, dao.GetDB(ctx, s.db), group, &models.Group{})
 		if err != nil {
 			// It is possible that a group that has been mapped via
 			// Idp is not available in our system. As of now, we
@@ -416,7 +421,7 @@ func (s *userService) getPartnerOrganization(ctx context.Context, db bun.IDB, us
 }
 
 func (s *userService) Create(ctx context.Context, user *userv3.User) (*userv3.User, error) {
-	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, user)
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), user)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get partner and org id")
 	}
@@ -444,7 +449,7 @@ func (s *userService) Create(ctx context.Context, user *userv3.User) (*userv3.Us
 
 	uid, _ := uuid.Parse(id)
 
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	tx, err := dao.BeginTx(ctx, s.db)
 	if err != nil {
 		return &userv3.User{}, err
 	}
@@ -464,11 +469,12 @@ func (s *userService) Create(ctx context.Context, user *userv3.User) (*userv3.Us
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
-		_log.Warn("unable to commit changes", err)
+		_log.WithContext(ctx).Warn("unable to commit changes", err)
 		return &userv3.User{}, err
 	}
 
-	CreateUserAuditEvent(ctx, s.al, s.db, AuditActionCreate, user.GetMetadata().GetName(), uid, []uuid.UUID{}, rolesAfter, []uuid.UUID{}, groupsAfter)
+	CreateUserAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionCreate, user.GetMetadata().GetName(), uid, []uuid.UUID{}, rolesAfter, []uuid.UUID{}, groupsAfter)
+	user.Status = namespaceBindingStatus(ctx, dao.GetDB(ctx, s.db), namespaceBindings("", user.GetSpec().GetProjectNamespaceRoles()))
 	return user, nil
 }
 
@@ -534,13 +540,13 @@ func (s *userService) GetByID(ctx context.Context, user *userv3.User) (*userv3.U
 	if err != nil {
 		return &userv3.User{}, err
 	}
-	entity, err := dao.GetM(ctx, s.db, map[string]interface{}{"id": uid}, &models.KratosIdentities{})
+	entity, err := dao.GetM(ctx, dao.GetDB(ctx, s.db), map[string]interface{}{"id": uid}, &models.KratosIdentities{})
 	if err != nil {
 		return &userv3.User{}, err
 	}
 
 	if usr, ok := entity.(*models.KratosIdentities); ok {
-		user, err := s.identitiesModelToUser(ctx, s.db, user, usr)
+		user, err := s.identitiesModelToUser(ctx, dao.GetDB(ctx, s.db), user, usr)
 		if err != nil {
 			return &userv3.User{}, err
 		}
@@ -553,13 +559,13 @@ func (s *userService) GetByID(ctx context.Context, user *userv3.User) (*userv3.U
 
 func (s *userService) GetByName(ctx context.Context, user *userv3.User) (*userv3.User, error) {
 	name := user.GetMetadata().GetName()
-	entity, err := dao.GetUserByEmail(ctx, s.db, name, &models.KratosIdentities{})
+	entity, err := dao.GetUserByEmail(ctx, dao.GetDB(ctx, s.db), name, &models.KratosIdentities{})
 	if err != nil {
 		return &userv3.User{}, err
 	}
 
 	if usr, ok := entity.(*models.KratosIdentities); ok {
-		user, err := s.identitiesModelToUser(ctx, s.db, user, usr)
+		user, err := s.identitiesModelToUser(ctx, dao.GetDB(ctx, s.db), user, usr)
 		if err != nil {
 			return &userv3.User{}, err
 		}
@@ -588,7 +594,7 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 	if s.dev {
 		username = user.GetMetadata().GetName()
 		if len(username) == 0 {
-			_log.Warn("Unable to fetch username. Don't use DEV mode when using from UI.")
+			_log.WithContext(ctx).Warn("Unable to fetch username. Don't use DEV mode when using from UI.")
 			return &userv3.UserInfo{}, fmt.Errorf("username should be provided")
 		}
 	} else {
@@ -599,7 +605,7 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 		username = sd.Username
 	}
 
-	entity, err := dao.GetUserByEmail(ctx, s.db, username, &models.KratosIdentities{})
+	entity, err := dao.GetUserByEmail(ctx, dao.GetDB(ctx, s.db), username, &models.KratosIdentities{})
 	if err != nil {
 		return &userv3.UserInfo{}, err
 	}
@@ -607,7 +613,7 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 	roleMap := map[string][]string{}
 	if usr, ok := entity.(*models.KratosIdentities); ok {
 
-		user, err := s.identitiesModelToUser(ctx, s.db, user, usr)
+		user, err := s.identitiesModelToUser(ctx, dao.GetDB(ctx, s.db), user, usr)
 		if err != nil {
 			return &userv3.UserInfo{}, err
 		}
@@ -629,16 +635,16 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 			var scope string
 			rps, ok := roleMap[p.Role]
 			if !ok {
-				role, err := dao.GetAttributesByName(ctx, s.db, p.Role, &models.Role{}, "id", "scope")
+				role, err := dao.GetAttributesByName(ctx, dao.GetDB(ctx, s.db), p.Role, &models.Role{}, "id", "scope")
 				if err != nil {
 					return &userv3.UserInfo{}, err
 				}
 				rle, ok := role.(*models.Role)
 				if !ok {
-					_log.Warn("unable to lookup existing role '%v'", p.Role)
+					_log.WithContext(ctx).Warn("unable to lookup existing role '%v'", p.Role)
 					return &userv3.UserInfo{}, err
 				}
-				rpms, err := dao.GetRolePermissions(ctx, s.db, rle.ID)
+				rpms, err := dao.GetRolePermissions(ctx, dao.GetDB(ctx, s.db), rle.ID)
 				if err != nil {
 					return &userv3.UserInfo{}, err
 				}
@@ -651,11 +657,12 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 			permissions = append(
 				permissions,
 				&userv3.Permission{
-					Project:     p.Project,
-					Namespace:   p.Namespace,
-					Role:        p.Role,
-					Permissions: rps,
-					Scope:       &scope,
+					Project:         p.Project,
+					Namespace:       p.Namespace,
+					Role:            p.Role,
+					Permissions:     rps,
+					Scope:           &scope,
+					ClusterSelector: p.ClusterSelector,
 				},
 			)
 
@@ -705,7 +712,7 @@ func (s *userService) deleteUserRoleRelations(ctx context.Context, db bun.IDB, u
 }
 
 func (s *userService) UpdateForceResetFlag(ctx context.Context, username string) error {
-	entity, err := dao.GetUserFullByEmail(ctx, s.db, username, &models.KratosIdentities{})
+	entity, err := dao.GetUserFullByEmail(ctx, dao.GetDB(ctx, s.db), username, &models.KratosIdentities{})
 	if err != nil {
 		return fmt.Errorf("no user found with name '%v'", username)
 	}
@@ -723,13 +730,13 @@ func (s *userService) UpdateForceResetFlag(ctx context.Context, username string)
 
 func (s *userService) Update(ctx context.Context, user *userv3.User) (*userv3.User, error) {
 	name := user.GetMetadata().GetName()
-	entity, err := dao.GetUserFullByEmail(ctx, s.db, name, &models.KratosIdentities{})
+	entity, err := dao.GetUserFullByEmail(ctx, dao.GetDB(ctx, s.db), name, &models.KratosIdentities{})
 	if err != nil {
 		return &userv3.User{}, fmt.Errorf("no user found with name '%v'", name)
 	}
 
 	if usr, ok := entity.(*models.KratosIdentities); ok {
-		partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, user)
+		partnerId, organizationId, err := s.getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), user)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get partner and org id")
 		}
@@ -750,7 +757,7 @@ func (s *userService) Update(ctx context.Context, user *userv3.User) (*userv3.Us
 			}
 		}
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &userv3.User{}, err
 		}
@@ -784,11 +791,12 @@ func (s *userService) Update(ctx context.Context, user *userv3.User) (*userv3.Us
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 			return &userv3.User{}, fmt.Errorf("unable to update user '%v'", name)
 		}
 
-		CreateUserAuditEvent(ctx, s.al, s.db, AuditActionUpdate, user.GetMetadata().GetName(), usr.ID, rolesBefore, rolesAfter, groupsBefore, groupsAfter)
+		CreateUserAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionUpdate, user.GetMetadata().GetName(), usr.ID, rolesBefore, rolesAfter, groupsBefore, groupsAfter)
+		user.Status = namespaceBindingStatus(ctx, dao.GetDB(ctx, s.db), namespaceBindings("", user.GetSpec().GetProjectNamespaceRoles()))
 		return user, nil
 
 	} else {
@@ -799,7 +807,7 @@ func (s *userService) Update(ctx context.Context, user *userv3.User) (*userv3.Us
 
 func (s *userService) Delete(ctx context.Context, user *userv3.User) (*userrpcv3.UserDeleteApiKeysResponse, error) {
 	name := user.GetMetadata().GetName()
-	entity, err := dao.GetUserIdByEmail(ctx, s.db, name, &models.KratosIdentities{})
+	entity, err := dao.GetUserIdByEmail(ctx, dao.GetDB(ctx, s.db), name, &models.KratosIdentities{})
 	if err != nil {
 		return &userrpcv3.UserDeleteApiKeysResponse{}, fmt.Errorf("no user founnd with username '%v'", name)
 	}
@@ -816,7 +824,7 @@ func (s *userService) Delete(ctx context.Context, user *userv3.User) (*userrpcv3
 
 	if usr, ok := entity.(*models.KratosIdentities); ok {
 
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		tx, err := dao.BeginTx(ctx, s.db)
 		if err != nil {
 			return &userrpcv3.UserDeleteApiKeysResponse{}, err
 		}
@@ -842,10 +850,10 @@ func (s *userService) Delete(ctx context.Context, user *userv3.User) (*userrpcv3
 		err = tx.Commit()
 		if err != nil {
 			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			_log.WithContext(ctx).Warn("unable to commit changes", err)
 		}
 
-		CreateUserAuditEvent(ctx, s.al, s.db, AuditActionDelete, user.GetMetadata().GetName(), usr.ID, rolesBefore, []uuid.UUID{}, groupsBefore, []uuid.UUID{})
+		CreateUserAuditEvent(ctx, s.al, dao.GetDB(ctx, s.db), AuditActionDelete, user.GetMetadata().GetName(), usr.ID, rolesBefore, []uuid.UUID{}, groupsBefore, []uuid.UUID{})
 		return &userrpcv3.UserDeleteApiKeysResponse{}, nil
 	}
 	return &userrpcv3.UserDeleteApiKeysResponse{}, fmt.Errorf("unable to delete user '%v'", user.Metadata.Name)
@@ -867,7 +875,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 		opt(&queryOptions)
 	}
 
-	partnerId, orgId, err := getPartnerOrganization(ctx, s.db, queryOptions.Partner, queryOptions.Organization)
+	partnerId, orgId, err := getPartnerOrganization(ctx, dao.GetDB(ctx, s.db), queryOptions.Partner, queryOptions.Organization)
 	if err != nil {
 		return &userv3.UserList{}, fmt.Errorf("unable to find role partner and org")
 	}
@@ -875,7 +883,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 	roleName := queryOptions.Role
 	roleId := uuid.Nil
 	if roleName != "" {
-		role, err := dao.GetIdByName(ctx, s.db, roleName, &models.Role{})
+		role, err := dao.GetIdByName(ctx, dao.GetDB(ctx, s.db), roleName, &models.Role{})
 		if err != nil {
 			return &userv3.UserList{}, fmt.Errorf("unable to find role '%v'", roleName)
 		}
@@ -887,7 +895,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 	groupName := queryOptions.Group
 	groupId := uuid.Nil
 	if groupName != "" {
-		group, err := dao.GetIdByName(ctx, s.db, groupName, &models.Group{})
+		group, err := dao.GetIdByName(ctx, dao.GetDB(ctx, s.db), groupName, &models.Group{})
 		if err != nil {
 			return &userv3.UserList{}, fmt.Errorf("unable to find group '%v'", groupName)
 		}
@@ -902,7 +910,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 			if p == "ALL" {
 				projectIds = append(projectIds, uuid.Nil)
 			} else {
-				project, err := dao.GetIdByName(ctx, s.db, p, &models.Project{})
+				project, err := dao.GetIdByName(ctx, dao.GetDB(ctx, s.db), p, &models.Project{})
 				if err != nil {
 					return &userv3.UserList{}, fmt.Errorf("unable to find project '%v'", p)
 				}
@@ -915,14 +923,14 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 
 	var usrs []models.KratosIdentities
 	if len(projectIds) != 0 || groupId != uuid.Nil || roleId != uuid.Nil {
-		uids, err := dao.GetQueryFilteredUsers(ctx, s.db, partnerId, orgId, groupId, roleId, projectIds)
+		uids, err := dao.GetQueryFilteredUsers(ctx, dao.GetDB(ctx, s.db), partnerId, orgId, groupId, roleId, projectIds)
 		if err != nil {
 			return &userv3.UserList{}, err
 		}
 
 		if len(uids) != 0 {
 			// TODO: merge this with the previous one into single sql
-			usrs, err = dao.ListFilteredUsers(ctx, s.db,
+			usrs, err = dao.ListFilteredUsers(ctx, dao.GetDB(ctx, s.db),
 				uids, queryOptions.Q, queryOptions.Type,
 				queryOptions.OrderBy, queryOptions.Order,
 				int(queryOptions.Limit), int(queryOptions.Offset))
@@ -932,7 +940,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 		}
 	} else {
 		// If no filters are available we have to list just using identities table
-		usrs, err = dao.ListFilteredUsers(ctx, s.db,
+		usrs, err = dao.ListFilteredUsers(ctx, dao.GetDB(ctx, s.db),
 			[]uuid.UUID{}, queryOptions.Q, queryOptions.Type,
 			queryOptions.OrderBy, queryOptions.Order,
 			int(queryOptions.Limit), int(queryOptions.Offset))
@@ -943,7 +951,7 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 
 	for _, usr := range usrs {
 		user := &userv3.User{}
-		user, err := s.identitiesModelToUser(ctx, s.db, user, &usr)
+		user, err := s.identitiesModelToUser(ctx, dao.GetDB(ctx, s.db), user, &usr)
 		if err != nil {
 			return userList, err
 		}
@@ -970,25 +978,25 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 
 func (s *userService) RetrieveCliConfig(ctx context.Context, req *userrpcv3.ApiKeyRequest) (*common.CliConfigDownloadData, error) {
 	// get the default project associated to this account
-	ap, err := dao.GetDefaultAccountProject(ctx, s.db, uuid.MustParse(req.Id))
+	ap, err := dao.GetDefaultAccountProject(ctx, dao.GetDB(ctx, s.db), uuid.MustParse(req.Id))
 	if err != nil {
 		return nil, err
 	}
 	// fetch the metadata information required to populate cli config
 	var proj models.Project
-	_, err = dao.GetByID(ctx, s.db, ap.ProjectId, &proj)
+	_, err = dao.GetByID(ctx, dao.GetDB(ctx, s.db), ap.ProjectId, &proj)
 	if err != nil {
 		return nil, err
 	}
 
 	var org models.Organization
-	_, err = dao.GetByID(ctx, s.db, ap.OrganizationId, &org)
+	_, err = dao.GetByID(ctx, dao.GetDB(ctx, s.db), ap.OrganizationId, &org)
 	if err != nil {
 		return nil, err
 	}
 
 	var part models.Partner
-	_, err = dao.GetByID(ctx, s.db, ap.PartnerId, &part)
+	_, err = dao.GetByID(ctx, dao.GetDB(ctx, s.db), ap.PartnerId, &part)
 	if err != nil {
 		return nil, err
 	}
@@ -1044,7 +1052,7 @@ func (s *userService) UpdateIdpUserGroupPolicy(ctx context.Context, op, id, trai
 
 	// Get existing user group so that the update does not wipe
 	// them out.
-	userGroups, err := dao.GetGroups(ctx, s.db, userUUID)
+	userGroups, err := dao.GetGroups(ctx, dao.GetDB(ctx, s.db), userUUID)
 	if err != nil {
 		return fmt.Errorf("empty to find existing groups for user with id %s", id)
 	}
@@ -1075,20 +1083,20 @@ func (s *userService) UpdateIdpUserGroupPolicy(ctx context.Context, op, id, trai
 	}
 	switch op {
 	case "DELETE":
-		_, _, err = s.deleteGroupAccountRelations(ctx, s.db, userUUID, user)
+		_, _, err = s.deleteGroupAccountRelations(ctx, dao.GetDB(ctx, s.db), userUUID, user)
 		if err != nil {
 			return err
 		}
 	case "UPDATE":
 		// delete old policies
-		_, _, err = s.deleteGroupAccountRelations(ctx, s.db, userUUID, user)
+		_, _, err = s.deleteGroupAccountRelations(ctx, dao.GetDB(ctx, s.db), userUUID, user)
 		if err != nil {
 			return err
 		}
 		// create new policies
 		fallthrough
 	case "INSERT":
-		_, _, err = s.createGroupAccountRelations(ctx, s.db, userUUID, user)
+		_, _, err = s.createGroupAccountRelations(ctx, dao.GetDB(ctx, s.db), userUUID, user)
 		if err != nil {
 			return err
 		}
@@ -1103,7 +1111,7 @@ func (s *userService) UpdateIdpUserGroupPolicy(ctx context.Context, op, id, trai
 // recovery link even when we do not have an email setup.
 func (s *userService) ForgotPassword(ctx context.Context, req *userrpcv3.UserForgotPasswordRequest) (*userrpcv3.UserForgotPasswordResponse, error) {
 	name := req.GetUsername()
-	entity, err := dao.GetUserByEmail(ctx, s.db, name, &models.KratosIdentities{})
+	entity, err := dao.GetUserByEmail(ctx, dao.GetDB(ctx, s.db), name, &models.KratosIdentities{})
 	if err != nil {
 		return &userrpcv3.UserForgotPasswordResponse{}, fmt.Errorf("unable to find user %s", name)
 	}
@@ -1111,7 +1119,7 @@ func (s *userService) ForgotPassword(ctx context.Context, req *userrpcv3.UserFor
 	if usr, ok := entity.(*models.KratosIdentities); ok {
 		rl, err := s.ap.GetRecoveryLink(ctx, usr.ID.String())
 		if err != nil {
-			_log.Warn("unable to generate recovery url", err)
+			_log.WithContext(ctx).Warn("unable to generate recovery url", err)
 			return &userrpcv3.UserForgotPasswordResponse{}, fmt.Errorf("unable to generate recovery url")
 		}
 		return &userrpcv3.UserForgotPasswordResponse{RecoveryLink: rl}, nil
@@ -1126,7 +1134,7 @@ func (s *userService) CreateLoginAuditLog(ctx context.Context, req *userrpcv3.Us
 		return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: uid parse error.%v", err.Error())
 	}
 
-	entities, err := dao.GetUserNamesByIds(ctx, s.db, []uuid.UUID{uid}, &models.KratosIdentities{})
+	entities, err := dao.GetUserNamesByIds(ctx, dao.GetDB(ctx, s.db), []uuid.UUID{uid}, &models.KratosIdentities{})
 	if err != nil {
 		return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: internal error. %v", err.Error())
 	}
@@ -1142,7 +1150,7 @@ func (s *userService) CreateLoginAuditLog(ctx context.Context, req *userrpcv3.Us
 
 func (s *userService) getUserLastLogin(ctx context.Context, userId uuid.UUID) (string, error) {
 	var lastLogin string
-	authTime, err := dao.GetUserLastAuthTime(ctx, s.db, userId)
+	authTime, err := dao.GetUserLastAuthTime(ctx, dao.GetDB(ctx, s.db), userId)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/uptrace/bun"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxStatementLen limits the size of db.statement attribute
+const maxStatementLen = 4096
+
+type queryHook struct{}
+
+var _ bun.QueryHook = queryHook{}
+
+// NewQueryHook returns bun query hook which records a span for every
+// query executed through bun
+func NewQueryHook() bun.QueryHook {
+	return queryHook{}
+}
+
+func (queryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	ctx, _ = Start(ctx, "db."+event.Operation())
+	return ctx
+}
+
+func (queryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	query := event.Query
+	if len(query) > maxStatementLen {
+		query = query[:maxStatementLen]
+	}
+	span.SetAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperation(event.Operation()),
+		semconv.DBStatement(query),
+	)
+
+	err := event.Err
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	End(span, err)
+}
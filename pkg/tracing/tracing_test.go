@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTraceID(t *testing.T) {
+	if id := TraceID(context.Background()); id != "" {
+		t.Errorf("expected empty trace id without span, got %s", id)
+	}
+
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	ctx, span := Start(context.Background(), "test")
+	id := TraceID(ctx)
+	End(span, nil)
+
+	if id == "" {
+		t.Fatal("expected trace id for recording span")
+	}
+	ended := sr.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected 1 ended span, got %d", len(ended))
+	}
+	if ended[0].SpanContext().TraceID().String() != id {
+		t.Errorf("trace id mismatch, expected %s got %s", ended[0].SpanContext().TraceID(), id)
+	}
+}
+
+func TestInitWithoutEndpoint(t *testing.T) {
+	shutdown, err := Init(context.Background(), &Options{ServiceName: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
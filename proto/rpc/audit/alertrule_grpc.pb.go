@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/audit/alertrule.proto
+
+package eventv1
+
+import (
+	context "context"
+	audit "github.com/paralus/paralus/proto/types/audit"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AlertRuleService_CreateAlertRule_FullMethodName = "/rep.framework.event.v1.AlertRuleService/CreateAlertRule"
+	AlertRuleService_GetAlertRules_FullMethodName   = "/rep.framework.event.v1.AlertRuleService/GetAlertRules"
+	AlertRuleService_GetAlertRule_FullMethodName    = "/rep.framework.event.v1.AlertRuleService/GetAlertRule"
+	AlertRuleService_UpdateAlertRule_FullMethodName = "/rep.framework.event.v1.AlertRuleService/UpdateAlertRule"
+	AlertRuleService_DeleteAlertRule_FullMethodName = "/rep.framework.event.v1.AlertRuleService/DeleteAlertRule"
+	AlertRuleService_TestAlertRule_FullMethodName   = "/rep.framework.event.v1.AlertRuleService/TestAlertRule"
+)
+
+// AlertRuleServiceClient is the client API for AlertRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlertRuleServiceClient interface {
+	CreateAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error)
+	GetAlertRules(ctx context.Context, in *v3.QueryOptions, opts ...grpc.CallOption) (*audit.AlertRuleList, error)
+	GetAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error)
+	UpdateAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	TestAlertRule(ctx context.Context, in *AlertRuleTestRequest, opts ...grpc.CallOption) (*AlertRuleTestResponse, error)
+}
+
+type alertRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertRuleServiceClient(cc grpc.ClientConnInterface) AlertRuleServiceClient {
+	return &alertRuleServiceClient{cc}
+}
+
+func (c *alertRuleServiceClient) CreateAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error) {
+	out := new(audit.AlertRule)
+	err := c.cc.Invoke(ctx, AlertRuleService_CreateAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertRuleServiceClient) GetAlertRules(ctx context.Context, in *v3.QueryOptions, opts ...grpc.CallOption) (*audit.AlertRuleList, error) {
+	out := new(audit.AlertRuleList)
+	err := c.cc.Invoke(ctx, AlertRuleService_GetAlertRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertRuleServiceClient) GetAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error) {
+	out := new(audit.AlertRule)
+	err := c.cc.Invoke(ctx, AlertRuleService_GetAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertRuleServiceClient) UpdateAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*audit.AlertRule, error) {
+	out := new(audit.AlertRule)
+	err := c.cc.Invoke(ctx, AlertRuleService_UpdateAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertRuleServiceClient) DeleteAlertRule(ctx context.Context, in *audit.AlertRule, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, AlertRuleService_DeleteAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertRuleServiceClient) TestAlertRule(ctx context.Context, in *AlertRuleTestRequest, opts ...grpc.CallOption) (*AlertRuleTestResponse, error) {
+	out := new(AlertRuleTestResponse)
+	err := c.cc.Invoke(ctx, AlertRuleService_TestAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlertRuleServiceServer is the server API for AlertRuleService service.
+// All implementations should embed UnimplementedAlertRuleServiceServer
+// for forward compatibility
+type AlertRuleServiceServer interface {
+	CreateAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error)
+	GetAlertRules(context.Context, *v3.QueryOptions) (*audit.AlertRuleList, error)
+	GetAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error)
+	UpdateAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error)
+	DeleteAlertRule(context.Context, *audit.AlertRule) (*DeleteAlertRuleResponse, error)
+	TestAlertRule(context.Context, *AlertRuleTestRequest) (*AlertRuleTestResponse, error)
+}
+
+// UnimplementedAlertRuleServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAlertRuleServiceServer struct {
+}
+
+func (UnimplementedAlertRuleServiceServer) CreateAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedAlertRuleServiceServer) GetAlertRules(context.Context, *v3.QueryOptions) (*audit.AlertRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRules not implemented")
+}
+func (UnimplementedAlertRuleServiceServer) GetAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRule not implemented")
+}
+func (UnimplementedAlertRuleServiceServer) UpdateAlertRule(context.Context, *audit.AlertRule) (*audit.AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedAlertRuleServiceServer) DeleteAlertRule(context.Context, *audit.AlertRule) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedAlertRuleServiceServer) TestAlertRule(context.Context, *AlertRuleTestRequest) (*AlertRuleTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestAlertRule not implemented")
+}
+
+// UnsafeAlertRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertRuleServiceServer will
+// result in compilation errors.
+type UnsafeAlertRuleServiceServer interface {
+	mustEmbedUnimplementedAlertRuleServiceServer()
+}
+
+func RegisterAlertRuleServiceServer(s grpc.ServiceRegistrar, srv AlertRuleServiceServer) {
+	s.RegisterService(&AlertRuleService_ServiceDesc, srv)
+}
+
+func _AlertRuleService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(audit.AlertRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).CreateAlertRule(ctx, req.(*audit.AlertRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertRuleService_GetAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).GetAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_GetAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).GetAlertRules(ctx, req.(*v3.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertRuleService_GetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(audit.AlertRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).GetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_GetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).GetAlertRule(ctx, req.(*audit.AlertRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertRuleService_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(audit.AlertRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).UpdateAlertRule(ctx, req.(*audit.AlertRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertRuleService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(audit.AlertRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).DeleteAlertRule(ctx, req.(*audit.AlertRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertRuleService_TestAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRuleTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleServiceServer).TestAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleService_TestAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleServiceServer).TestAlertRule(ctx, req.(*AlertRuleTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlertRuleService_ServiceDesc is the grpc.ServiceDesc for AlertRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlertRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rep.framework.event.v1.AlertRuleService",
+	HandlerType: (*AlertRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _AlertRuleService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "GetAlertRules",
+			Handler:    _AlertRuleService_GetAlertRules_Handler,
+		},
+		{
+			MethodName: "GetAlertRule",
+			Handler:    _AlertRuleService_GetAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _AlertRuleService_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _AlertRuleService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "TestAlertRule",
+			Handler:    _AlertRuleService_TestAlertRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/audit/alertrule.proto",
+}
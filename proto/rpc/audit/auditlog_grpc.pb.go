@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	AuditLogService_GetAuditLog_FullMethodName           = "/rep.framework.event.v1.AuditLogService/GetAuditLog"
 	AuditLogService_GetAuditLogByProjects_FullMethodName = "/rep.framework.event.v1.AuditLogService/GetAuditLogByProjects"
+	AuditLogService_VerifyAuditLog_FullMethodName        = "/rep.framework.event.v1.AuditLogService/VerifyAuditLog"
 )
 
 // AuditLogServiceClient is the client API for AuditLogService service.
@@ -29,6 +30,7 @@ const (
 type AuditLogServiceClient interface {
 	GetAuditLog(ctx context.Context, in *GetAuditLogSearchRequest, opts ...grpc.CallOption) (*GetAuditLogSearchResponse, error)
 	GetAuditLogByProjects(ctx context.Context, in *GetAuditLogSearchRequest, opts ...grpc.CallOption) (*GetAuditLogSearchResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
 }
 
 type auditLogServiceClient struct {
@@ -57,12 +59,22 @@ func (c *auditLogServiceClient) GetAuditLogByProjects(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *auditLogServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, AuditLogService_VerifyAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuditLogServiceServer is the server API for AuditLogService service.
 // All implementations should embed UnimplementedAuditLogServiceServer
 // for forward compatibility
 type AuditLogServiceServer interface {
 	GetAuditLog(context.Context, *GetAuditLogSearchRequest) (*GetAuditLogSearchResponse, error)
 	GetAuditLogByProjects(context.Context, *GetAuditLogSearchRequest) (*GetAuditLogSearchResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
 }
 
 // UnimplementedAuditLogServiceServer should be embedded to have forward compatible implementations.
@@ -75,6 +87,9 @@ func (UnimplementedAuditLogServiceServer) GetAuditLog(context.Context, *GetAudit
 func (UnimplementedAuditLogServiceServer) GetAuditLogByProjects(context.Context, *GetAuditLogSearchRequest) (*GetAuditLogSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogByProjects not implemented")
 }
+func (UnimplementedAuditLogServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
 
 // UnsafeAuditLogServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuditLogServiceServer will
@@ -123,6 +138,24 @@ func _AuditLogService_GetAuditLogByProjects_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditLogService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLogService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuditLogService_ServiceDesc is the grpc.ServiceDesc for AuditLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,6 +171,10 @@ var AuditLogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuditLogByProjects",
 			Handler:    _AuditLogService_GetAuditLogByProjects_Handler,
 		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _AuditLogService_VerifyAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/audit/auditlog.proto",
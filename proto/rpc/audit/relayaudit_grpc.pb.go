@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	RelayAuditService_GetRelayAudit_FullMethodName           = "/rep.framework.event.v1.RelayAuditService/GetRelayAudit"
 	RelayAuditService_GetRelayAuditByProjects_FullMethodName = "/rep.framework.event.v1.RelayAuditService/GetRelayAuditByProjects"
+	RelayAuditService_GetRelaySessions_FullMethodName        = "/rep.framework.event.v1.RelayAuditService/GetRelaySessions"
 )
 
 // RelayAuditServiceClient is the client API for RelayAuditService service.
@@ -29,6 +30,9 @@ const (
 type RelayAuditServiceClient interface {
 	GetRelayAudit(ctx context.Context, in *RelayAuditRequest, opts ...grpc.CallOption) (*RelayAuditResponse, error)
 	GetRelayAuditByProjects(ctx context.Context, in *RelayAuditRequest, opts ...grpc.CallOption) (*RelayAuditResponse, error)
+	// GetRelaySessions groups the relay api calls and kubectl commands of
+	// users into sessions per cluster and session type, split by idle time
+	GetRelaySessions(ctx context.Context, in *RelaySessionRequest, opts ...grpc.CallOption) (*RelaySessionResponse, error)
 }
 
 type relayAuditServiceClient struct {
@@ -57,12 +61,24 @@ func (c *relayAuditServiceClient) GetRelayAuditByProjects(ctx context.Context, i
 	return out, nil
 }
 
+func (c *relayAuditServiceClient) GetRelaySessions(ctx context.Context, in *RelaySessionRequest, opts ...grpc.CallOption) (*RelaySessionResponse, error) {
+	out := new(RelaySessionResponse)
+	err := c.cc.Invoke(ctx, RelayAuditService_GetRelaySessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelayAuditServiceServer is the server API for RelayAuditService service.
 // All implementations should embed UnimplementedRelayAuditServiceServer
 // for forward compatibility
 type RelayAuditServiceServer interface {
 	GetRelayAudit(context.Context, *RelayAuditRequest) (*RelayAuditResponse, error)
 	GetRelayAuditByProjects(context.Context, *RelayAuditRequest) (*RelayAuditResponse, error)
+	// GetRelaySessions groups the relay api calls and kubectl commands of
+	// users into sessions per cluster and session type, split by idle time
+	GetRelaySessions(context.Context, *RelaySessionRequest) (*RelaySessionResponse, error)
 }
 
 // UnimplementedRelayAuditServiceServer should be embedded to have forward compatible implementations.
@@ -75,6 +91,9 @@ func (UnimplementedRelayAuditServiceServer) GetRelayAudit(context.Context, *Rela
 func (UnimplementedRelayAuditServiceServer) GetRelayAuditByProjects(context.Context, *RelayAuditRequest) (*RelayAuditResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRelayAuditByProjects not implemented")
 }
+func (UnimplementedRelayAuditServiceServer) GetRelaySessions(context.Context, *RelaySessionRequest) (*RelaySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelaySessions not implemented")
+}
 
 // UnsafeRelayAuditServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RelayAuditServiceServer will
@@ -123,6 +142,24 @@ func _RelayAuditService_GetRelayAuditByProjects_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelayAuditService_GetRelaySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelaySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayAuditServiceServer).GetRelaySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelayAuditService_GetRelaySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayAuditServiceServer).GetRelaySessions(ctx, req.(*RelaySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RelayAuditService_ServiceDesc is the grpc.ServiceDesc for RelayAuditService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,6 +175,10 @@ var RelayAuditService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRelayAuditByProjects",
 			Handler:    _RelayAuditService_GetRelayAuditByProjects_Handler,
 		},
+		{
+			MethodName: "GetRelaySessions",
+			Handler:    _RelayAuditService_GetRelaySessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/audit/relayaudit.proto",
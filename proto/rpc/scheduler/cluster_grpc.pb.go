@@ -10,6 +10,7 @@ import (
 	context "context"
 	v31 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	v3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	scheduler "github.com/paralus/paralus/proto/types/scheduler"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,12 +22,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ClusterService_CreateCluster_FullMethodName   = "/paralus.dev.rpc.v3.ClusterService/CreateCluster"
-	ClusterService_GetClusters_FullMethodName     = "/paralus.dev.rpc.v3.ClusterService/GetClusters"
-	ClusterService_GetCluster_FullMethodName      = "/paralus.dev.rpc.v3.ClusterService/GetCluster"
-	ClusterService_UpdateCluster_FullMethodName   = "/paralus.dev.rpc.v3.ClusterService/UpdateCluster"
-	ClusterService_DeleteCluster_FullMethodName   = "/paralus.dev.rpc.v3.ClusterService/DeleteCluster"
-	ClusterService_DownloadCluster_FullMethodName = "/paralus.dev.rpc.v3.ClusterService/DownloadCluster"
+	ClusterService_CreateCluster_FullMethodName            = "/paralus.dev.rpc.v3.ClusterService/CreateCluster"
+	ClusterService_GetClusters_FullMethodName              = "/paralus.dev.rpc.v3.ClusterService/GetClusters"
+	ClusterService_GetCluster_FullMethodName               = "/paralus.dev.rpc.v3.ClusterService/GetCluster"
+	ClusterService_UpdateCluster_FullMethodName            = "/paralus.dev.rpc.v3.ClusterService/UpdateCluster"
+	ClusterService_DeleteCluster_FullMethodName            = "/paralus.dev.rpc.v3.ClusterService/DeleteCluster"
+	ClusterService_DownloadCluster_FullMethodName          = "/paralus.dev.rpc.v3.ClusterService/DownloadCluster"
+	ClusterService_DownloadClusterPackage_FullMethodName   = "/paralus.dev.rpc.v3.ClusterService/DownloadClusterPackage"
+	ClusterService_ResetClusterRegistration_FullMethodName = "/paralus.dev.rpc.v3.ClusterService/ResetClusterRegistration"
+	ClusterService_RotateClusterToken_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/RotateClusterToken"
+	ClusterService_RevokeClusterSessions_FullMethodName    = "/paralus.dev.rpc.v3.ClusterService/RevokeClusterSessions"
+	ClusterService_ApproveCluster_FullMethodName           = "/paralus.dev.rpc.v3.ClusterService/ApproveCluster"
+	ClusterService_RejectCluster_FullMethodName            = "/paralus.dev.rpc.v3.ClusterService/RejectCluster"
+	ClusterService_ShareCluster_FullMethodName             = "/paralus.dev.rpc.v3.ClusterService/ShareCluster"
+	ClusterService_UnshareCluster_FullMethodName           = "/paralus.dev.rpc.v3.ClusterService/UnshareCluster"
+	ClusterService_GetClusterNamespaces_FullMethodName     = "/paralus.dev.rpc.v3.ClusterService/GetClusterNamespaces"
+	ClusterService_GetProjectNamespaces_FullMethodName     = "/paralus.dev.rpc.v3.ClusterService/GetProjectNamespaces"
 )
 
 // ClusterServiceClient is the client API for ClusterService service.
@@ -39,6 +50,16 @@ type ClusterServiceClient interface {
 	UpdateCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error)
 	DeleteCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*DeleteClusterResponse, error)
 	DownloadCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v31.HttpBody, error)
+	DownloadClusterPackage(ctx context.Context, in *DownloadClusterPackageRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
+	ResetClusterRegistration(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error)
+	RotateClusterToken(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error)
+	RevokeClusterSessions(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error)
+	ApproveCluster(ctx context.Context, in *ClusterApprovalRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	RejectCluster(ctx context.Context, in *ClusterApprovalRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	ShareCluster(ctx context.Context, in *ShareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	UnshareCluster(ctx context.Context, in *UnshareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	GetClusterNamespaces(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*scheduler.ClusterNamespaceList, error)
+	GetProjectNamespaces(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*scheduler.ClusterNamespaceList, error)
 }
 
 type clusterServiceClient struct {
@@ -103,6 +124,96 @@ func (c *clusterServiceClient) DownloadCluster(ctx context.Context, in *v3.Clust
 	return out, nil
 }
 
+func (c *clusterServiceClient) DownloadClusterPackage(ctx context.Context, in *DownloadClusterPackageRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, ClusterService_DownloadClusterPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ResetClusterRegistration(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_ResetClusterRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) RotateClusterToken(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_RotateClusterToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) RevokeClusterSessions(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_RevokeClusterSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ApproveCluster(ctx context.Context, in *ClusterApprovalRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_ApproveCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) RejectCluster(ctx context.Context, in *ClusterApprovalRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_RejectCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ShareCluster(ctx context.Context, in *ShareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_ShareCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UnshareCluster(ctx context.Context, in *UnshareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_UnshareCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetClusterNamespaces(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*scheduler.ClusterNamespaceList, error) {
+	out := new(scheduler.ClusterNamespaceList)
+	err := c.cc.Invoke(ctx, ClusterService_GetClusterNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetProjectNamespaces(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*scheduler.ClusterNamespaceList, error) {
+	out := new(scheduler.ClusterNamespaceList)
+	err := c.cc.Invoke(ctx, ClusterService_GetProjectNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations should embed UnimplementedClusterServiceServer
 // for forward compatibility
@@ -113,6 +224,16 @@ type ClusterServiceServer interface {
 	UpdateCluster(context.Context, *v3.Cluster) (*v3.Cluster, error)
 	DeleteCluster(context.Context, *v3.Cluster) (*DeleteClusterResponse, error)
 	DownloadCluster(context.Context, *v3.Cluster) (*v31.HttpBody, error)
+	DownloadClusterPackage(context.Context, *DownloadClusterPackageRequest) (*v31.HttpBody, error)
+	ResetClusterRegistration(context.Context, *v3.Cluster) (*v3.Cluster, error)
+	RotateClusterToken(context.Context, *v3.Cluster) (*v3.Cluster, error)
+	RevokeClusterSessions(context.Context, *v3.Cluster) (*v3.Cluster, error)
+	ApproveCluster(context.Context, *ClusterApprovalRequest) (*v3.Cluster, error)
+	RejectCluster(context.Context, *ClusterApprovalRequest) (*v3.Cluster, error)
+	ShareCluster(context.Context, *ShareClusterRequest) (*v3.Cluster, error)
+	UnshareCluster(context.Context, *UnshareClusterRequest) (*v3.Cluster, error)
+	GetClusterNamespaces(context.Context, *v3.Cluster) (*scheduler.ClusterNamespaceList, error)
+	GetProjectNamespaces(context.Context, *v31.QueryOptions) (*scheduler.ClusterNamespaceList, error)
 }
 
 // UnimplementedClusterServiceServer should be embedded to have forward compatible implementations.
@@ -137,6 +258,36 @@ func (UnimplementedClusterServiceServer) DeleteCluster(context.Context, *v3.Clus
 func (UnimplementedClusterServiceServer) DownloadCluster(context.Context, *v3.Cluster) (*v31.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadCluster not implemented")
 }
+func (UnimplementedClusterServiceServer) DownloadClusterPackage(context.Context, *DownloadClusterPackageRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadClusterPackage not implemented")
+}
+func (UnimplementedClusterServiceServer) ResetClusterRegistration(context.Context, *v3.Cluster) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetClusterRegistration not implemented")
+}
+func (UnimplementedClusterServiceServer) RotateClusterToken(context.Context, *v3.Cluster) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateClusterToken not implemented")
+}
+func (UnimplementedClusterServiceServer) RevokeClusterSessions(context.Context, *v3.Cluster) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeClusterSessions not implemented")
+}
+func (UnimplementedClusterServiceServer) ApproveCluster(context.Context, *ClusterApprovalRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) RejectCluster(context.Context, *ClusterApprovalRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) ShareCluster(context.Context, *ShareClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) UnshareCluster(context.Context, *UnshareClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) GetClusterNamespaces(context.Context, *v3.Cluster) (*scheduler.ClusterNamespaceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterNamespaces not implemented")
+}
+func (UnimplementedClusterServiceServer) GetProjectNamespaces(context.Context, *v31.QueryOptions) (*scheduler.ClusterNamespaceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectNamespaces not implemented")
+}
 
 // UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ClusterServiceServer will
@@ -257,6 +408,186 @@ func _ClusterService_DownloadCluster_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_DownloadClusterPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadClusterPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DownloadClusterPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_DownloadClusterPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DownloadClusterPackage(ctx, req.(*DownloadClusterPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ResetClusterRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Cluster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ResetClusterRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ResetClusterRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ResetClusterRegistration(ctx, req.(*v3.Cluster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_RotateClusterToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Cluster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RotateClusterToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_RotateClusterToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RotateClusterToken(ctx, req.(*v3.Cluster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_RevokeClusterSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Cluster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RevokeClusterSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_RevokeClusterSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RevokeClusterSessions(ctx, req.(*v3.Cluster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ApproveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ApproveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ApproveCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ApproveCluster(ctx, req.(*ClusterApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_RejectCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RejectCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_RejectCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RejectCluster(ctx, req.(*ClusterApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ShareCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ShareCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ShareCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ShareCluster(ctx, req.(*ShareClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UnshareCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UnshareCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_UnshareCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UnshareCluster(ctx, req.(*UnshareClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetClusterNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Cluster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetClusterNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetClusterNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetClusterNamespaces(ctx, req.(*v3.Cluster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetProjectNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetProjectNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetProjectNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetProjectNamespaces(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,6 +619,46 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadCluster",
 			Handler:    _ClusterService_DownloadCluster_Handler,
 		},
+		{
+			MethodName: "DownloadClusterPackage",
+			Handler:    _ClusterService_DownloadClusterPackage_Handler,
+		},
+		{
+			MethodName: "ResetClusterRegistration",
+			Handler:    _ClusterService_ResetClusterRegistration_Handler,
+		},
+		{
+			MethodName: "RotateClusterToken",
+			Handler:    _ClusterService_RotateClusterToken_Handler,
+		},
+		{
+			MethodName: "RevokeClusterSessions",
+			Handler:    _ClusterService_RevokeClusterSessions_Handler,
+		},
+		{
+			MethodName: "ApproveCluster",
+			Handler:    _ClusterService_ApproveCluster_Handler,
+		},
+		{
+			MethodName: "RejectCluster",
+			Handler:    _ClusterService_RejectCluster_Handler,
+		},
+		{
+			MethodName: "ShareCluster",
+			Handler:    _ClusterService_ShareCluster_Handler,
+		},
+		{
+			MethodName: "UnshareCluster",
+			Handler:    _ClusterService_UnshareCluster_Handler,
+		},
+		{
+			MethodName: "GetClusterNamespaces",
+			Handler:    _ClusterService_GetClusterNamespaces_Handler,
+		},
+		{
+			MethodName: "GetProjectNamespaces",
+			Handler:    _ClusterService_GetProjectNamespaces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/scheduler/cluster.proto",
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/scheduler/clustergroup.proto
+
+package rpcv3
+
+import (
+	context "context"
+	v31 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	v3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterGroupService_CreateClusterGroup_FullMethodName         = "/paralus.dev.rpc.v3.ClusterGroupService/CreateClusterGroup"
+	ClusterGroupService_GetClusterGroups_FullMethodName           = "/paralus.dev.rpc.v3.ClusterGroupService/GetClusterGroups"
+	ClusterGroupService_GetClusterGroup_FullMethodName            = "/paralus.dev.rpc.v3.ClusterGroupService/GetClusterGroup"
+	ClusterGroupService_UpdateClusterGroup_FullMethodName         = "/paralus.dev.rpc.v3.ClusterGroupService/UpdateClusterGroup"
+	ClusterGroupService_DeleteClusterGroup_FullMethodName         = "/paralus.dev.rpc.v3.ClusterGroupService/DeleteClusterGroup"
+	ClusterGroupService_RotateClusterGroupToken_FullMethodName    = "/paralus.dev.rpc.v3.ClusterGroupService/RotateClusterGroupToken"
+	ClusterGroupService_RevokeClusterGroupSessions_FullMethodName = "/paralus.dev.rpc.v3.ClusterGroupService/RevokeClusterGroupSessions"
+)
+
+// ClusterGroupServiceClient is the client API for ClusterGroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterGroupServiceClient interface {
+	CreateClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error)
+	GetClusterGroups(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.ClusterGroupList, error)
+	GetClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error)
+	UpdateClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error)
+	DeleteClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*DeleteClusterGroupResponse, error)
+	RotateClusterGroupToken(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error)
+	RevokeClusterGroupSessions(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error)
+}
+
+type clusterGroupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterGroupServiceClient(cc grpc.ClientConnInterface) ClusterGroupServiceClient {
+	return &clusterGroupServiceClient{cc}
+}
+
+func (c *clusterGroupServiceClient) CreateClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error) {
+	out := new(v3.ClusterGroup)
+	err := c.cc.Invoke(ctx, ClusterGroupService_CreateClusterGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) GetClusterGroups(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.ClusterGroupList, error) {
+	out := new(v3.ClusterGroupList)
+	err := c.cc.Invoke(ctx, ClusterGroupService_GetClusterGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) GetClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error) {
+	out := new(v3.ClusterGroup)
+	err := c.cc.Invoke(ctx, ClusterGroupService_GetClusterGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) UpdateClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error) {
+	out := new(v3.ClusterGroup)
+	err := c.cc.Invoke(ctx, ClusterGroupService_UpdateClusterGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) DeleteClusterGroup(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*DeleteClusterGroupResponse, error) {
+	out := new(DeleteClusterGroupResponse)
+	err := c.cc.Invoke(ctx, ClusterGroupService_DeleteClusterGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) RotateClusterGroupToken(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error) {
+	out := new(v3.ClusterGroup)
+	err := c.cc.Invoke(ctx, ClusterGroupService_RotateClusterGroupToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterGroupServiceClient) RevokeClusterGroupSessions(ctx context.Context, in *v3.ClusterGroup, opts ...grpc.CallOption) (*v3.ClusterGroup, error) {
+	out := new(v3.ClusterGroup)
+	err := c.cc.Invoke(ctx, ClusterGroupService_RevokeClusterGroupSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterGroupServiceServer is the server API for ClusterGroupService service.
+// All implementations should embed UnimplementedClusterGroupServiceServer
+// for forward compatibility
+type ClusterGroupServiceServer interface {
+	CreateClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error)
+	GetClusterGroups(context.Context, *v31.QueryOptions) (*v3.ClusterGroupList, error)
+	GetClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error)
+	UpdateClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error)
+	DeleteClusterGroup(context.Context, *v3.ClusterGroup) (*DeleteClusterGroupResponse, error)
+	RotateClusterGroupToken(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error)
+	RevokeClusterGroupSessions(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error)
+}
+
+// UnimplementedClusterGroupServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterGroupServiceServer struct {
+}
+
+func (UnimplementedClusterGroupServiceServer) CreateClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClusterGroup not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) GetClusterGroups(context.Context, *v31.QueryOptions) (*v3.ClusterGroupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterGroups not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) GetClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterGroup not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) UpdateClusterGroup(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterGroup not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) DeleteClusterGroup(context.Context, *v3.ClusterGroup) (*DeleteClusterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClusterGroup not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) RotateClusterGroupToken(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateClusterGroupToken not implemented")
+}
+func (UnimplementedClusterGroupServiceServer) RevokeClusterGroupSessions(context.Context, *v3.ClusterGroup) (*v3.ClusterGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeClusterGroupSessions not implemented")
+}
+
+// UnsafeClusterGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterGroupServiceServer will
+// result in compilation errors.
+type UnsafeClusterGroupServiceServer interface {
+	mustEmbedUnimplementedClusterGroupServiceServer()
+}
+
+func RegisterClusterGroupServiceServer(s grpc.ServiceRegistrar, srv ClusterGroupServiceServer) {
+	s.RegisterService(&ClusterGroupService_ServiceDesc, srv)
+}
+
+func _ClusterGroupService_CreateClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).CreateClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_CreateClusterGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).CreateClusterGroup(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_GetClusterGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).GetClusterGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_GetClusterGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).GetClusterGroups(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_GetClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).GetClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_GetClusterGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).GetClusterGroup(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_UpdateClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).UpdateClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_UpdateClusterGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).UpdateClusterGroup(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_DeleteClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).DeleteClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_DeleteClusterGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).DeleteClusterGroup(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_RotateClusterGroupToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).RotateClusterGroupToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_RotateClusterGroupToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).RotateClusterGroupToken(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterGroupService_RevokeClusterGroupSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClusterGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterGroupServiceServer).RevokeClusterGroupSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterGroupService_RevokeClusterGroupSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterGroupServiceServer).RevokeClusterGroupSessions(ctx, req.(*v3.ClusterGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterGroupService_ServiceDesc is the grpc.ServiceDesc for ClusterGroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterGroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.rpc.v3.ClusterGroupService",
+	HandlerType: (*ClusterGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClusterGroup",
+			Handler:    _ClusterGroupService_CreateClusterGroup_Handler,
+		},
+		{
+			MethodName: "GetClusterGroups",
+			Handler:    _ClusterGroupService_GetClusterGroups_Handler,
+		},
+		{
+			MethodName: "GetClusterGroup",
+			Handler:    _ClusterGroupService_GetClusterGroup_Handler,
+		},
+		{
+			MethodName: "UpdateClusterGroup",
+			Handler:    _ClusterGroupService_UpdateClusterGroup_Handler,
+		},
+		{
+			MethodName: "DeleteClusterGroup",
+			Handler:    _ClusterGroupService_DeleteClusterGroup_Handler,
+		},
+		{
+			MethodName: "RotateClusterGroupToken",
+			Handler:    _ClusterGroupService_RotateClusterGroupToken_Handler,
+		},
+		{
+			MethodName: "RevokeClusterGroupSessions",
+			Handler:    _ClusterGroupService_RevokeClusterGroupSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/scheduler/clustergroup.proto",
+}
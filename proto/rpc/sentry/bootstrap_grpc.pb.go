@@ -33,6 +33,12 @@ const (
 	BootstrapService_GetBootstrapAgents_FullMethodName          = "/paralus.dev.sentry.rpc.BootstrapService/GetBootstrapAgents"
 	BootstrapService_DeleteBootstrapAgent_FullMethodName        = "/paralus.dev.sentry.rpc.BootstrapService/DeleteBootstrapAgent"
 	BootstrapService_UpdateBootstrapAgent_FullMethodName        = "/paralus.dev.sentry.rpc.BootstrapService/UpdateBootstrapAgent"
+	BootstrapService_CreateRelayNetwork_FullMethodName          = "/paralus.dev.sentry.rpc.BootstrapService/CreateRelayNetwork"
+	BootstrapService_GetRelayNetworks_FullMethodName            = "/paralus.dev.sentry.rpc.BootstrapService/GetRelayNetworks"
+	BootstrapService_GetRelayNetwork_FullMethodName             = "/paralus.dev.sentry.rpc.BootstrapService/GetRelayNetwork"
+	BootstrapService_UpdateRelayNetwork_FullMethodName          = "/paralus.dev.sentry.rpc.BootstrapService/UpdateRelayNetwork"
+	BootstrapService_DeleteRelayNetwork_FullMethodName          = "/paralus.dev.sentry.rpc.BootstrapService/DeleteRelayNetwork"
+	BootstrapService_GetRelayNetworkDownload_FullMethodName     = "/paralus.dev.sentry.rpc.BootstrapService/GetRelayNetworkDownload"
 )
 
 // BootstrapServiceClient is the client API for BootstrapService service.
@@ -51,6 +57,12 @@ type BootstrapServiceClient interface {
 	GetBootstrapAgents(ctx context.Context, in *GetBootstrapAgentsRequest, opts ...grpc.CallOption) (*sentry.BootstrapAgentList, error)
 	DeleteBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*DeleteBootstrapAgentResponse, error)
 	UpdateBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
+	CreateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error)
+	GetRelayNetworks(ctx context.Context, in *GetRelayNetworksRequest, opts ...grpc.CallOption) (*sentry.RelayNetworkList, error)
+	GetRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error)
+	UpdateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error)
+	DeleteRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*DeleteRelayNetworkResponse, error)
+	GetRelayNetworkDownload(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*v3.HttpBody, error)
 }
 
 type bootstrapServiceClient struct {
@@ -169,6 +181,60 @@ func (c *bootstrapServiceClient) UpdateBootstrapAgent(ctx context.Context, in *s
 	return out, nil
 }
 
+func (c *bootstrapServiceClient) CreateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error) {
+	out := new(sentry.RelayNetwork)
+	err := c.cc.Invoke(ctx, BootstrapService_CreateRelayNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) GetRelayNetworks(ctx context.Context, in *GetRelayNetworksRequest, opts ...grpc.CallOption) (*sentry.RelayNetworkList, error) {
+	out := new(sentry.RelayNetworkList)
+	err := c.cc.Invoke(ctx, BootstrapService_GetRelayNetworks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) GetRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error) {
+	out := new(sentry.RelayNetwork)
+	err := c.cc.Invoke(ctx, BootstrapService_GetRelayNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) UpdateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*sentry.RelayNetwork, error) {
+	out := new(sentry.RelayNetwork)
+	err := c.cc.Invoke(ctx, BootstrapService_UpdateRelayNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) DeleteRelayNetwork(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*DeleteRelayNetworkResponse, error) {
+	out := new(DeleteRelayNetworkResponse)
+	err := c.cc.Invoke(ctx, BootstrapService_DeleteRelayNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) GetRelayNetworkDownload(ctx context.Context, in *sentry.RelayNetwork, opts ...grpc.CallOption) (*v3.HttpBody, error) {
+	out := new(v3.HttpBody)
+	err := c.cc.Invoke(ctx, BootstrapService_GetRelayNetworkDownload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BootstrapServiceServer is the server API for BootstrapService service.
 // All implementations should embed UnimplementedBootstrapServiceServer
 // for forward compatibility
@@ -185,6 +251,12 @@ type BootstrapServiceServer interface {
 	GetBootstrapAgents(context.Context, *GetBootstrapAgentsRequest) (*sentry.BootstrapAgentList, error)
 	DeleteBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*DeleteBootstrapAgentResponse, error)
 	UpdateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
+	CreateRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	GetRelayNetworks(context.Context, *GetRelayNetworksRequest) (*sentry.RelayNetworkList, error)
+	GetRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	UpdateRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error)
+	DeleteRelayNetwork(context.Context, *sentry.RelayNetwork) (*DeleteRelayNetworkResponse, error)
+	GetRelayNetworkDownload(context.Context, *sentry.RelayNetwork) (*v3.HttpBody, error)
 }
 
 // UnimplementedBootstrapServiceServer should be embedded to have forward compatible implementations.
@@ -227,6 +299,24 @@ func (UnimplementedBootstrapServiceServer) DeleteBootstrapAgent(context.Context,
 func (UnimplementedBootstrapServiceServer) UpdateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBootstrapAgent not implemented")
 }
+func (UnimplementedBootstrapServiceServer) CreateRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelayNetwork not implemented")
+}
+func (UnimplementedBootstrapServiceServer) GetRelayNetworks(context.Context, *GetRelayNetworksRequest) (*sentry.RelayNetworkList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelayNetworks not implemented")
+}
+func (UnimplementedBootstrapServiceServer) GetRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelayNetwork not implemented")
+}
+func (UnimplementedBootstrapServiceServer) UpdateRelayNetwork(context.Context, *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRelayNetwork not implemented")
+}
+func (UnimplementedBootstrapServiceServer) DeleteRelayNetwork(context.Context, *sentry.RelayNetwork) (*DeleteRelayNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelayNetwork not implemented")
+}
+func (UnimplementedBootstrapServiceServer) GetRelayNetworkDownload(context.Context, *sentry.RelayNetwork) (*v3.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelayNetworkDownload not implemented")
+}
 
 // UnsafeBootstrapServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BootstrapServiceServer will
@@ -455,6 +545,114 @@ func _BootstrapService_UpdateBootstrapAgent_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BootstrapService_CreateRelayNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.RelayNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).CreateRelayNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_CreateRelayNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).CreateRelayNetwork(ctx, req.(*sentry.RelayNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_GetRelayNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelayNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).GetRelayNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_GetRelayNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).GetRelayNetworks(ctx, req.(*GetRelayNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_GetRelayNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.RelayNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).GetRelayNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_GetRelayNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).GetRelayNetwork(ctx, req.(*sentry.RelayNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_UpdateRelayNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.RelayNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).UpdateRelayNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_UpdateRelayNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).UpdateRelayNetwork(ctx, req.(*sentry.RelayNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_DeleteRelayNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.RelayNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).DeleteRelayNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_DeleteRelayNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).DeleteRelayNetwork(ctx, req.(*sentry.RelayNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_GetRelayNetworkDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.RelayNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).GetRelayNetworkDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_GetRelayNetworkDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).GetRelayNetworkDownload(ctx, req.(*sentry.RelayNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BootstrapService_ServiceDesc is the grpc.ServiceDesc for BootstrapService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,6 +708,30 @@ var BootstrapService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBootstrapAgent",
 			Handler:    _BootstrapService_UpdateBootstrapAgent_Handler,
 		},
+		{
+			MethodName: "CreateRelayNetwork",
+			Handler:    _BootstrapService_CreateRelayNetwork_Handler,
+		},
+		{
+			MethodName: "GetRelayNetworks",
+			Handler:    _BootstrapService_GetRelayNetworks_Handler,
+		},
+		{
+			MethodName: "GetRelayNetwork",
+			Handler:    _BootstrapService_GetRelayNetwork_Handler,
+		},
+		{
+			MethodName: "UpdateRelayNetwork",
+			Handler:    _BootstrapService_UpdateRelayNetwork_Handler,
+		},
+		{
+			MethodName: "DeleteRelayNetwork",
+			Handler:    _BootstrapService_DeleteRelayNetwork_Handler,
+		},
+		{
+			MethodName: "GetRelayNetworkDownload",
+			Handler:    _BootstrapService_GetRelayNetworkDownload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/sentry/bootstrap.proto",
@@ -0,0 +1,146 @@
+//protobuf for uninstalling agents from clusters being deleted
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/sentry/cluster_decommission.proto
+
+package sentry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterDecommissionService_GetDecommission_FullMethodName = "/paralus.dev.sentry.rpc.ClusterDecommissionService/GetDecommission"
+	ClusterDecommissionService_AckDecommission_FullMethodName = "/paralus.dev.sentry.rpc.ClusterDecommissionService/AckDecommission"
+)
+
+// ClusterDecommissionServiceClient is the client API for ClusterDecommissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterDecommissionServiceClient interface {
+	GetDecommission(ctx context.Context, in *GetDecommissionRequest, opts ...grpc.CallOption) (*GetDecommissionResponse, error)
+	AckDecommission(ctx context.Context, in *AckDecommissionRequest, opts ...grpc.CallOption) (*AckDecommissionResponse, error)
+}
+
+type clusterDecommissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterDecommissionServiceClient(cc grpc.ClientConnInterface) ClusterDecommissionServiceClient {
+	return &clusterDecommissionServiceClient{cc}
+}
+
+func (c *clusterDecommissionServiceClient) GetDecommission(ctx context.Context, in *GetDecommissionRequest, opts ...grpc.CallOption) (*GetDecommissionResponse, error) {
+	out := new(GetDecommissionResponse)
+	err := c.cc.Invoke(ctx, ClusterDecommissionService_GetDecommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterDecommissionServiceClient) AckDecommission(ctx context.Context, in *AckDecommissionRequest, opts ...grpc.CallOption) (*AckDecommissionResponse, error) {
+	out := new(AckDecommissionResponse)
+	err := c.cc.Invoke(ctx, ClusterDecommissionService_AckDecommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterDecommissionServiceServer is the server API for ClusterDecommissionService service.
+// All implementations should embed UnimplementedClusterDecommissionServiceServer
+// for forward compatibility
+type ClusterDecommissionServiceServer interface {
+	GetDecommission(context.Context, *GetDecommissionRequest) (*GetDecommissionResponse, error)
+	AckDecommission(context.Context, *AckDecommissionRequest) (*AckDecommissionResponse, error)
+}
+
+// UnimplementedClusterDecommissionServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterDecommissionServiceServer struct {
+}
+
+func (UnimplementedClusterDecommissionServiceServer) GetDecommission(context.Context, *GetDecommissionRequest) (*GetDecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecommission not implemented")
+}
+func (UnimplementedClusterDecommissionServiceServer) AckDecommission(context.Context, *AckDecommissionRequest) (*AckDecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckDecommission not implemented")
+}
+
+// UnsafeClusterDecommissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterDecommissionServiceServer will
+// result in compilation errors.
+type UnsafeClusterDecommissionServiceServer interface {
+	mustEmbedUnimplementedClusterDecommissionServiceServer()
+}
+
+func RegisterClusterDecommissionServiceServer(s grpc.ServiceRegistrar, srv ClusterDecommissionServiceServer) {
+	s.RegisterService(&ClusterDecommissionService_ServiceDesc, srv)
+}
+
+func _ClusterDecommissionService_GetDecommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterDecommissionServiceServer).GetDecommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterDecommissionService_GetDecommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterDecommissionServiceServer).GetDecommission(ctx, req.(*GetDecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterDecommissionService_AckDecommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckDecommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterDecommissionServiceServer).AckDecommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterDecommissionService_AckDecommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterDecommissionServiceServer).AckDecommission(ctx, req.(*AckDecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterDecommissionService_ServiceDesc is the grpc.ServiceDesc for ClusterDecommissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterDecommissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.sentry.rpc.ClusterDecommissionService",
+	HandlerType: (*ClusterDecommissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDecommission",
+			Handler:    _ClusterDecommissionService_GetDecommission_Handler,
+		},
+		{
+			MethodName: "AckDecommission",
+			Handler:    _ClusterDecommissionService_AckDecommission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/sentry/cluster_decommission.proto",
+}
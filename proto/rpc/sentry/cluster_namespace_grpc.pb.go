@@ -0,0 +1,109 @@
+//protobuf for namespace inventory reported from clusters
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/sentry/cluster_namespace.proto
+
+package sentry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterNamespaceService_ReportNamespaces_FullMethodName = "/paralus.dev.sentry.rpc.ClusterNamespaceService/ReportNamespaces"
+)
+
+// ClusterNamespaceServiceClient is the client API for ClusterNamespaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterNamespaceServiceClient interface {
+	ReportNamespaces(ctx context.Context, in *ReportNamespacesRequest, opts ...grpc.CallOption) (*ReportNamespacesResponse, error)
+}
+
+type clusterNamespaceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterNamespaceServiceClient(cc grpc.ClientConnInterface) ClusterNamespaceServiceClient {
+	return &clusterNamespaceServiceClient{cc}
+}
+
+func (c *clusterNamespaceServiceClient) ReportNamespaces(ctx context.Context, in *ReportNamespacesRequest, opts ...grpc.CallOption) (*ReportNamespacesResponse, error) {
+	out := new(ReportNamespacesResponse)
+	err := c.cc.Invoke(ctx, ClusterNamespaceService_ReportNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterNamespaceServiceServer is the server API for ClusterNamespaceService service.
+// All implementations should embed UnimplementedClusterNamespaceServiceServer
+// for forward compatibility
+type ClusterNamespaceServiceServer interface {
+	ReportNamespaces(context.Context, *ReportNamespacesRequest) (*ReportNamespacesResponse, error)
+}
+
+// UnimplementedClusterNamespaceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterNamespaceServiceServer struct {
+}
+
+func (UnimplementedClusterNamespaceServiceServer) ReportNamespaces(context.Context, *ReportNamespacesRequest) (*ReportNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNamespaces not implemented")
+}
+
+// UnsafeClusterNamespaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterNamespaceServiceServer will
+// result in compilation errors.
+type UnsafeClusterNamespaceServiceServer interface {
+	mustEmbedUnimplementedClusterNamespaceServiceServer()
+}
+
+func RegisterClusterNamespaceServiceServer(s grpc.ServiceRegistrar, srv ClusterNamespaceServiceServer) {
+	s.RegisterService(&ClusterNamespaceService_ServiceDesc, srv)
+}
+
+func _ClusterNamespaceService_ReportNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterNamespaceServiceServer).ReportNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterNamespaceService_ReportNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterNamespaceServiceServer).ReportNamespaces(ctx, req.(*ReportNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterNamespaceService_ServiceDesc is the grpc.ServiceDesc for ClusterNamespaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterNamespaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.sentry.rpc.ClusterNamespaceService",
+	HandlerType: (*ClusterNamespaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportNamespaces",
+			Handler:    _ClusterNamespaceService_ReportNamespaces_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/sentry/cluster_namespace.proto",
+}
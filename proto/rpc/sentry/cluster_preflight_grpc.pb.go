@@ -0,0 +1,147 @@
+//protobuf for agents checking connectivity to the relays through the
+//configured proxy
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/sentry/cluster_preflight.proto
+
+package sentry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterPreflightService_GetPreflight_FullMethodName    = "/paralus.dev.sentry.rpc.ClusterPreflightService/GetPreflight"
+	ClusterPreflightService_ReportPreflight_FullMethodName = "/paralus.dev.sentry.rpc.ClusterPreflightService/ReportPreflight"
+)
+
+// ClusterPreflightServiceClient is the client API for ClusterPreflightService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterPreflightServiceClient interface {
+	GetPreflight(ctx context.Context, in *GetPreflightRequest, opts ...grpc.CallOption) (*GetPreflightResponse, error)
+	ReportPreflight(ctx context.Context, in *ReportPreflightRequest, opts ...grpc.CallOption) (*ReportPreflightResponse, error)
+}
+
+type clusterPreflightServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterPreflightServiceClient(cc grpc.ClientConnInterface) ClusterPreflightServiceClient {
+	return &clusterPreflightServiceClient{cc}
+}
+
+func (c *clusterPreflightServiceClient) GetPreflight(ctx context.Context, in *GetPreflightRequest, opts ...grpc.CallOption) (*GetPreflightResponse, error) {
+	out := new(GetPreflightResponse)
+	err := c.cc.Invoke(ctx, ClusterPreflightService_GetPreflight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterPreflightServiceClient) ReportPreflight(ctx context.Context, in *ReportPreflightRequest, opts ...grpc.CallOption) (*ReportPreflightResponse, error) {
+	out := new(ReportPreflightResponse)
+	err := c.cc.Invoke(ctx, ClusterPreflightService_ReportPreflight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterPreflightServiceServer is the server API for ClusterPreflightService service.
+// All implementations should embed UnimplementedClusterPreflightServiceServer
+// for forward compatibility
+type ClusterPreflightServiceServer interface {
+	GetPreflight(context.Context, *GetPreflightRequest) (*GetPreflightResponse, error)
+	ReportPreflight(context.Context, *ReportPreflightRequest) (*ReportPreflightResponse, error)
+}
+
+// UnimplementedClusterPreflightServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterPreflightServiceServer struct {
+}
+
+func (UnimplementedClusterPreflightServiceServer) GetPreflight(context.Context, *GetPreflightRequest) (*GetPreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreflight not implemented")
+}
+func (UnimplementedClusterPreflightServiceServer) ReportPreflight(context.Context, *ReportPreflightRequest) (*ReportPreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPreflight not implemented")
+}
+
+// UnsafeClusterPreflightServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterPreflightServiceServer will
+// result in compilation errors.
+type UnsafeClusterPreflightServiceServer interface {
+	mustEmbedUnimplementedClusterPreflightServiceServer()
+}
+
+func RegisterClusterPreflightServiceServer(s grpc.ServiceRegistrar, srv ClusterPreflightServiceServer) {
+	s.RegisterService(&ClusterPreflightService_ServiceDesc, srv)
+}
+
+func _ClusterPreflightService_GetPreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterPreflightServiceServer).GetPreflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterPreflightService_GetPreflight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterPreflightServiceServer).GetPreflight(ctx, req.(*GetPreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterPreflightService_ReportPreflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPreflightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterPreflightServiceServer).ReportPreflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterPreflightService_ReportPreflight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterPreflightServiceServer).ReportPreflight(ctx, req.(*ReportPreflightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterPreflightService_ServiceDesc is the grpc.ServiceDesc for ClusterPreflightService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterPreflightService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.sentry.rpc.ClusterPreflightService",
+	HandlerType: (*ClusterPreflightServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPreflight",
+			Handler:    _ClusterPreflightService_GetPreflight_Handler,
+		},
+		{
+			MethodName: "ReportPreflight",
+			Handler:    _ClusterPreflightService_ReportPreflight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/sentry/cluster_preflight.proto",
+}
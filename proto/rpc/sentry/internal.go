@@ -27,6 +27,9 @@ type SentryAuthorizationClient interface {
 	Close() error
 	ClusterAuthorizationServiceClient
 	AuditInformationServiceClient
+	ClusterNamespaceServiceClient
+	ClusterDecommissionServiceClient
+	ClusterPreflightServiceClient
 }
 
 type sentryClient struct {
@@ -42,6 +45,9 @@ type sentryAuthorizationClient struct {
 	*grpcpool.ClientConn
 	*clusterAuthorizationServiceClient
 	*auditInformationServiceClient
+	*clusterNamespaceServiceClient
+	*clusterDecommissionServiceClient
+	*clusterPreflightServiceClient
 }
 
 var _ SentryAuthorizationClient = (*sentryAuthorizationClient)(nil)
@@ -108,6 +114,9 @@ func (p *sentryAuthorizationPool) NewClient(ctx context.Context) (SentryAuthoriz
 		cc,
 		&clusterAuthorizationServiceClient{cc.ClientConn},
 		&auditInformationServiceClient{cc.ClientConn},
+		&clusterNamespaceServiceClient{cc.ClientConn},
+		&clusterDecommissionServiceClient{cc.ClientConn},
+		&clusterPreflightServiceClient{cc.ClientConn},
 	}, nil
 }
 
@@ -21,18 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_AuditLogWebhook_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook"
-	UserService_CreateUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/CreateUser"
-	UserService_GetUsers_FullMethodName             = "/paralus.dev.rpc.user.v3.UserService/GetUsers"
-	UserService_GetUser_FullMethodName              = "/paralus.dev.rpc.user.v3.UserService/GetUser"
-	UserService_GetUserInfo_FullMethodName          = "/paralus.dev.rpc.user.v3.UserService/GetUserInfo"
-	UserService_UpdateUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/UpdateUser"
-	UserService_UpdateUserForceReset_FullMethodName = "/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset"
-	UserService_DeleteUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/DeleteUser"
-	UserService_DownloadCliConfig_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/DownloadCliConfig"
-	UserService_UserListApiKeys_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/UserListApiKeys"
-	UserService_UserDeleteApiKeys_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/UserDeleteApiKeys"
-	UserService_UserForgotPassword_FullMethodName   = "/paralus.dev.rpc.user.v3.UserService/UserForgotPassword"
+	UserService_AuditLogWebhook_FullMethodName         = "/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook"
+	UserService_CreateUser_FullMethodName              = "/paralus.dev.rpc.user.v3.UserService/CreateUser"
+	UserService_GetUsers_FullMethodName                = "/paralus.dev.rpc.user.v3.UserService/GetUsers"
+	UserService_GetUser_FullMethodName                 = "/paralus.dev.rpc.user.v3.UserService/GetUser"
+	UserService_GetUserInfo_FullMethodName             = "/paralus.dev.rpc.user.v3.UserService/GetUserInfo"
+	UserService_UpdateUser_FullMethodName              = "/paralus.dev.rpc.user.v3.UserService/UpdateUser"
+	UserService_UpdateUserForceReset_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset"
+	UserService_DeleteUser_FullMethodName              = "/paralus.dev.rpc.user.v3.UserService/DeleteUser"
+	UserService_DownloadCliConfig_FullMethodName       = "/paralus.dev.rpc.user.v3.UserService/DownloadCliConfig"
+	UserService_StartCliLogin_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/StartCliLogin"
+	UserService_GetCliLoginVerification_FullMethodName = "/paralus.dev.rpc.user.v3.UserService/GetCliLoginVerification"
+	UserService_ApproveCliLogin_FullMethodName         = "/paralus.dev.rpc.user.v3.UserService/ApproveCliLogin"
+	UserService_GetCliLoginConfig_FullMethodName       = "/paralus.dev.rpc.user.v3.UserService/GetCliLoginConfig"
+	UserService_UserListApiKeys_FullMethodName         = "/paralus.dev.rpc.user.v3.UserService/UserListApiKeys"
+	UserService_UserDeleteApiKeys_FullMethodName       = "/paralus.dev.rpc.user.v3.UserService/UserDeleteApiKeys"
+	UserService_UserForgotPassword_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/UserForgotPassword"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -48,6 +52,10 @@ type UserServiceClient interface {
 	UpdateUserForceReset(ctx context.Context, in *UpdateForceResetRequest, opts ...grpc.CallOption) (*UpdateForceResetResponse, error)
 	DeleteUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(ctx context.Context, in *CliConfigRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
+	StartCliLogin(ctx context.Context, in *CliLoginRequest, opts ...grpc.CallOption) (*CliLoginResponse, error)
+	GetCliLoginVerification(ctx context.Context, in *CliLoginVerifyRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
+	ApproveCliLogin(ctx context.Context, in *CliLoginApproveRequest, opts ...grpc.CallOption) (*CliLoginApproveResponse, error)
+	GetCliLoginConfig(ctx context.Context, in *CliLoginConfigRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
 	UserListApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error)
 	UserDeleteApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(ctx context.Context, in *UserForgotPasswordRequest, opts ...grpc.CallOption) (*UserForgotPasswordResponse, error)
@@ -142,6 +150,42 @@ func (c *userServiceClient) DownloadCliConfig(ctx context.Context, in *CliConfig
 	return out, nil
 }
 
+func (c *userServiceClient) StartCliLogin(ctx context.Context, in *CliLoginRequest, opts ...grpc.CallOption) (*CliLoginResponse, error) {
+	out := new(CliLoginResponse)
+	err := c.cc.Invoke(ctx, UserService_StartCliLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCliLoginVerification(ctx context.Context, in *CliLoginVerifyRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, UserService_GetCliLoginVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ApproveCliLogin(ctx context.Context, in *CliLoginApproveRequest, opts ...grpc.CallOption) (*CliLoginApproveResponse, error) {
+	out := new(CliLoginApproveResponse)
+	err := c.cc.Invoke(ctx, UserService_ApproveCliLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCliLoginConfig(ctx context.Context, in *CliLoginConfigRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, UserService_GetCliLoginConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UserListApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error) {
 	out := new(UserListApiKeysResponse)
 	err := c.cc.Invoke(ctx, UserService_UserListApiKeys_FullMethodName, in, out, opts...)
@@ -182,6 +226,10 @@ type UserServiceServer interface {
 	UpdateUserForceReset(context.Context, *UpdateForceResetRequest) (*UpdateForceResetResponse, error)
 	DeleteUser(context.Context, *v3.User) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(context.Context, *CliConfigRequest) (*v31.HttpBody, error)
+	StartCliLogin(context.Context, *CliLoginRequest) (*CliLoginResponse, error)
+	GetCliLoginVerification(context.Context, *CliLoginVerifyRequest) (*v31.HttpBody, error)
+	ApproveCliLogin(context.Context, *CliLoginApproveRequest) (*CliLoginApproveResponse, error)
+	GetCliLoginConfig(context.Context, *CliLoginConfigRequest) (*v31.HttpBody, error)
 	UserListApiKeys(context.Context, *ApiKeyRequest) (*UserListApiKeysResponse, error)
 	UserDeleteApiKeys(context.Context, *ApiKeyRequest) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(context.Context, *UserForgotPasswordRequest) (*UserForgotPasswordResponse, error)
@@ -218,6 +266,18 @@ func (UnimplementedUserServiceServer) DeleteUser(context.Context, *v3.User) (*Us
 func (UnimplementedUserServiceServer) DownloadCliConfig(context.Context, *CliConfigRequest) (*v31.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadCliConfig not implemented")
 }
+func (UnimplementedUserServiceServer) StartCliLogin(context.Context, *CliLoginRequest) (*CliLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCliLogin not implemented")
+}
+func (UnimplementedUserServiceServer) GetCliLoginVerification(context.Context, *CliLoginVerifyRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCliLoginVerification not implemented")
+}
+func (UnimplementedUserServiceServer) ApproveCliLogin(context.Context, *CliLoginApproveRequest) (*CliLoginApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCliLogin not implemented")
+}
+func (UnimplementedUserServiceServer) GetCliLoginConfig(context.Context, *CliLoginConfigRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCliLoginConfig not implemented")
+}
 func (UnimplementedUserServiceServer) UserListApiKeys(context.Context, *ApiKeyRequest) (*UserListApiKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserListApiKeys not implemented")
 }
@@ -401,6 +461,78 @@ func _UserService_DownloadCliConfig_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StartCliLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CliLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartCliLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartCliLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartCliLogin(ctx, req.(*CliLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCliLoginVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CliLoginVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCliLoginVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCliLoginVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCliLoginVerification(ctx, req.(*CliLoginVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ApproveCliLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CliLoginApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveCliLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveCliLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveCliLogin(ctx, req.(*CliLoginApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCliLoginConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CliLoginConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCliLoginConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCliLoginConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCliLoginConfig(ctx, req.(*CliLoginConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UserListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApiKeyRequest)
 	if err := dec(in); err != nil {
@@ -498,6 +630,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadCliConfig",
 			Handler:    _UserService_DownloadCliConfig_Handler,
 		},
+		{
+			MethodName: "StartCliLogin",
+			Handler:    _UserService_StartCliLogin_Handler,
+		},
+		{
+			MethodName: "GetCliLoginVerification",
+			Handler:    _UserService_GetCliLoginVerification_Handler,
+		},
+		{
+			MethodName: "ApproveCliLogin",
+			Handler:    _UserService_ApproveCliLogin_Handler,
+		},
+		{
+			MethodName: "GetCliLoginConfig",
+			Handler:    _UserService_GetCliLoginConfig_Handler,
+		},
 		{
 			MethodName: "UserListApiKeys",
 			Handler:    _UserService_UserListApiKeys_Handler,
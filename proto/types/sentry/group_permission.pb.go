@@ -25,18 +25,19 @@ type GroupPermission struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupID        string           `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	ProjectID      string           `protobuf:"bytes,2,opt,name=projectID,proto3" json:"projectID,omitempty"`
-	OrganizationID string           `protobuf:"bytes,3,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
-	PartnerID      string           `protobuf:"bytes,4,opt,name=partnerID,proto3" json:"partnerID,omitempty"`
-	GroupName      string           `protobuf:"bytes,5,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	RoleName       string           `protobuf:"bytes,6,opt,name=roleName,proto3" json:"roleName,omitempty"`
-	IsGlobal       bool             `protobuf:"varint,7,opt,name=isGlobal,proto3" json:"isGlobal,omitempty"`
-	Scope          string           `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
-	PermissionName string           `protobuf:"bytes,9,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
-	BaseURL        string           `protobuf:"bytes,10,opt,name=baseURL,proto3" json:"baseURL,omitempty"`
-	Urls           []*PermissionURL `protobuf:"bytes,11,rep,name=urls,proto3" json:"urls,omitempty"`
-	ProjectName    string           `protobuf:"bytes,12,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	GroupID         string           `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	ProjectID       string           `protobuf:"bytes,2,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	OrganizationID  string           `protobuf:"bytes,3,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	PartnerID       string           `protobuf:"bytes,4,opt,name=partnerID,proto3" json:"partnerID,omitempty"`
+	GroupName       string           `protobuf:"bytes,5,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	RoleName        string           `protobuf:"bytes,6,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	IsGlobal        bool             `protobuf:"varint,7,opt,name=isGlobal,proto3" json:"isGlobal,omitempty"`
+	Scope           string           `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	PermissionName  string           `protobuf:"bytes,9,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	BaseURL         string           `protobuf:"bytes,10,opt,name=baseURL,proto3" json:"baseURL,omitempty"`
+	Urls            []*PermissionURL `protobuf:"bytes,11,rep,name=urls,proto3" json:"urls,omitempty"`
+	ProjectName     string           `protobuf:"bytes,12,opt,name=projectName,proto3" json:"projectName,omitempty"`
+	ClusterSelector string           `protobuf:"bytes,13,opt,name=clusterSelector,proto3" json:"clusterSelector,omitempty"`
 }
 
 func (x *GroupPermission) Reset() {
@@ -155,6 +156,13 @@ func (x *GroupPermission) GetProjectName() string {
 	return ""
 }
 
+func (x *GroupPermission) GetClusterSelector() string {
+	if x != nil {
+		return x.ClusterSelector
+	}
+	return ""
+}
+
 var File_proto_types_sentry_group_permission_proto protoreflect.FileDescriptor
 
 var file_proto_types_sentry_group_permission_proto_rawDesc = []byte{
@@ -165,7 +173,7 @@ var file_proto_types_sentry_group_permission_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x2b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x9c, 0x03, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d,
+	0x74, 0x6f, 0x22, 0xc6, 0x03, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
 	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
@@ -191,22 +199,24 @@ var file_proto_types_sentry_group_permission_proto_rawDesc = []byte{
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12,
 	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x42, 0xe7, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75,
-	0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2f, 0x70,
-	0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0xa2, 0x02, 0x04, 0x50, 0x44, 0x54, 0x53,
-	0xaa, 0x02, 0x18, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0xca, 0x02, 0x18, 0x50, 0x61,
-	0x72, 0x61, 0x6c, 0x75, 0x73, 0x5c, 0x44, 0x65, 0x76, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c,
-	0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0xe2, 0x02, 0x24, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73,
-	0x5c, 0x44, 0x65, 0x76, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x53, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x3a, 0x3a, 0x44, 0x65, 0x76, 0x3a, 0x3a, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x3a, 0x3a, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0xe7, 0x01, 0x0a, 0x1c,
+	0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0xa2, 0x02, 0x04, 0x50, 0x44, 0x54, 0x53, 0xaa, 0x02, 0x18, 0x50, 0x61, 0x72,
+	0x61, 0x6c, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0xca, 0x02, 0x18, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x5c,
+	0x44, 0x65, 0x76, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0xe2, 0x02, 0x24, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x5c, 0x44, 0x65, 0x76, 0x5c, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x5c, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75,
+	0x73, 0x3a, 0x3a, 0x44, 0x65, 0x76, 0x3a, 0x3a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x3a, 0x3a, 0x53,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -85,19 +85,84 @@ func (x *Lockout) GetAttempts() int32 {
 	return 0
 }
 
+type AuditRetention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SystemDays     int32 `protobuf:"varint,1,opt,name=system_days,json=systemDays,proto3" json:"system_days,omitempty"`
+	KubectlCmdDays int32 `protobuf:"varint,2,opt,name=kubectl_cmd_days,json=kubectlCmdDays,proto3" json:"kubectl_cmd_days,omitempty"`
+	KubectlApiDays int32 `protobuf:"varint,3,opt,name=kubectl_api_days,json=kubectlApiDays,proto3" json:"kubectl_api_days,omitempty"`
+}
+
+func (x *AuditRetention) Reset() {
+	*x = AuditRetention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRetention) ProtoMessage() {}
+
+func (x *AuditRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRetention.ProtoReflect.Descriptor instead.
+func (*AuditRetention) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditRetention) GetSystemDays() int32 {
+	if x != nil {
+		return x.SystemDays
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetKubectlCmdDays() int32 {
+	if x != nil {
+		return x.KubectlCmdDays
+	}
+	return 0
+}
+
+func (x *AuditRetention) GetKubectlApiDays() int32 {
+	if x != nil {
+		return x.KubectlApiDays
+	}
+	return 0
+}
+
 type OrganizationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lockout       *Lockout `protobuf:"bytes,1,opt,name=lockout,proto3" json:"lockout,omitempty"`
-	IdleLogoutMin int32    `protobuf:"varint,2,opt,name=idleLogoutMin,proto3" json:"idleLogoutMin,omitempty"`
+	Lockout                *Lockout        `protobuf:"bytes,1,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	IdleLogoutMin          int32           `protobuf:"varint,2,opt,name=idleLogoutMin,proto3" json:"idleLogoutMin,omitempty"`
+	RequireClusterApproval bool            `protobuf:"varint,3,opt,name=requireClusterApproval,proto3" json:"requireClusterApproval,omitempty"`
+	AuditRetention         *AuditRetention `protobuf:"bytes,4,opt,name=auditRetention,proto3" json:"auditRetention,omitempty"`
 }
 
 func (x *OrganizationSettings) Reset() {
 	*x = OrganizationSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[1]
+		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -110,7 +175,7 @@ func (x *OrganizationSettings) String() string {
 func (*OrganizationSettings) ProtoMessage() {}
 
 func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[1]
+	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +188,7 @@ func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationSettings.ProtoReflect.Descriptor instead.
 func (*OrganizationSettings) Descriptor() ([]byte, []int) {
-	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{1}
+	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OrganizationSettings) GetLockout() *Lockout {
@@ -140,6 +205,20 @@ func (x *OrganizationSettings) GetIdleLogoutMin() int32 {
 	return 0
 }
 
+func (x *OrganizationSettings) GetRequireClusterApproval() bool {
+	if x != nil {
+		return x.RequireClusterApproval
+	}
+	return false
+}
+
+func (x *OrganizationSettings) GetAuditRetention() *AuditRetention {
+	if x != nil {
+		return x.AuditRetention
+	}
+	return nil
+}
+
 type OrganizationSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -165,7 +244,7 @@ type OrganizationSpec struct {
 func (x *OrganizationSpec) Reset() {
 	*x = OrganizationSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[2]
+		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -178,7 +257,7 @@ func (x *OrganizationSpec) String() string {
 func (*OrganizationSpec) ProtoMessage() {}
 
 func (x *OrganizationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[2]
+	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +270,7 @@ func (x *OrganizationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationSpec.ProtoReflect.Descriptor instead.
 func (*OrganizationSpec) Descriptor() ([]byte, []int) {
-	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{2}
+	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OrganizationSpec) GetBillingAddress() string {
@@ -314,7 +393,7 @@ type Organization struct {
 func (x *Organization) Reset() {
 	*x = Organization{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[3]
+		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +406,7 @@ func (x *Organization) String() string {
 func (*Organization) ProtoMessage() {}
 
 func (x *Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[3]
+	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +419,7 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Organization.ProtoReflect.Descriptor instead.
 func (*Organization) Descriptor() ([]byte, []int) {
-	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{3}
+	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Organization) GetApiVersion() string {
@@ -392,7 +471,7 @@ type OrganizationList struct {
 func (x *OrganizationList) Reset() {
 	*x = OrganizationList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[4]
+		mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +484,7 @@ func (x *OrganizationList) String() string {
 func (*OrganizationList) ProtoMessage() {}
 
 func (x *OrganizationList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[4]
+	mi := &file_proto_types_systempb_v3_organization_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +497,7 @@ func (x *OrganizationList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationList.ProtoReflect.Descriptor instead.
 func (*OrganizationList) Descriptor() ([]byte, []int) {
-	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{4}
+	return file_proto_types_systempb_v3_organization_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *OrganizationList) GetApiVersion() string {
@@ -477,21 +556,69 @@ var file_proto_types_systempb_v3_organization_proto_rawDesc = []byte{
 	0x73, 0x32, 0x25, 0x4d, 0x61, 0x78, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
 	0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
 	0x20, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x14, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x07, 0x6c,
-	0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
-	0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f,
-	0x75, 0x74, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x2a, 0x07, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x32, 0x24, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12,
-	0x5b, 0x0a, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a, 0x13, 0x49, 0x64, 0x6c,
-	0x65, 0x20, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
-	0x32, 0x1b, 0x49, 0x64, 0x6c, 0x65, 0x20, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x69,
-	0x6d, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x69,
-	0x64, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x22, 0xa7, 0x09, 0x0a,
+	0x74, 0x73, 0x22, 0xa8, 0x03, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7a, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x59, 0x92, 0x41, 0x56, 0x2a,
+	0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x20, 0x44, 0x61, 0x79, 0x73, 0x32, 0x47, 0x44, 0x61,
+	0x79, 0x73, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x2c,
+	0x20, 0x30, 0x20, 0x75, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x8f, 0x01, 0x0a, 0x10, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x5f, 0x63, 0x6d,
+	0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x65, 0x92, 0x41,
+	0x62, 0x2a, 0x14, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x20, 0x44, 0x61, 0x79, 0x73, 0x32, 0x4a, 0x44, 0x61, 0x79, 0x73, 0x20, 0x6b, 0x75,
+	0x62, 0x65, 0x63, 0x74, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x2c, 0x20, 0x30,
+	0x20, 0x75, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x43, 0x6d, 0x64, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x5d,
+	0x92, 0x41, 0x5a, 0x2a, 0x10, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x20, 0x41, 0x50, 0x49,
+	0x20, 0x44, 0x61, 0x79, 0x73, 0x32, 0x46, 0x44, 0x61, 0x79, 0x73, 0x20, 0x6b, 0x75, 0x62, 0x65,
+	0x63, 0x74, 0x6c, 0x20, 0x61, 0x70, 0x69, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x2c, 0x20, 0x30, 0x20, 0x75, 0x73, 0x65, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x6b,
+	0x75, 0x62, 0x65, 0x63, 0x74, 0x6c, 0x41, 0x70, 0x69, 0x44, 0x61, 0x79, 0x73, 0x22, 0xbc, 0x04,
+	0x0a, 0x14, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75,
+	0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x32, 0x92,
+	0x41, 0x2f, 0x2a, 0x07, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x32, 0x24, 0x4c, 0x6f, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x5b, 0x0a, 0x0d, 0x69, 0x64,
+	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a, 0x13, 0x49, 0x64, 0x6c, 0x65, 0x20, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x20, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x32, 0x1b, 0x49, 0x64, 0x6c,
+	0x65, 0x20, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x69, 0x6e,
+	0x20, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x6d, 0x92, 0x41, 0x6a, 0x2a, 0x18, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x20, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x20, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x32, 0x4e, 0x4e, 0x65, 0x77, 0x6c, 0x79, 0x20, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x20, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0xaa, 0x01, 0x0a, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x55, 0x92, 0x41, 0x52, 0x2a, 0x0f, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x20, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x3f, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x20, 0x6c, 0x6f, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x0e, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x09, 0x0a,
 	0x10, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65,
 	0x63, 0x12, 0x61, 0x0a, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x36, 0x2a, 0x0f,
@@ -660,30 +787,32 @@ func file_proto_types_systempb_v3_organization_proto_rawDescGZIP() []byte {
 	return file_proto_types_systempb_v3_organization_proto_rawDescData
 }
 
-var file_proto_types_systempb_v3_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_types_systempb_v3_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_types_systempb_v3_organization_proto_goTypes = []interface{}{
 	(*Lockout)(nil),              // 0: paralus.dev.types.system.v3.Lockout
-	(*OrganizationSettings)(nil), // 1: paralus.dev.types.system.v3.OrganizationSettings
-	(*OrganizationSpec)(nil),     // 2: paralus.dev.types.system.v3.OrganizationSpec
-	(*Organization)(nil),         // 3: paralus.dev.types.system.v3.Organization
-	(*OrganizationList)(nil),     // 4: paralus.dev.types.system.v3.OrganizationList
-	(*v3.Metadata)(nil),          // 5: paralus.dev.types.common.v3.Metadata
-	(*v3.Status)(nil),            // 6: paralus.dev.types.common.v3.Status
-	(*v3.ListMetadata)(nil),      // 7: paralus.dev.types.common.v3.ListMetadata
+	(*AuditRetention)(nil),       // 1: paralus.dev.types.system.v3.AuditRetention
+	(*OrganizationSettings)(nil), // 2: paralus.dev.types.system.v3.OrganizationSettings
+	(*OrganizationSpec)(nil),     // 3: paralus.dev.types.system.v3.OrganizationSpec
+	(*Organization)(nil),         // 4: paralus.dev.types.system.v3.Organization
+	(*OrganizationList)(nil),     // 5: paralus.dev.types.system.v3.OrganizationList
+	(*v3.Metadata)(nil),          // 6: paralus.dev.types.common.v3.Metadata
+	(*v3.Status)(nil),            // 7: paralus.dev.types.common.v3.Status
+	(*v3.ListMetadata)(nil),      // 8: paralus.dev.types.common.v3.ListMetadata
 }
 var file_proto_types_systempb_v3_organization_proto_depIdxs = []int32{
 	0, // 0: paralus.dev.types.system.v3.OrganizationSettings.lockout:type_name -> paralus.dev.types.system.v3.Lockout
-	1, // 1: paralus.dev.types.system.v3.OrganizationSpec.settings:type_name -> paralus.dev.types.system.v3.OrganizationSettings
-	5, // 2: paralus.dev.types.system.v3.Organization.metadata:type_name -> paralus.dev.types.common.v3.Metadata
-	2, // 3: paralus.dev.types.system.v3.Organization.spec:type_name -> paralus.dev.types.system.v3.OrganizationSpec
-	6, // 4: paralus.dev.types.system.v3.Organization.status:type_name -> paralus.dev.types.common.v3.Status
-	7, // 5: paralus.dev.types.system.v3.OrganizationList.metadata:type_name -> paralus.dev.types.common.v3.ListMetadata
-	3, // 6: paralus.dev.types.system.v3.OrganizationList.items:type_name -> paralus.dev.types.system.v3.Organization
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1, // 1: paralus.dev.types.system.v3.OrganizationSettings.auditRetention:type_name -> paralus.dev.types.system.v3.AuditRetention
+	2, // 2: paralus.dev.types.system.v3.OrganizationSpec.settings:type_name -> paralus.dev.types.system.v3.OrganizationSettings
+	6, // 3: paralus.dev.types.system.v3.Organization.metadata:type_name -> paralus.dev.types.common.v3.Metadata
+	3, // 4: paralus.dev.types.system.v3.Organization.spec:type_name -> paralus.dev.types.system.v3.OrganizationSpec
+	7, // 5: paralus.dev.types.system.v3.Organization.status:type_name -> paralus.dev.types.common.v3.Status
+	8, // 6: paralus.dev.types.system.v3.OrganizationList.metadata:type_name -> paralus.dev.types.common.v3.ListMetadata
+	4, // 7: paralus.dev.types.system.v3.OrganizationList.items:type_name -> paralus.dev.types.system.v3.Organization
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_types_systempb_v3_organization_proto_init() }
@@ -705,7 +834,7 @@ func file_proto_types_systempb_v3_organization_proto_init() {
 			}
 		}
 		file_proto_types_systempb_v3_organization_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrganizationSettings); i {
+			switch v := v.(*AuditRetention); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -717,7 +846,7 @@ func file_proto_types_systempb_v3_organization_proto_init() {
 			}
 		}
 		file_proto_types_systempb_v3_organization_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrganizationSpec); i {
+			switch v := v.(*OrganizationSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -729,7 +858,7 @@ func file_proto_types_systempb_v3_organization_proto_init() {
 			}
 		}
 		file_proto_types_systempb_v3_organization_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organization); i {
+			switch v := v.(*OrganizationSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -741,6 +870,18 @@ func file_proto_types_systempb_v3_organization_proto_init() {
 			}
 		}
 		file_proto_types_systempb_v3_organization_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_organization_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrganizationList); i {
 			case 0:
 				return &v.state
@@ -759,7 +900,7 @@ func file_proto_types_systempb_v3_organization_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_types_systempb_v3_organization_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
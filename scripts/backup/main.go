@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	kclient "github.com/ory/kratos-client-go"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/backup"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/enforcer"
+	"github.com/paralus/paralus/pkg/service"
+	"github.com/spf13/viper"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// This script takes a backup of an organization into a signed archive
+// and restores it, possibly into a fresh deployment:
+//
+//	paralus-backup export -partner <partner> -org <org> -out org.backup
+//	paralus-backup import -in org.backup
+//
+// The archive is signed with a key derived from BOOTSTRAP_KEK and the
+// same KEK is required to import it. Secrets are encrypted under the KEK
+// unless -encrypt-secrets=false is passed.
+
+const (
+	dbDSNEnv        = "DSN"
+	dbAddrEnv       = "DB_ADDR"
+	dbNameEnv       = "DB_NAME"
+	dbUserEnv       = "DB_USER"
+	dbPasswordEnv   = "DB_PASSWORD"
+	kratosAddrEnv   = "KRATOS_ADDR"
+	auditFileEnv    = "AUDIT_LOG_FILE"
+	bootstrapKEKEnv = "BOOTSTRAP_KEK"
+	apiAddrEnv      = "API_ADDR"
+	relayImageEnv   = "RELAY_IMAGE"
+)
+
+func usage() {
+	fmt.Println("Usage: backup export -partner <partner> -org <org> -out <file> [-encrypt-secrets=false]")
+	fmt.Println("       backup import -in <file> [-partner <partner>] [-org <org>]")
+	os.Exit(1)
+}
+
+func services(debug bool) *backup.Services {
+	viper.SetDefault(dbAddrEnv, "localhost:5432")
+	viper.SetDefault(dbNameEnv, "admindb")
+	viper.SetDefault(dbUserEnv, "admindbuser")
+	viper.SetDefault(dbPasswordEnv, "admindbpassword")
+	viper.SetDefault(kratosAddrEnv, "http://localhost:4434")
+	viper.SetDefault(auditFileEnv, "audit.log")
+	viper.SetDefault(apiAddrEnv, "localhost:11000")
+	viper.SetDefault(relayImageEnv, "paralusio/relay:v0.1.0")
+
+	viper.BindEnv(auditFileEnv)
+	viper.BindEnv(dbDSNEnv)
+	viper.BindEnv(dbAddrEnv)
+	viper.BindEnv(dbNameEnv)
+	viper.BindEnv(dbUserEnv)
+	viper.BindEnv(dbPasswordEnv)
+	viper.BindEnv(kratosAddrEnv)
+	viper.BindEnv(apiAddrEnv)
+	viper.BindEnv(relayImageEnv)
+
+	dbDSN := viper.GetString(dbDSNEnv)
+	dbAddr := viper.GetString(dbAddrEnv)
+	dbName := viper.GetString(dbNameEnv)
+	dbUser := viper.GetString(dbUserEnv)
+	dbPassword := viper.GetString(dbPasswordEnv)
+	kratosAddr := viper.GetString(kratosAddrEnv)
+	auditFile := viper.GetString(auditFileEnv)
+	apiAddr := viper.GetString(apiAddrEnv)
+	relayImage := viper.GetString(relayImageEnv)
+
+	if dbDSN == "" {
+		dbDSN = fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", dbUser, dbPassword, dbAddr, dbName)
+	}
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dbDSN)))
+	db := bun.NewDB(sqldb, pgdialect.New())
+
+	if debug {
+		db.AddQueryHook(bundebug.NewQueryHook(
+			bundebug.WithVerbose(true),
+			bundebug.FromEnv("BUNDEBUG"),
+		))
+	}
+
+	kratosConfig := kclient.NewConfiguration()
+	kratosConfig.Servers[0].URL = kratosAddr
+	kc := kclient.NewAPIClient(kratosConfig)
+
+	ao := audit.AuditOptions{
+		LogPath:    auditFile,
+		MaxSizeMB:  1,
+		MaxBackups: 10,
+		MaxAgeDays: 10,
+	}
+	auditLogger := audit.GetAuditLogger(&ao)
+
+	gormDb, err := gorm.Open(postgres.Open(dbDSN), &gorm.Config{})
+	if err != nil {
+		log.Fatal("unable to create db connection", "error", err)
+	}
+	enforcer, err := enforcer.NewCasbinEnforcer(gormDb).Init()
+	if err != nil {
+		log.Fatal("unable to init enforcer", "error", err)
+	}
+	as := service.NewAuthzService(db, enforcer)
+	bs := service.NewBootstrapService(db)
+
+	return &backup.Services{
+		Partner:           service.NewPartnerService(db, auditLogger),
+		Organization:      service.NewOrganizationService(db, auditLogger),
+		Project:           service.NewProjectService(db, as, auditLogger, true),
+		Role:              service.NewRoleService(db, as, auditLogger),
+		Group:             service.NewGroupService(db, as, auditLogger),
+		User:              service.NewUserService(providers.NewKratosAuthProvider(kc), db, as, nil, common.CliConfigDownloadData{}, auditLogger, true),
+		Idp:               service.NewIdpService(db, apiAddr, auditLogger),
+		OIDCProvider:      service.NewOIDCProviderService(db, kratosAddr, auditLogger),
+		KubeconfigSetting: service.NewKubeconfigSettingService(db),
+		Cluster: service.NewClusterService(db, &common.DownloadData{
+			APIAddr:         apiAddr,
+			RelayAgentImage: relayImage,
+		}, bs, as, auditLogger),
+		Bootstrap: bs,
+		Authz:     as,
+	}
+}
+
+func kek() []byte {
+	viper.SetDefault(bootstrapKEKEnv, "paralus")
+	viper.BindEnv(bootstrapKEKEnv)
+	return []byte(viper.GetString(bootstrapKEKEnv))
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "export":
+		fs := flag.NewFlagSet("export", flag.ExitOnError)
+		partner := fs.String("partner", "DefaultPartner", "Name of partner")
+		org := fs.String("org", "DefaultOrg", "Name of org")
+		out := fs.String("out", "", "File to write the archive to")
+		encrypt := fs.Bool("encrypt-secrets", true, "Encrypt secrets in the archive under the KEK")
+		debug := fs.Bool("debug", false, "Enable verbose mode")
+		fs.Parse(os.Args[2:])
+		if *out == "" {
+			usage()
+		}
+
+		a, err := backup.Export(context.Background(), services(*debug), *partner, *org)
+		if err != nil {
+			log.Fatal("unable to export organization: ", err)
+		}
+		b, err := backup.Encode(a, kek(), *encrypt)
+		if err != nil {
+			log.Fatal("unable to encode archive: ", err)
+		}
+		if err := ioutil.WriteFile(*out, b, 0600); err != nil {
+			log.Fatal("unable to write archive: ", err)
+		}
+		fmt.Printf("Exported organization %s: %d projects, %d groups, %d users, %d clusters\n",
+			*org, len(a.Projects), len(a.Groups), len(a.Users), len(a.Clusters))
+
+	case "import":
+		fs := flag.NewFlagSet("import", flag.ExitOnError)
+		in := fs.String("in", "", "File to read the archive from")
+		partner := fs.String("partner", "", "Restore under this partner instead of the one in archive")
+		org := fs.String("org", "", "Restore as this org instead of the one in archive")
+		debug := fs.Bool("debug", false, "Enable verbose mode")
+		fs.Parse(os.Args[2:])
+		if *in == "" {
+			usage()
+		}
+
+		b, err := ioutil.ReadFile(*in)
+		if err != nil {
+			log.Fatal("unable to read archive: ", err)
+		}
+		a, err := backup.Decode(b, kek())
+		if err != nil {
+			log.Fatal("unable to decode archive: ", err)
+		}
+		report, err := backup.Restore(context.Background(), services(*debug), a, backup.RestoreOptions{
+			Partner:      *partner,
+			Organization: *org,
+		})
+		if report != nil {
+			out, _ := json.MarshalIndent(report, "", "  ")
+			fmt.Println(string(out))
+		}
+		if err != nil {
+			log.Fatal("unable to restore organization: ", err)
+		}
+		fmt.Println("Users of the restored organization have to reset their passwords")
+
+	default:
+		usage()
+	}
+}
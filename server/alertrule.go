@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	v1 "github.com/paralus/paralus/proto/rpc/audit"
+	auditv1 "github.com/paralus/paralus/proto/types/audit"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+type alertRuleServer struct {
+	ars service.AlertRuleService
+}
+
+// NewAlertRuleServer returns new audit alert rule server
+func NewAlertRuleServer(ars service.AlertRuleService) v1.AlertRuleServiceServer {
+	return &alertRuleServer{ars: ars}
+}
+
+func (s *alertRuleServer) CreateAlertRule(ctx context.Context, in *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	return s.ars.Create(ctx, in)
+}
+
+func (s *alertRuleServer) GetAlertRules(ctx context.Context, in *commonv3.QueryOptions) (*auditv1.AlertRuleList, error) {
+	return s.ars.List(ctx, query.WithOptions(in))
+}
+
+func (s *alertRuleServer) GetAlertRule(ctx context.Context, in *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	return s.ars.Get(ctx, in)
+}
+
+func (s *alertRuleServer) UpdateAlertRule(ctx context.Context, in *auditv1.AlertRule) (*auditv1.AlertRule, error) {
+	return s.ars.Update(ctx, in)
+}
+
+func (s *alertRuleServer) DeleteAlertRule(ctx context.Context, in *auditv1.AlertRule) (*v1.DeleteAlertRuleResponse, error) {
+	if err := s.ars.Delete(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteAlertRuleResponse{}, nil
+}
+
+func (s *alertRuleServer) TestAlertRule(ctx context.Context, in *v1.AlertRuleTestRequest) (*v1.AlertRuleTestResponse, error) {
+	return s.ars.Test(ctx, in)
+}
@@ -29,7 +29,7 @@ func (s *auditInfoServer) LookupUser(ctx context.Context, req *sentryrpc.LookupU
 
 	attrs := kubeconfig.GetCNAttributes(req.UserCN)
 
-	_log.Infow("lookupUser", "attrs", attrs)
+	_log.WithContext(ctx).Infow("lookupUser", "attrs", attrs)
 
 	if attrs.SystemUser {
 		return &sentryrpc.LookupUserResponse{
@@ -79,17 +79,17 @@ func (s *auditInfoServer) LookupCluster(ctx context.Context, req *sentryrpc.Look
 
 	ba, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Labels["paralus.dev/connectorAgentTemplate"], query.WithName(clusterID), query.WithIgnoreScopeDefault(), query.WithDeleted())
 	if err != nil {
-		_log.Infow("unable to get bootstrap agent", "req", req, "error", err)
+		_log.WithContext(ctx).Infow("unable to get bootstrap agent", "req", req, "error", err)
 		return nil, err
 	}
 
 	project, err := s.prs.GetByID(ctx, ba.Metadata.Project)
 	if err != nil {
-		_log.Warnw("unable to get project name", "id", ba.Metadata.Project, "error", err)
+		_log.WithContext(ctx).Warnw("unable to get project name", "id", ba.Metadata.Project, "error", err)
 		return nil, err
 	}
 
-	_log.Infow("project name in lookup cluster", "project", project.Metadata.Name)
+	_log.WithContext(ctx).Infow("project name in lookup cluster", "project", project.Metadata.Name)
 
 	return &sentryrpc.LookupClusterResponse{
 		Name:    ba.Metadata.Labels["paralus.dev/clusterName"],
@@ -9,13 +9,14 @@ import (
 
 type auditLogServer struct {
 	as q.AuditLogService
+	ac q.AuditChainService
 }
 
 var _ v1.AuditLogServiceServer = (*auditLogServer)(nil)
 
 // NewAuditServer returns new placement server implementation
-func NewAuditLogServer(auditLogService q.AuditLogService) (v1.AuditLogServiceServer, error) {
-	return &auditLogServer{as: auditLogService}, nil
+func NewAuditLogServer(auditLogService q.AuditLogService, auditChainService q.AuditChainService) (v1.AuditLogServiceServer, error) {
+	return &auditLogServer{as: auditLogService, ac: auditChainService}, nil
 }
 
 func (a *auditLogServer) GetAuditLog(ctx context.Context, req *v1.GetAuditLogSearchRequest) (res *v1.GetAuditLogSearchResponse, err error) {
@@ -25,3 +26,7 @@ func (a *auditLogServer) GetAuditLog(ctx context.Context, req *v1.GetAuditLogSea
 func (a *auditLogServer) GetAuditLogByProjects(ctx context.Context, req *v1.GetAuditLogSearchRequest) (res *v1.GetAuditLogSearchResponse, err error) {
 	return a.as.GetAuditLogByProjects(ctx, req)
 }
+
+func (a *auditLogServer) VerifyAuditLog(ctx context.Context, req *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error) {
+	return a.ac.Verify(ctx, req)
+}
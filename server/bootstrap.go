@@ -3,8 +3,14 @@ package server
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/audit"
+	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/service"
 
 	"github.com/paralus/paralus/pkg/gateway"
@@ -14,7 +20,10 @@ import (
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -24,6 +33,10 @@ import (
 
 var _log = log.GetLogger()
 
+// errBootstrapTemplateMismatch is returned when an agent registers a token
+// of another template
+var errBootstrapTemplateMismatch = errors.New("bootstrap token cannot be registered for template")
+
 type RelayNetworkDownloadData struct {
 	SentryAddr     string
 	PeerHost       string
@@ -50,6 +63,9 @@ type bootstrapServer struct {
 	bs       service.BootstrapService
 	passFunc cryptoutil.PasswordFunc
 	cs       service.ClusterService
+	rns      service.RelayNetworkService
+	dd       common.DownloadData
+	al       *zap.Logger
 }
 
 var _ sentryrpc.BootstrapServiceServer = (*bootstrapServer)(nil)
@@ -141,13 +157,18 @@ func (s *bootstrapServer) UpdateBootstrapAgent(ctx context.Context, in *sentry.B
 }
 
 func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentryrpc.RegisterAgentRequest) (resp *sentryrpc.RegisterAgentResponse, err error) {
-	_log.Infow("received agent register", "request", *in)
+	_log.WithContext(ctx).Infow("received agent register", "request", *in)
 
 	resp = &sentryrpc.RegisterAgentResponse{}
 
+	var agent *sentry.BootstrapAgent
+	defer func() {
+		s.auditRegistration(ctx, in, agent, err)
+	}()
+
 	token, err := util.GetTemplateScope(in.TemplateToken)
 	if err != nil {
-		_log.Error(err.Error())
+		_log.WithContext(ctx).Error(err.Error())
 		return
 	}
 
@@ -156,20 +177,20 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 	if (token == "-" || token == "cd-relay") && !gateway.IsGatewayRequest(ctx) {
 		template, err = s.bs.GetBootstrapAgentTemplate(ctx, in.TemplateName)
 		if err != nil {
-			_log.Error(err.Error())
+			_log.WithContext(ctx).Error(err.Error())
 			return
 		}
 	} else {
 		template, err = s.bs.GetBootstrapAgentTemplateForToken(ctx, token)
 		if err != nil {
-			_log.Error(err.Error())
+			_log.WithContext(ctx).Error(err.Error())
 			return
 		}
 	}
 
 	infra, err := s.bs.GetBootstrapInfra(ctx, template.Spec.InfraRef)
 	if err != nil {
-		_log.Error(err.Error())
+		_log.WithContext(ctx).Error(err.Error())
 		return
 	}
 
@@ -196,17 +217,16 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 
 	signer, err = cryptoutil.NewSigner([]byte(infra.Spec.CaCert), []byte(infra.Spec.CaKey), opts...)
 	if err != nil {
-		_log.Errorw("error getting cert signer", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error getting cert signer", "error", err.Error())
 		return
 	}
 
 	signed, err := signer.Sign(in.Csr)
 	if err != nil {
-		_log.Error(err.Error())
+		_log.WithContext(ctx).Error(err.Error())
 		return
 	}
 
-	var agent *sentry.BootstrapAgent
 	agent, err = s.bs.GetBootstrapAgentForToken(ctx, in.Token)
 
 	// if agent is not found and template has auto register
@@ -221,26 +241,26 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 
 		err = s.bs.CreateBootstrapAgent(ctx, agent)
 		if err != nil {
-			_log.Error(err.Error())
+			_log.WithContext(ctx).Error(err.Error())
 			return
 		}
 	} else {
 		if err != nil {
 			//agent is nil
-			_log.Error(err.Error())
+			_log.WithContext(ctx).Error(err.Error())
 			return
 		}
 	}
 
 	if agent.Spec.TemplateRef != template.Metadata.Name {
-		err = fmt.Errorf("token %s cannot be registered for template %s", in.Token, in.TemplateToken)
-		_log.Error(err.Error())
+		err = errBootstrapTemplateMismatch
+		_log.WithContext(ctx).Errorw(err.Error(), "agent", in.Name, "template", template.Metadata.Name)
 		return
 	}
 
 	err = s.bs.RegisterBootstrapAgent(ctx, in.Token, in.IpAddress, in.Fingerprint)
 	if err != nil {
-		_log.Error(err.Error())
+		_log.WithContext(ctx).Error(err.Error())
 		return
 	}
 
@@ -248,13 +268,110 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 	resp.CaCertificate = []byte(infra.Spec.CaCert)
 
 	if template.Metadata.Name == "paralus-core-relay-agent" {
-		_log.Info("updating cluster status for :: ", agent.Metadata.Name)
+		_log.WithContext(ctx).Info("updating cluster status for :: ", agent.Metadata.Name)
 		err = s.updateClusterStatus(ctx, agent.Metadata.Name, agent.Metadata.Project)
 	}
 
 	return
 }
 
+// auditRegistration records the registration attempt of an agent along
+// with the address it came from, the token is not recorded
+func (s *bootstrapServer) auditRegistration(ctx context.Context, in *sentryrpc.RegisterAgentRequest, agent *sentry.BootstrapAgent, err error) {
+	ip := registrationSourceIP(ctx)
+	detail := &audit.EventDetail{
+		Meta: map[string]string{
+			"agent_name":  in.Name,
+			"template":    in.TemplateName,
+			"source_ip":   ip,
+			"reported_ip": in.IpAddress,
+			"fingerprint": in.Fingerprint,
+		},
+	}
+	var project, org string
+	if agent != nil && agent.Metadata != nil {
+		detail.Meta["agent_id"] = agent.Metadata.Name
+		detail.Meta["template"] = agent.Spec.GetTemplateRef()
+		project = agent.Metadata.Project
+		org = agent.Metadata.Organization
+	}
+
+	eventType := "bootstrap.agent.register.success"
+	detail.Message = fmt.Sprintf("Agent %s registered from %s", in.Name, ip)
+	if err != nil {
+		eventType = "bootstrap.agent.register.failure"
+		detail.Message = fmt.Sprintf("Agent %s registration from %s rejected", in.Name, ip)
+		detail.Meta["reason"] = registrationFailureReason(err)
+	}
+
+	event := &audit.Event{
+		Type:   eventType,
+		Portal: "OPS",
+		Actor: &audit.EventActor{
+			Type:    "AGENT",
+			Account: audit.EventActorAccount{Username: in.Name},
+		},
+		Client: &audit.EventClient{
+			Type:      "AGENT",
+			IP:        ip,
+			UserAgent: "-",
+			Host:      "-",
+		},
+		Detail: detail,
+	}
+	audit.CreateEvent(s.al, event,
+		audit.WithVersion(audit.VersionV1),
+		audit.WithCategory(audit.AuditCategory),
+		audit.WithOrigin(audit.OriginCluster),
+		audit.WithProject(project),
+		audit.WithOrganization(org),
+		audit.WithContext(ctx),
+	)
+}
+
+// registrationSourceIP returns the address the registering agent
+// connected from. Requests through the gateway come from loopback, for
+// them the address seen by the gateway is used, the last hop it appends
+// to x-forwarded-for, as the hops before it are set by the client.
+func registrationSourceIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "-"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ips := md.Get("x-forwarded-for"); len(ips) > 0 {
+			hops := strings.Split(ips[len(ips)-1], ",")
+			return strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	return host
+}
+
+// registrationFailureReason returns the reason code recorded for a failed
+// registration, errors may carry the token and are not recorded
+func registrationFailureReason(err error) string {
+	switch {
+	case errors.Is(err, dao.ErrBootstrapTokenExpired):
+		return "token_expired"
+	case errors.Is(err, dao.ErrBootstrapFingerprintMismatch):
+		return "fingerprint_mismatch"
+	case errors.Is(err, dao.ErrBootstrapTokenUsed):
+		return "token_used"
+	case errors.Is(err, errBootstrapTemplateMismatch):
+		return "template_mismatch"
+	case errors.Is(err, sql.ErrNoRows):
+		return "not_found"
+	}
+	return "registration_failed"
+}
+
 func (s *bootstrapServer) updateClusterStatus(ctx context.Context, clusterID, projectID string) error {
 	cluster := &infrav3.Cluster{
 		Metadata: &commonv3.Metadata{
@@ -296,6 +413,6 @@ func (s *bootstrapServer) GetBootstrapAgentConfig(ctx context.Context, in *sentr
 }
 
 // NewBootstrapServer return new bootstrap server
-func NewBootstrapServer(bs service.BootstrapService, f cryptoutil.PasswordFunc, cs service.ClusterService) sentryrpc.BootstrapServiceServer {
-	return &bootstrapServer{bs, f, cs}
+func NewBootstrapServer(bs service.BootstrapService, f cryptoutil.PasswordFunc, cs service.ClusterService, rns service.RelayNetworkService, data *common.DownloadData, al *zap.Logger) sentryrpc.BootstrapServiceServer {
+	return &bootstrapServer{bs, f, cs, rns, *data, al}
 }
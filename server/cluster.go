@@ -1,11 +1,9 @@
 package server
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
 
-	"github.com/paralus/paralus/internal/cluster/fixtures"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/service"
@@ -13,18 +11,23 @@ import (
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrapbv3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/paralus/paralus/proto/types/scheduler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type clusterServer struct {
 	service.ClusterService
+	ns           service.NamespaceService
 	downloadData common.DownloadData
 }
 
 // NewClusterServer returns new cluster server implementation
-func NewClusterServer(es service.ClusterService, data *common.DownloadData) rpcv3.ClusterServiceServer {
+func NewClusterServer(es service.ClusterService, ns service.NamespaceService, data *common.DownloadData) rpcv3.ClusterServiceServer {
 	return &clusterServer{
 		ClusterService: es,
+		ns:             ns,
 		downloadData:   *data,
 	}
 }
@@ -74,31 +77,86 @@ func (s *clusterServer) DownloadCluster(ctx context.Context, cluster *infrapbv3.
 	if err != nil {
 		return nil, err
 	}
-	bb := new(bytes.Buffer)
-
-	if c.Spec.ProxyConfig != nil {
-		if c.Spec.ProxyConfig.BootstrapCA != "" {
-			c.Spec.ProxyConfig.BootstrapCA = base64.StdEncoding.EncodeToString([]byte(c.Spec.ProxyConfig.BootstrapCA))
-		}
+	contentType, b, err := clstrutil.GetClusterPackage(ctx, &s.downloadData, c, clstrutil.PackageFormatYAML)
+	if err != nil {
+		return nil, err
 	}
 
-	err = fixtures.DownloadTemplate.Execute(bb, struct {
-		DownloadData common.DownloadData
-		Cluster      *infrapbv3.Cluster
-	}{
-		s.downloadData, c,
-	})
+	return &commonv3.HttpBody{
+		ContentType: contentType,
+		Data:        b,
+	}, nil
+}
 
+func (s *clusterServer) DownloadClusterPackage(ctx context.Context, req *rpcv3.DownloadClusterPackageRequest) (*commonv3.HttpBody, error) {
+	switch req.Format {
+	case clstrutil.PackageFormatYAML, clstrutil.PackageFormatHelm, clstrutil.PackageFormatKustomize:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid package format %q, must be one of %s, %s or %s",
+			req.Format, clstrutil.PackageFormatYAML, clstrutil.PackageFormatHelm, clstrutil.PackageFormatKustomize)
+	}
+	c, err := s.Select(ctx, &infrapbv3.Cluster{Metadata: req.Metadata}, true)
+	if err != nil {
+		return nil, err
+	}
+	contentType, b, err := clstrutil.GetClusterPackage(ctx, &s.downloadData, c, req.Format)
 	if err != nil {
 		return nil, err
 	}
 
 	return &commonv3.HttpBody{
-		ContentType: "application/x-paralus-yaml",
-		Data:        bb.Bytes(),
+		ContentType: contentType,
+		Data:        b,
 	}, nil
 }
 
+func (s *clusterServer) ResetClusterRegistration(ctx context.Context, cluster *infrapbv3.Cluster) (*infrapbv3.Cluster, error) {
+	resp, err := s.ClusterService.ResetClusterRegistration(ctx, cluster)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) RotateClusterToken(ctx context.Context, cluster *infrapbv3.Cluster) (*infrapbv3.Cluster, error) {
+	resp, err := s.ClusterService.RotateClusterToken(ctx, cluster)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) RevokeClusterSessions(ctx context.Context, cluster *infrapbv3.Cluster) (*infrapbv3.Cluster, error) {
+	resp, err := s.ClusterService.RevokeClusterSessions(ctx, cluster)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) ApproveCluster(ctx context.Context, req *rpcv3.ClusterApprovalRequest) (*infrapbv3.Cluster, error) {
+	cluster := &infrapbv3.Cluster{Metadata: req.Metadata}
+	resp, err := s.ClusterService.ApproveCluster(ctx, cluster, req.Reason)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) RejectCluster(ctx context.Context, req *rpcv3.ClusterApprovalRequest) (*infrapbv3.Cluster, error) {
+	cluster := &infrapbv3.Cluster{Metadata: req.Metadata}
+	resp, err := s.ClusterService.RejectCluster(ctx, cluster, req.Reason)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) ShareCluster(ctx context.Context, req *rpcv3.ShareClusterRequest) (*infrapbv3.Cluster, error) {
+	cluster := &infrapbv3.Cluster{Metadata: req.Metadata}
+	resp, err := s.ClusterService.ShareCluster(ctx, cluster, req.Project, req.Access, req.Namespaces)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) UnshareCluster(ctx context.Context, req *rpcv3.UnshareClusterRequest) (*infrapbv3.Cluster, error) {
+	cluster := &infrapbv3.Cluster{Metadata: req.Metadata}
+	resp, err := s.ClusterService.UnshareCluster(ctx, cluster, req.Project)
+	return updateClusterStatus(cluster, resp, err), err
+}
+
+func (s *clusterServer) GetClusterNamespaces(ctx context.Context, cluster *infrapbv3.Cluster) (*scheduler.ClusterNamespaceList, error) {
+	return s.ns.ListClusterNamespaces(ctx, query.WithMeta(cluster.GetMetadata()))
+}
+
+func (s *clusterServer) GetProjectNamespaces(ctx context.Context, req *commonv3.QueryOptions) (*scheduler.ClusterNamespaceList, error) {
+	return s.ns.ListClusterNamespaces(ctx, query.WithOptions(req))
+}
+
 func (s *clusterServer) UpdateClusterStatus(ctx context.Context, cluster *infrapbv3.Cluster) (*infrapbv3.Cluster, error) {
 	err := s.UpdateClusterConditionStatus(ctx, cluster)
 	if err != nil {
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/sentry/authz"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+)
+
+type clusterDecommissionServer struct {
+	bs service.BootstrapService
+	cs service.ClusterService
+}
+
+var _ sentryrpc.ClusterDecommissionServiceServer = (*clusterDecommissionServer)(nil)
+
+// NewClusterDecommissionServer returns new server for agents uninstalling
+// themselves from clusters being deleted
+func NewClusterDecommissionServer(bs service.BootstrapService, cs service.ClusterService) sentryrpc.ClusterDecommissionServiceServer {
+	return &clusterDecommissionServer{bs: bs, cs: cs}
+}
+
+// GetDecommission returns the steps uninstalling the agent once the
+// cluster is being deleted
+func (s *clusterDecommissionServer) GetDecommission(ctx context.Context, req *sentryrpc.GetDecommissionRequest) (*sentryrpc.GetDecommissionResponse, error) {
+	clusterID, err := peerClusterID(ctx, s.bs, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.cs.IsClusterDecommissioning(ctx, clusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error checking cluster decommission", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	if !ok {
+		return &sentryrpc.GetDecommissionResponse{}, nil
+	}
+	users, namespaces, err := s.cs.GetClusterKubectlUsers(ctx, clusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error getting cluster kubectl users", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	steps, err := authz.GetUninstallSteps(users, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	return &sentryrpc.GetDecommissionResponse{
+		Decommission: true,
+		Steps:        steps,
+		Selector:     authz.UninstallSelector,
+	}, nil
+}
+
+// AckDecommission records the result of the agent uninstalling itself
+func (s *clusterDecommissionServer) AckDecommission(ctx context.Context, req *sentryrpc.AckDecommissionRequest) (*sentryrpc.AckDecommissionResponse, error) {
+	clusterID, err := peerClusterID(ctx, s.bs, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.cs.AckClusterDecommission(ctx, clusterID, req.Success, req.Reason)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error acknowledging cluster decommission", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	return &sentryrpc.AckDecommissionResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+)
+
+type clusterNamespaceServer struct {
+	bs service.BootstrapService
+	ns service.NamespaceService
+}
+
+var _ sentryrpc.ClusterNamespaceServiceServer = (*clusterNamespaceServer)(nil)
+
+// NewClusterNamespaceServer returns new server for namespace inventory
+// reported by clusters
+func NewClusterNamespaceServer(bs service.BootstrapService, ns service.NamespaceService) sentryrpc.ClusterNamespaceServiceServer {
+	return &clusterNamespaceServer{bs: bs, ns: ns}
+}
+
+// ReportNamespaces syncs the namespace inventory of the cluster
+func (s *clusterNamespaceServer) ReportNamespaces(ctx context.Context, req *sentryrpc.ReportNamespacesRequest) (*sentryrpc.ReportNamespacesResponse, error) {
+	clusterID, err := peerClusterID(ctx, s.bs, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.ns.ReportClusterNamespaces(ctx, clusterID, req.Namespaces)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error syncing cluster namespaces", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
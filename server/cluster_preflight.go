@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+)
+
+type clusterPreflightServer struct {
+	bs service.BootstrapService
+	cs service.ClusterService
+}
+
+var _ sentryrpc.ClusterPreflightServiceServer = (*clusterPreflightServer)(nil)
+
+// NewClusterPreflightServer returns new server for agents checking
+// connectivity to the relays through the configured proxy
+func NewClusterPreflightServer(bs service.BootstrapService, cs service.ClusterService) sentryrpc.ClusterPreflightServiceServer {
+	return &clusterPreflightServer{bs: bs, cs: cs}
+}
+
+// GetPreflight returns the relay endpoints and proxy the agent checks
+func (s *clusterPreflightServer) GetPreflight(ctx context.Context, req *sentryrpc.GetPreflightRequest) (*sentryrpc.GetPreflightResponse, error) {
+	clusterID, err := peerClusterID(ctx, s.bs, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.cs.GetClusterPreflight(ctx, clusterID)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error getting cluster preflight", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReportPreflight records the connectivity results of the agent
+func (s *clusterPreflightServer) ReportPreflight(ctx context.Context, req *sentryrpc.ReportPreflightRequest) (*sentryrpc.ReportPreflightResponse, error) {
+	clusterID, err := peerClusterID(ctx, s.bs, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.cs.ReportClusterPreflight(ctx, clusterID, req.Results)
+	if err != nil {
+		_log.WithContext(ctx).Errorw("error reporting cluster preflight", "cluster", clusterID, "error", err.Error())
+		return nil, err
+	}
+	return &sentryrpc.ReportPreflightResponse{}, nil
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/scheduler"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrapbv3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type clusterGroupServer struct {
+	service.ClusterGroupService
+}
+
+// NewClusterGroupServer returns new cluster group server implementation
+func NewClusterGroupServer(cgs service.ClusterGroupService) rpcv3.ClusterGroupServiceServer {
+	return &clusterGroupServer{cgs}
+}
+
+func updateClusterGroupStatus(req *infrapbv3.ClusterGroup, resp *infrapbv3.ClusterGroup, err error) *infrapbv3.ClusterGroup {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{ConditionStatus: commonv3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *clusterGroupServer) CreateClusterGroup(ctx context.Context, req *infrapbv3.ClusterGroup) (*infrapbv3.ClusterGroup, error) {
+	resp, err := s.Create(ctx, req)
+	return updateClusterGroupStatus(req, resp, err), err
+}
+
+func (s *clusterGroupServer) GetClusterGroups(ctx context.Context, req *commonv3.QueryOptions) (*infrapbv3.ClusterGroupList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *clusterGroupServer) GetClusterGroup(ctx context.Context, req *infrapbv3.ClusterGroup) (*infrapbv3.ClusterGroup, error) {
+	resp, err := s.Get(ctx, req)
+	return updateClusterGroupStatus(req, resp, err), err
+}
+
+func (s *clusterGroupServer) UpdateClusterGroup(ctx context.Context, req *infrapbv3.ClusterGroup) (*infrapbv3.ClusterGroup, error) {
+	resp, err := s.Update(ctx, req)
+	return updateClusterGroupStatus(req, resp, err), err
+}
+
+func (s *clusterGroupServer) DeleteClusterGroup(ctx context.Context, req *infrapbv3.ClusterGroup) (*rpcv3.DeleteClusterGroupResponse, error) {
+	err := s.Delete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcv3.DeleteClusterGroupResponse{}, nil
+}
+
+func (s *clusterGroupServer) RotateClusterGroupToken(ctx context.Context, req *infrapbv3.ClusterGroup) (*infrapbv3.ClusterGroup, error) {
+	resp, err := s.RotateToken(ctx, req)
+	return updateClusterGroupStatus(req, resp, err), err
+}
+
+func (s *clusterGroupServer) RevokeClusterGroupSessions(ctx context.Context, req *infrapbv3.ClusterGroup) (*infrapbv3.ClusterGroup, error) {
+	resp, err := s.RevokeSessions(ctx, req)
+	return updateClusterGroupStatus(req, resp, err), err
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	systemrpc "github.com/paralus/paralus/proto/rpc/system"
+	systempbv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+)
+
+type configServer struct {
+	service.ConfigService
+}
+
+// NewConfigServer returns new config server implementation
+func NewConfigServer(cs service.ConfigService) systemrpc.ConfigServiceServer {
+	return &configServer{cs}
+}
+
+func (s *configServer) ApplyConfig(ctx context.Context, req *systempbv3.Config) (*systempbv3.Config, error) {
+	return s.Apply(ctx, req)
+}
+
+func (s *configServer) ExportConfig(ctx context.Context, req *systempbv3.Config) (*systempbv3.Config, error) {
+	return s.Export(ctx, req)
+}
@@ -30,7 +30,10 @@ func updateGroupStatus(req *userpbv3.Group, resp *userpbv3.Group, err error) *us
 		}
 		return req
 	}
-	resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	// status set by the service carries warnings like unknown namespaces
+	if resp.GetStatus().GetConditionStatus() != v3.ConditionStatus_StatusOK {
+		resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	}
 	return resp
 }
 
@@ -26,7 +26,7 @@ func (s *idpServer) GetIdp(ctx context.Context, idp *systemv3.Idp) (*systemv3.Id
 }
 
 func (s *idpServer) ListIdps(ctx context.Context, _ *emptypb.Empty) (*systemv3.IdpList, error) {
-	return s.IdpService.List(ctx)
+	return s.IdpService.List(ctx, nil)
 }
 
 func (s *idpServer) UpdateIdp(ctx context.Context, idp *systemv3.Idp) (*systemv3.Idp, error) {
@@ -59,7 +59,7 @@ func (s *kubeConfigServer) GetForClusterWebSession(ctx context.Context, in *sent
 func (s *kubeConfigServer) GetForUser(ctx context.Context, in *sentryrpc.GetForUserRequest) (*commonv3.HttpBody, error) {
 	config, err := kubeconfig.GetConfigForUser(ctx, s.bs, s.aps, s.gps, in, s.pf, s.kss, s.ks, s.os, s.ps, s.al)
 	if err != nil {
-		_log.Errorw("error generating kubeconfig", "error", err.Error())
+		_log.WithContext(ctx).Errorw("error generating kubeconfig", "error", err.Error())
 		return nil, err
 	}
 	return &commonv3.HttpBody{
@@ -184,8 +184,8 @@ func (s *kubeConfigServer) UpdateUserSetting(ctx context.Context, req *sentryrpc
 	if err != nil {
 		return nil, err
 	}
-	_log.Infow("UpdateUserSetting", "req.EnforceOrgAdminSecretAccess", req.EnforceOrgAdminSecretAccess)
-	_log.Infow("UpdateUserSetting", "req.DisableWebKubectl", req.DisableWebKubectl)
+	_log.WithContext(ctx).Infow("UpdateUserSetting", "req.EnforceOrgAdminSecretAccess", req.EnforceOrgAdminSecretAccess)
+	_log.WithContext(ctx).Infow("UpdateUserSetting", "req.DisableWebKubectl", req.DisableWebKubectl)
 
 	err = s.kss.Patch(ctx, &sentry.KubeconfigSetting{
 		OrganizationID:              opts.Organization,
@@ -279,8 +279,8 @@ func (s *kubeConfigServer) UpdateSSOUserSetting(ctx context.Context, req *sentry
 	if err != nil {
 		return nil, err
 	}
-	_log.Infow("UpdateSSOUserSetting", "req.EnforceOrgAdminSecretAccess", req.EnforceOrgAdminSecretAccess)
-	_log.Infow("UpdateSSOUserSetting", "req.DisableWebKubectl", req.DisableWebKubectl)
+	_log.WithContext(ctx).Infow("UpdateSSOUserSetting", "req.EnforceOrgAdminSecretAccess", req.EnforceOrgAdminSecretAccess)
+	_log.WithContext(ctx).Infow("UpdateSSOUserSetting", "req.DisableWebKubectl", req.DisableWebKubectl)
 
 	err = s.kss.Patch(ctx, &sentry.KubeconfigSetting{
 		OrganizationID:              opts.Organization,
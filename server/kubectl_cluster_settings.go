@@ -23,17 +23,17 @@ func (s *kubectlClusterSettingsServer) GetKubectlClusterSettings(ctx context.Con
 
 	clusterID, err := util.GetClusterScope(opts.UrlScope)
 	if err != nil {
-		_log.Infow("get kubectl cluster settings failed to get clusterID", "opts", opts)
+		_log.WithContext(ctx).Infow("get kubectl cluster settings failed to get clusterID", "opts", opts)
 		return nil, err
 	}
 
 	cnt, err := s.bs.GetBootstrapAgentCountForClusterID(ctx, clusterID, opts.Organization)
 	if err != nil {
-		_log.Infow("get kubectl cluster settings invalid request", "opts", opts, "cluster", clusterID)
+		_log.WithContext(ctx).Infow("get kubectl cluster settings invalid request", "opts", opts, "cluster", clusterID)
 		return nil, err
 	}
 
-	_log.Infow("get kubectl cluster settings ", "cnt", cnt, "opts", opts, "clusterID", clusterID)
+	_log.WithContext(ctx).Infow("get kubectl cluster settings ", "cnt", cnt, "opts", opts, "clusterID", clusterID)
 
 	kc, err := s.kcs.Get(ctx, opts.Organization, clusterID)
 	if err == constants.ErrNotFound {
@@ -72,15 +72,15 @@ func (s *kubectlClusterSettingsServer) UpdateKubectlClusterSettings(ctx context.
 
 	clusterID, err := util.GetClusterScope(opts.UrlScope)
 	if err != nil {
-		_log.Infow("update kubectl cluster settings failed to get clusterID", "opts", opts)
+		_log.WithContext(ctx).Infow("update kubectl cluster settings failed to get clusterID", "opts", opts)
 		return nil, err
 	}
 
-	_log.Infow("update kubectl cluster settings ", "opts", opts, "clusterID", clusterID)
+	_log.WithContext(ctx).Infow("update kubectl cluster settings ", "opts", opts, "clusterID", clusterID)
 
 	_, err = s.bs.GetBootstrapAgentCountForClusterID(ctx, clusterID, opts.Organization)
 	if err != nil {
-		_log.Infow("update kubectl cluster settings invalid request", "opts", opts, "cluster", clusterID)
+		_log.WithContext(ctx).Infow("update kubectl cluster settings invalid request", "opts", opts, "cluster", clusterID)
 		return nil, err
 	}
 
@@ -101,7 +101,7 @@ func (s *kubectlClusterSettingsServer) UpdateKubectlClusterSettings(ctx context.
 		return nil, err
 	}
 
-	_log.Infow("updated kubectl cluster setting with values ", clusterName, userAgent, host, remoteAddr)
+	_log.WithContext(ctx).Infow("updated kubectl cluster setting with values ", clusterName, userAgent, host, remoteAddr)
 
 	/*TODO: to be done with events
 	partnerID := opts.Partner
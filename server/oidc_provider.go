@@ -24,7 +24,7 @@ func (s *oidcProvider) GetOIDCProvider(ctx context.Context, p *systemv3.OIDCProv
 	return s.GetByName(ctx, p)
 }
 func (s *oidcProvider) ListOIDCProvider(ctx context.Context, p *emptypb.Empty) (*systemv3.OIDCProviderList, error) {
-	return s.List(ctx)
+	return s.List(ctx, nil)
 }
 func (s *oidcProvider) UpdateOIDCProvider(ctx context.Context, p *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error) {
 	return s.Update(ctx, p)
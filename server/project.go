@@ -28,7 +28,10 @@ func updateProjectStatus(req *systempbv3.Project, resp *systempbv3.Project, err
 		}
 		return req
 	}
-	resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	// status set by the service carries warnings like unknown namespaces
+	if resp.GetStatus().GetConditionStatus() != v3.ConditionStatus_StatusOK {
+		resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	}
 	return resp
 }
 
@@ -73,6 +73,10 @@ func (r *relayAuditServer) GetRelayAuditByProjects(ctx context.Context, req *v1.
 	return
 }
 
+func (r *relayAuditServer) GetRelaySessions(ctx context.Context, req *v1.RelaySessionRequest) (*v1.RelaySessionResponse, error) {
+	return r.rs.GetRelaySessions(ctx, req)
+}
+
 func convertRelayToAuditSearchRequest(req *v1.RelayAuditRequest) (*v1.GetAuditLogSearchRequest, error) {
 	reqByte, err := json.Marshal(req)
 	if err != nil {
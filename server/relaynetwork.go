@@ -0,0 +1,123 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/fixtures"
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+
+	"github.com/paralus/paralus/pkg/sentry/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	peeringServerTemplate = "paralus-sentry-peering-server"
+	defaultRelayPort      = 443
+)
+
+func (s *bootstrapServer) CreateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return s.rns.Create(ctx, in)
+}
+
+func (s *bootstrapServer) GetRelayNetworks(ctx context.Context, in *sentryrpc.GetRelayNetworksRequest) (*sentry.RelayNetworkList, error) {
+	return s.rns.List(ctx, query.WithOptions(in.Opts))
+}
+
+func (s *bootstrapServer) GetRelayNetwork(ctx context.Context, in *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return s.rns.Get(ctx, in)
+}
+
+func (s *bootstrapServer) UpdateRelayNetwork(ctx context.Context, in *sentry.RelayNetwork) (*sentry.RelayNetwork, error) {
+	return s.rns.Update(ctx, in)
+}
+
+func (s *bootstrapServer) DeleteRelayNetwork(ctx context.Context, in *sentry.RelayNetwork) (*sentryrpc.DeleteRelayNetworkResponse, error) {
+	err := s.rns.Delete(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &sentryrpc.DeleteRelayNetworkResponse{}, nil
+}
+
+// GetRelayNetworkDownload renders the deployment of the relay serving the
+// relay network
+func (s *bootstrapServer) GetRelayNetworkDownload(ctx context.Context, in *sentry.RelayNetwork) (*commonv3.HttpBody, error) {
+	rn, err := s.rns.Get(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	userTemplate, err := s.bs.GetBootstrapAgentTemplate(ctx, rn.Status.UserTemplate)
+	if err != nil {
+		return nil, err
+	}
+	connectorTemplate, err := s.bs.GetBootstrapAgentTemplate(ctx, rn.Status.ConnectorTemplate)
+	if err != nil {
+		return nil, err
+	}
+	peerServer, err := s.bs.GetBootstrapAgentTemplate(ctx, peeringServerTemplate)
+	if err != nil {
+		return nil, err
+	}
+	// relays register as peering clients with the token of their own
+	// network, the template is created when the network is updated
+	peerClient, err := s.bs.GetBootstrapAgentTemplate(ctx, service.RelayNetworkPeerTemplateName(rn.Metadata.Id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "relay network %s has no peering token, update the relay network to issue it", rn.Metadata.Name)
+		}
+		return nil, err
+	}
+
+	data := RelayNetworkDownloadData{
+		SentryAddr:     s.dd.ControlAddr,
+		PeerHost:       externalHost(peerServer),
+		PeerToken:      peerClient.Spec.Token,
+		UserToken:      userTemplate.Spec.Token,
+		ConnectorToken: connectorTemplate.Spec.Token,
+		RelayImage:     s.dd.RelayAgentImage,
+		UserHostPort:   hostPort(rn.Spec.UserHost),
+		RelayHostPort:  hostPort(rn.Spec.ConnectorHost),
+		RelayNetworkID: rn.Metadata.Id,
+	}
+
+	bb := new(bytes.Buffer)
+	if err := fixtures.RelayTemplate.Execute(bb, map[string]interface{}{"DownloadData": data}); err != nil {
+		return nil, err
+	}
+
+	service.CreateRelayNetworkActionAuditEvent(ctx, s.al, service.AuditActionDownload, "relay manifest downloaded", rn.Metadata.Name, uuid.MustParse(rn.Metadata.Id))
+
+	return &commonv3.HttpBody{
+		ContentType: "application/yaml",
+		Data:        bb.Bytes(),
+	}, nil
+}
+
+func externalHost(bat *sentry.BootstrapAgentTemplate) string {
+	for _, host := range bat.Spec.Hosts {
+		if host.Type == sentry.BootstrapTemplateHostType_HostTypeExternal {
+			return host.Host
+		}
+	}
+	return ""
+}
+
+// hostPort returns the port of the host, the relay listens on 443 when
+// the host does not have one
+func hostPort(host string) string {
+	_, port := util.ParseAddr(host)
+	if port == 0 {
+		port = defaultRelayPort
+	}
+	return fmt.Sprintf("%d", port)
+}
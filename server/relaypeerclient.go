@@ -63,7 +63,7 @@ func GetPeerCache(cache *ristretto.Cache, key interface{}) (string, bool) {
 
 // sends periodic heartbeats to core service
 func helloRPCSend(ctx context.Context, stream relayrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string) {
-	_log.Infow("send first hello")
+	_log.WithContext(ctx).Infow("send first hello")
 	msg := &relayrpc.PeerHelloRequest{
 		Relayuuid: relayUUID,
 		Relayip:   ip(),
@@ -71,7 +71,7 @@ func helloRPCSend(ctx context.Context, stream relayrpc.RelayPeerService_RelayPee
 	// send first hello message
 	err := stream.Send(msg)
 	if err != nil {
-		_log.Errorw("failed to send hello message", "error", err)
+		_log.WithContext(ctx).Errorw("failed to send hello message", "error", err)
 		return
 	}
 
@@ -87,12 +87,12 @@ helloRPCSendLoop:
 		case <-tick.C:
 			err := stream.Send(msg)
 			if err != nil {
-				_log.Errorw("failed to send hello message", err)
+				_log.WithContext(ctx).Errorw("failed to send hello message", err)
 				break helloRPCSendLoop
 			}
 		}
 	}
-	_log.Debugw("Exit: helloRPCSendLoop")
+	_log.WithContext(ctx).Debugw("Exit: helloRPCSendLoop")
 }
 
 // ClientHelloRPC will handle periodic heartbeat messages between relay and the core service.
@@ -103,17 +103,17 @@ func ClientHelloRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayP
 	for {
 		in, err := stream.Recv()
 		if err != nil {
-			_log.Errorw("helloRPC stream recv error", err)
+			_log.WithContext(ctx).Errorw("helloRPC stream recv error", err)
 			stream.CloseSend()
 			break
 		}
-		_log.Debugw("recvd hello resp from service",
+		_log.WithContext(ctx).Debugw("recvd hello resp from service",
 			"serviceip", in.GetServiceip(),
 			"serviceuuid", in.GetServiceuuid(),
 		)
 	}
 
-	_log.Debugw("stopping helloRPC routine")
+	_log.WithContext(ctx).Debugw("stopping helloRPC routine")
 }
 
 // ClientTLSConfig sets tls config
@@ -161,14 +161,14 @@ probeRPCSendLoop:
 	for {
 		select {
 		case clustersni := <-peerProbeChanel:
-			_log.Debugw("probeRPCSend", "clustersni", clustersni)
+			_log.WithContext(ctx).Debugw("probeRPCSend", "clustersni", clustersni)
 			msg := &relayrpc.PeerProbeRequest{
 				Relayuuid:  relayUUID,
 				Clustersni: clustersni,
 			}
 			err := stream.Send(msg)
 			if err != nil {
-				_log.Errorw(
+				_log.WithContext(ctx).Errorw(
 					"failed to send probe message for ",
 					"clustersni", clustersni,
 					"error", err,
@@ -182,7 +182,7 @@ probeRPCSendLoop:
 		}
 
 	}
-	_log.Debugw("exit: probeRPCSendLoop")
+	_log.WithContext(ctx).Debugw("exit: probeRPCSendLoop")
 }
 
 // ClientProbeRPC will manage the probes.
@@ -202,7 +202,7 @@ func ClientProbeRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayP
 		stream.CloseSend()
 		return
 	}
-	_log.Debugw("probeRPC send first", "msg", msg)
+	_log.WithContext(ctx).Debugw("probeRPC send first", "msg", msg)
 
 	go probeRPCSend(ctx, stream, relayUUID, peerProbeChanel)
 
@@ -223,7 +223,7 @@ func ClientProbeRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayP
 			for _, item := range items {
 				matched, err := regexp.Match(relayUUID, []byte(item.Relayuuid))
 				if err == nil && matched {
-					_log.Errorw("skip duplicate probe resp",
+					_log.WithContext(ctx).Errorw("skip duplicate probe resp",
 						"relayuuid", relayUUID,
 						"recvd-relayuuid", item.Relayuuid,
 					)
@@ -233,7 +233,7 @@ func ClientProbeRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayP
 
 				ipAddr := ip()
 				if ipAddr != "" && ipAddr == item.Relayip {
-					_log.Errorw("skip duplicate probe resp", "ip address", item.Relayip)
+					_log.WithContext(ctx).Errorw("skip duplicate probe resp", "ip address", item.Relayip)
 					//ip is same as this relay skip this entry
 					continue
 				}
@@ -242,27 +242,27 @@ func ClientProbeRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayP
 				cachevalue = append(cachevalue, v)
 			}
 
-			_log.Infow(
+			_log.WithContext(ctx).Infow(
 				"cache probeRPC response",
 				"key", clustersni,
 				"value", cachevalue,
 			)
 			//insert to peer cache
 			if !InsertPeerCache(pcache, expiry, clustersni, cachevalue) {
-				_log.Errorw(
+				_log.WithContext(ctx).Errorw(
 					"failed cache probeRPC response",
 					"key", clustersni,
 					"value", cachevalue,
 				)
 			}
 		} else {
-			_log.Errorw(
+			_log.WithContext(ctx).Errorw(
 				"prob response with empty items for ", clustersni,
 			)
 		}
 	}
 
-	_log.Debug(
+	_log.WithContext(ctx).Debug(
 		"stopping probeRPC routine",
 	)
 }
@@ -291,7 +291,7 @@ func ClientSurveyRPC(ctx context.Context, stream relayrpc.RelayPeerService_Relay
 		return
 	}
 
-	_log.Debugw("surveyRPC send first", "msg", msg)
+	_log.WithContext(ctx).Debugw("surveyRPC send first", "msg", msg)
 	go func() {
 	surveyRPCStremWatch:
 		for {
@@ -314,7 +314,7 @@ func ClientSurveyRPC(ctx context.Context, stream relayrpc.RelayPeerService_Relay
 
 		clustersni := surveyReq.GetClustersni()
 		if clustersni == "" {
-			_log.Errorw(
+			_log.WithContext(ctx).Errorw(
 				"prob response with empty items for ",
 				"clustersni", clustersni,
 			)
@@ -323,14 +323,14 @@ func ClientSurveyRPC(ctx context.Context, stream relayrpc.RelayPeerService_Relay
 
 		//lookup the local dialin table for connections\
 		cnt := dialinlookup(clustersni)
-		_log.Infow(
+		_log.WithContext(ctx).Infow(
 			"survey lookup",
 			"key", clustersni,
 			"count", cnt,
 		)
 
 		if relayIP == "" {
-			_log.Errorw(
+			_log.WithContext(ctx).Errorw(
 				"survey failed to get relay ip",
 				"key", clustersni,
 			)
@@ -345,7 +345,7 @@ func ClientSurveyRPC(ctx context.Context, stream relayrpc.RelayPeerService_Relay
 			}
 			err = stream.Send(msg)
 			if err != nil {
-				_log.Errorw(
+				_log.WithContext(ctx).Errorw(
 					"survey send response failed",
 					"key", clustersni,
 					"error", err,
@@ -357,7 +357,7 @@ func ClientSurveyRPC(ctx context.Context, stream relayrpc.RelayPeerService_Relay
 
 	}
 
-	_log.Debug(
+	_log.WithContext(ctx).Debug(
 		"stopping probeRPC routine",
 	)
 }
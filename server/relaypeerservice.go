@@ -516,14 +516,14 @@ func (s *relayPeerService) RelayPeerProbeRPC(stream sentryrpc.RelayPeerService_R
 
 // relayPeerSurveySender send routine to handle sending probe messges
 func (s *relayPeerService) relayPeerSurveySender(ctx context.Context, stream sentryrpc.RelayPeerService_RelayPeerSurveyRPCServer, relayuuid string, robj *relayObject) {
-	_log.Debugw("started relayPeerSurveySender")
+	_log.WithContext(ctx).Debugw("started relayPeerSurveySender")
 	for {
 		select {
 		case <-ctx.Done():
 			s.putRelayObject(relayuuid, robj.ou)
 			return
 		case surveyRequest := <-robj.surveyRequestChnl:
-			_log.Debugw("msg recvd from survey chnl sending to stream")
+			_log.WithContext(ctx).Debugw("msg recvd from survey chnl sending to stream")
 			err := stream.Send(&surveyRequest)
 			if err != nil {
 				s.putRelayObject(relayuuid, robj.ou)
@@ -14,13 +14,14 @@ import (
 )
 
 type userServer struct {
-	us service.UserService
-	ks service.ApiKeyService
+	us  service.UserService
+	ks  service.ApiKeyService
+	cls service.CliLoginService
 }
 
 // NewUserServer returns new user server implementation
-func NewUserServer(ps service.UserService, as service.ApiKeyService) rpcv3.UserServiceServer {
-	return &userServer{us: ps, ks: as}
+func NewUserServer(ps service.UserService, as service.ApiKeyService, cls service.CliLoginService) rpcv3.UserServiceServer {
+	return &userServer{us: ps, ks: as, cls: cls}
 }
 func updateUserStatus(req *userpbv3.User, resp *userpbv3.User, err error) *userpbv3.User {
 	if err != nil {
@@ -31,7 +32,10 @@ func updateUserStatus(req *userpbv3.User, resp *userpbv3.User, err error) *userp
 		}
 		return req
 	}
-	resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	// status set by the service carries warnings like unknown namespaces
+	if resp.GetStatus().GetConditionStatus() != v3.ConditionStatus_StatusOK {
+		resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	}
 	return resp
 }
 
@@ -107,6 +111,45 @@ func (s *userServer) DownloadCliConfig(ctx context.Context, req *rpcv3.CliConfig
 	}, nil
 }
 
+func (s *userServer) StartCliLogin(ctx context.Context, req *rpcv3.CliLoginRequest) (*rpcv3.CliLoginResponse, error) {
+	return s.cls.Start(ctx)
+}
+
+func (s *userServer) GetCliLoginVerification(ctx context.Context, req *rpcv3.CliLoginVerifyRequest) (*v3.HttpBody, error) {
+	page, err := s.cls.VerificationPage(ctx, req.UserCode)
+	if err != nil {
+		return nil, err
+	}
+	return &v3.HttpBody{
+		ContentType: "text/html; charset=utf-8",
+		Data:        page,
+	}, nil
+}
+
+func (s *userServer) ApproveCliLogin(ctx context.Context, req *rpcv3.CliLoginApproveRequest) (*rpcv3.CliLoginApproveResponse, error) {
+	if err := s.cls.Approve(ctx, req.UserCode); err != nil {
+		return nil, err
+	}
+	return &rpcv3.CliLoginApproveResponse{}, nil
+}
+
+func (s *userServer) GetCliLoginConfig(ctx context.Context, req *rpcv3.CliLoginConfigRequest) (*v3.HttpBody, error) {
+	cliConfig, err := s.cls.Config(ctx, req.DeviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := json.Marshal(cliConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v3.HttpBody{
+		ContentType: "application/json",
+		Data:        bb,
+	}, nil
+}
+
 func (s *userServer) UserListApiKeys(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.UserListApiKeysResponse, error) {
 	return s.ks.List(ctx, req)
 }
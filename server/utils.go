@@ -1,7 +1,13 @@
 package server
 
 import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/grpc"
+	"github.com/paralus/paralus/pkg/service"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -18,3 +24,25 @@ func getStatus(err error) *v3.Status {
 		ConditionStatus: v3.ConditionStatus_StatusOK,
 	}
 }
+
+// peerClusterID returns the id of the cluster the client certificate of
+// the peer was issued to. Agent certificates carry the token of the
+// bootstrap agent of the cluster as CN, the agent is named after the
+// cluster. A cluster id sent in the request has to match it.
+func peerClusterID(ctx context.Context, bs service.BootstrapService, requested string) (string, error) {
+	cn, err := grpc.GetClientName(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "client certificate is missing")
+	}
+	agent, err := bs.GetBootstrapAgentForToken(ctx, cn)
+	if err != nil {
+		_log.WithContext(ctx).Infow("unable to find bootstrap agent of client certificate", "error", err)
+		return "", status.Error(codes.PermissionDenied, "client certificate is not issued to a cluster")
+	}
+	clusterID := agent.GetMetadata().GetName()
+	if requested != "" && requested != clusterID {
+		_log.WithContext(ctx).Infow("cluster id of request does not match client certificate", "requested", requested, "cluster", clusterID)
+		return "", status.Error(codes.PermissionDenied, "cluster id does not match client certificate")
+	}
+	return clusterID, nil
+}